@@ -1,6 +1,7 @@
 package config
 
 import (
+	"github.com/lt90s/rfschub-server/common/mail"
 	"github.com/micro/go-config"
 	"github.com/micro/go-config/source/env"
 	"github.com/micro/go-config/source/file"
@@ -11,6 +12,50 @@ type AccountConfig struct {
 	Name    string        `json:"name"`
 	Store   string        `json:"store"`
 	Mongodb MongodbConfig `json:"mongodb"`
+	Mail    mail.Config   `json:"mail"`
+	// link in activation mails, with {code} replaced
+	ActivateLink string `json:"activateLink"`
+	// links in password reset and email confirmation mails, with {token} replaced
+	ResetPasswordLink string `json:"resetPasswordLink"`
+	ConfirmEmailLink  string `json:"confirmEmailLink"`
+	// lifetime of password reset and email confirmation tokens, like "1h"
+	TokenExpire string `json:"tokenExpire"`
+	// sessions not refreshed for this long expire, like "720h"
+	SessionExpire string `json:"sessionExpire"`
+	// link of avatars uploaded, with {key} replaced by the blob key
+	AvatarLink string `json:"avatarLink"`
+	// avatar of accounts without one uploaded, with {hash} replaced by md5
+	// of the email. Empty to disable gravatar
+	GravatarLink string `json:"gravatarLink"`
+	// failed logins of an account or address beyond the free attempts lock
+	// it out for BaseDelay, doubled on every further failure up to MaxDelay.
+	// Failures are forgotten after Window without any of them
+	LoginThrottle LoginThrottleConfig `json:"loginThrottle"`
+	// audit events are kept for this long, like "2160h"
+	AuditExpire string `json:"auditExpire"`
+	// issuer shown by authenticator apps
+	TotpIssuer string `json:"totpIssuer"`
+	// names of accounts with the admin role, the role is revoked from
+	// accounts removed from the list on restart
+	Admins []string `json:"admins"`
+	// project service, data of accounts is exported from and deleted in it
+	Project string `json:"project"`
+	// notification service, notifications of accounts are deleted in it
+	Notification string `json:"notification"`
+	// accounts are deleted this long after users request it, like "720h",
+	// and can be restored until then
+	DeletionGrace string `json:"deletionGrace"`
+	// interval of deleting accounts due, like "1h". Accounts are not
+	// deleted if it is empty
+	DeletionInterval string `json:"deletionInterval"`
+}
+
+type LoginThrottleConfig struct {
+	AccountFreeAttempts int    `json:"accountFreeAttempts"`
+	IpFreeAttempts      int    `json:"ipFreeAttempts"`
+	BaseDelay           string `json:"baseDelay"`
+	MaxDelay            string `json:"maxDelay"`
+	Window              string `json:"window"`
 }
 
 type MongodbConfig struct {
@@ -25,6 +70,30 @@ var DefaultConfig = AccountConfig{
 		Uri:      "mongodb://127.0.0.1:27017",
 		Database: "rfschub",
 	},
+	Mail: mail.Config{
+		Transport: "stdout",
+		From:      "rfschub <noreply@rfschub.local>",
+	},
+	ActivateLink:      "http://127.0.0.1:8080/activate?code={code}",
+	ResetPasswordLink: "http://127.0.0.1:8080/reset-password?token={token}",
+	ConfirmEmailLink:  "http://127.0.0.1:8080/confirm-email?token={token}",
+	TokenExpire:       "1h",
+	SessionExpire:     "720h",
+	AvatarLink:        "http://127.0.0.1:8888/avatar/{key}",
+	GravatarLink:      "https://www.gravatar.com/avatar/{hash}?d=identicon&s=256",
+	LoginThrottle: LoginThrottleConfig{
+		AccountFreeAttempts: 5,
+		IpFreeAttempts:      20,
+		BaseDelay:           "2s",
+		MaxDelay:            "15m",
+		Window:              "1h",
+	},
+	AuditExpire:      "2160h",
+	TotpIssuer:       "rfschub",
+	Project:          "ProjectService",
+	Notification:     "NotificationService",
+	DeletionGrace:    "720h",
+	DeletionInterval: "1h",
 }
 
 func init() {
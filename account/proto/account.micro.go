@@ -39,6 +39,87 @@ type AccountService interface {
 	AccountId(ctx context.Context, in *AccountIdRequest, opts ...client.CallOption) (*AccountIdResponse, error)
 	AccountInfoByName(ctx context.Context, in *AccountName, opts ...client.CallOption) (*AccountInfo, error)
 	AccountsBasicInfo(ctx context.Context, in *AccountsBasicInfoRequest, opts ...client.CallOption) (*AccountsBasicInfoResponse, error)
+	// basic info of accounts by names, names not found are missing
+	AccountsBasicInfoByNames(ctx context.Context, in *AccountsBasicInfoByNamesRequest, opts ...client.CallOption) (*AccountsBasicInfoResponse, error)
+	// activate an account with the code mailed on registration
+	Activate(ctx context.Context, in *ActivateRequest, opts ...client.CallOption) (*ActivateResponse, error)
+	// mail a new activation code if email belongs to an account not activated
+	ResendActivation(ctx context.Context, in *ResendActivationRequest, opts ...client.CallOption) (*ResendActivationResponse, error)
+	// emails of activated accounts, for services mailing users
+	AccountsEmail(ctx context.Context, in *AccountsEmailRequest, opts ...client.CallOption) (*AccountsEmailResponse, error)
+	// mail a single use password reset token if email belongs to an account
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...client.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...client.CallOption) (*ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*ChangePasswordResponse, error)
+	// mail a confirmation token to the new email, email is changed after confirmation
+	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...client.CallOption) (*ChangeEmailResponse, error)
+	ConfirmEmail(ctx context.Context, in *ConfirmEmailRequest, opts ...client.CallOption) (*ConfirmEmailResponse, error)
+	// tokens issued with an older version are invalid
+	TokenVersion(ctx context.Context, in *TokenVersionRequest, opts ...client.CallOption) (*TokenVersionResponse, error)
+	// login with an identity of an external provider, the identity is linked
+	// to account uid if it is not empty, or to an account created for it
+	OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...client.CallOption) (*OAuthLoginResponse, error)
+	Identities(ctx context.Context, in *IdentitiesRequest, opts ...client.CallOption) (*IdentitiesResponse, error)
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...client.CallOption) (*UnlinkIdentityResponse, error)
+	// personal access tokens for scripts, the token is only returned when
+	// it is created
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...client.CallOption) (*CreateAccessTokenResponse, error)
+	AccessTokens(ctx context.Context, in *AccessTokensRequest, opts ...client.CallOption) (*AccessTokensResponse, error)
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...client.CallOption) (*RevokeAccessTokenResponse, error)
+	// find account and scopes of an access token and record its use
+	VerifyAccessToken(ctx context.Context, in *VerifyAccessTokenRequest, opts ...client.CallOption) (*VerifyAccessTokenResponse, error)
+	// a session is created on login and kept by its refresh token, jwt
+	// tokens are issued to sessions and are valid as long as the session is
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...client.CallOption) (*CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...client.CallOption) (*RefreshSessionResponse, error)
+	CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...client.CallOption) (*CheckSessionResponse, error)
+	Sessions(ctx context.Context, in *SessionsRequest, opts ...client.CallOption) (*SessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...client.CallOption) (*RevokeSessionResponse, error)
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*RevokeSessionsResponse, error)
+	// public profile of an account
+	Profile(ctx context.Context, in *ProfileRequest, opts ...client.CallOption) (*ProfileResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...client.CallOption) (*UpdateProfileResponse, error)
+	// set blob key of the avatar uploaded, the blob of the key replaced is
+	// for the caller to remove
+	SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...client.CallOption) (*SetAvatarResponse, error)
+	// security events of an account, like logins and lockouts, newest first
+	AuditEvents(ctx context.Context, in *AuditEventsRequest, opts ...client.CallOption) (*AuditEventsResponse, error)
+	// two-factor authentication with time based one time passwords. A new
+	// secret is enabled once a code of it is confirmed
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...client.CallOption) (*EnrollTotpResponse, error)
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...client.CallOption) (*ConfirmTotpResponse, error)
+	// second step of logins of accounts with two-factor authentication,
+	// code is a one time password or a recovery code
+	VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...client.CallOption) (*VerifyTotpResponse, error)
+	TotpStatus(ctx context.Context, in *TotpStatusRequest, opts ...client.CallOption) (*TotpStatusResponse, error)
+	DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...client.CallOption) (*DisableTotpResponse, error)
+	// replace recovery codes, the old ones are invalid
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...client.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	// accounts whose name or email contains query, newest first, for admins
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...client.CallOption) (*ListAccountsResponse, error)
+	// disabled accounts can not login and their sessions are revoked, uid is
+	// the admin disabling it
+	SetAccountDisabled(ctx context.Context, in *SetAccountDisabledRequest, opts ...client.CallOption) (*SetAccountDisabledResponse, error)
+	// create an organization owned by uid, organizations and accounts share
+	// one namespace of names
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...client.CallOption) (*CreateOrganizationResponse, error)
+	// role of uid in org, organization is false if org is not an organization
+	OrganizationRole(ctx context.Context, in *OrganizationRoleRequest, opts ...client.CallOption) (*OrganizationRoleResponse, error)
+	OrganizationMembers(ctx context.Context, in *OrganizationMembersRequest, opts ...client.CallOption) (*OrganizationMembersResponse, error)
+	// add a member or change role of a member, by owners of the organization
+	SetOrganizationMember(ctx context.Context, in *SetOrganizationMemberRequest, opts ...client.CallOption) (*SetOrganizationMemberResponse, error)
+	// remove a member by owners, members can leave by themselves
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...client.CallOption) (*RemoveOrganizationMemberResponse, error)
+	// organizations uid is a member of
+	UserOrganizations(ctx context.Context, in *UserOrganizationsRequest, opts ...client.CallOption) (*UserOrganizationsResponse, error)
+	// zip of json files of the account, its projects and annotations
+	ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...client.CallOption) (*ExportAccountResponse, error)
+	// delete the account once the grace period passes, it can be cancelled
+	// until then. password is required if the account has one
+	RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, opts ...client.CallOption) (*RequestAccountDeletionResponse, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...client.CallOption) (*CancelAccountDeletionResponse, error)
+	// deleteAt is 0 if deletion is not requested
+	AccountDeletion(ctx context.Context, in *AccountDeletionRequest, opts ...client.CallOption) (*AccountDeletionResponse, error)
 }
 
 type accountService struct {
@@ -109,6 +190,456 @@ func (c *accountService) AccountsBasicInfo(ctx context.Context, in *AccountsBasi
 	return out, nil
 }
 
+func (c *accountService) AccountsBasicInfoByNames(ctx context.Context, in *AccountsBasicInfoByNamesRequest, opts ...client.CallOption) (*AccountsBasicInfoResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.AccountsBasicInfoByNames", in)
+	out := new(AccountsBasicInfoResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) Activate(ctx context.Context, in *ActivateRequest, opts ...client.CallOption) (*ActivateResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.Activate", in)
+	out := new(ActivateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ResendActivation(ctx context.Context, in *ResendActivationRequest, opts ...client.CallOption) (*ResendActivationResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ResendActivation", in)
+	out := new(ResendActivationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) AccountsEmail(ctx context.Context, in *AccountsEmailRequest, opts ...client.CallOption) (*AccountsEmailResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.AccountsEmail", in)
+	out := new(AccountsEmailResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...client.CallOption) (*RequestPasswordResetResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RequestPasswordReset", in)
+	out := new(RequestPasswordResetResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...client.CallOption) (*ResetPasswordResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ResetPassword", in)
+	out := new(ResetPasswordResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*ChangePasswordResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ChangePassword", in)
+	out := new(ChangePasswordResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...client.CallOption) (*ChangeEmailResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ChangeEmail", in)
+	out := new(ChangeEmailResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ConfirmEmail(ctx context.Context, in *ConfirmEmailRequest, opts ...client.CallOption) (*ConfirmEmailResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ConfirmEmail", in)
+	out := new(ConfirmEmailResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) TokenVersion(ctx context.Context, in *TokenVersionRequest, opts ...client.CallOption) (*TokenVersionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.TokenVersion", in)
+	out := new(TokenVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...client.CallOption) (*OAuthLoginResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.OAuthLogin", in)
+	out := new(OAuthLoginResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) Identities(ctx context.Context, in *IdentitiesRequest, opts ...client.CallOption) (*IdentitiesResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.Identities", in)
+	out := new(IdentitiesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...client.CallOption) (*UnlinkIdentityResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.UnlinkIdentity", in)
+	out := new(UnlinkIdentityResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...client.CallOption) (*CreateAccessTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.CreateAccessToken", in)
+	out := new(CreateAccessTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) AccessTokens(ctx context.Context, in *AccessTokensRequest, opts ...client.CallOption) (*AccessTokensResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.AccessTokens", in)
+	out := new(AccessTokensResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...client.CallOption) (*RevokeAccessTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RevokeAccessToken", in)
+	out := new(RevokeAccessTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) VerifyAccessToken(ctx context.Context, in *VerifyAccessTokenRequest, opts ...client.CallOption) (*VerifyAccessTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.VerifyAccessToken", in)
+	out := new(VerifyAccessTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...client.CallOption) (*CreateSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.CreateSession", in)
+	out := new(CreateSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...client.CallOption) (*RefreshSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RefreshSession", in)
+	out := new(RefreshSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...client.CallOption) (*CheckSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.CheckSession", in)
+	out := new(CheckSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) Sessions(ctx context.Context, in *SessionsRequest, opts ...client.CallOption) (*SessionsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.Sessions", in)
+	out := new(SessionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...client.CallOption) (*RevokeSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RevokeSession", in)
+	out := new(RevokeSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*RevokeSessionsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RevokeSessions", in)
+	out := new(RevokeSessionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) Profile(ctx context.Context, in *ProfileRequest, opts ...client.CallOption) (*ProfileResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.Profile", in)
+	out := new(ProfileResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...client.CallOption) (*UpdateProfileResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.UpdateProfile", in)
+	out := new(UpdateProfileResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...client.CallOption) (*SetAvatarResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.SetAvatar", in)
+	out := new(SetAvatarResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) AuditEvents(ctx context.Context, in *AuditEventsRequest, opts ...client.CallOption) (*AuditEventsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.AuditEvents", in)
+	out := new(AuditEventsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...client.CallOption) (*EnrollTotpResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.EnrollTotp", in)
+	out := new(EnrollTotpResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...client.CallOption) (*ConfirmTotpResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ConfirmTotp", in)
+	out := new(ConfirmTotpResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...client.CallOption) (*VerifyTotpResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.VerifyTotp", in)
+	out := new(VerifyTotpResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) TotpStatus(ctx context.Context, in *TotpStatusRequest, opts ...client.CallOption) (*TotpStatusResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.TotpStatus", in)
+	out := new(TotpStatusResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...client.CallOption) (*DisableTotpResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.DisableTotp", in)
+	out := new(DisableTotpResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...client.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RegenerateRecoveryCodes", in)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...client.CallOption) (*ListAccountsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ListAccounts", in)
+	out := new(ListAccountsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) SetAccountDisabled(ctx context.Context, in *SetAccountDisabledRequest, opts ...client.CallOption) (*SetAccountDisabledResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.SetAccountDisabled", in)
+	out := new(SetAccountDisabledResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...client.CallOption) (*CreateOrganizationResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.CreateOrganization", in)
+	out := new(CreateOrganizationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) OrganizationRole(ctx context.Context, in *OrganizationRoleRequest, opts ...client.CallOption) (*OrganizationRoleResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.OrganizationRole", in)
+	out := new(OrganizationRoleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) OrganizationMembers(ctx context.Context, in *OrganizationMembersRequest, opts ...client.CallOption) (*OrganizationMembersResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.OrganizationMembers", in)
+	out := new(OrganizationMembersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) SetOrganizationMember(ctx context.Context, in *SetOrganizationMemberRequest, opts ...client.CallOption) (*SetOrganizationMemberResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.SetOrganizationMember", in)
+	out := new(SetOrganizationMemberResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...client.CallOption) (*RemoveOrganizationMemberResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RemoveOrganizationMember", in)
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) UserOrganizations(ctx context.Context, in *UserOrganizationsRequest, opts ...client.CallOption) (*UserOrganizationsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.UserOrganizations", in)
+	out := new(UserOrganizationsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...client.CallOption) (*ExportAccountResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.ExportAccount", in)
+	out := new(ExportAccountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, opts ...client.CallOption) (*RequestAccountDeletionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.RequestAccountDeletion", in)
+	out := new(RequestAccountDeletionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...client.CallOption) (*CancelAccountDeletionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.CancelAccountDeletion", in)
+	out := new(CancelAccountDeletionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountService) AccountDeletion(ctx context.Context, in *AccountDeletionRequest, opts ...client.CallOption) (*AccountDeletionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountService.AccountDeletion", in)
+	out := new(AccountDeletionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AccountService service
 
 type AccountServiceHandler interface {
@@ -117,6 +648,87 @@ type AccountServiceHandler interface {
 	AccountId(context.Context, *AccountIdRequest, *AccountIdResponse) error
 	AccountInfoByName(context.Context, *AccountName, *AccountInfo) error
 	AccountsBasicInfo(context.Context, *AccountsBasicInfoRequest, *AccountsBasicInfoResponse) error
+	// basic info of accounts by names, names not found are missing
+	AccountsBasicInfoByNames(context.Context, *AccountsBasicInfoByNamesRequest, *AccountsBasicInfoResponse) error
+	// activate an account with the code mailed on registration
+	Activate(context.Context, *ActivateRequest, *ActivateResponse) error
+	// mail a new activation code if email belongs to an account not activated
+	ResendActivation(context.Context, *ResendActivationRequest, *ResendActivationResponse) error
+	// emails of activated accounts, for services mailing users
+	AccountsEmail(context.Context, *AccountsEmailRequest, *AccountsEmailResponse) error
+	// mail a single use password reset token if email belongs to an account
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest, *RequestPasswordResetResponse) error
+	ResetPassword(context.Context, *ResetPasswordRequest, *ResetPasswordResponse) error
+	ChangePassword(context.Context, *ChangePasswordRequest, *ChangePasswordResponse) error
+	// mail a confirmation token to the new email, email is changed after confirmation
+	ChangeEmail(context.Context, *ChangeEmailRequest, *ChangeEmailResponse) error
+	ConfirmEmail(context.Context, *ConfirmEmailRequest, *ConfirmEmailResponse) error
+	// tokens issued with an older version are invalid
+	TokenVersion(context.Context, *TokenVersionRequest, *TokenVersionResponse) error
+	// login with an identity of an external provider, the identity is linked
+	// to account uid if it is not empty, or to an account created for it
+	OAuthLogin(context.Context, *OAuthLoginRequest, *OAuthLoginResponse) error
+	Identities(context.Context, *IdentitiesRequest, *IdentitiesResponse) error
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest, *UnlinkIdentityResponse) error
+	// personal access tokens for scripts, the token is only returned when
+	// it is created
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest, *CreateAccessTokenResponse) error
+	AccessTokens(context.Context, *AccessTokensRequest, *AccessTokensResponse) error
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest, *RevokeAccessTokenResponse) error
+	// find account and scopes of an access token and record its use
+	VerifyAccessToken(context.Context, *VerifyAccessTokenRequest, *VerifyAccessTokenResponse) error
+	// a session is created on login and kept by its refresh token, jwt
+	// tokens are issued to sessions and are valid as long as the session is
+	CreateSession(context.Context, *CreateSessionRequest, *CreateSessionResponse) error
+	RefreshSession(context.Context, *RefreshSessionRequest, *RefreshSessionResponse) error
+	CheckSession(context.Context, *CheckSessionRequest, *CheckSessionResponse) error
+	Sessions(context.Context, *SessionsRequest, *SessionsResponse) error
+	RevokeSession(context.Context, *RevokeSessionRequest, *RevokeSessionResponse) error
+	RevokeSessions(context.Context, *RevokeSessionsRequest, *RevokeSessionsResponse) error
+	// public profile of an account
+	Profile(context.Context, *ProfileRequest, *ProfileResponse) error
+	UpdateProfile(context.Context, *UpdateProfileRequest, *UpdateProfileResponse) error
+	// set blob key of the avatar uploaded, the blob of the key replaced is
+	// for the caller to remove
+	SetAvatar(context.Context, *SetAvatarRequest, *SetAvatarResponse) error
+	// security events of an account, like logins and lockouts, newest first
+	AuditEvents(context.Context, *AuditEventsRequest, *AuditEventsResponse) error
+	// two-factor authentication with time based one time passwords. A new
+	// secret is enabled once a code of it is confirmed
+	EnrollTotp(context.Context, *EnrollTotpRequest, *EnrollTotpResponse) error
+	ConfirmTotp(context.Context, *ConfirmTotpRequest, *ConfirmTotpResponse) error
+	// second step of logins of accounts with two-factor authentication,
+	// code is a one time password or a recovery code
+	VerifyTotp(context.Context, *VerifyTotpRequest, *VerifyTotpResponse) error
+	TotpStatus(context.Context, *TotpStatusRequest, *TotpStatusResponse) error
+	DisableTotp(context.Context, *DisableTotpRequest, *DisableTotpResponse) error
+	// replace recovery codes, the old ones are invalid
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest, *RegenerateRecoveryCodesResponse) error
+	// accounts whose name or email contains query, newest first, for admins
+	ListAccounts(context.Context, *ListAccountsRequest, *ListAccountsResponse) error
+	// disabled accounts can not login and their sessions are revoked, uid is
+	// the admin disabling it
+	SetAccountDisabled(context.Context, *SetAccountDisabledRequest, *SetAccountDisabledResponse) error
+	// create an organization owned by uid, organizations and accounts share
+	// one namespace of names
+	CreateOrganization(context.Context, *CreateOrganizationRequest, *CreateOrganizationResponse) error
+	// role of uid in org, organization is false if org is not an organization
+	OrganizationRole(context.Context, *OrganizationRoleRequest, *OrganizationRoleResponse) error
+	OrganizationMembers(context.Context, *OrganizationMembersRequest, *OrganizationMembersResponse) error
+	// add a member or change role of a member, by owners of the organization
+	SetOrganizationMember(context.Context, *SetOrganizationMemberRequest, *SetOrganizationMemberResponse) error
+	// remove a member by owners, members can leave by themselves
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest, *RemoveOrganizationMemberResponse) error
+	// organizations uid is a member of
+	UserOrganizations(context.Context, *UserOrganizationsRequest, *UserOrganizationsResponse) error
+	// zip of json files of the account, its projects and annotations
+	ExportAccount(context.Context, *ExportAccountRequest, *ExportAccountResponse) error
+	// delete the account once the grace period passes, it can be cancelled
+	// until then. password is required if the account has one
+	RequestAccountDeletion(context.Context, *RequestAccountDeletionRequest, *RequestAccountDeletionResponse) error
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest, *CancelAccountDeletionResponse) error
+	// deleteAt is 0 if deletion is not requested
+	AccountDeletion(context.Context, *AccountDeletionRequest, *AccountDeletionResponse) error
 }
 
 func RegisterAccountServiceHandler(s server.Server, hdlr AccountServiceHandler, opts ...server.HandlerOption) error {
@@ -126,6 +738,51 @@ func RegisterAccountServiceHandler(s server.Server, hdlr AccountServiceHandler,
 		AccountId(ctx context.Context, in *AccountIdRequest, out *AccountIdResponse) error
 		AccountInfoByName(ctx context.Context, in *AccountName, out *AccountInfo) error
 		AccountsBasicInfo(ctx context.Context, in *AccountsBasicInfoRequest, out *AccountsBasicInfoResponse) error
+		AccountsBasicInfoByNames(ctx context.Context, in *AccountsBasicInfoByNamesRequest, out *AccountsBasicInfoResponse) error
+		Activate(ctx context.Context, in *ActivateRequest, out *ActivateResponse) error
+		ResendActivation(ctx context.Context, in *ResendActivationRequest, out *ResendActivationResponse) error
+		AccountsEmail(ctx context.Context, in *AccountsEmailRequest, out *AccountsEmailResponse) error
+		RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, out *RequestPasswordResetResponse) error
+		ResetPassword(ctx context.Context, in *ResetPasswordRequest, out *ResetPasswordResponse) error
+		ChangePassword(ctx context.Context, in *ChangePasswordRequest, out *ChangePasswordResponse) error
+		ChangeEmail(ctx context.Context, in *ChangeEmailRequest, out *ChangeEmailResponse) error
+		ConfirmEmail(ctx context.Context, in *ConfirmEmailRequest, out *ConfirmEmailResponse) error
+		TokenVersion(ctx context.Context, in *TokenVersionRequest, out *TokenVersionResponse) error
+		OAuthLogin(ctx context.Context, in *OAuthLoginRequest, out *OAuthLoginResponse) error
+		Identities(ctx context.Context, in *IdentitiesRequest, out *IdentitiesResponse) error
+		UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, out *UnlinkIdentityResponse) error
+		CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, out *CreateAccessTokenResponse) error
+		AccessTokens(ctx context.Context, in *AccessTokensRequest, out *AccessTokensResponse) error
+		RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, out *RevokeAccessTokenResponse) error
+		VerifyAccessToken(ctx context.Context, in *VerifyAccessTokenRequest, out *VerifyAccessTokenResponse) error
+		CreateSession(ctx context.Context, in *CreateSessionRequest, out *CreateSessionResponse) error
+		RefreshSession(ctx context.Context, in *RefreshSessionRequest, out *RefreshSessionResponse) error
+		CheckSession(ctx context.Context, in *CheckSessionRequest, out *CheckSessionResponse) error
+		Sessions(ctx context.Context, in *SessionsRequest, out *SessionsResponse) error
+		RevokeSession(ctx context.Context, in *RevokeSessionRequest, out *RevokeSessionResponse) error
+		RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *RevokeSessionsResponse) error
+		Profile(ctx context.Context, in *ProfileRequest, out *ProfileResponse) error
+		UpdateProfile(ctx context.Context, in *UpdateProfileRequest, out *UpdateProfileResponse) error
+		SetAvatar(ctx context.Context, in *SetAvatarRequest, out *SetAvatarResponse) error
+		AuditEvents(ctx context.Context, in *AuditEventsRequest, out *AuditEventsResponse) error
+		EnrollTotp(ctx context.Context, in *EnrollTotpRequest, out *EnrollTotpResponse) error
+		ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, out *ConfirmTotpResponse) error
+		VerifyTotp(ctx context.Context, in *VerifyTotpRequest, out *VerifyTotpResponse) error
+		TotpStatus(ctx context.Context, in *TotpStatusRequest, out *TotpStatusResponse) error
+		DisableTotp(ctx context.Context, in *DisableTotpRequest, out *DisableTotpResponse) error
+		RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, out *RegenerateRecoveryCodesResponse) error
+		ListAccounts(ctx context.Context, in *ListAccountsRequest, out *ListAccountsResponse) error
+		SetAccountDisabled(ctx context.Context, in *SetAccountDisabledRequest, out *SetAccountDisabledResponse) error
+		CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, out *CreateOrganizationResponse) error
+		OrganizationRole(ctx context.Context, in *OrganizationRoleRequest, out *OrganizationRoleResponse) error
+		OrganizationMembers(ctx context.Context, in *OrganizationMembersRequest, out *OrganizationMembersResponse) error
+		SetOrganizationMember(ctx context.Context, in *SetOrganizationMemberRequest, out *SetOrganizationMemberResponse) error
+		RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, out *RemoveOrganizationMemberResponse) error
+		UserOrganizations(ctx context.Context, in *UserOrganizationsRequest, out *UserOrganizationsResponse) error
+		ExportAccount(ctx context.Context, in *ExportAccountRequest, out *ExportAccountResponse) error
+		RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, out *RequestAccountDeletionResponse) error
+		CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, out *CancelAccountDeletionResponse) error
+		AccountDeletion(ctx context.Context, in *AccountDeletionRequest, out *AccountDeletionResponse) error
 	}
 	type AccountService struct {
 		accountService
@@ -157,3 +814,183 @@ func (h *accountServiceHandler) AccountInfoByName(ctx context.Context, in *Accou
 func (h *accountServiceHandler) AccountsBasicInfo(ctx context.Context, in *AccountsBasicInfoRequest, out *AccountsBasicInfoResponse) error {
 	return h.AccountServiceHandler.AccountsBasicInfo(ctx, in, out)
 }
+
+func (h *accountServiceHandler) AccountsBasicInfoByNames(ctx context.Context, in *AccountsBasicInfoByNamesRequest, out *AccountsBasicInfoResponse) error {
+	return h.AccountServiceHandler.AccountsBasicInfoByNames(ctx, in, out)
+}
+
+func (h *accountServiceHandler) Activate(ctx context.Context, in *ActivateRequest, out *ActivateResponse) error {
+	return h.AccountServiceHandler.Activate(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ResendActivation(ctx context.Context, in *ResendActivationRequest, out *ResendActivationResponse) error {
+	return h.AccountServiceHandler.ResendActivation(ctx, in, out)
+}
+
+func (h *accountServiceHandler) AccountsEmail(ctx context.Context, in *AccountsEmailRequest, out *AccountsEmailResponse) error {
+	return h.AccountServiceHandler.AccountsEmail(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, out *RequestPasswordResetResponse) error {
+	return h.AccountServiceHandler.RequestPasswordReset(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ResetPassword(ctx context.Context, in *ResetPasswordRequest, out *ResetPasswordResponse) error {
+	return h.AccountServiceHandler.ResetPassword(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ChangePassword(ctx context.Context, in *ChangePasswordRequest, out *ChangePasswordResponse) error {
+	return h.AccountServiceHandler.ChangePassword(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, out *ChangeEmailResponse) error {
+	return h.AccountServiceHandler.ChangeEmail(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ConfirmEmail(ctx context.Context, in *ConfirmEmailRequest, out *ConfirmEmailResponse) error {
+	return h.AccountServiceHandler.ConfirmEmail(ctx, in, out)
+}
+
+func (h *accountServiceHandler) TokenVersion(ctx context.Context, in *TokenVersionRequest, out *TokenVersionResponse) error {
+	return h.AccountServiceHandler.TokenVersion(ctx, in, out)
+}
+
+func (h *accountServiceHandler) OAuthLogin(ctx context.Context, in *OAuthLoginRequest, out *OAuthLoginResponse) error {
+	return h.AccountServiceHandler.OAuthLogin(ctx, in, out)
+}
+
+func (h *accountServiceHandler) Identities(ctx context.Context, in *IdentitiesRequest, out *IdentitiesResponse) error {
+	return h.AccountServiceHandler.Identities(ctx, in, out)
+}
+
+func (h *accountServiceHandler) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, out *UnlinkIdentityResponse) error {
+	return h.AccountServiceHandler.UnlinkIdentity(ctx, in, out)
+}
+
+func (h *accountServiceHandler) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, out *CreateAccessTokenResponse) error {
+	return h.AccountServiceHandler.CreateAccessToken(ctx, in, out)
+}
+
+func (h *accountServiceHandler) AccessTokens(ctx context.Context, in *AccessTokensRequest, out *AccessTokensResponse) error {
+	return h.AccountServiceHandler.AccessTokens(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, out *RevokeAccessTokenResponse) error {
+	return h.AccountServiceHandler.RevokeAccessToken(ctx, in, out)
+}
+
+func (h *accountServiceHandler) VerifyAccessToken(ctx context.Context, in *VerifyAccessTokenRequest, out *VerifyAccessTokenResponse) error {
+	return h.AccountServiceHandler.VerifyAccessToken(ctx, in, out)
+}
+
+func (h *accountServiceHandler) CreateSession(ctx context.Context, in *CreateSessionRequest, out *CreateSessionResponse) error {
+	return h.AccountServiceHandler.CreateSession(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RefreshSession(ctx context.Context, in *RefreshSessionRequest, out *RefreshSessionResponse) error {
+	return h.AccountServiceHandler.RefreshSession(ctx, in, out)
+}
+
+func (h *accountServiceHandler) CheckSession(ctx context.Context, in *CheckSessionRequest, out *CheckSessionResponse) error {
+	return h.AccountServiceHandler.CheckSession(ctx, in, out)
+}
+
+func (h *accountServiceHandler) Sessions(ctx context.Context, in *SessionsRequest, out *SessionsResponse) error {
+	return h.AccountServiceHandler.Sessions(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RevokeSession(ctx context.Context, in *RevokeSessionRequest, out *RevokeSessionResponse) error {
+	return h.AccountServiceHandler.RevokeSession(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *RevokeSessionsResponse) error {
+	return h.AccountServiceHandler.RevokeSessions(ctx, in, out)
+}
+
+func (h *accountServiceHandler) Profile(ctx context.Context, in *ProfileRequest, out *ProfileResponse) error {
+	return h.AccountServiceHandler.Profile(ctx, in, out)
+}
+
+func (h *accountServiceHandler) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, out *UpdateProfileResponse) error {
+	return h.AccountServiceHandler.UpdateProfile(ctx, in, out)
+}
+
+func (h *accountServiceHandler) SetAvatar(ctx context.Context, in *SetAvatarRequest, out *SetAvatarResponse) error {
+	return h.AccountServiceHandler.SetAvatar(ctx, in, out)
+}
+
+func (h *accountServiceHandler) AuditEvents(ctx context.Context, in *AuditEventsRequest, out *AuditEventsResponse) error {
+	return h.AccountServiceHandler.AuditEvents(ctx, in, out)
+}
+
+func (h *accountServiceHandler) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, out *EnrollTotpResponse) error {
+	return h.AccountServiceHandler.EnrollTotp(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, out *ConfirmTotpResponse) error {
+	return h.AccountServiceHandler.ConfirmTotp(ctx, in, out)
+}
+
+func (h *accountServiceHandler) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, out *VerifyTotpResponse) error {
+	return h.AccountServiceHandler.VerifyTotp(ctx, in, out)
+}
+
+func (h *accountServiceHandler) TotpStatus(ctx context.Context, in *TotpStatusRequest, out *TotpStatusResponse) error {
+	return h.AccountServiceHandler.TotpStatus(ctx, in, out)
+}
+
+func (h *accountServiceHandler) DisableTotp(ctx context.Context, in *DisableTotpRequest, out *DisableTotpResponse) error {
+	return h.AccountServiceHandler.DisableTotp(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, out *RegenerateRecoveryCodesResponse) error {
+	return h.AccountServiceHandler.RegenerateRecoveryCodes(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ListAccounts(ctx context.Context, in *ListAccountsRequest, out *ListAccountsResponse) error {
+	return h.AccountServiceHandler.ListAccounts(ctx, in, out)
+}
+
+func (h *accountServiceHandler) SetAccountDisabled(ctx context.Context, in *SetAccountDisabledRequest, out *SetAccountDisabledResponse) error {
+	return h.AccountServiceHandler.SetAccountDisabled(ctx, in, out)
+}
+
+func (h *accountServiceHandler) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, out *CreateOrganizationResponse) error {
+	return h.AccountServiceHandler.CreateOrganization(ctx, in, out)
+}
+
+func (h *accountServiceHandler) OrganizationRole(ctx context.Context, in *OrganizationRoleRequest, out *OrganizationRoleResponse) error {
+	return h.AccountServiceHandler.OrganizationRole(ctx, in, out)
+}
+
+func (h *accountServiceHandler) OrganizationMembers(ctx context.Context, in *OrganizationMembersRequest, out *OrganizationMembersResponse) error {
+	return h.AccountServiceHandler.OrganizationMembers(ctx, in, out)
+}
+
+func (h *accountServiceHandler) SetOrganizationMember(ctx context.Context, in *SetOrganizationMemberRequest, out *SetOrganizationMemberResponse) error {
+	return h.AccountServiceHandler.SetOrganizationMember(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, out *RemoveOrganizationMemberResponse) error {
+	return h.AccountServiceHandler.RemoveOrganizationMember(ctx, in, out)
+}
+
+func (h *accountServiceHandler) UserOrganizations(ctx context.Context, in *UserOrganizationsRequest, out *UserOrganizationsResponse) error {
+	return h.AccountServiceHandler.UserOrganizations(ctx, in, out)
+}
+
+func (h *accountServiceHandler) ExportAccount(ctx context.Context, in *ExportAccountRequest, out *ExportAccountResponse) error {
+	return h.AccountServiceHandler.ExportAccount(ctx, in, out)
+}
+
+func (h *accountServiceHandler) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, out *RequestAccountDeletionResponse) error {
+	return h.AccountServiceHandler.RequestAccountDeletion(ctx, in, out)
+}
+
+func (h *accountServiceHandler) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, out *CancelAccountDeletionResponse) error {
+	return h.AccountServiceHandler.CancelAccountDeletion(ctx, in, out)
+}
+
+func (h *accountServiceHandler) AccountDeletion(ctx context.Context, in *AccountDeletionRequest, out *AccountDeletionResponse) error {
+	return h.AccountServiceHandler.AccountDeletion(ctx, in, out)
+}
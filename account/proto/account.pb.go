@@ -21,11 +21,38 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type ErrorCode int32
 
 const (
-	ErrorCode_Success                   ErrorCode = 0
-	ErrorCode_ErrorNameUsed             ErrorCode = 300001
-	ErrorCode_ErrorEmailRegistered      ErrorCode = 300002
-	ErrorCode_ErrorNamePasswordMisMatch ErrorCode = 300003
-	ErrorCode_ErrorNotActivated         ErrorCode = 30004
+	ErrorCode_Success                    ErrorCode = 0
+	ErrorCode_ErrorNameUsed              ErrorCode = 300001
+	ErrorCode_ErrorEmailRegistered       ErrorCode = 300002
+	ErrorCode_ErrorNamePasswordMisMatch  ErrorCode = 300003
+	ErrorCode_ErrorNotActivated          ErrorCode = 30004
+	ErrorCode_ErrorActivationCodeInvalid ErrorCode = 300005
+	// password reset or email confirmation token invalid or expired
+	ErrorCode_ErrorTokenInvalid     ErrorCode = 300006
+	ErrorCode_ErrorPasswordMisMatch ErrorCode = 300007
+	// identity is linked to another account
+	ErrorCode_ErrorIdentityLinked ErrorCode = 300008
+	// provider did not return a verified email for a new account
+	ErrorCode_ErrorEmailUnverified ErrorCode = 300009
+	// the only way to login of an account can not be removed
+	ErrorCode_ErrorLastLogin           ErrorCode = 300010
+	ErrorCode_ErrorAccessTokenInvalid  ErrorCode = 300011
+	ErrorCode_ErrorAccessTokenNameUsed ErrorCode = 300012
+	// too many access tokens
+	ErrorCode_ErrorAccessTokenLimit ErrorCode = 300013
+	// session revoked or expired
+	ErrorCode_ErrorSessionInvalid ErrorCode = 300014
+	// too many failed logins of the account or address, retry later
+	ErrorCode_ErrorLoginThrottled ErrorCode = 300015
+	// password is right, a two-factor code is required to login
+	ErrorCode_ErrorTotpRequired ErrorCode = 300016
+	// two-factor code or recovery code wrong
+	ErrorCode_ErrorTotpInvalid     ErrorCode = 300017
+	ErrorCode_ErrorTotpEnabled     ErrorCode = 300018
+	ErrorCode_ErrorTotpNotEnabled  ErrorCode = 300019
+	ErrorCode_ErrorAccountDisabled ErrorCode = 300020
+	// an organization must have an owner
+	ErrorCode_ErrorLastOwner ErrorCode = 300021
 )
 
 var ErrorCode_name = map[int32]string{
@@ -34,20 +61,88 @@ var ErrorCode_name = map[int32]string{
 	300002: "ErrorEmailRegistered",
 	300003: "ErrorNamePasswordMisMatch",
 	30004:  "ErrorNotActivated",
+	300005: "ErrorActivationCodeInvalid",
+	300006: "ErrorTokenInvalid",
+	300007: "ErrorPasswordMisMatch",
+	300008: "ErrorIdentityLinked",
+	300009: "ErrorEmailUnverified",
+	300010: "ErrorLastLogin",
+	300011: "ErrorAccessTokenInvalid",
+	300012: "ErrorAccessTokenNameUsed",
+	300013: "ErrorAccessTokenLimit",
+	300014: "ErrorSessionInvalid",
+	300015: "ErrorLoginThrottled",
+	300016: "ErrorTotpRequired",
+	300017: "ErrorTotpInvalid",
+	300018: "ErrorTotpEnabled",
+	300019: "ErrorTotpNotEnabled",
+	300020: "ErrorAccountDisabled",
+	300021: "ErrorLastOwner",
 }
 var ErrorCode_value = map[string]int32{
-	"Success":                   0,
-	"ErrorNameUsed":             300001,
-	"ErrorEmailRegistered":      300002,
-	"ErrorNamePasswordMisMatch": 300003,
-	"ErrorNotActivated":         30004,
+	"Success":                    0,
+	"ErrorNameUsed":              300001,
+	"ErrorEmailRegistered":       300002,
+	"ErrorNamePasswordMisMatch":  300003,
+	"ErrorNotActivated":          30004,
+	"ErrorActivationCodeInvalid": 300005,
+	"ErrorTokenInvalid":          300006,
+	"ErrorPasswordMisMatch":      300007,
+	"ErrorIdentityLinked":        300008,
+	"ErrorEmailUnverified":       300009,
+	"ErrorLastLogin":             300010,
+	"ErrorAccessTokenInvalid":    300011,
+	"ErrorAccessTokenNameUsed":   300012,
+	"ErrorAccessTokenLimit":      300013,
+	"ErrorSessionInvalid":        300014,
+	"ErrorLoginThrottled":        300015,
+	"ErrorTotpRequired":          300016,
+	"ErrorTotpInvalid":           300017,
+	"ErrorTotpEnabled":           300018,
+	"ErrorTotpNotEnabled":        300019,
+	"ErrorAccountDisabled":       300020,
+	"ErrorLastOwner":             300021,
 }
 
 func (x ErrorCode) String() string {
 	return proto.EnumName(ErrorCode_name, int32(x))
 }
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{0}
+	return fileDescriptor_account_04f4ccf857891a96, []int{0}
+}
+
+// roles of members of an organization apply to all its projects, the values
+// are those of project roles
+type OrgRole int32
+
+const (
+	OrgRole_OrgRoleNone   OrgRole = 0
+	OrgRole_OrgViewer     OrgRole = 1
+	OrgRole_OrgAnnotator  OrgRole = 2
+	OrgRole_OrgMaintainer OrgRole = 3
+	OrgRole_OrgOwner      OrgRole = 4
+)
+
+var OrgRole_name = map[int32]string{
+	0: "OrgRoleNone",
+	1: "OrgViewer",
+	2: "OrgAnnotator",
+	3: "OrgMaintainer",
+	4: "OrgOwner",
+}
+var OrgRole_value = map[string]int32{
+	"OrgRoleNone":   0,
+	"OrgViewer":     1,
+	"OrgAnnotator":  2,
+	"OrgMaintainer": 3,
+	"OrgOwner":      4,
+}
+
+func (x OrgRole) String() string {
+	return proto.EnumName(OrgRole_name, int32(x))
+}
+func (OrgRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{1}
 }
 
 type RegisterRequest struct {
@@ -63,7 +158,7 @@ func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
 func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterRequest) ProtoMessage()    {}
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{0}
+	return fileDescriptor_account_04f4ccf857891a96, []int{0}
 }
 func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterRequest.Unmarshal(m, b)
@@ -114,7 +209,7 @@ func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
 func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterResponse) ProtoMessage()    {}
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{1}
+	return fileDescriptor_account_04f4ccf857891a96, []int{1}
 }
 func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterResponse.Unmarshal(m, b)
@@ -135,9 +230,13 @@ func (m *RegisterResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
 
 type LoginRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+	// address and user agent of the client, failed logins of an address are
+	// throttled
+	Ip                   string   `protobuf:"bytes,4,opt,name=ip" json:"ip,omitempty"`
+	UserAgent            string   `protobuf:"bytes,5,opt,name=userAgent" json:"userAgent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -147,7 +246,7 @@ func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
 func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
 func (*LoginRequest) ProtoMessage()    {}
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{2}
+	return fileDescriptor_account_04f4ccf857891a96, []int{2}
 }
 func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
@@ -188,6 +287,20 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (m *LoginRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	Token                string       `protobuf:"bytes,3,opt,name=token" json:"token,omitempty"`
 	Info                 *AccountInfo `protobuf:"bytes,4,opt,name=info" json:"info,omitempty"`
@@ -200,7 +313,7 @@ func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
 func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
 func (*LoginResponse) ProtoMessage()    {}
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{3}
+	return fileDescriptor_account_04f4ccf857891a96, []int{3}
 }
 func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
@@ -235,10 +348,19 @@ func (m *LoginResponse) GetInfo() *AccountInfo {
 }
 
 type AccountInfo struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Avatar               string   `protobuf:"bytes,3,opt,name=avatar" json:"avatar,omitempty"`
-	CreatedAt            int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// url of the avatar uploaded, or of gravatar if there is none
+	Avatar    string `protobuf:"bytes,3,opt,name=avatar" json:"avatar,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	// bumped when password changes to invalidate issued tokens
+	TokenVersion int64 `protobuf:"varint,5,opt,name=token_version,json=tokenVersion" json:"token_version,omitempty"`
+	// logins need a two-factor code
+	TotpEnabled bool `protobuf:"varint,6,opt,name=totpEnabled" json:"totpEnabled,omitempty"`
+	// admin, or empty for users
+	Role string `protobuf:"bytes,7,opt,name=role" json:"role,omitempty"`
+	// the name is of an organization
+	Organization         bool     `protobuf:"varint,8,opt,name=organization" json:"organization,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -248,7 +370,7 @@ func (m *AccountInfo) Reset()         { *m = AccountInfo{} }
 func (m *AccountInfo) String() string { return proto.CompactTextString(m) }
 func (*AccountInfo) ProtoMessage()    {}
 func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{4}
+	return fileDescriptor_account_04f4ccf857891a96, []int{4}
 }
 func (m *AccountInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountInfo.Unmarshal(m, b)
@@ -296,6 +418,34 @@ func (m *AccountInfo) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *AccountInfo) GetTokenVersion() int64 {
+	if m != nil {
+		return m.TokenVersion
+	}
+	return 0
+}
+
+func (m *AccountInfo) GetTotpEnabled() bool {
+	if m != nil {
+		return m.TotpEnabled
+	}
+	return false
+}
+
+func (m *AccountInfo) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *AccountInfo) GetOrganization() bool {
+	if m != nil {
+		return m.Organization
+	}
+	return false
+}
+
 type AccountIdRequest struct {
 	Username             string   `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -307,7 +457,7 @@ func (m *AccountIdRequest) Reset()         { *m = AccountIdRequest{} }
 func (m *AccountIdRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountIdRequest) ProtoMessage()    {}
 func (*AccountIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{5}
+	return fileDescriptor_account_04f4ccf857891a96, []int{5}
 }
 func (m *AccountIdRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountIdRequest.Unmarshal(m, b)
@@ -345,7 +495,7 @@ func (m *AccountName) Reset()         { *m = AccountName{} }
 func (m *AccountName) String() string { return proto.CompactTextString(m) }
 func (*AccountName) ProtoMessage()    {}
 func (*AccountName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{6}
+	return fileDescriptor_account_04f4ccf857891a96, []int{6}
 }
 func (m *AccountName) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountName.Unmarshal(m, b)
@@ -383,7 +533,7 @@ func (m *AccountIdResponse) Reset()         { *m = AccountIdResponse{} }
 func (m *AccountIdResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountIdResponse) ProtoMessage()    {}
 func (*AccountIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{7}
+	return fileDescriptor_account_04f4ccf857891a96, []int{7}
 }
 func (m *AccountIdResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountIdResponse.Unmarshal(m, b)
@@ -421,7 +571,7 @@ func (m *AccountsBasicInfoRequest) Reset()         { *m = AccountsBasicInfoReque
 func (m *AccountsBasicInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountsBasicInfoRequest) ProtoMessage()    {}
 func (*AccountsBasicInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{8}
+	return fileDescriptor_account_04f4ccf857891a96, []int{8}
 }
 func (m *AccountsBasicInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsBasicInfoRequest.Unmarshal(m, b)
@@ -448,6 +598,44 @@ func (m *AccountsBasicInfoRequest) GetUids() []string {
 	return nil
 }
 
+type AccountsBasicInfoByNamesRequest struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountsBasicInfoByNamesRequest) Reset()         { *m = AccountsBasicInfoByNamesRequest{} }
+func (m *AccountsBasicInfoByNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsBasicInfoByNamesRequest) ProtoMessage()    {}
+func (*AccountsBasicInfoByNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{9}
+}
+func (m *AccountsBasicInfoByNamesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsBasicInfoByNamesRequest.Unmarshal(m, b)
+}
+func (m *AccountsBasicInfoByNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsBasicInfoByNamesRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccountsBasicInfoByNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsBasicInfoByNamesRequest.Merge(dst, src)
+}
+func (m *AccountsBasicInfoByNamesRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsBasicInfoByNamesRequest.Size(m)
+}
+func (m *AccountsBasicInfoByNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsBasicInfoByNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsBasicInfoByNamesRequest proto.InternalMessageInfo
+
+func (m *AccountsBasicInfoByNamesRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
 type AccountsBasicInfoResponse struct {
 	Infos                []*BasicInfo `protobuf:"bytes,1,rep,name=infos" json:"infos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -459,7 +647,7 @@ func (m *AccountsBasicInfoResponse) Reset()         { *m = AccountsBasicInfoResp
 func (m *AccountsBasicInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountsBasicInfoResponse) ProtoMessage()    {}
 func (*AccountsBasicInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{9}
+	return fileDescriptor_account_04f4ccf857891a96, []int{10}
 }
 func (m *AccountsBasicInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsBasicInfoResponse.Unmarshal(m, b)
@@ -499,7 +687,7 @@ func (m *BasicInfo) Reset()         { *m = BasicInfo{} }
 func (m *BasicInfo) String() string { return proto.CompactTextString(m) }
 func (*BasicInfo) ProtoMessage()    {}
 func (*BasicInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_147c02a51765ec75, []int{10}
+	return fileDescriptor_account_04f4ccf857891a96, []int{11}
 }
 func (m *BasicInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BasicInfo.Unmarshal(m, b)
@@ -540,57 +728,4591 @@ func (m *BasicInfo) GetAvatar() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*RegisterRequest)(nil), "account.RegisterRequest")
-	proto.RegisterType((*RegisterResponse)(nil), "account.RegisterResponse")
-	proto.RegisterType((*LoginRequest)(nil), "account.LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "account.LoginResponse")
-	proto.RegisterType((*AccountInfo)(nil), "account.AccountInfo")
-	proto.RegisterType((*AccountIdRequest)(nil), "account.AccountIdRequest")
-	proto.RegisterType((*AccountName)(nil), "account.AccountName")
-	proto.RegisterType((*AccountIdResponse)(nil), "account.AccountIdResponse")
-	proto.RegisterType((*AccountsBasicInfoRequest)(nil), "account.AccountsBasicInfoRequest")
-	proto.RegisterType((*AccountsBasicInfoResponse)(nil), "account.AccountsBasicInfoResponse")
-	proto.RegisterType((*BasicInfo)(nil), "account.BasicInfo")
-	proto.RegisterEnum("account.ErrorCode", ErrorCode_name, ErrorCode_value)
+type ActivateRequest struct {
+	// hex of the random code followed by account id
+	Code                 string   `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivateRequest) Reset()         { *m = ActivateRequest{} }
+func (m *ActivateRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateRequest) ProtoMessage()    {}
+func (*ActivateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{12}
+}
+func (m *ActivateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateRequest.Unmarshal(m, b)
+}
+func (m *ActivateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateRequest.Marshal(b, m, deterministic)
+}
+func (dst *ActivateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateRequest.Merge(dst, src)
+}
+func (m *ActivateRequest) XXX_Size() int {
+	return xxx_messageInfo_ActivateRequest.Size(m)
+}
+func (m *ActivateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateRequest proto.InternalMessageInfo
+
+func (m *ActivateRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type ActivateResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ActivateResponse) Reset()         { *m = ActivateResponse{} }
+func (m *ActivateResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateResponse) ProtoMessage()    {}
+func (*ActivateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{13}
+}
+func (m *ActivateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateResponse.Unmarshal(m, b)
+}
+func (m *ActivateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateResponse.Marshal(b, m, deterministic)
+}
+func (dst *ActivateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateResponse.Merge(dst, src)
+}
+func (m *ActivateResponse) XXX_Size() int {
+	return xxx_messageInfo_ActivateResponse.Size(m)
+}
+func (m *ActivateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateResponse proto.InternalMessageInfo
+
+func (m *ActivateResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ResendActivationRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResendActivationRequest) Reset()         { *m = ResendActivationRequest{} }
+func (m *ResendActivationRequest) String() string { return proto.CompactTextString(m) }
+func (*ResendActivationRequest) ProtoMessage()    {}
+func (*ResendActivationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{14}
+}
+func (m *ResendActivationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResendActivationRequest.Unmarshal(m, b)
+}
+func (m *ResendActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResendActivationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResendActivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResendActivationRequest.Merge(dst, src)
+}
+func (m *ResendActivationRequest) XXX_Size() int {
+	return xxx_messageInfo_ResendActivationRequest.Size(m)
+}
+func (m *ResendActivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResendActivationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResendActivationRequest proto.InternalMessageInfo
+
+func (m *ResendActivationRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type ResendActivationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResendActivationResponse) Reset()         { *m = ResendActivationResponse{} }
+func (m *ResendActivationResponse) String() string { return proto.CompactTextString(m) }
+func (*ResendActivationResponse) ProtoMessage()    {}
+func (*ResendActivationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{15}
+}
+func (m *ResendActivationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResendActivationResponse.Unmarshal(m, b)
+}
+func (m *ResendActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResendActivationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ResendActivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResendActivationResponse.Merge(dst, src)
+}
+func (m *ResendActivationResponse) XXX_Size() int {
+	return xxx_messageInfo_ResendActivationResponse.Size(m)
+}
+func (m *ResendActivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResendActivationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResendActivationResponse proto.InternalMessageInfo
+
+type AccountsEmailRequest struct {
+	Uids                 []string `protobuf:"bytes,1,rep,name=uids" json:"uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountsEmailRequest) Reset()         { *m = AccountsEmailRequest{} }
+func (m *AccountsEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsEmailRequest) ProtoMessage()    {}
+func (*AccountsEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{16}
+}
+func (m *AccountsEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsEmailRequest.Unmarshal(m, b)
+}
+func (m *AccountsEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsEmailRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccountsEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsEmailRequest.Merge(dst, src)
+}
+func (m *AccountsEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsEmailRequest.Size(m)
+}
+func (m *AccountsEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsEmailRequest proto.InternalMessageInfo
+
+func (m *AccountsEmailRequest) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
+type AccountsEmailResponse struct {
+	// uid to email, accounts not found or not activated are missing
+	Emails               map[string]string `protobuf:"bytes,1,rep,name=emails" json:"emails,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AccountsEmailResponse) Reset()         { *m = AccountsEmailResponse{} }
+func (m *AccountsEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountsEmailResponse) ProtoMessage()    {}
+func (*AccountsEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{17}
+}
+func (m *AccountsEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsEmailResponse.Unmarshal(m, b)
+}
+func (m *AccountsEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsEmailResponse.Marshal(b, m, deterministic)
+}
+func (dst *AccountsEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsEmailResponse.Merge(dst, src)
+}
+func (m *AccountsEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountsEmailResponse.Size(m)
+}
+func (m *AccountsEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsEmailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsEmailResponse proto.InternalMessageInfo
+
+func (m *AccountsEmailResponse) GetEmails() map[string]string {
+	if m != nil {
+		return m.Emails
+	}
+	return nil
+}
+
+type RequestPasswordResetRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{18}
+}
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(dst, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetResponse) Reset()         { *m = RequestPasswordResetResponse{} }
+func (m *RequestPasswordResetResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetResponse) ProtoMessage()    {}
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{19}
+}
+func (m *RequestPasswordResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetResponse.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetResponse.Marshal(b, m, deterministic)
+}
+func (dst *RequestPasswordResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetResponse.Merge(dst, src)
+}
+func (m *RequestPasswordResetResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetResponse.Size(m)
+}
+func (m *RequestPasswordResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetResponse proto.InternalMessageInfo
+
+type ResetPasswordRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{20}
+}
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(dst, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ResetPasswordResponse) Reset()         { *m = ResetPasswordResponse{} }
+func (m *ResetPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordResponse) ProtoMessage()    {}
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{21}
+}
+func (m *ResetPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordResponse.Unmarshal(m, b)
+}
+func (m *ResetPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordResponse.Marshal(b, m, deterministic)
+}
+func (dst *ResetPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordResponse.Merge(dst, src)
+}
+func (m *ResetPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordResponse.Size(m)
+}
+func (m *ResetPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordResponse proto.InternalMessageInfo
+
+func (m *ResetPasswordResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("account.proto", fileDescriptor_account_147c02a51765ec75) }
-
-var fileDescriptor_account_147c02a51765ec75 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0x13, 0x3f,
-	0x10, 0xfe, 0x6d, 0xfe, 0xb4, 0xc9, 0xe4, 0x97, 0xb2, 0x1d, 0x42, 0xd9, 0xac, 0x84, 0x48, 0x2d,
-	0x21, 0x45, 0x1c, 0x72, 0x08, 0x12, 0xd7, 0x2a, 0x41, 0x11, 0x42, 0xa2, 0x80, 0x52, 0x10, 0x12,
-	0x17, 0x64, 0x76, 0x9d, 0xd6, 0x82, 0xae, 0x83, 0xed, 0x0d, 0xe2, 0xcc, 0xc3, 0xe4, 0x05, 0x78,
-	0x21, 0xe0, 0xd6, 0xa7, 0x40, 0xf6, 0x7a, 0x9d, 0x28, 0x59, 0x71, 0x81, 0x53, 0x3c, 0x33, 0x9f,
-	0xbf, 0x99, 0xfd, 0xfc, 0x4d, 0xa0, 0x4b, 0x93, 0x44, 0xe4, 0x99, 0x1e, 0x2d, 0xa5, 0xd0, 0x02,
-	0x0f, 0x5d, 0x48, 0xde, 0xc2, 0xad, 0x39, 0xbb, 0xe4, 0x4a, 0x33, 0x39, 0x67, 0x9f, 0x73, 0xa6,
-	0x34, 0x22, 0x34, 0x32, 0x7a, 0xcd, 0xa2, 0x60, 0x10, 0x0c, 0xdb, 0x73, 0x7b, 0xc6, 0x1e, 0x34,
-	0xd9, 0x35, 0xe5, 0x9f, 0xa2, 0x9a, 0x4d, 0x16, 0x01, 0xc6, 0xd0, 0x5a, 0x52, 0xa5, 0xbe, 0x08,
-	0x99, 0x46, 0x75, 0x5b, 0xf0, 0x31, 0x41, 0x08, 0x37, 0xc4, 0x6a, 0x29, 0x32, 0xc5, 0xc8, 0x6b,
-	0xf8, 0xff, 0xb9, 0xb8, 0xe4, 0xd9, 0xbf, 0xed, 0xf4, 0x12, 0xba, 0x8e, 0xb5, 0x68, 0x63, 0x28,
-	0xb4, 0xf8, 0xc8, 0x32, 0x87, 0x2c, 0x02, 0x1c, 0x42, 0x83, 0x67, 0x0b, 0x11, 0x35, 0x06, 0xc1,
-	0xb0, 0x33, 0xee, 0x8d, 0x4a, 0x41, 0x26, 0xc5, 0xef, 0xb3, 0x6c, 0x21, 0xe6, 0x16, 0x41, 0xae,
-	0xa0, 0xb3, 0x95, 0xc4, 0x23, 0xa8, 0xf1, 0xd4, 0xcd, 0x58, 0xe3, 0xa9, 0x9f, 0xba, 0xb6, 0x35,
-	0xf5, 0x09, 0x1c, 0xd0, 0x15, 0xd5, 0x54, 0xba, 0x9e, 0x2e, 0xc2, 0x7b, 0x00, 0x89, 0x64, 0x54,
-	0xb3, 0xf4, 0x3d, 0xd5, 0xb6, 0x75, 0x7d, 0xde, 0x76, 0x99, 0x89, 0x26, 0x23, 0x08, 0xcb, 0x4e,
-	0x69, 0x29, 0x4a, 0x0c, 0xad, 0x5c, 0x31, 0xb9, 0x25, 0x8c, 0x8f, 0xc9, 0xa9, 0x9f, 0xec, 0x85,
-	0xe9, 0x5a, 0xa1, 0x1f, 0x79, 0x00, 0xc7, 0x5b, 0x94, 0x4e, 0x91, 0x10, 0xea, 0xb9, 0xff, 0x06,
-	0x73, 0x24, 0x23, 0x88, 0x1c, 0x4c, 0x4d, 0xa9, 0xe2, 0x89, 0xfd, 0xfc, 0xcd, 0xb3, 0xe4, 0x3c,
-	0x55, 0x51, 0x30, 0xa8, 0x1b, 0x5a, 0x73, 0x26, 0x33, 0xe8, 0x57, 0xe0, 0x1d, 0xfd, 0x10, 0x9a,
-	0x46, 0xb8, 0xe2, 0x46, 0x67, 0x8c, 0x5e, 0xdb, 0x0d, 0xb4, 0x00, 0x90, 0xa7, 0xd0, 0xf6, 0xb9,
-	0xbf, 0x11, 0xf6, 0xe1, 0xb7, 0x00, 0xda, 0x33, 0x29, 0x85, 0x7c, 0x22, 0x52, 0x86, 0x1d, 0x38,
-	0xbc, 0xc8, 0x93, 0x84, 0x29, 0x15, 0xfe, 0x87, 0xb7, 0xa1, 0x6b, 0x2b, 0x46, 0xa2, 0x37, 0x8a,
-	0xa5, 0xe1, 0x8f, 0x35, 0x62, 0x0c, 0x3d, 0x9b, 0x9c, 0x19, 0x3b, 0x95, 0xc6, 0x64, 0x69, 0xf8,
-	0x73, 0x8d, 0x78, 0x1f, 0xfa, 0xfe, 0xc2, 0x2b, 0xe7, 0xaa, 0x73, 0xae, 0xce, 0xa9, 0x4e, 0xae,
-	0xc2, 0x5f, 0x6b, 0xc4, 0xbb, 0x70, 0x5c, 0x00, 0x84, 0x9e, 0x24, 0x9a, 0xaf, 0xcc, 0xeb, 0x85,
-	0xdf, 0x6f, 0x82, 0xf1, 0x4d, 0x0d, 0x8e, 0x9c, 0x2c, 0x17, 0x4c, 0xae, 0x78, 0xc2, 0xf0, 0x0c,
-	0x5a, 0x25, 0x3d, 0x46, 0x5e, 0x88, 0x9d, 0x1d, 0x8b, 0xfb, 0x15, 0x15, 0x27, 0xe6, 0x63, 0x68,
-	0x5a, 0x3b, 0xe3, 0x1d, 0x8f, 0xd9, 0x5e, 0x9a, 0xf8, 0x64, 0x37, 0xed, 0xee, 0x4d, 0xa1, 0xed,
-	0x1f, 0x1e, 0xfb, 0x7b, 0xf6, 0x2e, 0xfd, 0x15, 0xc7, 0x55, 0x25, 0xc7, 0x71, 0xb6, 0x31, 0x4f,
-	0xb6, 0x10, 0xd3, 0xaf, 0xd6, 0x65, 0x7b, 0xab, 0x62, 0xb2, 0x71, 0xe5, 0x02, 0xe1, 0x3b, 0x4f,
-	0xb0, 0xb1, 0x09, 0x9e, 0xee, 0x42, 0xf7, 0x2c, 0x17, 0x93, 0x3f, 0x41, 0x8a, 0xe1, 0x3e, 0x1c,
-	0xd8, 0xbf, 0xae, 0x47, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x52, 0x59, 0xc6, 0xcb, 0x04,
-	0x00, 0x00,
+type ChangePasswordRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	OldPassword          string   `protobuf:"bytes,2,opt,name=oldPassword" json:"oldPassword,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=newPassword" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{22}
+}
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(dst, src)
+}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+}
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetOldPassword() string {
+	if m != nil {
+		return m.OldPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{} }
+func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordResponse) ProtoMessage()    {}
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{23}
+}
+func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
+}
+func (m *ChangePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChangePasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordResponse.Merge(dst, src)
+}
+func (m *ChangePasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordResponse.Size(m)
+}
+func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
+
+func (m *ChangePasswordResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ChangeEmailRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeEmailRequest) Reset()         { *m = ChangeEmailRequest{} }
+func (m *ChangeEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeEmailRequest) ProtoMessage()    {}
+func (*ChangeEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{24}
+}
+func (m *ChangeEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEmailRequest.Unmarshal(m, b)
+}
+func (m *ChangeEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEmailRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChangeEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEmailRequest.Merge(dst, src)
+}
+func (m *ChangeEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeEmailRequest.Size(m)
+}
+func (m *ChangeEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeEmailRequest proto.InternalMessageInfo
+
+func (m *ChangeEmailRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ChangeEmailRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ChangeEmailRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type ChangeEmailResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeEmailResponse) Reset()         { *m = ChangeEmailResponse{} }
+func (m *ChangeEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeEmailResponse) ProtoMessage()    {}
+func (*ChangeEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{25}
+}
+func (m *ChangeEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEmailResponse.Unmarshal(m, b)
+}
+func (m *ChangeEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEmailResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChangeEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEmailResponse.Merge(dst, src)
+}
+func (m *ChangeEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangeEmailResponse.Size(m)
+}
+func (m *ChangeEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEmailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeEmailResponse proto.InternalMessageInfo
+
+type ConfirmEmailRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmEmailRequest) Reset()         { *m = ConfirmEmailRequest{} }
+func (m *ConfirmEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailRequest) ProtoMessage()    {}
+func (*ConfirmEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{26}
+}
+func (m *ConfirmEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailRequest.Unmarshal(m, b)
+}
+func (m *ConfirmEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailRequest.Merge(dst, src)
+}
+func (m *ConfirmEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailRequest.Size(m)
+}
+func (m *ConfirmEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailRequest proto.InternalMessageInfo
+
+func (m *ConfirmEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ConfirmEmailResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ConfirmEmailResponse) Reset()         { *m = ConfirmEmailResponse{} }
+func (m *ConfirmEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailResponse) ProtoMessage()    {}
+func (*ConfirmEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{27}
+}
+func (m *ConfirmEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailResponse.Unmarshal(m, b)
+}
+func (m *ConfirmEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailResponse.Merge(dst, src)
+}
+func (m *ConfirmEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailResponse.Size(m)
+}
+func (m *ConfirmEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailResponse proto.InternalMessageInfo
+
+func (m *ConfirmEmailResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type TokenVersionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenVersionRequest) Reset()         { *m = TokenVersionRequest{} }
+func (m *TokenVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenVersionRequest) ProtoMessage()    {}
+func (*TokenVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{28}
+}
+func (m *TokenVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenVersionRequest.Unmarshal(m, b)
+}
+func (m *TokenVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *TokenVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenVersionRequest.Merge(dst, src)
+}
+func (m *TokenVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_TokenVersionRequest.Size(m)
+}
+func (m *TokenVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenVersionRequest proto.InternalMessageInfo
+
+func (m *TokenVersionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type TokenVersionResponse struct {
+	Version              int64    `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenVersionResponse) Reset()         { *m = TokenVersionResponse{} }
+func (m *TokenVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenVersionResponse) ProtoMessage()    {}
+func (*TokenVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{29}
+}
+func (m *TokenVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenVersionResponse.Unmarshal(m, b)
+}
+func (m *TokenVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *TokenVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenVersionResponse.Merge(dst, src)
+}
+func (m *TokenVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_TokenVersionResponse.Size(m)
+}
+func (m *TokenVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenVersionResponse proto.InternalMessageInfo
+
+func (m *TokenVersionResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type OAuthLoginRequest struct {
+	Provider      string `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+	Subject       string `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	Email         string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	EmailVerified bool   `protobuf:"varint,4,opt,name=emailVerified" json:"emailVerified,omitempty"`
+	// preferred name of a new account
+	Login string `protobuf:"bytes,5,opt,name=login" json:"login,omitempty"`
+	// account to link identity to, empty to login
+	Uid                  string   `protobuf:"bytes,6,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OAuthLoginRequest) Reset()         { *m = OAuthLoginRequest{} }
+func (m *OAuthLoginRequest) String() string { return proto.CompactTextString(m) }
+func (*OAuthLoginRequest) ProtoMessage()    {}
+func (*OAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{30}
+}
+func (m *OAuthLoginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OAuthLoginRequest.Unmarshal(m, b)
+}
+func (m *OAuthLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OAuthLoginRequest.Marshal(b, m, deterministic)
+}
+func (dst *OAuthLoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuthLoginRequest.Merge(dst, src)
+}
+func (m *OAuthLoginRequest) XXX_Size() int {
+	return xxx_messageInfo_OAuthLoginRequest.Size(m)
+}
+func (m *OAuthLoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuthLoginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuthLoginRequest proto.InternalMessageInfo
+
+func (m *OAuthLoginRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *OAuthLoginRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *OAuthLoginRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *OAuthLoginRequest) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
+func (m *OAuthLoginRequest) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+func (m *OAuthLoginRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type OAuthLoginResponse struct {
+	Info *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	// a new account is created for the identity
+	Created              bool     `protobuf:"varint,2,opt,name=created" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OAuthLoginResponse) Reset()         { *m = OAuthLoginResponse{} }
+func (m *OAuthLoginResponse) String() string { return proto.CompactTextString(m) }
+func (*OAuthLoginResponse) ProtoMessage()    {}
+func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{31}
+}
+func (m *OAuthLoginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OAuthLoginResponse.Unmarshal(m, b)
+}
+func (m *OAuthLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OAuthLoginResponse.Marshal(b, m, deterministic)
+}
+func (dst *OAuthLoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuthLoginResponse.Merge(dst, src)
+}
+func (m *OAuthLoginResponse) XXX_Size() int {
+	return xxx_messageInfo_OAuthLoginResponse.Size(m)
+}
+func (m *OAuthLoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuthLoginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuthLoginResponse proto.InternalMessageInfo
+
+func (m *OAuthLoginResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *OAuthLoginResponse) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+type Identity struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	Login                string   `protobuf:"bytes,4,opt,name=login" json:"login,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Identity) Reset()         { *m = Identity{} }
+func (m *Identity) String() string { return proto.CompactTextString(m) }
+func (*Identity) ProtoMessage()    {}
+func (*Identity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{32}
+}
+func (m *Identity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Identity.Unmarshal(m, b)
+}
+func (m *Identity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Identity.Marshal(b, m, deterministic)
+}
+func (dst *Identity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Identity.Merge(dst, src)
+}
+func (m *Identity) XXX_Size() int {
+	return xxx_messageInfo_Identity.Size(m)
+}
+func (m *Identity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Identity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Identity proto.InternalMessageInfo
+
+func (m *Identity) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *Identity) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Identity) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Identity) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+func (m *Identity) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type IdentitiesRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdentitiesRequest) Reset()         { *m = IdentitiesRequest{} }
+func (m *IdentitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*IdentitiesRequest) ProtoMessage()    {}
+func (*IdentitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{33}
+}
+func (m *IdentitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentitiesRequest.Unmarshal(m, b)
+}
+func (m *IdentitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentitiesRequest.Marshal(b, m, deterministic)
+}
+func (dst *IdentitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentitiesRequest.Merge(dst, src)
+}
+func (m *IdentitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_IdentitiesRequest.Size(m)
+}
+func (m *IdentitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentitiesRequest proto.InternalMessageInfo
+
+func (m *IdentitiesRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type IdentitiesResponse struct {
+	Identities           []*Identity `protobuf:"bytes,1,rep,name=identities" json:"identities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *IdentitiesResponse) Reset()         { *m = IdentitiesResponse{} }
+func (m *IdentitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*IdentitiesResponse) ProtoMessage()    {}
+func (*IdentitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{34}
+}
+func (m *IdentitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentitiesResponse.Unmarshal(m, b)
+}
+func (m *IdentitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentitiesResponse.Marshal(b, m, deterministic)
+}
+func (dst *IdentitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentitiesResponse.Merge(dst, src)
+}
+func (m *IdentitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_IdentitiesResponse.Size(m)
+}
+func (m *IdentitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentitiesResponse proto.InternalMessageInfo
+
+func (m *IdentitiesResponse) GetIdentities() []*Identity {
+	if m != nil {
+		return m.Identities
+	}
+	return nil
+}
+
+type UnlinkIdentityRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Provider             string   `protobuf:"bytes,2,opt,name=provider" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlinkIdentityRequest) Reset()         { *m = UnlinkIdentityRequest{} }
+func (m *UnlinkIdentityRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlinkIdentityRequest) ProtoMessage()    {}
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{35}
+}
+func (m *UnlinkIdentityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlinkIdentityRequest.Unmarshal(m, b)
+}
+func (m *UnlinkIdentityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlinkIdentityRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnlinkIdentityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlinkIdentityRequest.Merge(dst, src)
+}
+func (m *UnlinkIdentityRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlinkIdentityRequest.Size(m)
+}
+func (m *UnlinkIdentityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlinkIdentityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlinkIdentityRequest proto.InternalMessageInfo
+
+func (m *UnlinkIdentityRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UnlinkIdentityRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlinkIdentityResponse) Reset()         { *m = UnlinkIdentityResponse{} }
+func (m *UnlinkIdentityResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlinkIdentityResponse) ProtoMessage()    {}
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{36}
+}
+func (m *UnlinkIdentityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlinkIdentityResponse.Unmarshal(m, b)
+}
+func (m *UnlinkIdentityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlinkIdentityResponse.Marshal(b, m, deterministic)
+}
+func (dst *UnlinkIdentityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlinkIdentityResponse.Merge(dst, src)
+}
+func (m *UnlinkIdentityResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlinkIdentityResponse.Size(m)
+}
+func (m *UnlinkIdentityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlinkIdentityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlinkIdentityResponse proto.InternalMessageInfo
+
+type AccessToken struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// first characters of the token to tell tokens apart
+	Prefix    string   `protobuf:"bytes,3,opt,name=prefix" json:"prefix,omitempty"`
+	Scopes    []string `protobuf:"bytes,4,rep,name=scopes" json:"scopes,omitempty"`
+	CreatedAt int64    `protobuf:"varint,5,opt,name=createdAt" json:"createdAt,omitempty"`
+	// 0 if never used
+	LastUsedAt           int64    `protobuf:"varint,6,opt,name=lastUsedAt" json:"lastUsedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessToken) Reset()         { *m = AccessToken{} }
+func (m *AccessToken) String() string { return proto.CompactTextString(m) }
+func (*AccessToken) ProtoMessage()    {}
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{37}
+}
+func (m *AccessToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessToken.Unmarshal(m, b)
+}
+func (m *AccessToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessToken.Marshal(b, m, deterministic)
+}
+func (dst *AccessToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessToken.Merge(dst, src)
+}
+func (m *AccessToken) XXX_Size() int {
+	return xxx_messageInfo_AccessToken.Size(m)
+}
+func (m *AccessToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessToken proto.InternalMessageInfo
+
+func (m *AccessToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AccessToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccessToken) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AccessToken) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *AccessToken) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *AccessToken) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+type CreateAccessTokenRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Scopes               []string `protobuf:"bytes,3,rep,name=scopes" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAccessTokenRequest) Reset()         { *m = CreateAccessTokenRequest{} }
+func (m *CreateAccessTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessTokenRequest) ProtoMessage()    {}
+func (*CreateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{38}
+}
+func (m *CreateAccessTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccessTokenRequest.Unmarshal(m, b)
+}
+func (m *CreateAccessTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccessTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAccessTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessTokenRequest.Merge(dst, src)
+}
+func (m *CreateAccessTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccessTokenRequest.Size(m)
+}
+func (m *CreateAccessTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessTokenRequest proto.InternalMessageInfo
+
+func (m *CreateAccessTokenRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CreateAccessTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAccessTokenRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type CreateAccessTokenResponse struct {
+	Info                 *AccessToken `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Token                string       `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateAccessTokenResponse) Reset()         { *m = CreateAccessTokenResponse{} }
+func (m *CreateAccessTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessTokenResponse) ProtoMessage()    {}
+func (*CreateAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{39}
+}
+func (m *CreateAccessTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccessTokenResponse.Unmarshal(m, b)
+}
+func (m *CreateAccessTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccessTokenResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateAccessTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessTokenResponse.Merge(dst, src)
+}
+func (m *CreateAccessTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAccessTokenResponse.Size(m)
+}
+func (m *CreateAccessTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessTokenResponse proto.InternalMessageInfo
+
+func (m *CreateAccessTokenResponse) GetInfo() *AccessToken {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *CreateAccessTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type AccessTokensRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessTokensRequest) Reset()         { *m = AccessTokensRequest{} }
+func (m *AccessTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*AccessTokensRequest) ProtoMessage()    {}
+func (*AccessTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{40}
+}
+func (m *AccessTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessTokensRequest.Unmarshal(m, b)
+}
+func (m *AccessTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessTokensRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccessTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTokensRequest.Merge(dst, src)
+}
+func (m *AccessTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_AccessTokensRequest.Size(m)
+}
+func (m *AccessTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessTokensRequest proto.InternalMessageInfo
+
+func (m *AccessTokensRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type AccessTokensResponse struct {
+	Tokens               []*AccessToken `protobuf:"bytes,1,rep,name=tokens" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AccessTokensResponse) Reset()         { *m = AccessTokensResponse{} }
+func (m *AccessTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*AccessTokensResponse) ProtoMessage()    {}
+func (*AccessTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{41}
+}
+func (m *AccessTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessTokensResponse.Unmarshal(m, b)
+}
+func (m *AccessTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessTokensResponse.Marshal(b, m, deterministic)
+}
+func (dst *AccessTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTokensResponse.Merge(dst, src)
+}
+func (m *AccessTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_AccessTokensResponse.Size(m)
+}
+func (m *AccessTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessTokensResponse proto.InternalMessageInfo
+
+func (m *AccessTokensResponse) GetTokens() []*AccessToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type RevokeAccessTokenRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAccessTokenRequest) Reset()         { *m = RevokeAccessTokenRequest{} }
+func (m *RevokeAccessTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAccessTokenRequest) ProtoMessage()    {}
+func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{42}
+}
+func (m *RevokeAccessTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAccessTokenRequest.Unmarshal(m, b)
+}
+func (m *RevokeAccessTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAccessTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAccessTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAccessTokenRequest.Merge(dst, src)
+}
+func (m *RevokeAccessTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAccessTokenRequest.Size(m)
+}
+func (m *RevokeAccessTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAccessTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAccessTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeAccessTokenRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RevokeAccessTokenRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RevokeAccessTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAccessTokenResponse) Reset()         { *m = RevokeAccessTokenResponse{} }
+func (m *RevokeAccessTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAccessTokenResponse) ProtoMessage()    {}
+func (*RevokeAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{43}
+}
+func (m *RevokeAccessTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAccessTokenResponse.Unmarshal(m, b)
+}
+func (m *RevokeAccessTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAccessTokenResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAccessTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAccessTokenResponse.Merge(dst, src)
+}
+func (m *RevokeAccessTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAccessTokenResponse.Size(m)
+}
+func (m *RevokeAccessTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAccessTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAccessTokenResponse proto.InternalMessageInfo
+
+type VerifyAccessTokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyAccessTokenRequest) Reset()         { *m = VerifyAccessTokenRequest{} }
+func (m *VerifyAccessTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyAccessTokenRequest) ProtoMessage()    {}
+func (*VerifyAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{44}
+}
+func (m *VerifyAccessTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyAccessTokenRequest.Unmarshal(m, b)
+}
+func (m *VerifyAccessTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyAccessTokenRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyAccessTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAccessTokenRequest.Merge(dst, src)
+}
+func (m *VerifyAccessTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyAccessTokenRequest.Size(m)
+}
+func (m *VerifyAccessTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAccessTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAccessTokenRequest proto.InternalMessageInfo
+
+func (m *VerifyAccessTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type VerifyAccessTokenResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Scopes               []string     `protobuf:"bytes,2,rep,name=scopes" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *VerifyAccessTokenResponse) Reset()         { *m = VerifyAccessTokenResponse{} }
+func (m *VerifyAccessTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyAccessTokenResponse) ProtoMessage()    {}
+func (*VerifyAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{45}
+}
+func (m *VerifyAccessTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyAccessTokenResponse.Unmarshal(m, b)
+}
+func (m *VerifyAccessTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyAccessTokenResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyAccessTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAccessTokenResponse.Merge(dst, src)
+}
+func (m *VerifyAccessTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyAccessTokenResponse.Size(m)
+}
+func (m *VerifyAccessTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAccessTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyAccessTokenResponse proto.InternalMessageInfo
+
+func (m *VerifyAccessTokenResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *VerifyAccessTokenResponse) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type Session struct {
+	Id         string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	UserAgent  string `protobuf:"bytes,2,opt,name=userAgent" json:"userAgent,omitempty"`
+	Ip         string `protobuf:"bytes,3,opt,name=ip" json:"ip,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	LastSeenAt int64  `protobuf:"varint,5,opt,name=lastSeenAt" json:"lastSeenAt,omitempty"`
+	ExpireAt   int64  `protobuf:"varint,6,opt,name=expireAt" json:"expireAt,omitempty"`
+	// the session of the request
+	Current              bool     `protobuf:"varint,7,opt,name=current" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{46}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (dst *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(dst, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Session) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *Session) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *Session) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Session) GetLastSeenAt() int64 {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return 0
+}
+
+func (m *Session) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+func (m *Session) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type CreateSessionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	UserAgent            string   `protobuf:"bytes,2,opt,name=userAgent" json:"userAgent,omitempty"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSessionRequest) Reset()         { *m = CreateSessionRequest{} }
+func (m *CreateSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSessionRequest) ProtoMessage()    {}
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{47}
+}
+func (m *CreateSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSessionRequest.Unmarshal(m, b)
+}
+func (m *CreateSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSessionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSessionRequest.Merge(dst, src)
+}
+func (m *CreateSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSessionRequest.Size(m)
+}
+func (m *CreateSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSessionRequest proto.InternalMessageInfo
+
+func (m *CreateSessionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CreateSessionRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *CreateSessionRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type CreateSessionResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken" json:"refreshToken,omitempty"`
+	ExpireAt             int64    `protobuf:"varint,3,opt,name=expireAt" json:"expireAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSessionResponse) Reset()         { *m = CreateSessionResponse{} }
+func (m *CreateSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSessionResponse) ProtoMessage()    {}
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{48}
+}
+func (m *CreateSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSessionResponse.Unmarshal(m, b)
+}
+func (m *CreateSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSessionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSessionResponse.Merge(dst, src)
+}
+func (m *CreateSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateSessionResponse.Size(m)
+}
+func (m *CreateSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSessionResponse proto.InternalMessageInfo
+
+func (m *CreateSessionResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateSessionResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *CreateSessionResponse) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+type RefreshSessionRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken" json:"refreshToken,omitempty"`
+	UserAgent            string   `protobuf:"bytes,2,opt,name=userAgent" json:"userAgent,omitempty"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshSessionRequest) Reset()         { *m = RefreshSessionRequest{} }
+func (m *RefreshSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshSessionRequest) ProtoMessage()    {}
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{49}
+}
+func (m *RefreshSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshSessionRequest.Unmarshal(m, b)
+}
+func (m *RefreshSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshSessionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RefreshSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshSessionRequest.Merge(dst, src)
+}
+func (m *RefreshSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshSessionRequest.Size(m)
+}
+func (m *RefreshSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshSessionRequest proto.InternalMessageInfo
+
+func (m *RefreshSessionRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *RefreshSessionRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *RefreshSessionRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	Info *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Id   string       `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	// empty if the refresh token was just rotated by another request, the
+	// new one is kept by that request
+	RefreshToken         string   `protobuf:"bytes,3,opt,name=refreshToken" json:"refreshToken,omitempty"`
+	ExpireAt             int64    `protobuf:"varint,4,opt,name=expireAt" json:"expireAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshSessionResponse) Reset()         { *m = RefreshSessionResponse{} }
+func (m *RefreshSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshSessionResponse) ProtoMessage()    {}
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{50}
+}
+func (m *RefreshSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshSessionResponse.Unmarshal(m, b)
+}
+func (m *RefreshSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshSessionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RefreshSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshSessionResponse.Merge(dst, src)
+}
+func (m *RefreshSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshSessionResponse.Size(m)
+}
+func (m *RefreshSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshSessionResponse proto.InternalMessageInfo
+
+func (m *RefreshSessionResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *RefreshSessionResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RefreshSessionResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *RefreshSessionResponse) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+type CheckSessionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckSessionRequest) Reset()         { *m = CheckSessionRequest{} }
+func (m *CheckSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckSessionRequest) ProtoMessage()    {}
+func (*CheckSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{51}
+}
+func (m *CheckSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckSessionRequest.Unmarshal(m, b)
+}
+func (m *CheckSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckSessionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CheckSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckSessionRequest.Merge(dst, src)
+}
+func (m *CheckSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckSessionRequest.Size(m)
+}
+func (m *CheckSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckSessionRequest proto.InternalMessageInfo
+
+func (m *CheckSessionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CheckSessionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CheckSessionResponse struct {
+	TokenVersion         int64    `protobuf:"varint,1,opt,name=tokenVersion" json:"tokenVersion,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckSessionResponse) Reset()         { *m = CheckSessionResponse{} }
+func (m *CheckSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckSessionResponse) ProtoMessage()    {}
+func (*CheckSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{52}
+}
+func (m *CheckSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckSessionResponse.Unmarshal(m, b)
+}
+func (m *CheckSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckSessionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CheckSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckSessionResponse.Merge(dst, src)
+}
+func (m *CheckSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckSessionResponse.Size(m)
+}
+func (m *CheckSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckSessionResponse proto.InternalMessageInfo
+
+func (m *CheckSessionResponse) GetTokenVersion() int64 {
+	if m != nil {
+		return m.TokenVersion
+	}
+	return 0
+}
+
+func (m *CheckSessionResponse) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type SessionsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionsRequest) Reset()         { *m = SessionsRequest{} }
+func (m *SessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionsRequest) ProtoMessage()    {}
+func (*SessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{53}
+}
+func (m *SessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionsRequest.Unmarshal(m, b)
+}
+func (m *SessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionsRequest.Merge(dst, src)
+}
+func (m *SessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SessionsRequest.Size(m)
+}
+func (m *SessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionsRequest proto.InternalMessageInfo
+
+func (m *SessionsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type SessionsResponse struct {
+	Sessions             []*Session `protobuf:"bytes,1,rep,name=sessions" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SessionsResponse) Reset()         { *m = SessionsResponse{} }
+func (m *SessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SessionsResponse) ProtoMessage()    {}
+func (*SessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{54}
+}
+func (m *SessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionsResponse.Unmarshal(m, b)
+}
+func (m *SessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionsResponse.Merge(dst, src)
+}
+func (m *SessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_SessionsResponse.Size(m)
+}
+func (m *SessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionsResponse proto.InternalMessageInfo
+
+func (m *SessionsResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionRequest) Reset()         { *m = RevokeSessionRequest{} }
+func (m *RevokeSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionRequest) ProtoMessage()    {}
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{55}
+}
+func (m *RevokeSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionRequest.Unmarshal(m, b)
+}
+func (m *RevokeSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionRequest.Merge(dst, src)
+}
+func (m *RevokeSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionRequest.Size(m)
+}
+func (m *RevokeSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RevokeSessionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionResponse) Reset()         { *m = RevokeSessionResponse{} }
+func (m *RevokeSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionResponse) ProtoMessage()    {}
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{56}
+}
+func (m *RevokeSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionResponse.Unmarshal(m, b)
+}
+func (m *RevokeSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionResponse.Merge(dst, src)
+}
+func (m *RevokeSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionResponse.Size(m)
+}
+func (m *RevokeSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionResponse proto.InternalMessageInfo
+
+type RevokeSessionsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionsRequest) Reset()         { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()    {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{57}
+}
+func (m *RevokeSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionsRequest.Unmarshal(m, b)
+}
+func (m *RevokeSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsRequest.Merge(dst, src)
+}
+func (m *RevokeSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionsRequest.Size(m)
+}
+func (m *RevokeSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionsRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RevokeSessionsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionsResponse) Reset()         { *m = RevokeSessionsResponse{} }
+func (m *RevokeSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsResponse) ProtoMessage()    {}
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{58}
+}
+func (m *RevokeSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionsResponse.Unmarshal(m, b)
+}
+func (m *RevokeSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsResponse.Merge(dst, src)
+}
+func (m *RevokeSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionsResponse.Size(m)
+}
+func (m *RevokeSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionsResponse proto.InternalMessageInfo
+
+type Profile struct {
+	DisplayName          string   `protobuf:"bytes,1,opt,name=displayName" json:"displayName,omitempty"`
+	Bio                  string   `protobuf:"bytes,2,opt,name=bio" json:"bio,omitempty"`
+	Links                []string `protobuf:"bytes,3,rep,name=links" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+func (*Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{59}
+}
+func (m *Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile.Unmarshal(m, b)
+}
+func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+}
+func (dst *Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile.Merge(dst, src)
+}
+func (m *Profile) XXX_Size() int {
+	return xxx_messageInfo_Profile.Size(m)
+}
+func (m *Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile proto.InternalMessageInfo
+
+func (m *Profile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Profile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *Profile) GetLinks() []string {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type ProfileRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileRequest) Reset()         { *m = ProfileRequest{} }
+func (m *ProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*ProfileRequest) ProtoMessage()    {}
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{60}
+}
+func (m *ProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileRequest.Unmarshal(m, b)
+}
+func (m *ProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileRequest.Merge(dst, src)
+}
+func (m *ProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_ProfileRequest.Size(m)
+}
+func (m *ProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileRequest proto.InternalMessageInfo
+
+func (m *ProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ProfileResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Profile              *Profile     `protobuf:"bytes,2,opt,name=profile" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ProfileResponse) Reset()         { *m = ProfileResponse{} }
+func (m *ProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*ProfileResponse) ProtoMessage()    {}
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{61}
+}
+func (m *ProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileResponse.Unmarshal(m, b)
+}
+func (m *ProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileResponse.Marshal(b, m, deterministic)
+}
+func (dst *ProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileResponse.Merge(dst, src)
+}
+func (m *ProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_ProfileResponse.Size(m)
+}
+func (m *ProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileResponse proto.InternalMessageInfo
+
+func (m *ProfileResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *ProfileResponse) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Profile              *Profile `protobuf:"bytes,2,opt,name=profile" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileRequest) Reset()         { *m = UpdateProfileRequest{} }
+func (m *UpdateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileRequest) ProtoMessage()    {}
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{62}
+}
+func (m *UpdateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileRequest.Unmarshal(m, b)
+}
+func (m *UpdateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileRequest.Merge(dst, src)
+}
+func (m *UpdateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileRequest.Size(m)
+}
+func (m *UpdateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileRequest proto.InternalMessageInfo
+
+func (m *UpdateProfileRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	// the profile saved, with spaces trimmed
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileResponse) Reset()         { *m = UpdateProfileResponse{} }
+func (m *UpdateProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileResponse) ProtoMessage()    {}
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{63}
+}
+func (m *UpdateProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileResponse.Unmarshal(m, b)
+}
+func (m *UpdateProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileResponse.Merge(dst, src)
+}
+func (m *UpdateProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileResponse.Size(m)
+}
+func (m *UpdateProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileResponse proto.InternalMessageInfo
+
+func (m *UpdateProfileResponse) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type SetAvatarRequest struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	// empty to remove the avatar
+	Key                  string   `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAvatarRequest) Reset()         { *m = SetAvatarRequest{} }
+func (m *SetAvatarRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAvatarRequest) ProtoMessage()    {}
+func (*SetAvatarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{64}
+}
+func (m *SetAvatarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAvatarRequest.Unmarshal(m, b)
+}
+func (m *SetAvatarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAvatarRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetAvatarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAvatarRequest.Merge(dst, src)
+}
+func (m *SetAvatarRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAvatarRequest.Size(m)
+}
+func (m *SetAvatarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAvatarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAvatarRequest proto.InternalMessageInfo
+
+func (m *SetAvatarRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetAvatarRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type SetAvatarResponse struct {
+	OldKey string `protobuf:"bytes,1,opt,name=oldKey" json:"oldKey,omitempty"`
+	// url of the new avatar
+	Avatar               string   `protobuf:"bytes,2,opt,name=avatar" json:"avatar,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAvatarResponse) Reset()         { *m = SetAvatarResponse{} }
+func (m *SetAvatarResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAvatarResponse) ProtoMessage()    {}
+func (*SetAvatarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{65}
+}
+func (m *SetAvatarResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAvatarResponse.Unmarshal(m, b)
+}
+func (m *SetAvatarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAvatarResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetAvatarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAvatarResponse.Merge(dst, src)
+}
+func (m *SetAvatarResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAvatarResponse.Size(m)
+}
+func (m *SetAvatarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAvatarResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAvatarResponse proto.InternalMessageInfo
+
+func (m *SetAvatarResponse) GetOldKey() string {
+	if m != nil {
+		return m.OldKey
+	}
+	return ""
+}
+
+func (m *SetAvatarResponse) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	// login_succeeded, login_failed, login_locked, totp_enabled,
+	// totp_disabled, recovery_code_used or recovery_codes_regenerated
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
+	UserAgent            string   `protobuf:"bytes,3,opt,name=userAgent" json:"userAgent,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{66}
+}
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (dst *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(dst, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AuditEventsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEventsRequest) Reset()         { *m = AuditEventsRequest{} }
+func (m *AuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditEventsRequest) ProtoMessage()    {}
+func (*AuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{67}
+}
+func (m *AuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEventsRequest.Unmarshal(m, b)
+}
+func (m *AuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *AuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEventsRequest.Merge(dst, src)
+}
+func (m *AuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditEventsRequest.Size(m)
+}
+func (m *AuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEventsRequest proto.InternalMessageInfo
+
+func (m *AuditEventsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AuditEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AuditEventsResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditEventsResponse) Reset()         { *m = AuditEventsResponse{} }
+func (m *AuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditEventsResponse) ProtoMessage()    {}
+func (*AuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{68}
+}
+func (m *AuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEventsResponse.Unmarshal(m, b)
+}
+func (m *AuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *AuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEventsResponse.Merge(dst, src)
+}
+func (m *AuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditEventsResponse.Size(m)
+}
+func (m *AuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEventsResponse proto.InternalMessageInfo
+
+func (m *AuditEventsResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type EnrollTotpRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnrollTotpRequest) Reset()         { *m = EnrollTotpRequest{} }
+func (m *EnrollTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*EnrollTotpRequest) ProtoMessage()    {}
+func (*EnrollTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{69}
+}
+func (m *EnrollTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTotpRequest.Unmarshal(m, b)
+}
+func (m *EnrollTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTotpRequest.Marshal(b, m, deterministic)
+}
+func (dst *EnrollTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTotpRequest.Merge(dst, src)
+}
+func (m *EnrollTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_EnrollTotpRequest.Size(m)
+}
+func (m *EnrollTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnrollTotpRequest proto.InternalMessageInfo
+
+func (m *EnrollTotpRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type EnrollTotpResponse struct {
+	// base32 secret for typing into apps
+	Secret string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+	// otpauth uri to show as a QR code
+	Uri                  string   `protobuf:"bytes,2,opt,name=uri" json:"uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnrollTotpResponse) Reset()         { *m = EnrollTotpResponse{} }
+func (m *EnrollTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*EnrollTotpResponse) ProtoMessage()    {}
+func (*EnrollTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{70}
+}
+func (m *EnrollTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTotpResponse.Unmarshal(m, b)
+}
+func (m *EnrollTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTotpResponse.Marshal(b, m, deterministic)
+}
+func (dst *EnrollTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTotpResponse.Merge(dst, src)
+}
+func (m *EnrollTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_EnrollTotpResponse.Size(m)
+}
+func (m *EnrollTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTotpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnrollTotpResponse proto.InternalMessageInfo
+
+func (m *EnrollTotpResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *EnrollTotpResponse) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type ConfirmTotpRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTotpRequest) Reset()         { *m = ConfirmTotpRequest{} }
+func (m *ConfirmTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTotpRequest) ProtoMessage()    {}
+func (*ConfirmTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{71}
+}
+func (m *ConfirmTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTotpRequest.Unmarshal(m, b)
+}
+func (m *ConfirmTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTotpRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTotpRequest.Merge(dst, src)
+}
+func (m *ConfirmTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTotpRequest.Size(m)
+}
+func (m *ConfirmTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTotpRequest proto.InternalMessageInfo
+
+func (m *ConfirmTotpRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ConfirmTotpRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type ConfirmTotpResponse struct {
+	// only returned once, users should save them
+	RecoveryCodes        []string `protobuf:"bytes,1,rep,name=recoveryCodes" json:"recoveryCodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTotpResponse) Reset()         { *m = ConfirmTotpResponse{} }
+func (m *ConfirmTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTotpResponse) ProtoMessage()    {}
+func (*ConfirmTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{72}
+}
+func (m *ConfirmTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTotpResponse.Unmarshal(m, b)
+}
+func (m *ConfirmTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTotpResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTotpResponse.Merge(dst, src)
+}
+func (m *ConfirmTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTotpResponse.Size(m)
+}
+func (m *ConfirmTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTotpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTotpResponse proto.InternalMessageInfo
+
+func (m *ConfirmTotpResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifyTotpRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip" json:"ip,omitempty"`
+	UserAgent            string   `protobuf:"bytes,4,opt,name=userAgent" json:"userAgent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyTotpRequest) Reset()         { *m = VerifyTotpRequest{} }
+func (m *VerifyTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyTotpRequest) ProtoMessage()    {}
+func (*VerifyTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{73}
+}
+func (m *VerifyTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTotpRequest.Unmarshal(m, b)
+}
+func (m *VerifyTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTotpRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTotpRequest.Merge(dst, src)
+}
+func (m *VerifyTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyTotpRequest.Size(m)
+}
+func (m *VerifyTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTotpRequest proto.InternalMessageInfo
+
+func (m *VerifyTotpRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *VerifyTotpRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *VerifyTotpRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *VerifyTotpRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+type VerifyTotpResponse struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *VerifyTotpResponse) Reset()         { *m = VerifyTotpResponse{} }
+func (m *VerifyTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyTotpResponse) ProtoMessage()    {}
+func (*VerifyTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{74}
+}
+func (m *VerifyTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTotpResponse.Unmarshal(m, b)
+}
+func (m *VerifyTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTotpResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTotpResponse.Merge(dst, src)
+}
+func (m *VerifyTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyTotpResponse.Size(m)
+}
+func (m *VerifyTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTotpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTotpResponse proto.InternalMessageInfo
+
+func (m *VerifyTotpResponse) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type TotpStatusRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TotpStatusRequest) Reset()         { *m = TotpStatusRequest{} }
+func (m *TotpStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*TotpStatusRequest) ProtoMessage()    {}
+func (*TotpStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{75}
+}
+func (m *TotpStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotpStatusRequest.Unmarshal(m, b)
+}
+func (m *TotpStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotpStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *TotpStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotpStatusRequest.Merge(dst, src)
+}
+func (m *TotpStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_TotpStatusRequest.Size(m)
+}
+func (m *TotpStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotpStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotpStatusRequest proto.InternalMessageInfo
+
+func (m *TotpStatusRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type TotpStatusResponse struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	RecoveryCodesLeft    int32    `protobuf:"varint,2,opt,name=recoveryCodesLeft" json:"recoveryCodesLeft,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TotpStatusResponse) Reset()         { *m = TotpStatusResponse{} }
+func (m *TotpStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TotpStatusResponse) ProtoMessage()    {}
+func (*TotpStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{76}
+}
+func (m *TotpStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotpStatusResponse.Unmarshal(m, b)
+}
+func (m *TotpStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotpStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *TotpStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotpStatusResponse.Merge(dst, src)
+}
+func (m *TotpStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_TotpStatusResponse.Size(m)
+}
+func (m *TotpStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotpStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotpStatusResponse proto.InternalMessageInfo
+
+func (m *TotpStatusResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TotpStatusResponse) GetRecoveryCodesLeft() int32 {
+	if m != nil {
+		return m.RecoveryCodesLeft
+	}
+	return 0
+}
+
+type DisableTotpRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableTotpRequest) Reset()         { *m = DisableTotpRequest{} }
+func (m *DisableTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTotpRequest) ProtoMessage()    {}
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{77}
+}
+func (m *DisableTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTotpRequest.Unmarshal(m, b)
+}
+func (m *DisableTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTotpRequest.Marshal(b, m, deterministic)
+}
+func (dst *DisableTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTotpRequest.Merge(dst, src)
+}
+func (m *DisableTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_DisableTotpRequest.Size(m)
+}
+func (m *DisableTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableTotpRequest proto.InternalMessageInfo
+
+func (m *DisableTotpRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DisableTotpRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type DisableTotpResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableTotpResponse) Reset()         { *m = DisableTotpResponse{} }
+func (m *DisableTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableTotpResponse) ProtoMessage()    {}
+func (*DisableTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{78}
+}
+func (m *DisableTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTotpResponse.Unmarshal(m, b)
+}
+func (m *DisableTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTotpResponse.Marshal(b, m, deterministic)
+}
+func (dst *DisableTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTotpResponse.Merge(dst, src)
+}
+func (m *DisableTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_DisableTotpResponse.Size(m)
+}
+func (m *DisableTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTotpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableTotpResponse proto.InternalMessageInfo
+
+type RegenerateRecoveryCodesRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegenerateRecoveryCodesRequest) Reset()         { *m = RegenerateRecoveryCodesRequest{} }
+func (m *RegenerateRecoveryCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*RegenerateRecoveryCodesRequest) ProtoMessage()    {}
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{79}
+}
+func (m *RegenerateRecoveryCodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegenerateRecoveryCodesRequest.Unmarshal(m, b)
+}
+func (m *RegenerateRecoveryCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegenerateRecoveryCodesRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegenerateRecoveryCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegenerateRecoveryCodesRequest.Merge(dst, src)
+}
+func (m *RegenerateRecoveryCodesRequest) XXX_Size() int {
+	return xxx_messageInfo_RegenerateRecoveryCodesRequest.Size(m)
+}
+func (m *RegenerateRecoveryCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegenerateRecoveryCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegenerateRecoveryCodesRequest proto.InternalMessageInfo
+
+func (m *RegenerateRecoveryCodesRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RegenerateRecoveryCodesRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	RecoveryCodes        []string `protobuf:"bytes,1,rep,name=recoveryCodes" json:"recoveryCodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegenerateRecoveryCodesResponse) Reset()         { *m = RegenerateRecoveryCodesResponse{} }
+func (m *RegenerateRecoveryCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*RegenerateRecoveryCodesResponse) ProtoMessage()    {}
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{80}
+}
+func (m *RegenerateRecoveryCodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegenerateRecoveryCodesResponse.Unmarshal(m, b)
+}
+func (m *RegenerateRecoveryCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegenerateRecoveryCodesResponse.Marshal(b, m, deterministic)
+}
+func (dst *RegenerateRecoveryCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegenerateRecoveryCodesResponse.Merge(dst, src)
+}
+func (m *RegenerateRecoveryCodesResponse) XXX_Size() int {
+	return xxx_messageInfo_RegenerateRecoveryCodesResponse.Size(m)
+}
+func (m *RegenerateRecoveryCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegenerateRecoveryCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegenerateRecoveryCodesResponse proto.InternalMessageInfo
+
+func (m *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type AdminAccount struct {
+	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Email                string       `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	Activated            bool         `protobuf:"varint,3,opt,name=activated" json:"activated,omitempty"`
+	Disabled             bool         `protobuf:"varint,4,opt,name=disabled" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AdminAccount) Reset()         { *m = AdminAccount{} }
+func (m *AdminAccount) String() string { return proto.CompactTextString(m) }
+func (*AdminAccount) ProtoMessage()    {}
+func (*AdminAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{81}
+}
+func (m *AdminAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminAccount.Unmarshal(m, b)
+}
+func (m *AdminAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminAccount.Marshal(b, m, deterministic)
+}
+func (dst *AdminAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAccount.Merge(dst, src)
+}
+func (m *AdminAccount) XXX_Size() int {
+	return xxx_messageInfo_AdminAccount.Size(m)
+}
+func (m *AdminAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAccount proto.InternalMessageInfo
+
+func (m *AdminAccount) GetInfo() *AccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *AdminAccount) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *AdminAccount) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *AdminAccount) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type ListAccountsRequest struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	Skip                 int32    `protobuf:"varint,2,opt,name=skip" json:"skip,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{82}
+}
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(dst, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListAccountsRequest) GetSkip() int32 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *ListAccountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListAccountsResponse struct {
+	Accounts             []*AdminAccount `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
+	Total                int64           `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{83}
+}
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(dst, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*AdminAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *ListAccountsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type SetAccountDisabledRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
+	Disabled             bool     `protobuf:"varint,3,opt,name=disabled" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAccountDisabledRequest) Reset()         { *m = SetAccountDisabledRequest{} }
+func (m *SetAccountDisabledRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAccountDisabledRequest) ProtoMessage()    {}
+func (*SetAccountDisabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{84}
+}
+func (m *SetAccountDisabledRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAccountDisabledRequest.Unmarshal(m, b)
+}
+func (m *SetAccountDisabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAccountDisabledRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetAccountDisabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountDisabledRequest.Merge(dst, src)
+}
+func (m *SetAccountDisabledRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAccountDisabledRequest.Size(m)
+}
+func (m *SetAccountDisabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountDisabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountDisabledRequest proto.InternalMessageInfo
+
+func (m *SetAccountDisabledRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetAccountDisabledRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SetAccountDisabledRequest) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type SetAccountDisabledResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAccountDisabledResponse) Reset()         { *m = SetAccountDisabledResponse{} }
+func (m *SetAccountDisabledResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAccountDisabledResponse) ProtoMessage()    {}
+func (*SetAccountDisabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{85}
+}
+func (m *SetAccountDisabledResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAccountDisabledResponse.Unmarshal(m, b)
+}
+func (m *SetAccountDisabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAccountDisabledResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetAccountDisabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountDisabledResponse.Merge(dst, src)
+}
+func (m *SetAccountDisabledResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAccountDisabledResponse.Size(m)
+}
+func (m *SetAccountDisabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountDisabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountDisabledResponse proto.InternalMessageInfo
+
+type CreateOrganizationRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationRequest) Reset()         { *m = CreateOrganizationRequest{} }
+func (m *CreateOrganizationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationRequest) ProtoMessage()    {}
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{86}
+}
+func (m *CreateOrganizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationRequest.Merge(dst, src)
+}
+func (m *CreateOrganizationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationRequest.Size(m)
+}
+func (m *CreateOrganizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CreateOrganizationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationResponse) Reset()         { *m = CreateOrganizationResponse{} }
+func (m *CreateOrganizationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationResponse) ProtoMessage()    {}
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{87}
+}
+func (m *CreateOrganizationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationResponse.Unmarshal(m, b)
+}
+func (m *CreateOrganizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateOrganizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationResponse.Merge(dst, src)
+}
+func (m *CreateOrganizationResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationResponse.Size(m)
+}
+func (m *CreateOrganizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationResponse proto.InternalMessageInfo
+
+func (m *CreateOrganizationResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type OrganizationRoleRequest struct {
+	Org                  string   `protobuf:"bytes,1,opt,name=org" json:"org,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationRoleRequest) Reset()         { *m = OrganizationRoleRequest{} }
+func (m *OrganizationRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OrganizationRoleRequest) ProtoMessage()    {}
+func (*OrganizationRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{88}
+}
+func (m *OrganizationRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationRoleRequest.Unmarshal(m, b)
+}
+func (m *OrganizationRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationRoleRequest.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationRoleRequest.Merge(dst, src)
+}
+func (m *OrganizationRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OrganizationRoleRequest.Size(m)
+}
+func (m *OrganizationRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationRoleRequest proto.InternalMessageInfo
+
+func (m *OrganizationRoleRequest) GetOrg() string {
+	if m != nil {
+		return m.Org
+	}
+	return ""
+}
+
+func (m *OrganizationRoleRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type OrganizationRoleResponse struct {
+	Organization         bool     `protobuf:"varint,1,opt,name=organization" json:"organization,omitempty"`
+	Role                 OrgRole  `protobuf:"varint,2,opt,name=role,enum=account.OrgRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationRoleResponse) Reset()         { *m = OrganizationRoleResponse{} }
+func (m *OrganizationRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*OrganizationRoleResponse) ProtoMessage()    {}
+func (*OrganizationRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{89}
+}
+func (m *OrganizationRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationRoleResponse.Unmarshal(m, b)
+}
+func (m *OrganizationRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationRoleResponse.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationRoleResponse.Merge(dst, src)
+}
+func (m *OrganizationRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_OrganizationRoleResponse.Size(m)
+}
+func (m *OrganizationRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationRoleResponse proto.InternalMessageInfo
+
+func (m *OrganizationRoleResponse) GetOrganization() bool {
+	if m != nil {
+		return m.Organization
+	}
+	return false
+}
+
+func (m *OrganizationRoleResponse) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_OrgRoleNone
+}
+
+type OrganizationMember struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Role                 OrgRole  `protobuf:"varint,3,opt,name=role,enum=account.OrgRole" json:"role,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationMember) Reset()         { *m = OrganizationMember{} }
+func (m *OrganizationMember) String() string { return proto.CompactTextString(m) }
+func (*OrganizationMember) ProtoMessage()    {}
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{90}
+}
+func (m *OrganizationMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationMember.Unmarshal(m, b)
+}
+func (m *OrganizationMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationMember.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationMember.Merge(dst, src)
+}
+func (m *OrganizationMember) XXX_Size() int {
+	return xxx_messageInfo_OrganizationMember.Size(m)
+}
+func (m *OrganizationMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationMember proto.InternalMessageInfo
+
+func (m *OrganizationMember) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *OrganizationMember) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OrganizationMember) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_OrgRoleNone
+}
+
+func (m *OrganizationMember) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type OrganizationMembersRequest struct {
+	Org                  string   `protobuf:"bytes,1,opt,name=org" json:"org,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationMembersRequest) Reset()         { *m = OrganizationMembersRequest{} }
+func (m *OrganizationMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*OrganizationMembersRequest) ProtoMessage()    {}
+func (*OrganizationMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{91}
+}
+func (m *OrganizationMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationMembersRequest.Unmarshal(m, b)
+}
+func (m *OrganizationMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationMembersRequest.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationMembersRequest.Merge(dst, src)
+}
+func (m *OrganizationMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_OrganizationMembersRequest.Size(m)
+}
+func (m *OrganizationMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationMembersRequest proto.InternalMessageInfo
+
+func (m *OrganizationMembersRequest) GetOrg() string {
+	if m != nil {
+		return m.Org
+	}
+	return ""
+}
+
+type OrganizationMembersResponse struct {
+	Members              []*OrganizationMember `protobuf:"bytes,1,rep,name=members" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *OrganizationMembersResponse) Reset()         { *m = OrganizationMembersResponse{} }
+func (m *OrganizationMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*OrganizationMembersResponse) ProtoMessage()    {}
+func (*OrganizationMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{92}
+}
+func (m *OrganizationMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationMembersResponse.Unmarshal(m, b)
+}
+func (m *OrganizationMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationMembersResponse.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationMembersResponse.Merge(dst, src)
+}
+func (m *OrganizationMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_OrganizationMembersResponse.Size(m)
+}
+func (m *OrganizationMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationMembersResponse proto.InternalMessageInfo
+
+func (m *OrganizationMembersResponse) GetMembers() []*OrganizationMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type SetOrganizationMemberRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Org                  string   `protobuf:"bytes,2,opt,name=org" json:"org,omitempty"`
+	Member               string   `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	Role                 OrgRole  `protobuf:"varint,4,opt,name=role,enum=account.OrgRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetOrganizationMemberRequest) Reset()         { *m = SetOrganizationMemberRequest{} }
+func (m *SetOrganizationMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*SetOrganizationMemberRequest) ProtoMessage()    {}
+func (*SetOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{93}
+}
+func (m *SetOrganizationMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetOrganizationMemberRequest.Unmarshal(m, b)
+}
+func (m *SetOrganizationMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetOrganizationMemberRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetOrganizationMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetOrganizationMemberRequest.Merge(dst, src)
+}
+func (m *SetOrganizationMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_SetOrganizationMemberRequest.Size(m)
+}
+func (m *SetOrganizationMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetOrganizationMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetOrganizationMemberRequest proto.InternalMessageInfo
+
+func (m *SetOrganizationMemberRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetOrganizationMemberRequest) GetOrg() string {
+	if m != nil {
+		return m.Org
+	}
+	return ""
+}
+
+func (m *SetOrganizationMemberRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *SetOrganizationMemberRequest) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_OrgRoleNone
+}
+
+type SetOrganizationMemberResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetOrganizationMemberResponse) Reset()         { *m = SetOrganizationMemberResponse{} }
+func (m *SetOrganizationMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*SetOrganizationMemberResponse) ProtoMessage()    {}
+func (*SetOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{94}
+}
+func (m *SetOrganizationMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetOrganizationMemberResponse.Unmarshal(m, b)
+}
+func (m *SetOrganizationMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetOrganizationMemberResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetOrganizationMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetOrganizationMemberResponse.Merge(dst, src)
+}
+func (m *SetOrganizationMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_SetOrganizationMemberResponse.Size(m)
+}
+func (m *SetOrganizationMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetOrganizationMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetOrganizationMemberResponse proto.InternalMessageInfo
+
+type RemoveOrganizationMemberRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Org                  string   `protobuf:"bytes,2,opt,name=org" json:"org,omitempty"`
+	Member               string   `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveOrganizationMemberRequest) Reset()         { *m = RemoveOrganizationMemberRequest{} }
+func (m *RemoveOrganizationMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveOrganizationMemberRequest) ProtoMessage()    {}
+func (*RemoveOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{95}
+}
+func (m *RemoveOrganizationMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveOrganizationMemberRequest.Unmarshal(m, b)
+}
+func (m *RemoveOrganizationMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveOrganizationMemberRequest.Marshal(b, m, deterministic)
+}
+func (dst *RemoveOrganizationMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOrganizationMemberRequest.Merge(dst, src)
+}
+func (m *RemoveOrganizationMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveOrganizationMemberRequest.Size(m)
+}
+func (m *RemoveOrganizationMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOrganizationMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveOrganizationMemberRequest proto.InternalMessageInfo
+
+func (m *RemoveOrganizationMemberRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RemoveOrganizationMemberRequest) GetOrg() string {
+	if m != nil {
+		return m.Org
+	}
+	return ""
+}
+
+func (m *RemoveOrganizationMemberRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+type RemoveOrganizationMemberResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveOrganizationMemberResponse) Reset()         { *m = RemoveOrganizationMemberResponse{} }
+func (m *RemoveOrganizationMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveOrganizationMemberResponse) ProtoMessage()    {}
+func (*RemoveOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{96}
+}
+func (m *RemoveOrganizationMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveOrganizationMemberResponse.Unmarshal(m, b)
+}
+func (m *RemoveOrganizationMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveOrganizationMemberResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemoveOrganizationMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOrganizationMemberResponse.Merge(dst, src)
+}
+func (m *RemoveOrganizationMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveOrganizationMemberResponse.Size(m)
+}
+func (m *RemoveOrganizationMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOrganizationMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveOrganizationMemberResponse proto.InternalMessageInfo
+
+type UserOrganization struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Role                 OrgRole  `protobuf:"varint,3,opt,name=role,enum=account.OrgRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserOrganization) Reset()         { *m = UserOrganization{} }
+func (m *UserOrganization) String() string { return proto.CompactTextString(m) }
+func (*UserOrganization) ProtoMessage()    {}
+func (*UserOrganization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{97}
+}
+func (m *UserOrganization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserOrganization.Unmarshal(m, b)
+}
+func (m *UserOrganization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserOrganization.Marshal(b, m, deterministic)
+}
+func (dst *UserOrganization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserOrganization.Merge(dst, src)
+}
+func (m *UserOrganization) XXX_Size() int {
+	return xxx_messageInfo_UserOrganization.Size(m)
+}
+func (m *UserOrganization) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserOrganization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserOrganization proto.InternalMessageInfo
+
+func (m *UserOrganization) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UserOrganization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserOrganization) GetRole() OrgRole {
+	if m != nil {
+		return m.Role
+	}
+	return OrgRole_OrgRoleNone
+}
+
+type UserOrganizationsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserOrganizationsRequest) Reset()         { *m = UserOrganizationsRequest{} }
+func (m *UserOrganizationsRequest) String() string { return proto.CompactTextString(m) }
+func (*UserOrganizationsRequest) ProtoMessage()    {}
+func (*UserOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{98}
+}
+func (m *UserOrganizationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserOrganizationsRequest.Unmarshal(m, b)
+}
+func (m *UserOrganizationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserOrganizationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UserOrganizationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserOrganizationsRequest.Merge(dst, src)
+}
+func (m *UserOrganizationsRequest) XXX_Size() int {
+	return xxx_messageInfo_UserOrganizationsRequest.Size(m)
+}
+func (m *UserOrganizationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserOrganizationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserOrganizationsRequest proto.InternalMessageInfo
+
+func (m *UserOrganizationsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type UserOrganizationsResponse struct {
+	Organizations        []*UserOrganization `protobuf:"bytes,1,rep,name=organizations" json:"organizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UserOrganizationsResponse) Reset()         { *m = UserOrganizationsResponse{} }
+func (m *UserOrganizationsResponse) String() string { return proto.CompactTextString(m) }
+func (*UserOrganizationsResponse) ProtoMessage()    {}
+func (*UserOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{99}
+}
+func (m *UserOrganizationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserOrganizationsResponse.Unmarshal(m, b)
+}
+func (m *UserOrganizationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserOrganizationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *UserOrganizationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserOrganizationsResponse.Merge(dst, src)
+}
+func (m *UserOrganizationsResponse) XXX_Size() int {
+	return xxx_messageInfo_UserOrganizationsResponse.Size(m)
+}
+func (m *UserOrganizationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserOrganizationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserOrganizationsResponse proto.InternalMessageInfo
+
+func (m *UserOrganizationsResponse) GetOrganizations() []*UserOrganization {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+type ExportAccountRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountRequest) Reset()         { *m = ExportAccountRequest{} }
+func (m *ExportAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountRequest) ProtoMessage()    {}
+func (*ExportAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{100}
+}
+func (m *ExportAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountRequest.Unmarshal(m, b)
+}
+func (m *ExportAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountRequest.Merge(dst, src)
+}
+func (m *ExportAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountRequest.Size(m)
+}
+func (m *ExportAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountRequest proto.InternalMessageInfo
+
+func (m *ExportAccountRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ExportAccountResponse struct {
+	// zip archive
+	Archive              []byte   `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountResponse) Reset()         { *m = ExportAccountResponse{} }
+func (m *ExportAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountResponse) ProtoMessage()    {}
+func (*ExportAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{101}
+}
+func (m *ExportAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountResponse.Unmarshal(m, b)
+}
+func (m *ExportAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountResponse.Merge(dst, src)
+}
+func (m *ExportAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountResponse.Size(m)
+}
+func (m *ExportAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountResponse proto.InternalMessageInfo
+
+func (m *ExportAccountResponse) GetArchive() []byte {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+func (m *ExportAccountResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RequestAccountDeletionRequest struct {
+	Uid      string `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	// delete annotations instead of anonymizing them
+	DeleteAnnotations    bool     `protobuf:"varint,3,opt,name=deleteAnnotations" json:"deleteAnnotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestAccountDeletionRequest) Reset()         { *m = RequestAccountDeletionRequest{} }
+func (m *RequestAccountDeletionRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestAccountDeletionRequest) ProtoMessage()    {}
+func (*RequestAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{102}
+}
+func (m *RequestAccountDeletionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestAccountDeletionRequest.Unmarshal(m, b)
+}
+func (m *RequestAccountDeletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestAccountDeletionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestAccountDeletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestAccountDeletionRequest.Merge(dst, src)
+}
+func (m *RequestAccountDeletionRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestAccountDeletionRequest.Size(m)
+}
+func (m *RequestAccountDeletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestAccountDeletionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestAccountDeletionRequest proto.InternalMessageInfo
+
+func (m *RequestAccountDeletionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RequestAccountDeletionRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RequestAccountDeletionRequest) GetDeleteAnnotations() bool {
+	if m != nil {
+		return m.DeleteAnnotations
+	}
+	return false
+}
+
+type RequestAccountDeletionResponse struct {
+	DeleteAt             int64    `protobuf:"varint,1,opt,name=deleteAt" json:"deleteAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestAccountDeletionResponse) Reset()         { *m = RequestAccountDeletionResponse{} }
+func (m *RequestAccountDeletionResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestAccountDeletionResponse) ProtoMessage()    {}
+func (*RequestAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{103}
+}
+func (m *RequestAccountDeletionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestAccountDeletionResponse.Unmarshal(m, b)
+}
+func (m *RequestAccountDeletionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestAccountDeletionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RequestAccountDeletionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestAccountDeletionResponse.Merge(dst, src)
+}
+func (m *RequestAccountDeletionResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestAccountDeletionResponse.Size(m)
+}
+func (m *RequestAccountDeletionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestAccountDeletionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestAccountDeletionResponse proto.InternalMessageInfo
+
+func (m *RequestAccountDeletionResponse) GetDeleteAt() int64 {
+	if m != nil {
+		return m.DeleteAt
+	}
+	return 0
+}
+
+type CancelAccountDeletionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelAccountDeletionRequest) Reset()         { *m = CancelAccountDeletionRequest{} }
+func (m *CancelAccountDeletionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelAccountDeletionRequest) ProtoMessage()    {}
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{104}
+}
+func (m *CancelAccountDeletionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelAccountDeletionRequest.Unmarshal(m, b)
+}
+func (m *CancelAccountDeletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelAccountDeletionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelAccountDeletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAccountDeletionRequest.Merge(dst, src)
+}
+func (m *CancelAccountDeletionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelAccountDeletionRequest.Size(m)
+}
+func (m *CancelAccountDeletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAccountDeletionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAccountDeletionRequest proto.InternalMessageInfo
+
+func (m *CancelAccountDeletionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type CancelAccountDeletionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelAccountDeletionResponse) Reset()         { *m = CancelAccountDeletionResponse{} }
+func (m *CancelAccountDeletionResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelAccountDeletionResponse) ProtoMessage()    {}
+func (*CancelAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{105}
+}
+func (m *CancelAccountDeletionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelAccountDeletionResponse.Unmarshal(m, b)
+}
+func (m *CancelAccountDeletionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelAccountDeletionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CancelAccountDeletionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAccountDeletionResponse.Merge(dst, src)
+}
+func (m *CancelAccountDeletionResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelAccountDeletionResponse.Size(m)
+}
+func (m *CancelAccountDeletionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAccountDeletionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAccountDeletionResponse proto.InternalMessageInfo
+
+type AccountDeletionRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountDeletionRequest) Reset()         { *m = AccountDeletionRequest{} }
+func (m *AccountDeletionRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountDeletionRequest) ProtoMessage()    {}
+func (*AccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{106}
+}
+func (m *AccountDeletionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountDeletionRequest.Unmarshal(m, b)
+}
+func (m *AccountDeletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountDeletionRequest.Marshal(b, m, deterministic)
+}
+func (dst *AccountDeletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDeletionRequest.Merge(dst, src)
+}
+func (m *AccountDeletionRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountDeletionRequest.Size(m)
+}
+func (m *AccountDeletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDeletionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDeletionRequest proto.InternalMessageInfo
+
+func (m *AccountDeletionRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type AccountDeletionResponse struct {
+	DeleteAt             int64    `protobuf:"varint,1,opt,name=deleteAt" json:"deleteAt,omitempty"`
+	DeleteAnnotations    bool     `protobuf:"varint,2,opt,name=deleteAnnotations" json:"deleteAnnotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountDeletionResponse) Reset()         { *m = AccountDeletionResponse{} }
+func (m *AccountDeletionResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountDeletionResponse) ProtoMessage()    {}
+func (*AccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_04f4ccf857891a96, []int{107}
+}
+func (m *AccountDeletionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountDeletionResponse.Unmarshal(m, b)
+}
+func (m *AccountDeletionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountDeletionResponse.Marshal(b, m, deterministic)
+}
+func (dst *AccountDeletionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDeletionResponse.Merge(dst, src)
+}
+func (m *AccountDeletionResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountDeletionResponse.Size(m)
+}
+func (m *AccountDeletionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDeletionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDeletionResponse proto.InternalMessageInfo
+
+func (m *AccountDeletionResponse) GetDeleteAt() int64 {
+	if m != nil {
+		return m.DeleteAt
+	}
+	return 0
+}
+
+func (m *AccountDeletionResponse) GetDeleteAnnotations() bool {
+	if m != nil {
+		return m.DeleteAnnotations
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*RegisterRequest)(nil), "account.RegisterRequest")
+	proto.RegisterType((*RegisterResponse)(nil), "account.RegisterResponse")
+	proto.RegisterType((*LoginRequest)(nil), "account.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "account.LoginResponse")
+	proto.RegisterType((*AccountInfo)(nil), "account.AccountInfo")
+	proto.RegisterType((*AccountIdRequest)(nil), "account.AccountIdRequest")
+	proto.RegisterType((*AccountName)(nil), "account.AccountName")
+	proto.RegisterType((*AccountIdResponse)(nil), "account.AccountIdResponse")
+	proto.RegisterType((*AccountsBasicInfoRequest)(nil), "account.AccountsBasicInfoRequest")
+	proto.RegisterType((*AccountsBasicInfoByNamesRequest)(nil), "account.AccountsBasicInfoByNamesRequest")
+	proto.RegisterType((*AccountsBasicInfoResponse)(nil), "account.AccountsBasicInfoResponse")
+	proto.RegisterType((*BasicInfo)(nil), "account.BasicInfo")
+	proto.RegisterType((*ActivateRequest)(nil), "account.ActivateRequest")
+	proto.RegisterType((*ActivateResponse)(nil), "account.ActivateResponse")
+	proto.RegisterType((*ResendActivationRequest)(nil), "account.ResendActivationRequest")
+	proto.RegisterType((*ResendActivationResponse)(nil), "account.ResendActivationResponse")
+	proto.RegisterType((*AccountsEmailRequest)(nil), "account.AccountsEmailRequest")
+	proto.RegisterType((*AccountsEmailResponse)(nil), "account.AccountsEmailResponse")
+	proto.RegisterMapType((map[string]string)(nil), "account.AccountsEmailResponse.EmailsEntry")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "account.RequestPasswordResetRequest")
+	proto.RegisterType((*RequestPasswordResetResponse)(nil), "account.RequestPasswordResetResponse")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "account.ResetPasswordRequest")
+	proto.RegisterType((*ResetPasswordResponse)(nil), "account.ResetPasswordResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "account.ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "account.ChangePasswordResponse")
+	proto.RegisterType((*ChangeEmailRequest)(nil), "account.ChangeEmailRequest")
+	proto.RegisterType((*ChangeEmailResponse)(nil), "account.ChangeEmailResponse")
+	proto.RegisterType((*ConfirmEmailRequest)(nil), "account.ConfirmEmailRequest")
+	proto.RegisterType((*ConfirmEmailResponse)(nil), "account.ConfirmEmailResponse")
+	proto.RegisterType((*TokenVersionRequest)(nil), "account.TokenVersionRequest")
+	proto.RegisterType((*TokenVersionResponse)(nil), "account.TokenVersionResponse")
+	proto.RegisterType((*OAuthLoginRequest)(nil), "account.OAuthLoginRequest")
+	proto.RegisterType((*OAuthLoginResponse)(nil), "account.OAuthLoginResponse")
+	proto.RegisterType((*Identity)(nil), "account.Identity")
+	proto.RegisterType((*IdentitiesRequest)(nil), "account.IdentitiesRequest")
+	proto.RegisterType((*IdentitiesResponse)(nil), "account.IdentitiesResponse")
+	proto.RegisterType((*UnlinkIdentityRequest)(nil), "account.UnlinkIdentityRequest")
+	proto.RegisterType((*UnlinkIdentityResponse)(nil), "account.UnlinkIdentityResponse")
+	proto.RegisterType((*AccessToken)(nil), "account.AccessToken")
+	proto.RegisterType((*CreateAccessTokenRequest)(nil), "account.CreateAccessTokenRequest")
+	proto.RegisterType((*CreateAccessTokenResponse)(nil), "account.CreateAccessTokenResponse")
+	proto.RegisterType((*AccessTokensRequest)(nil), "account.AccessTokensRequest")
+	proto.RegisterType((*AccessTokensResponse)(nil), "account.AccessTokensResponse")
+	proto.RegisterType((*RevokeAccessTokenRequest)(nil), "account.RevokeAccessTokenRequest")
+	proto.RegisterType((*RevokeAccessTokenResponse)(nil), "account.RevokeAccessTokenResponse")
+	proto.RegisterType((*VerifyAccessTokenRequest)(nil), "account.VerifyAccessTokenRequest")
+	proto.RegisterType((*VerifyAccessTokenResponse)(nil), "account.VerifyAccessTokenResponse")
+	proto.RegisterType((*Session)(nil), "account.Session")
+	proto.RegisterType((*CreateSessionRequest)(nil), "account.CreateSessionRequest")
+	proto.RegisterType((*CreateSessionResponse)(nil), "account.CreateSessionResponse")
+	proto.RegisterType((*RefreshSessionRequest)(nil), "account.RefreshSessionRequest")
+	proto.RegisterType((*RefreshSessionResponse)(nil), "account.RefreshSessionResponse")
+	proto.RegisterType((*CheckSessionRequest)(nil), "account.CheckSessionRequest")
+	proto.RegisterType((*CheckSessionResponse)(nil), "account.CheckSessionResponse")
+	proto.RegisterType((*SessionsRequest)(nil), "account.SessionsRequest")
+	proto.RegisterType((*SessionsResponse)(nil), "account.SessionsResponse")
+	proto.RegisterType((*RevokeSessionRequest)(nil), "account.RevokeSessionRequest")
+	proto.RegisterType((*RevokeSessionResponse)(nil), "account.RevokeSessionResponse")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "account.RevokeSessionsRequest")
+	proto.RegisterType((*RevokeSessionsResponse)(nil), "account.RevokeSessionsResponse")
+	proto.RegisterType((*Profile)(nil), "account.Profile")
+	proto.RegisterType((*ProfileRequest)(nil), "account.ProfileRequest")
+	proto.RegisterType((*ProfileResponse)(nil), "account.ProfileResponse")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "account.UpdateProfileRequest")
+	proto.RegisterType((*UpdateProfileResponse)(nil), "account.UpdateProfileResponse")
+	proto.RegisterType((*SetAvatarRequest)(nil), "account.SetAvatarRequest")
+	proto.RegisterType((*SetAvatarResponse)(nil), "account.SetAvatarResponse")
+	proto.RegisterType((*AuditEvent)(nil), "account.AuditEvent")
+	proto.RegisterType((*AuditEventsRequest)(nil), "account.AuditEventsRequest")
+	proto.RegisterType((*AuditEventsResponse)(nil), "account.AuditEventsResponse")
+	proto.RegisterType((*EnrollTotpRequest)(nil), "account.EnrollTotpRequest")
+	proto.RegisterType((*EnrollTotpResponse)(nil), "account.EnrollTotpResponse")
+	proto.RegisterType((*ConfirmTotpRequest)(nil), "account.ConfirmTotpRequest")
+	proto.RegisterType((*ConfirmTotpResponse)(nil), "account.ConfirmTotpResponse")
+	proto.RegisterType((*VerifyTotpRequest)(nil), "account.VerifyTotpRequest")
+	proto.RegisterType((*VerifyTotpResponse)(nil), "account.VerifyTotpResponse")
+	proto.RegisterType((*TotpStatusRequest)(nil), "account.TotpStatusRequest")
+	proto.RegisterType((*TotpStatusResponse)(nil), "account.TotpStatusResponse")
+	proto.RegisterType((*DisableTotpRequest)(nil), "account.DisableTotpRequest")
+	proto.RegisterType((*DisableTotpResponse)(nil), "account.DisableTotpResponse")
+	proto.RegisterType((*RegenerateRecoveryCodesRequest)(nil), "account.RegenerateRecoveryCodesRequest")
+	proto.RegisterType((*RegenerateRecoveryCodesResponse)(nil), "account.RegenerateRecoveryCodesResponse")
+	proto.RegisterType((*AdminAccount)(nil), "account.AdminAccount")
+	proto.RegisterType((*ListAccountsRequest)(nil), "account.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "account.ListAccountsResponse")
+	proto.RegisterType((*SetAccountDisabledRequest)(nil), "account.SetAccountDisabledRequest")
+	proto.RegisterType((*SetAccountDisabledResponse)(nil), "account.SetAccountDisabledResponse")
+	proto.RegisterType((*CreateOrganizationRequest)(nil), "account.CreateOrganizationRequest")
+	proto.RegisterType((*CreateOrganizationResponse)(nil), "account.CreateOrganizationResponse")
+	proto.RegisterType((*OrganizationRoleRequest)(nil), "account.OrganizationRoleRequest")
+	proto.RegisterType((*OrganizationRoleResponse)(nil), "account.OrganizationRoleResponse")
+	proto.RegisterType((*OrganizationMember)(nil), "account.OrganizationMember")
+	proto.RegisterType((*OrganizationMembersRequest)(nil), "account.OrganizationMembersRequest")
+	proto.RegisterType((*OrganizationMembersResponse)(nil), "account.OrganizationMembersResponse")
+	proto.RegisterType((*SetOrganizationMemberRequest)(nil), "account.SetOrganizationMemberRequest")
+	proto.RegisterType((*SetOrganizationMemberResponse)(nil), "account.SetOrganizationMemberResponse")
+	proto.RegisterType((*RemoveOrganizationMemberRequest)(nil), "account.RemoveOrganizationMemberRequest")
+	proto.RegisterType((*RemoveOrganizationMemberResponse)(nil), "account.RemoveOrganizationMemberResponse")
+	proto.RegisterType((*UserOrganization)(nil), "account.UserOrganization")
+	proto.RegisterType((*UserOrganizationsRequest)(nil), "account.UserOrganizationsRequest")
+	proto.RegisterType((*UserOrganizationsResponse)(nil), "account.UserOrganizationsResponse")
+	proto.RegisterType((*ExportAccountRequest)(nil), "account.ExportAccountRequest")
+	proto.RegisterType((*ExportAccountResponse)(nil), "account.ExportAccountResponse")
+	proto.RegisterType((*RequestAccountDeletionRequest)(nil), "account.RequestAccountDeletionRequest")
+	proto.RegisterType((*RequestAccountDeletionResponse)(nil), "account.RequestAccountDeletionResponse")
+	proto.RegisterType((*CancelAccountDeletionRequest)(nil), "account.CancelAccountDeletionRequest")
+	proto.RegisterType((*CancelAccountDeletionResponse)(nil), "account.CancelAccountDeletionResponse")
+	proto.RegisterType((*AccountDeletionRequest)(nil), "account.AccountDeletionRequest")
+	proto.RegisterType((*AccountDeletionResponse)(nil), "account.AccountDeletionResponse")
+	proto.RegisterEnum("account.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterEnum("account.OrgRole", OrgRole_name, OrgRole_value)
+}
+
+func init() { proto.RegisterFile("account.proto", fileDescriptor_account_04f4ccf857891a96) }
+
+var fileDescriptor_account_04f4ccf857891a96 = []byte{
+	// 3214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x4d, 0x73, 0x1b, 0xc7,
+	0xb1, 0x06, 0xc0, 0xcf, 0x26, 0x29, 0x81, 0x43, 0x90, 0x04, 0x87, 0x9f, 0x1a, 0x49, 0x16, 0x2d,
+	0xab, 0xf4, 0xfc, 0xec, 0x7a, 0xb6, 0x9f, 0x9f, 0x9e, 0x6d, 0x8a, 0x66, 0x6c, 0x97, 0x25, 0x51,
+	0x01, 0x29, 0xd9, 0xe5, 0xd8, 0xe5, 0xac, 0x80, 0x21, 0xb8, 0x21, 0xb8, 0x0b, 0xef, 0x2e, 0x28,
+	0x33, 0xa9, 0x4a, 0xa5, 0x72, 0x4a, 0xe5, 0x98, 0x43, 0xae, 0x39, 0xf2, 0x94, 0x5b, 0x6e, 0xf9,
+	0x13, 0xf9, 0x0b, 0x49, 0x2a, 0x71, 0xe2, 0x7c, 0x7f, 0xdd, 0x53, 0x33, 0xdb, 0xb3, 0x33, 0xbb,
+	0x3b, 0xbb, 0x24, 0x12, 0xe7, 0x24, 0x4c, 0x77, 0x4f, 0x7f, 0x6f, 0xcf, 0x4c, 0x37, 0x05, 0x33,
+	0x4e, 0xbb, 0xed, 0x0f, 0xbc, 0xe8, 0x76, 0x3f, 0xf0, 0x23, 0x9f, 0x8c, 0xe3, 0x92, 0xbd, 0x0f,
+	0x97, 0x5b, 0xbc, 0xeb, 0x86, 0x11, 0x0f, 0x5a, 0xfc, 0xd3, 0x01, 0x0f, 0x23, 0x42, 0x60, 0xc4,
+	0x73, 0x8e, 0x79, 0xb3, 0xb2, 0x51, 0xd9, 0x9c, 0x6c, 0xc9, 0xdf, 0xa4, 0x01, 0xa3, 0xfc, 0xd8,
+	0x71, 0x7b, 0xcd, 0xaa, 0x04, 0xc6, 0x0b, 0x42, 0x61, 0xa2, 0xef, 0x84, 0xe1, 0x53, 0x3f, 0xe8,
+	0x34, 0x6b, 0x12, 0x91, 0xac, 0x19, 0x81, 0xba, 0x66, 0x1c, 0xf6, 0x7d, 0x2f, 0xe4, 0xec, 0xbb,
+	0x15, 0x98, 0xbe, 0xe7, 0x77, 0x5d, 0xef, 0x4b, 0x15, 0x45, 0x2e, 0x41, 0xd5, 0xed, 0x37, 0x47,
+	0x24, 0xb4, 0xea, 0xf6, 0xc9, 0x0a, 0x4c, 0x0e, 0x42, 0x1e, 0x6c, 0x75, 0xb9, 0x17, 0x35, 0x47,
+	0x25, 0x58, 0x03, 0xd8, 0x2e, 0xcc, 0xa0, 0x0e, 0xb1, 0x56, 0x42, 0x60, 0xe4, 0x1f, 0x71, 0x0f,
+	0xf9, 0xc6, 0x0b, 0xb2, 0x09, 0x23, 0xae, 0x77, 0xe0, 0x4b, 0xb6, 0x53, 0x2f, 0x36, 0x6e, 0x2b,
+	0xff, 0x6d, 0xc5, 0xff, 0xbe, 0xeb, 0x1d, 0xf8, 0x2d, 0x49, 0xc1, 0x3e, 0xaf, 0xc0, 0x94, 0x01,
+	0x95, 0xea, 0x74, 0xd0, 0xa4, 0xaa, 0xdb, 0x49, 0x8c, 0xac, 0x1a, 0x46, 0x2e, 0xc0, 0x98, 0x73,
+	0xe2, 0x44, 0x4e, 0x80, 0x42, 0x71, 0x45, 0x56, 0x01, 0xda, 0x01, 0x77, 0x22, 0xde, 0xf9, 0xc4,
+	0x89, 0xa4, 0xec, 0x5a, 0x6b, 0x12, 0x21, 0x5b, 0x11, 0xb9, 0x0a, 0x33, 0x52, 0xbb, 0x4f, 0x4e,
+	0x78, 0x10, 0xba, 0xbe, 0x27, 0xad, 0xab, 0xb5, 0xa6, 0x25, 0xf0, 0x71, 0x0c, 0x23, 0x1b, 0x30,
+	0x15, 0xf9, 0x51, 0x7f, 0xc7, 0x73, 0x9e, 0xf4, 0x78, 0xa7, 0x39, 0xb6, 0x51, 0xd9, 0x9c, 0x68,
+	0x99, 0x20, 0xa1, 0x51, 0xe0, 0xf7, 0x78, 0x73, 0x3c, 0xd6, 0x48, 0xfc, 0x26, 0x0c, 0xa6, 0xfd,
+	0xa0, 0xeb, 0x78, 0xee, 0x37, 0x9d, 0x48, 0x70, 0x9e, 0x90, 0xdb, 0x52, 0x30, 0x76, 0x1b, 0xea,
+	0xca, 0xd0, 0x8e, 0x0a, 0x21, 0x85, 0x09, 0xe1, 0x5b, 0x23, 0x8c, 0xc9, 0x9a, 0x5d, 0x49, 0x1c,
+	0xf3, 0x40, 0x18, 0x6d, 0x89, 0x36, 0xbb, 0x0e, 0xb3, 0x06, 0x4b, 0x8c, 0x48, 0x1d, 0x6a, 0x83,
+	0xc4, 0x85, 0xe2, 0x27, 0xbb, 0x0d, 0x4d, 0x24, 0x0b, 0xef, 0x3a, 0xa1, 0xdb, 0x96, 0xee, 0xd7,
+	0x49, 0x34, 0x70, 0x3b, 0x61, 0xb3, 0xb2, 0x51, 0x13, 0x6c, 0xc5, 0x6f, 0xf6, 0x0a, 0xac, 0xe7,
+	0xe8, 0xef, 0x9e, 0x0a, 0x2d, 0x42, 0xb5, 0xad, 0x01, 0xa3, 0x42, 0x03, 0xb5, 0x2f, 0x5e, 0xb0,
+	0x1d, 0x58, 0xb2, 0x08, 0x42, 0xbd, 0x36, 0x61, 0x54, 0x44, 0x3c, 0xde, 0x32, 0xf5, 0x22, 0x49,
+	0x92, 0x42, 0x93, 0xc6, 0x04, 0xec, 0x6d, 0x98, 0x4c, 0x60, 0xff, 0x4e, 0x42, 0xb0, 0xeb, 0x70,
+	0x79, 0xab, 0x1d, 0xb9, 0x27, 0x4e, 0xc4, 0x0d, 0x7b, 0xdb, 0x7e, 0x27, 0x71, 0xa3, 0xf8, 0xcd,
+	0xee, 0x40, 0x5d, 0x93, 0x25, 0xda, 0xc6, 0x19, 0x5c, 0x39, 0x37, 0x83, 0xff, 0x0b, 0x16, 0x5b,
+	0x3c, 0xe4, 0x5e, 0x07, 0x79, 0xb8, 0xbe, 0x67, 0x78, 0x29, 0xfe, 0x1a, 0x2b, 0xc6, 0xd7, 0xc8,
+	0x28, 0x34, 0xf3, 0x1b, 0xf0, 0x23, 0xbf, 0x09, 0x0d, 0xe5, 0xc1, 0x1d, 0x41, 0x5c, 0x16, 0xa6,
+	0x1f, 0x56, 0x60, 0x3e, 0x43, 0x8c, 0xca, 0xdf, 0x85, 0x31, 0x29, 0x4a, 0xf9, 0xfa, 0x66, 0x56,
+	0xfd, 0x34, 0xfd, 0x6d, 0xb9, 0x0a, 0x77, 0xbc, 0x28, 0x38, 0x6d, 0xe1, 0x4e, 0xfa, 0xbf, 0x30,
+	0x65, 0x80, 0x45, 0x56, 0x1d, 0xf1, 0x53, 0x95, 0x55, 0x47, 0xfc, 0x54, 0x18, 0x77, 0xe2, 0xf4,
+	0x06, 0x2a, 0x12, 0xf1, 0xe2, 0xb5, 0xea, 0xab, 0x15, 0xf6, 0x12, 0x2c, 0xa3, 0xde, 0x0f, 0xb1,
+	0xca, 0x08, 0x7b, 0xa3, 0x72, 0xaf, 0xac, 0xc1, 0x8a, 0x7d, 0x13, 0x7a, 0xe6, 0x1d, 0x68, 0x48,
+	0x80, 0xc6, 0x26, 0xdc, 0xe2, 0x02, 0x54, 0x31, 0x0b, 0x90, 0x59, 0xf1, 0xaa, 0x99, 0xe2, 0xba,
+	0x05, 0xf3, 0x19, 0x4e, 0x43, 0xc7, 0xfc, 0x18, 0xe6, 0xb7, 0x0f, 0x1d, 0xaf, 0xcb, 0xb3, 0xda,
+	0xe4, 0x3e, 0x3e, 0x51, 0x50, 0xfc, 0x5e, 0xe7, 0x61, 0x5a, 0x19, 0x13, 0x24, 0x28, 0x3c, 0xfe,
+	0xf4, 0x61, 0xba, 0x40, 0x9b, 0x20, 0x76, 0x17, 0x16, 0xb2, 0xe2, 0x86, 0x56, 0xf9, 0x03, 0x20,
+	0x31, 0x8f, 0x54, 0x5e, 0xe5, 0xf5, 0x2d, 0xf1, 0x9c, 0x8e, 0x5c, 0xcd, 0x8c, 0xdc, 0x3c, 0xcc,
+	0xa5, 0x38, 0x63, 0xc0, 0x9e, 0x87, 0xb9, 0x6d, 0xdf, 0x3b, 0x70, 0x83, 0xe3, 0x94, 0x44, 0x6b,
+	0xbc, 0xd8, 0x9b, 0xd0, 0x48, 0x13, 0x0f, 0x6d, 0xdf, 0x0d, 0x98, 0xdb, 0x37, 0x0a, 0x79, 0xa1,
+	0x81, 0xec, 0x05, 0x68, 0xa4, 0x09, 0x51, 0x54, 0x13, 0xc6, 0xd5, 0xc1, 0x50, 0x91, 0x07, 0x83,
+	0x5a, 0xb2, 0x1f, 0x57, 0x60, 0x76, 0x77, 0x6b, 0x10, 0x1d, 0xa6, 0x8e, 0x5f, 0xe1, 0xa8, 0xc0,
+	0x3f, 0x71, 0x3b, 0x3c, 0x50, 0xb5, 0x5b, 0xad, 0x05, 0xaf, 0x70, 0xf0, 0xe4, 0x1b, 0xbc, 0x1d,
+	0xa1, 0x0f, 0xd5, 0xd2, 0xee, 0x42, 0x72, 0x0d, 0x66, 0xe4, 0x8f, 0xc7, 0x3c, 0x70, 0x0f, 0x5c,
+	0xde, 0x91, 0x87, 0xd7, 0x44, 0x2b, 0x0d, 0x14, 0x7b, 0x7b, 0x42, 0x03, 0x3c, 0x96, 0xe3, 0x85,
+	0xb2, 0x70, 0x4c, 0x5b, 0xf8, 0x01, 0x10, 0x53, 0xdd, 0x61, 0x5d, 0x29, 0xb4, 0xc7, 0x53, 0x53,
+	0x6a, 0x3f, 0xd1, 0x52, 0x4b, 0xf6, 0xfd, 0x0a, 0x4c, 0xbc, 0xdb, 0xe1, 0x5e, 0xe4, 0x46, 0xa7,
+	0x5f, 0xaa, 0x03, 0x12, 0xd3, 0x46, 0x4c, 0xd3, 0x56, 0x40, 0x1f, 0xdf, 0x78, 0x5a, 0x6b, 0x80,
+	0x38, 0xfd, 0x50, 0x17, 0x57, 0x1f, 0x4c, 0xf9, 0x78, 0xbf, 0x0d, 0xc4, 0x24, 0x43, 0x6f, 0xfc,
+	0x37, 0x80, 0x9b, 0x40, 0xb1, 0x4c, 0xce, 0x26, 0x3e, 0x51, 0x36, 0xb6, 0x0c, 0x22, 0xb6, 0x03,
+	0xf3, 0x8f, 0xbc, 0x9e, 0xeb, 0x1d, 0x25, 0xd8, 0xd2, 0x8f, 0x48, 0xb9, 0xa6, 0x9a, 0x76, 0x0d,
+	0x6b, 0xc2, 0x42, 0x96, 0x0d, 0x7e, 0x31, 0x3f, 0x8a, 0xef, 0x42, 0x3c, 0x0c, 0x65, 0x82, 0x5e,
+	0xf4, 0xe8, 0xeb, 0x07, 0xfc, 0xc0, 0xfd, 0x4c, 0x1d, 0x7d, 0xf1, 0x4a, 0xc0, 0xc3, 0xb6, 0xdf,
+	0xe7, 0x61, 0x73, 0x44, 0x1e, 0x19, 0xb8, 0x2a, 0x77, 0x29, 0x59, 0x03, 0xe8, 0x39, 0x61, 0xf4,
+	0x28, 0x94, 0xe8, 0x31, 0x89, 0x36, 0x20, 0xec, 0x03, 0x68, 0x6e, 0x4b, 0x62, 0x43, 0xcd, 0x62,
+	0x2f, 0x14, 0xe8, 0x8b, 0x7a, 0xd5, 0x4c, 0xbd, 0xd8, 0xd7, 0x60, 0xc9, 0xc2, 0xf9, 0xfc, 0xd4,
+	0x4d, 0x68, 0xe3, 0xd4, 0x4d, 0xaa, 0x4b, 0xd5, 0xac, 0x2e, 0x37, 0x60, 0xce, 0x20, 0x2d, 0xc9,
+	0x95, 0xb7, 0xa0, 0x91, 0x26, 0x44, 0x05, 0x6e, 0xc1, 0x98, 0xe4, 0xa4, 0x32, 0xc5, 0xae, 0x02,
+	0xd2, 0xb0, 0x3b, 0xe2, 0x80, 0x3f, 0xf1, 0x8f, 0x2e, 0xe6, 0xa5, 0x38, 0xca, 0x55, 0x15, 0x65,
+	0xb6, 0x0c, 0x4b, 0x96, 0xdd, 0x98, 0x22, 0x2f, 0x40, 0x53, 0x96, 0x83, 0x53, 0x0b, 0x6b, 0x7b,
+	0x65, 0xfd, 0x18, 0x96, 0x2c, 0x3b, 0x86, 0xae, 0x09, 0x3a, 0x6e, 0xd5, 0x54, 0xdc, 0x7e, 0x5a,
+	0x81, 0xf1, 0x3d, 0x1e, 0xca, 0xbb, 0x73, 0x36, 0x5f, 0x53, 0x4f, 0x89, 0x6a, 0xe6, 0x29, 0x81,
+	0x0f, 0x8f, 0x9a, 0xf9, 0xf0, 0xd0, 0x99, 0x39, 0x52, 0x90, 0x99, 0x7b, 0x9c, 0x7b, 0x49, 0xe2,
+	0x1a, 0x10, 0xf1, 0xc5, 0xf1, 0xcf, 0xfa, 0x6e, 0xc0, 0x93, 0xbc, 0x4d, 0xd6, 0xb2, 0x9e, 0x0d,
+	0x82, 0x40, 0x68, 0x31, 0x8e, 0xf5, 0x2c, 0x5e, 0xb2, 0xc7, 0xd0, 0x88, 0xb3, 0x0e, 0x4d, 0x28,
+	0x8e, 0xd2, 0x50, 0xb6, 0xb0, 0x2e, 0xcc, 0x67, 0xf8, 0xa2, 0xc3, 0xb3, 0x2e, 0x62, 0x30, 0x1d,
+	0xf0, 0x83, 0x80, 0x87, 0x87, 0xfb, 0x46, 0xda, 0xa6, 0x60, 0x29, 0xd3, 0x6a, 0x69, 0xd3, 0x98,
+	0x2b, 0xee, 0x32, 0x92, 0x36, 0x63, 0x41, 0x96, 0x71, 0xc5, 0xc2, 0x78, 0x38, 0x9b, 0x7e, 0x50,
+	0x81, 0x85, 0xac, 0xac, 0xa1, 0xd3, 0x28, 0x93, 0xec, 0x39, 0x35, 0x6b, 0xe7, 0xd8, 0x3f, 0x92,
+	0xb1, 0xff, 0x15, 0x71, 0xf7, 0xe0, 0xed, 0xa3, 0x73, 0xe3, 0x97, 0xfd, 0xca, 0x1e, 0x40, 0x23,
+	0xbd, 0x11, 0x4d, 0x61, 0x90, 0x7a, 0x0f, 0xe2, 0x55, 0x20, 0x05, 0x4b, 0x5e, 0x80, 0x55, 0xfd,
+	0x02, 0x64, 0x57, 0xe1, 0x32, 0xb2, 0x2a, 0x29, 0x2f, 0x6f, 0x42, 0x5d, 0x13, 0x25, 0xa5, 0x65,
+	0x22, 0x44, 0x18, 0x16, 0x97, 0x7a, 0xe2, 0x3f, 0xa5, 0x5c, 0x42, 0xc1, 0x5e, 0x85, 0x46, 0x5c,
+	0x1c, 0x86, 0x36, 0x78, 0x11, 0xe6, 0x33, 0x3b, 0xb1, 0xa4, 0x3c, 0x97, 0x41, 0x94, 0xe8, 0xdf,
+	0x84, 0x85, 0x2c, 0x29, 0x32, 0xd9, 0x83, 0xf1, 0x87, 0x81, 0x7f, 0xe0, 0xf6, 0xb8, 0xb8, 0xce,
+	0x76, 0xdc, 0xb0, 0xdf, 0x73, 0xe4, 0x8b, 0x11, 0xb7, 0x9b, 0x20, 0xc1, 0xf8, 0x89, 0xeb, 0xa3,
+	0x6e, 0xe2, 0xa7, 0x3c, 0xfe, 0x5d, 0xef, 0x48, 0x1d, 0x0a, 0xf1, 0x82, 0x5d, 0x83, 0x4b, 0xc8,
+	0xb4, 0xa4, 0xe5, 0xc1, 0xba, 0x70, 0x39, 0xa1, 0x1a, 0x3a, 0x1f, 0x6f, 0xc2, 0x78, 0x3f, 0xde,
+	0x2c, 0xd5, 0x31, 0x9d, 0xaf, 0x98, 0x2a, 0x02, 0xb6, 0x0f, 0x8d, 0x47, 0xfd, 0x8e, 0x13, 0xf1,
+	0x8c, 0x52, 0x79, 0xdf, 0x0f, 0xc3, 0x75, 0x1b, 0xe6, 0x33, 0x5c, 0xd1, 0x08, 0x83, 0x49, 0xe5,
+	0x3c, 0x26, 0x2f, 0x8b, 0xc4, 0x8a, 0xb6, 0xe4, 0xab, 0xb7, 0x58, 0x2d, 0x7c, 0xc3, 0x55, 0x93,
+	0x37, 0x1c, 0xdb, 0x86, 0x59, 0x63, 0x1f, 0x0a, 0x5e, 0x80, 0x31, 0xbf, 0xd7, 0x79, 0x2f, 0x79,
+	0xed, 0xe1, 0xca, 0x78, 0x65, 0x57, 0x53, 0xaf, 0xec, 0x1e, 0xc0, 0xd6, 0xa0, 0xe3, 0x46, 0x3b,
+	0x27, 0xdc, 0x93, 0x21, 0x3a, 0x72, 0x3d, 0x25, 0x57, 0xfe, 0xc6, 0x52, 0x52, 0xb5, 0xf7, 0x98,
+	0x6a, 0xd9, 0xc2, 0x53, 0x7a, 0x10, 0xb0, 0x3b, 0x40, 0xb4, 0xb4, 0xe2, 0x5c, 0x8d, 0x53, 0xea,
+	0xd8, 0x8d, 0x0b, 0xdb, 0x68, 0x2b, 0x5e, 0xb0, 0xbb, 0x30, 0x97, 0xda, 0x8d, 0x26, 0x3f, 0x0f,
+	0x63, 0x5c, 0x42, 0xf0, 0x13, 0x9c, 0xd3, 0x29, 0x93, 0x50, 0xb7, 0x90, 0x44, 0xdc, 0x3b, 0x77,
+	0xbc, 0xc0, 0xef, 0xf5, 0xf6, 0xfd, 0xa8, 0x5f, 0xfc, 0xb1, 0xbc, 0x0e, 0xc4, 0x24, 0xd3, 0xce,
+	0x0d, 0x79, 0x3b, 0xe0, 0x91, 0x72, 0x6e, 0xbc, 0x92, 0xfb, 0x03, 0x57, 0xc5, 0x66, 0x10, 0xb8,
+	0xec, 0x35, 0x20, 0xf8, 0x24, 0x2a, 0x95, 0x93, 0x74, 0x34, 0xaa, 0x46, 0x47, 0xe3, 0xff, 0x60,
+	0x2e, 0xb5, 0x17, 0x85, 0x5f, 0x83, 0x99, 0x80, 0xb7, 0xfd, 0x13, 0x1e, 0x9c, 0x6e, 0xfb, 0x9d,
+	0xa4, 0x7b, 0x93, 0x06, 0xb2, 0x2e, 0xcc, 0xc6, 0x37, 0x86, 0xa1, 0xe5, 0xda, 0xce, 0x74, 0x1d,
+	0xe8, 0x91, 0x6c, 0x33, 0xf1, 0x75, 0x20, 0xa6, 0xa0, 0xa1, 0x9f, 0x7c, 0xd7, 0x61, 0x56, 0xec,
+	0xdc, 0x8b, 0x9c, 0x68, 0x50, 0x52, 0xb5, 0x3e, 0x02, 0x62, 0x92, 0xe9, 0xe7, 0x1e, 0xc7, 0x26,
+	0x5f, 0x25, 0xbe, 0x14, 0xe0, 0x92, 0xdc, 0x82, 0xd9, 0x94, 0x43, 0xee, 0xf1, 0x03, 0x95, 0x45,
+	0x79, 0x84, 0x08, 0xd3, 0x5b, 0x6e, 0x28, 0x76, 0x0e, 0x1f, 0xa6, 0x79, 0x98, 0x4b, 0xed, 0xc5,
+	0x62, 0xfa, 0x15, 0x58, 0x6b, 0xf1, 0x2e, 0xf7, 0x78, 0x20, 0x3b, 0x52, 0x86, 0xc4, 0xe1, 0xd8,
+	0xbf, 0x0d, 0xeb, 0x85, 0x7c, 0x86, 0xca, 0x88, 0xef, 0x55, 0x60, 0x7a, 0xab, 0x73, 0xec, 0x7a,
+	0x18, 0x83, 0x21, 0x0a, 0xac, 0xbd, 0x21, 0xbd, 0x02, 0x93, 0x0e, 0x76, 0xdc, 0xe2, 0x86, 0xc7,
+	0x44, 0x4b, 0x03, 0xc4, 0x81, 0xdf, 0x89, 0xdd, 0xa2, 0x1e, 0xc2, 0xc9, 0x9a, 0x3d, 0x82, 0xb9,
+	0x7b, 0x6e, 0x18, 0xa1, 0x20, 0xb3, 0x1f, 0xf9, 0xe9, 0x80, 0x07, 0xaa, 0x64, 0xc5, 0x0b, 0xe1,
+	0x94, 0xf0, 0x08, 0x2b, 0xcf, 0x68, 0x4b, 0xfe, 0xd6, 0x75, 0xa1, 0x66, 0xd6, 0x85, 0x4f, 0xa0,
+	0x91, 0x66, 0x9b, 0x3c, 0x13, 0x27, 0xd0, 0x36, 0x55, 0x1a, 0xe6, 0xb5, 0xb1, 0x86, 0x47, 0x5a,
+	0x09, 0x59, 0x7c, 0x0d, 0x8f, 0x9c, 0xd8, 0xe2, 0x5a, 0x2b, 0x5e, 0xb0, 0x36, 0x2c, 0x89, 0x4a,
+	0x1b, 0x13, 0x61, 0xd0, 0x4b, 0xba, 0x46, 0x4d, 0x50, 0x43, 0x06, 0xf5, 0x7e, 0xc6, 0x65, 0xca,
+	0x39, 0xb5, 0x8c, 0x73, 0x56, 0x80, 0xda, 0x84, 0x60, 0x5a, 0x6d, 0xa9, 0x27, 0xd6, 0xae, 0xd1,
+	0x96, 0x1e, 0xea, 0xf5, 0xc6, 0x6e, 0x01, 0xb5, 0xb1, 0xb0, 0x5f, 0x6e, 0xd9, 0xff, 0xc3, 0x62,
+	0x8a, 0xce, 0x4f, 0x9d, 0x99, 0x7e, 0xd0, 0x55, 0xe2, 0xfc, 0xa0, 0xab, 0x14, 0xa8, 0xea, 0xef,
+	0xb6, 0x03, 0xcd, 0xfc, 0x76, 0x7d, 0x4d, 0x4b, 0x35, 0xdc, 0x2b, 0xf9, 0x86, 0x3b, 0xb9, 0x66,
+	0x5c, 0xd3, 0x2e, 0x19, 0xa7, 0xe7, 0x6e, 0xd0, 0x95, 0xbc, 0x24, 0x96, 0x7d, 0x1b, 0x88, 0x29,
+	0xe5, 0x3e, 0x3f, 0x7e, 0xc2, 0x83, 0x0b, 0x3e, 0x66, 0x95, 0x84, 0x5a, 0x99, 0x84, 0x73, 0xce,
+	0xb3, 0xdb, 0x40, 0xf3, 0xf2, 0xc3, 0x42, 0x3f, 0xb1, 0x7d, 0x58, 0xb6, 0xd2, 0xa3, 0x63, 0xfe,
+	0x07, 0xc6, 0x8f, 0x63, 0x10, 0xe6, 0xeb, 0xb2, 0xa9, 0x55, 0x66, 0x5b, 0x4b, 0xd1, 0xb2, 0xef,
+	0x54, 0x60, 0x65, 0x8f, 0x47, 0x16, 0x92, 0xb2, 0xdb, 0x84, 0x50, 0xad, 0xaa, 0x43, 0xb8, 0x00,
+	0x63, 0x31, 0x3f, 0xd5, 0x8b, 0x88, 0x57, 0x89, 0x9b, 0x46, 0x4a, 0x03, 0xb1, 0x0e, 0xab, 0x05,
+	0x1a, 0x60, 0xfe, 0x7e, 0x2c, 0xca, 0xd9, 0xb1, 0x7f, 0xc2, 0xff, 0x23, 0x5a, 0x32, 0x06, 0x1b,
+	0xc5, 0xec, 0x51, 0x85, 0x8f, 0xa0, 0xfe, 0x28, 0xe4, 0x81, 0x49, 0x71, 0xa1, 0x2e, 0xcd, 0x85,
+	0x12, 0x85, 0xdd, 0x82, 0x66, 0x96, 0x7b, 0xe9, 0xb1, 0xb6, 0x64, 0xa1, 0xc6, 0x34, 0x78, 0x03,
+	0x66, 0xcc, 0x6f, 0x41, 0x25, 0xc3, 0x52, 0x22, 0x39, 0xbb, 0xb5, 0x95, 0xa6, 0x67, 0x9b, 0xd0,
+	0xd8, 0xf9, 0xac, 0xef, 0x07, 0xaa, 0x9a, 0x14, 0xeb, 0xb1, 0x03, 0xf3, 0x19, 0x4a, 0x7d, 0xc2,
+	0x3a, 0x41, 0xfb, 0xd0, 0x3d, 0x89, 0x2f, 0xb0, 0xd3, 0x2d, 0xb5, 0xb4, 0x96, 0x96, 0x6f, 0xc1,
+	0x2a, 0xca, 0x50, 0xf5, 0x8b, 0xf7, 0x78, 0x79, 0x85, 0x2a, 0x6b, 0x55, 0xdf, 0x82, 0xd9, 0x8e,
+	0x60, 0xc0, 0xb7, 0x3c, 0xcf, 0x8f, 0xd0, 0x09, 0x71, 0xbd, 0xcc, 0x23, 0xd8, 0x1d, 0x58, 0x43,
+	0x31, 0x39, 0xe1, 0x68, 0x8c, 0x28, 0xbb, 0xf1, 0xb6, 0x08, 0xdf, 0x84, 0xc9, 0x9a, 0xbd, 0x00,
+	0x2b, 0xdb, 0x8e, 0xd7, 0xe6, 0xbd, 0x8b, 0x6a, 0x2e, 0x72, 0xbd, 0x60, 0x47, 0x32, 0x07, 0x5a,
+	0xb8, 0x30, 0xb3, 0x36, 0x2c, 0xfe, 0x0b, 0x5a, 0xdb, 0x3d, 0x54, 0x2d, 0xf0, 0xd0, 0xcd, 0x9f,
+	0x8d, 0xc0, 0xe4, 0x4e, 0x10, 0xf8, 0x81, 0xb8, 0x11, 0x90, 0x29, 0x18, 0xdf, 0x1b, 0xc8, 0x7e,
+	0x52, 0xfd, 0x19, 0x32, 0x07, 0x33, 0x12, 0x23, 0xde, 0x76, 0xa2, 0x4f, 0x58, 0xff, 0xf9, 0x19,
+	0x21, 0x14, 0x1a, 0x12, 0x88, 0xed, 0xfc, 0x78, 0x96, 0xcd, 0x3b, 0xf5, 0x5f, 0x9c, 0x11, 0xb2,
+	0x0e, 0x4b, 0xc9, 0x06, 0x35, 0xd1, 0xb8, 0xef, 0x86, 0xf7, 0x9d, 0xa8, 0x7d, 0x58, 0xff, 0xe5,
+	0x19, 0x21, 0x8b, 0x30, 0x1b, 0x13, 0xf8, 0x91, 0x1a, 0xcc, 0x75, 0xea, 0x3f, 0xf9, 0xa2, 0x42,
+	0x36, 0x80, 0x4a, 0x84, 0x9e, 0x9c, 0x09, 0x75, 0xde, 0xf5, 0x4e, 0x9c, 0x9e, 0xdb, 0xa9, 0xff,
+	0xca, 0xd8, 0x2a, 0x5b, 0x07, 0x0a, 0xf1, 0xeb, 0x33, 0x42, 0x96, 0x61, 0x5e, 0x22, 0x72, 0x02,
+	0x3f, 0x3f, 0x23, 0x64, 0x09, 0xe6, 0x24, 0x52, 0xb5, 0x64, 0xef, 0xb9, 0xde, 0x11, 0xef, 0xd4,
+	0x7f, 0x93, 0x35, 0xe4, 0x91, 0x77, 0x82, 0xcd, 0xf8, 0xfa, 0x6f, 0xcf, 0x08, 0x69, 0xc0, 0x25,
+	0x89, 0xbb, 0xe7, 0x84, 0x91, 0x6c, 0xb6, 0xd7, 0xbf, 0x38, 0x23, 0x64, 0x15, 0x16, 0x51, 0xc9,
+	0xa4, 0xe1, 0xa6, 0x14, 0xf9, 0xdd, 0x19, 0x21, 0x6b, 0xd0, 0xcc, 0xa2, 0x13, 0xcf, 0xfd, 0xde,
+	0x50, 0xd4, 0xc0, 0xdf, 0x13, 0x77, 0x94, 0xfa, 0x1f, 0x0c, 0x45, 0xf1, 0x01, 0xae, 0xf8, 0xfe,
+	0xd1, 0x40, 0x49, 0x45, 0xf6, 0x0f, 0x03, 0x3f, 0x8a, 0x7a, 0xbc, 0x53, 0xff, 0x53, 0xca, 0x29,
+	0xf1, 0x0d, 0xd5, 0x15, 0x91, 0xf8, 0xf3, 0x19, 0x21, 0x0b, 0x50, 0x4f, 0x10, 0x8a, 0xd7, 0x5f,
+	0x32, 0x70, 0x9c, 0x7b, 0xd7, 0xff, 0x6a, 0xc8, 0x10, 0xf0, 0x07, 0x7e, 0xa4, 0x50, 0x7f, 0x33,
+	0xfc, 0x94, 0xb9, 0x7d, 0xd4, 0xff, 0x9e, 0xf1, 0xd3, 0xee, 0x53, 0x8f, 0x07, 0xf5, 0x7f, 0x9c,
+	0x91, 0x9b, 0x1f, 0xc1, 0x38, 0xd6, 0x3f, 0x72, 0x19, 0xa6, 0xf0, 0xe7, 0x03, 0xdf, 0xe3, 0xf5,
+	0x67, 0xc8, 0x0c, 0x4c, 0xee, 0x06, 0xdd, 0xc7, 0x2e, 0x7f, 0xca, 0x83, 0x7a, 0x85, 0xd4, 0x61,
+	0x7a, 0x37, 0xe8, 0x62, 0x3e, 0xfa, 0x41, 0xbd, 0x4a, 0x66, 0x61, 0x66, 0x37, 0xe8, 0xde, 0x77,
+	0x5c, 0x2f, 0x72, 0x5c, 0xc1, 0xb1, 0x46, 0xa6, 0x61, 0x62, 0x37, 0xe8, 0xc6, 0xfc, 0x47, 0x5e,
+	0x3c, 0xdb, 0x80, 0x4b, 0xa8, 0xcb, 0x1e, 0x0f, 0x4e, 0xdc, 0xb6, 0x28, 0x8a, 0x13, 0x2a, 0x13,
+	0x49, 0x33, 0xa9, 0x84, 0x99, 0xbf, 0xe0, 0xa0, 0x4b, 0x16, 0x0c, 0x7e, 0x4e, 0x2f, 0xc3, 0xa8,
+	0xf4, 0x2e, 0xd1, 0x97, 0x40, 0x73, 0x24, 0x44, 0x17, 0xb2, 0xe0, 0x64, 0x1e, 0x3b, 0x99, 0xcc,
+	0xe9, 0xc9, 0x52, 0xee, 0xb6, 0xac, 0xee, 0x81, 0x94, 0xda, 0x50, 0x49, 0x45, 0x9f, 0x35, 0x6e,
+	0xd7, 0xf1, 0x38, 0x9e, 0xe4, 0x6e, 0xde, 0x02, 0x4a, 0xad, 0xf7, 0x71, 0xf2, 0x21, 0xcc, 0xe6,
+	0x86, 0xf3, 0xe4, 0x4a, 0x96, 0x34, 0xf7, 0x17, 0x02, 0x94, 0x95, 0x91, 0xa0, 0x72, 0x87, 0x96,
+	0xbf, 0x30, 0x88, 0x55, 0x0c, 0xc9, 0x66, 0xf1, 0xfe, 0xf4, 0x1f, 0x15, 0x5c, 0x48, 0xd2, 0x1b,
+	0x30, 0xa1, 0x4a, 0x82, 0x11, 0xc3, 0xcc, 0x94, 0x9f, 0x2e, 0x59, 0x30, 0xc8, 0xe0, 0x7d, 0xa8,
+	0x67, 0xa7, 0xef, 0x64, 0xc3, 0x08, 0xb9, 0x75, 0x92, 0x4f, 0xaf, 0x94, 0x50, 0x20, 0xe3, 0x07,
+	0x30, 0x93, 0x9a, 0xae, 0x93, 0xd5, 0xa2, 0xa9, 0x7b, 0xcc, 0x72, 0xad, 0x7c, 0x28, 0x4f, 0xda,
+	0xd0, 0x40, 0xd2, 0xd4, 0x40, 0x9c, 0x5c, 0x33, 0x54, 0x29, 0x1c, 0xb2, 0xd3, 0xeb, 0xe7, 0x50,
+	0x69, 0xa5, 0x53, 0xb3, 0x70, 0x43, 0x69, 0xdb, 0xb4, 0x9d, 0xae, 0x15, 0xa1, 0x91, 0xdf, 0x57,
+	0xe1, 0x52, 0x7a, 0x52, 0x4d, 0xf4, 0x0e, 0xeb, 0xc4, 0x9c, 0xae, 0x17, 0xe2, 0x91, 0xe5, 0x3b,
+	0x30, 0x65, 0x8c, 0x97, 0xc9, 0x72, 0x86, 0x3e, 0xe5, 0xd3, 0x15, 0x3b, 0x12, 0x39, 0xbd, 0x07,
+	0xd3, 0xe6, 0x90, 0x99, 0x18, 0xd4, 0xf9, 0x41, 0x35, 0x5d, 0x2d, 0xc0, 0x6a, 0x66, 0xe6, 0x18,
+	0xd9, 0x60, 0x66, 0x19, 0x43, 0xd3, 0xd5, 0x02, 0x2c, 0x32, 0xdb, 0x01, 0xd0, 0x13, 0x5b, 0xa2,
+	0xcb, 0x40, 0x6e, 0xea, 0x4c, 0x97, 0xad, 0x38, 0xcd, 0x46, 0x8f, 0x3a, 0x0d, 0x36, 0xb9, 0x31,
+	0x29, 0x5d, 0xb6, 0xe2, 0x74, 0x10, 0xd3, 0x13, 0x4a, 0x23, 0x88, 0xd6, 0x09, 0x28, 0x5d, 0x2f,
+	0xc4, 0x23, 0xcb, 0x0f, 0x61, 0x36, 0x37, 0xde, 0x33, 0x8a, 0x4f, 0xd1, 0x50, 0x91, 0xb2, 0x32,
+	0x12, 0x1d, 0x09, 0x03, 0x1c, 0x1a, 0x91, 0xb0, 0x0c, 0xfd, 0xe8, 0x6a, 0x01, 0x56, 0x2b, 0x9a,
+	0x9b, 0xbe, 0x11, 0xf3, 0xeb, 0xb7, 0xcf, 0xf5, 0x28, 0x2b, 0x23, 0xd1, 0xbc, 0x73, 0xa3, 0x38,
+	0x83, 0x77, 0xd1, 0x60, 0x8f, 0xb2, 0x32, 0x12, 0xfd, 0x21, 0xa7, 0x26, 0x4e, 0xc6, 0x87, 0x6c,
+	0x9b, 0x70, 0xd1, 0xb5, 0x22, 0xb4, 0xce, 0x81, 0xf4, 0xb0, 0x87, 0x98, 0x9f, 0xbe, 0x65, 0xe2,
+	0x44, 0xd7, 0x0b, 0xf1, 0xc6, 0xe7, 0x67, 0x8c, 0x5c, 0xcc, 0xcf, 0x2f, 0x3f, 0xc2, 0xa1, 0xab,
+	0x05, 0x58, 0x7d, 0x0e, 0x20, 0x28, 0x34, 0xce, 0x81, 0xcc, 0x08, 0x83, 0x2e, 0x59, 0x30, 0x66,
+	0xe5, 0x33, 0x66, 0x19, 0xa9, 0xca, 0x97, 0x9f, 0xb0, 0xd0, 0xb5, 0x22, 0xb4, 0xe9, 0x30, 0x03,
+	0x11, 0x92, 0x82, 0x1d, 0xa1, 0xcd, 0x61, 0xb6, 0xa1, 0x0a, 0xb9, 0xa3, 0x87, 0x2a, 0x8b, 0xb9,
+	0xde, 0x3f, 0x32, 0x69, 0xe6, 0x11, 0xda, 0xc0, 0xd4, 0x60, 0xc1, 0x30, 0xd0, 0x36, 0xc6, 0xa0,
+	0x6b, 0x45, 0x68, 0x7d, 0x89, 0x49, 0x66, 0x05, 0xc4, 0x74, 0x6c, 0x7a, 0xee, 0x40, 0xa9, 0x0d,
+	0xa5, 0x6b, 0xb9, 0xd1, 0x7e, 0x37, 0x6a, 0x79, 0xbe, 0xa5, 0x4f, 0x57, 0xec, 0x48, 0x5d, 0xea,
+	0x74, 0x77, 0xdd, 0x28, 0x75, 0xb9, 0xce, 0x3c, 0x5d, 0xb6, 0xe2, 0x8c, 0xc3, 0x45, 0x37, 0xca,
+	0xcd, 0xc3, 0x25, 0xd7, 0x7a, 0xa7, 0x2b, 0x76, 0xa4, 0x56, 0x48, 0x37, 0xb3, 0x0d, 0x85, 0x72,
+	0xad, 0x74, 0xba, 0x6c, 0xc5, 0x69, 0x36, 0xba, 0x59, 0x6d, 0xb0, 0xc9, 0x35, 0xba, 0xe9, 0xb2,
+	0x15, 0xa7, 0xed, 0x32, 0x3a, 0xcb, 0x86, 0x5d, 0xf9, 0x5e, 0x35, 0x5d, 0xb1, 0x23, 0x91, 0x53,
+	0x0f, 0x16, 0x0b, 0x9a, 0xc8, 0xe4, 0x86, 0x79, 0x53, 0x2e, 0x69, 0x57, 0xd3, 0xcd, 0xf3, 0x09,
+	0x75, 0x8d, 0x30, 0xfb, 0xb0, 0x46, 0x8d, 0xb0, 0x74, 0x7d, 0xe9, 0x6a, 0x01, 0x16, 0x99, 0x7d,
+	0x0c, 0x24, 0xdf, 0x0e, 0x25, 0x2c, 0x95, 0x9f, 0xd6, 0x86, 0x2c, 0xbd, 0x5a, 0x4a, 0xa3, 0xd9,
+	0xe7, 0x9b, 0xa1, 0x24, 0x7b, 0x62, 0x59, 0x9a, 0xad, 0xf4, 0x6a, 0x29, 0x8d, 0xbe, 0xa8, 0x66,
+	0xdb, 0x9f, 0xc6, 0x45, 0xb5, 0xa0, 0xb1, 0x4a, 0xaf, 0x94, 0x50, 0x20, 0xe3, 0xaf, 0xc3, 0x9c,
+	0xa5, 0x83, 0x48, 0xae, 0x96, 0x34, 0x0a, 0x13, 0x8f, 0x5f, 0x2b, 0x27, 0x42, 0x09, 0x07, 0x30,
+	0x6f, 0x6d, 0xe5, 0x91, 0xeb, 0xa6, 0x5f, 0x0b, 0xdb, 0x78, 0xf4, 0xd9, 0xf3, 0xc8, 0x50, 0x8e,
+	0x0f, 0xcd, 0xa2, 0x96, 0x1d, 0x31, 0x73, 0xae, 0xb4, 0x69, 0x48, 0x9f, 0xbb, 0x00, 0xa5, 0x3e,
+	0xc1, 0x73, 0x3d, 0x37, 0xe3, 0x04, 0x2f, 0xea, 0xde, 0x51, 0x56, 0x46, 0xa2, 0xeb, 0x75, 0xaa,
+	0x8f, 0x66, 0xd4, 0x6b, 0x5b, 0x27, 0x8e, 0xae, 0x15, 0xa1, 0x91, 0x9f, 0x0b, 0x0b, 0x48, 0x9a,
+	0xe9, 0x0e, 0x91, 0x67, 0xb3, 0x6f, 0x03, 0x7b, 0xab, 0x89, 0xde, 0x38, 0x97, 0x4e, 0xc7, 0xdb,
+	0xda, 0xce, 0x32, 0xe2, 0x5d, 0xd6, 0x20, 0xa3, 0xcf, 0x9e, 0x47, 0x86, 0x72, 0xf6, 0xe1, 0x72,
+	0x06, 0x45, 0xd6, 0xb3, 0xaf, 0xa8, 0x2c, 0xef, 0x8d, 0x62, 0x82, 0x98, 0xeb, 0x93, 0x31, 0xf9,
+	0xbf, 0x3a, 0x5e, 0xfa, 0xe7, 0x00, 0x5e, 0x2e, 0x0c, 0x8e, 0xe6, 0x31, 0x00, 0x00,
 }
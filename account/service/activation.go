@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/mail"
+	log "github.com/sirupsen/logrus"
+	neturl "net/url"
+	"strings"
+)
+
+const (
+	activationCodeSize = 16
+	// length of hex of an account id
+	accountIdLength = 24
+)
+
+var activationTemplate = mail.MustTemplate("activation",
+	"Activate your rfschub account",
+	`Hi {{.Name}},
+
+Welcome to rfschub! Open the link below to activate your account:
+
+{{.Link}}
+
+If you did not sign up, please ignore this mail.
+`,
+	`<p>Hi {{.Name}},</p>
+<p>Welcome to rfschub! Click the link below to activate your account:</p>
+<p><a href="{{.Link}}">{{.Link}}</a></p>
+<p>If you did not sign up, please ignore this mail.</p>
+`)
+
+func newActivationCode() ([]byte, error) {
+	code := make([]byte, activationCodeSize)
+	_, err := rand.Read(code)
+	return code, err
+}
+
+// the code mailed to users is hex of the random code followed by account id
+func encodeActivationCode(id string, code []byte) string {
+	return hex.EncodeToString(code) + id
+}
+
+func decodeActivationCode(s string) (id string, code []byte, ok bool) {
+	if len(s) != 2*activationCodeSize+accountIdLength {
+		return "", nil, false
+	}
+	code, err := hex.DecodeString(s[:2*activationCodeSize])
+	if err != nil {
+		return "", nil, false
+	}
+	return s[2*activationCodeSize:], code, true
+}
+
+func activateLink(code string) string {
+	return strings.Replace(config.DefaultConfig.ActivateLink, "{code}", neturl.QueryEscape(code), -1)
+}
+
+// mail activation code to a newly registered account, failures are logged
+// since the code can be sent again
+func (a *accountService) sendActivation(ctx context.Context, name, email, id string, code []byte) {
+	message, err := activationTemplate.Render(email, struct {
+		Name string
+		Link string
+	}{name, activateLink(encodeActivationCode(id, code))})
+	if err == nil {
+		err = a.mailer.Send(ctx, message)
+	}
+	if err != nil {
+		log.Warnf("[sendActivation] send activation mail error: name=%s error=%v", name, err)
+	}
+}
+
+func (a *accountService) Activate(ctx context.Context, req *proto.ActivateRequest, rsp *proto.ActivateResponse) error {
+	id, code, ok := decodeActivationCode(req.Code)
+	if !ok {
+		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorActivationCodeInvalid), store.ErrInvalidCode.Error())
+	}
+	info, err := a.store.ActivateAccount(ctx, id, code)
+	if err != nil {
+		if err == store.ErrInvalidCode {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorActivationCodeInvalid), err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[Activate] account activated: uid=%s name=%s", info.Id, info.Name)
+	rsp.Info = protoAccountInfo(info)
+	return nil
+}
+
+// ResendActivation succeeds whether email is registered or not, so it
+// cannot be used to find out registered emails
+func (a *accountService) ResendActivation(ctx context.Context, req *proto.ResendActivationRequest, rsp *proto.ResendActivationResponse) error {
+	if !emailRegexp.MatchString(req.Email) {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	code, err := newActivationCode()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	info, err := a.store.ResetActivationCode(ctx, req.Email, code)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return nil
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	a.sendActivation(ctx, info.Name, req.Email, info.Id, code)
+	return nil
+}
+
+func (a *accountService) AccountsEmail(ctx context.Context, req *proto.AccountsEmailRequest, rsp *proto.AccountsEmailResponse) error {
+	if len(req.Uids) == 0 {
+		return nil
+	}
+	emails, err := a.store.GetAccountsEmail(ctx, req.Uids)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Emails = emails
+	return nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	auditAccountDisabled = "account_disabled"
+	auditAccountEnabled  = "account_enabled"
+
+	accountsDefaultLimit = 20
+	accountsMaxLimit     = 100
+)
+
+func accountDisabled() error {
+	return errors.NewForbiddenError(int(proto.ErrorCode_ErrorAccountDisabled), "account disabled")
+}
+
+// grant the admin role to accounts of names of the config, the role of
+// accounts no longer listed is revoked
+func (a *accountService) setupAdmins(names []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := a.store.SetAdmins(ctx, names); err != nil {
+		log.Errorf("[setupAdmins] SetAdmins error: names=%v err=%v", names, err)
+	}
+}
+
+func (a *accountService) ListAccounts(ctx context.Context, req *proto.ListAccountsRequest, rsp *proto.ListAccountsResponse) error {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = accountsDefaultLimit
+	} else if limit > accountsMaxLimit {
+		limit = accountsMaxLimit
+	}
+	skip := int(req.Skip)
+	if skip < 0 {
+		skip = 0
+	}
+	accounts, total, err := a.store.ListAccounts(ctx, req.Query, skip, limit)
+	if err != nil {
+		log.Errorf("[ListAccounts] ListAccounts error: query=%s err=%v", req.Query, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Total = total
+	rsp.Accounts = make([]*proto.AdminAccount, 0, len(accounts))
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, &proto.AdminAccount{
+			Info:      protoAccountInfo(account.AccountInfo),
+			Email:     account.Email,
+			Activated: account.Activated,
+			Disabled:  account.Disabled,
+		})
+	}
+	return nil
+}
+
+func (a *accountService) SetAccountDisabled(ctx context.Context, req *proto.SetAccountDisabledRequest, rsp *proto.SetAccountDisabledResponse) error {
+	if req.Account == req.Uid {
+		return errors.NewBadRequestError(-1, "can not disable your own account")
+	}
+	info, err := a.store.GetAccountInfo(ctx, req.Account)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	// admins are managed by the config
+	if info.Role == store.RoleAdmin {
+		return errors.NewForbiddenError(-1, "admin accounts can not be disabled")
+	}
+	if info.Disabled == req.Disabled {
+		return nil
+	}
+
+	if err = a.store.SetAccountDisabled(ctx, req.Account, req.Disabled); err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[SetAccountDisabled] SetAccountDisabled error: account=%s err=%v", req.Account, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if !req.Disabled {
+		a.audit(ctx, req.Account, auditAccountEnabled, "", "")
+		log.Infof("[SetAccountDisabled] account enabled: account=%s admin=%s", req.Account, req.Uid)
+		return nil
+	}
+
+	// jwt tokens are checked against sessions, removing them logs the
+	// account out everywhere
+	if err = a.store.RemoveSessions(ctx, req.Account); err != nil {
+		log.Errorf("[SetAccountDisabled] RemoveSessions error: account=%s err=%v", req.Account, err)
+	}
+	a.audit(ctx, req.Account, auditAccountDisabled, "", "")
+	log.Infof("[SetAccountDisabled] account disabled: account=%s admin=%s", req.Account, req.Uid)
+	return nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/mail"
+	"github.com/lt90s/rfschub-server/notification/proto"
+	"github.com/lt90s/rfschub-server/project/proto"
+	"github.com/micro/go-micro/client"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	auditDeletionRequested = "deletion_requested"
+	auditDeletionCancelled = "deletion_cancelled"
+
+	defaultDeletionGrace = 30 * 24 * time.Hour
+	// accounts deleted in a round at most, the rest are in later ones
+	deletionBatch = 20
+	// data of accounts with many annotations takes a while to remove
+	deletionTimeout = time.Minute
+)
+
+var deletionTemplate = mail.MustTemplate("account_deletion",
+	"Your rfschub account will be deleted",
+	`Hi {{.Name}},
+
+Your rfschub account will be deleted on {{.DeleteAt}}. Login and cancel the deletion before then to keep it.
+
+If you did not ask for it, please cancel the deletion and change your password.
+`,
+	`<p>Hi {{.Name}},</p>
+<p>Your rfschub account will be deleted on {{.DeleteAt}}. Login and cancel the deletion before then to keep it.</p>
+<p>If you did not ask for it, please cancel the deletion and change your password.</p>
+`)
+
+func deletionGrace() time.Duration {
+	return parseDuration(config.DefaultConfig.DeletionGrace, defaultDeletionGrace)
+}
+
+// member an organization is handed to when its last owner is deleted: the
+// one with the highest role except uid, the earliest of them. members are
+// oldest first
+func orgSuccessor(members []store.OrganizationMember, uid string) (store.OrganizationMember, bool) {
+	var successor store.OrganizationMember
+	for _, member := range members {
+		if member.Uid != uid && member.Role > successor.Role {
+			successor = member
+		}
+	}
+	return successor, successor.Role > store.OrgRoleNone
+}
+
+func (a *accountService) RequestAccountDeletion(ctx context.Context, req *proto.RequestAccountDeletionRequest, rsp *proto.RequestAccountDeletionResponse) error {
+	// accounts created by oauth logins have no password
+	has, err := a.store.HasPassword(ctx, req.Uid)
+	if err != nil {
+		return passwordError(err)
+	}
+	if has {
+		if err = a.store.CheckPassword(ctx, req.Uid, req.Password); err != nil {
+			return passwordError(err)
+		}
+	}
+	info, err := a.store.GetAccountInfo(ctx, req.Uid)
+	if err != nil {
+		return passwordError(err)
+	}
+
+	// requesting again changes the policy of annotations only
+	deleteAt := info.DeleteAt
+	if deleteAt == 0 {
+		deleteAt = time.Now().Add(deletionGrace()).Unix()
+	}
+	if err = a.store.ScheduleDeletion(ctx, req.Uid, deleteAt, req.DeleteAnnotations); err != nil {
+		log.Errorf("[RequestAccountDeletion] ScheduleDeletion error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	a.audit(ctx, req.Uid, auditDeletionRequested, "", "")
+	log.Infof("[RequestAccountDeletion] deletion scheduled: uid=%s deleteAt=%d", req.Uid, deleteAt)
+
+	err = a.sendMail(ctx, deletionTemplate, info.Email, struct {
+		Name     string
+		DeleteAt string
+	}{info.Name, time.Unix(deleteAt, 0).UTC().Format("2006-01-02 15:04 MST")})
+	if err != nil {
+		log.Warnf("[RequestAccountDeletion] send mail error: uid=%s error=%v", req.Uid, err)
+	}
+	rsp.DeleteAt = deleteAt
+	return nil
+}
+
+func (a *accountService) CancelAccountDeletion(ctx context.Context, req *proto.CancelAccountDeletionRequest, rsp *proto.CancelAccountDeletionResponse) error {
+	if err := a.store.CancelDeletion(ctx, req.Uid); err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[CancelAccountDeletion] CancelDeletion error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	a.audit(ctx, req.Uid, auditDeletionCancelled, "", "")
+	log.Infof("[CancelAccountDeletion] deletion cancelled: uid=%s", req.Uid)
+	return nil
+}
+
+func (a *accountService) AccountDeletion(ctx context.Context, req *proto.AccountDeletionRequest, rsp *proto.AccountDeletionResponse) error {
+	info, err := a.store.GetAccountInfo(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.DeleteAt = info.DeleteAt
+	rsp.DeleteAnnotations = info.DeleteAnnotations
+	return nil
+}
+
+// delete accounts due every interval until ctx is done
+func (a *accountService) runDeletions(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.deleteDueAccounts(ctx)
+		}
+	}
+}
+
+// accounts failed to delete are retried in the next round
+func (a *accountService) deleteDueAccounts(ctx context.Context) {
+	infos, err := a.store.GetDueDeletions(ctx, time.Now().Unix(), deletionBatch)
+	if err != nil {
+		log.Errorf("[deleteDueAccounts] GetDueDeletions error: err=%v", err)
+		return
+	}
+	for _, info := range infos {
+		if err := a.deleteAccount(ctx, info); err != nil {
+			log.Errorf("[deleteDueAccounts] delete account error: uid=%s err=%v", info.Id, err)
+		}
+	}
+}
+
+func (a *accountService) deleteAccount(ctx context.Context, info store.AccountInfo) error {
+	_, err := a.projectClient.DeleteUserData(ctx, &project.DeleteUserDataRequest{
+		Uid:               info.Id,
+		DeleteAnnotations: info.DeleteAnnotations,
+	}, client.WithRequestTimeout(deletionTimeout))
+	if err != nil {
+		return err
+	}
+	_, err = a.notificationClient.DeleteUserNotifications(ctx, &notification.DeleteUserNotificationsRequest{Uid: info.Id})
+	if err != nil {
+		return err
+	}
+	if err = a.handOverOrganizations(ctx, info.Id); err != nil {
+		return err
+	}
+	if err = a.store.DeleteAccount(ctx, info.Id); err != nil {
+		return err
+	}
+	log.Infof("[deleteAccount] account deleted: uid=%s name=%s", info.Id, info.Name)
+	return nil
+}
+
+// organizations uid is the last owner of are handed to their successors,
+// organizations without other members are left without any
+func (a *accountService) handOverOrganizations(ctx context.Context, uid string) error {
+	memberships, err := a.store.GetUserOrganizations(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships {
+		if membership.Role != store.OrgRoleOwner {
+			continue
+		}
+		members, err := a.store.GetOrganizationMembers(ctx, membership.Org)
+		if err != nil {
+			return err
+		}
+		if ownersLeft(members, uid) > 0 {
+			continue
+		}
+		successor, ok := orgSuccessor(members, uid)
+		if !ok {
+			log.Warnf("[handOverOrganizations] organization left without members: org=%s uid=%s", membership.Org, uid)
+			continue
+		}
+		if err = a.store.SetOrganizationMember(ctx, membership.Org, successor.Uid, store.OrgRoleOwner); err != nil {
+			return err
+		}
+		log.Infof("[handOverOrganizations] organization handed over: org=%s from=%s to=%s", membership.Org, uid, successor.Uid)
+	}
+	return nil
+}
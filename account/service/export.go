@@ -0,0 +1,145 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/project/proto"
+	"github.com/micro/go-micro/client"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// exports of accounts with many annotations take a while
+const exportTimeout = time.Minute
+
+// accountExport is account.json of exports, secrets like password and
+// token hashes are left out
+type accountExport struct {
+	Info          *proto.AccountInfo        `json:"info"`
+	Email         string                    `json:"email"`
+	Profile       *proto.Profile            `json:"profile"`
+	Identities    []*proto.Identity         `json:"identities"`
+	AccessTokens  []*proto.AccessToken      `json:"accessTokens"`
+	Sessions      []*proto.Session          `json:"sessions"`
+	AuditEvents   []*proto.AuditEvent       `json:"auditEvents"`
+	Organizations []*proto.UserOrganization `json:"organizations"`
+}
+
+type exportFile struct {
+	name string
+	data interface{}
+}
+
+// zip of files with data encoded as json
+func exportArchive(files []exportFile) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range files {
+		f, err := w.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(file.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (a *accountService) exportAccount(ctx context.Context, uid string) (export accountExport, err error) {
+	info, err := a.store.GetAccountInfo(ctx, uid)
+	if err != nil {
+		return export, passwordError(err)
+	}
+	export.Info = protoAccountInfo(info)
+	export.Email = info.Email
+
+	var profile proto.ProfileResponse
+	if err = a.Profile(ctx, &proto.ProfileRequest{Name: info.Name}, &profile); err != nil {
+		return
+	}
+	export.Profile = profile.Profile
+
+	var identities proto.IdentitiesResponse
+	if err = a.Identities(ctx, &proto.IdentitiesRequest{Uid: uid}, &identities); err != nil {
+		return
+	}
+	export.Identities = identities.Identities
+
+	var tokens proto.AccessTokensResponse
+	if err = a.AccessTokens(ctx, &proto.AccessTokensRequest{Uid: uid}, &tokens); err != nil {
+		return
+	}
+	export.AccessTokens = tokens.Tokens
+
+	var sessions proto.SessionsResponse
+	if err = a.Sessions(ctx, &proto.SessionsRequest{Uid: uid}, &sessions); err != nil {
+		return
+	}
+	export.Sessions = sessions.Sessions
+
+	events, err := a.allAuditEvents(ctx, uid)
+	if err != nil {
+		return
+	}
+	export.AuditEvents = protoAuditEvents(events)
+
+	var organizations proto.UserOrganizationsResponse
+	if err = a.UserOrganizations(ctx, &proto.UserOrganizationsRequest{Uid: uid}, &organizations); err != nil {
+		return
+	}
+	export.Organizations = organizations.Organizations
+	return
+}
+
+// all audit events of uid newest first, read in pages of maxAuditLimit
+func (a *accountService) allAuditEvents(ctx context.Context, uid string) ([]store.AuditEvent, error) {
+	var events []store.AuditEvent
+	for {
+		page, err := a.store.GetAuditEvents(ctx, uid, len(events), maxAuditLimit)
+		if err != nil {
+			log.Errorf("[allAuditEvents] GetAuditEvents error: uid=%s err=%v", uid, err)
+			return nil, errors.NewInternalError(-1, err.Error())
+		}
+		events = append(events, page...)
+		if len(page) < maxAuditLimit {
+			return events, nil
+		}
+	}
+}
+
+func (a *accountService) ExportAccount(ctx context.Context, req *proto.ExportAccountRequest, rsp *proto.ExportAccountResponse) error {
+	export, err := a.exportAccount(ctx, req.Uid)
+	if err != nil {
+		return err
+	}
+	data, err := a.projectClient.ExportUserData(ctx, &project.ExportUserDataRequest{Uid: req.Uid}, client.WithRequestTimeout(exportTimeout))
+	if err != nil {
+		log.Errorf("[ExportAccount] ExportUserData error: uid=%s err=%v", req.Uid, err)
+		return err
+	}
+
+	archive, err := exportArchive([]exportFile{
+		{"account.json", export},
+		{"projects.json", data.Projects},
+		{"annotations.json", data.Annotations},
+	})
+	if err != nil {
+		log.Errorf("[ExportAccount] create archive error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[ExportAccount] account exported: uid=%s size=%d", req.Uid, len(archive))
+	rsp.Archive = archive
+	rsp.Name = export.Info.Name
+	return nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const (
+	// max tries to find an unused name for a new account
+	oauthNameTries = 10
+)
+
+func (a *accountService) OAuthLogin(ctx context.Context, req *proto.OAuthLoginRequest, rsp *proto.OAuthLoginResponse) error {
+	if req.Provider == "" || req.Subject == "" {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+
+	identity, err := a.store.GetIdentity(ctx, req.Provider, req.Subject)
+	switch err {
+	case nil:
+		if req.Uid != "" && req.Uid != identity.Uid {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorIdentityLinked), "identity linked to another account")
+		}
+		return a.oauthAccountInfo(ctx, identity.Uid, rsp)
+	case store.ErrNoIdentity:
+	default:
+		log.Errorf("[OAuthLogin] GetIdentity error: provider=%s subject=%s err=%v", req.Provider, req.Subject, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	uid := req.Uid
+	if uid == "" {
+		// accounts are created with email, which must be owned by the user
+		if !req.EmailVerified || !emailRegexp.MatchString(req.Email) {
+			return errors.NewForbiddenError(int(proto.ErrorCode_ErrorEmailUnverified), "verified email required")
+		}
+		uid, err = a.oauthAccount(ctx, req, rsp)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = a.store.AddIdentity(ctx, store.Identity{
+		Uid:       uid,
+		Provider:  req.Provider,
+		Subject:   req.Subject,
+		Email:     req.Email,
+		Login:     req.Login,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		if err == store.ErrIdentityLinked {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorIdentityLinked), err.Error())
+		}
+		log.Errorf("[OAuthLogin] AddIdentity error: uid=%s provider=%s err=%v", uid, req.Provider, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return a.oauthAccountInfo(ctx, uid, rsp)
+}
+
+// create an account for email of identity. An account registered with the
+// email is never linked here, a provider may claim emails it does not own,
+// its owner links the identity by logging in with it after logging in.
+func (a *accountService) oauthAccount(ctx context.Context, req *proto.OAuthLoginRequest, rsp *proto.OAuthLoginResponse) (string, error) {
+	_, _, err := a.store.GetAccountInfoByEmail(ctx, req.Email)
+	switch err {
+	case nil:
+		return "", errors.NewBadRequestError(int(proto.ErrorCode_ErrorEmailRegistered), "email registered, login to link the identity")
+	case store.ErrNoAccount:
+	default:
+		log.Errorf("[OAuthLogin] GetAccountInfoByEmail error: email=%s err=%v", req.Email, err)
+		return "", errors.NewInternalError(-1, err.Error())
+	}
+
+	base := oauthName(req.Login, req.Email)
+	for i := 0; i < oauthNameTries; i++ {
+		name := oauthNameCandidate(base, i)
+		if _, ok := reservedNames[name]; ok {
+			continue
+		}
+		uid, err := a.store.CreateOAuthAccount(ctx, name, req.Email)
+		switch err {
+		case nil:
+			rsp.Created = true
+			return uid, nil
+		case store.ErrNameUsed:
+			continue
+		case store.ErrEmailRegistered:
+			return "", errors.NewBadRequestError(int(proto.ErrorCode_ErrorEmailRegistered), err.Error())
+		default:
+			log.Errorf("[OAuthLogin] CreateOAuthAccount error: name=%s err=%v", name, err)
+			return "", errors.NewInternalError(-1, err.Error())
+		}
+	}
+	return "", errors.NewBadRequestError(int(proto.ErrorCode_ErrorNameUsed), "no name available")
+}
+
+func (a *accountService) oauthAccountInfo(ctx context.Context, uid string, rsp *proto.OAuthLoginResponse) error {
+	info, err := a.store.GetAccountInfo(ctx, uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if info.Disabled {
+		return accountDisabled()
+	}
+	rsp.Info = protoAccountInfo(info)
+	return nil
+}
+
+// a valid account name from login or email of an identity
+func oauthName(login, email string) string {
+	if login == "" {
+		login = email
+		if i := strings.IndexByte(login, '@'); i >= 0 {
+			login = login[:i]
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range login {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r == '-' || r == '.':
+			b.WriteByte('_')
+		}
+	}
+	name := strings.TrimLeft(b.String(), "0123456789_")
+	if name == "" {
+		name = "user"
+	}
+	// leave room for suffixes
+	if len(name) > 12 {
+		name = name[:12]
+	}
+	return name
+}
+
+// the i-th name tried for a new account
+func oauthNameCandidate(base string, i int) string {
+	if i == 0 {
+		return base
+	}
+	return fmt.Sprintf("%s_%d", base, i+1)
+}
+
+func (a *accountService) Identities(ctx context.Context, req *proto.IdentitiesRequest, rsp *proto.IdentitiesResponse) error {
+	identities, err := a.store.GetIdentities(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[Identities] GetIdentities error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Identities = make([]*proto.Identity, 0, len(identities))
+	for _, identity := range identities {
+		rsp.Identities = append(rsp.Identities, &proto.Identity{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+			Login:     identity.Login,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (a *accountService) UnlinkIdentity(ctx context.Context, req *proto.UnlinkIdentityRequest, rsp *proto.UnlinkIdentityResponse) error {
+	hasPassword, err := a.store.HasPassword(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if !hasPassword {
+		identities, err := a.store.GetIdentities(ctx, req.Uid)
+		if err != nil {
+			return errors.NewInternalError(-1, err.Error())
+		}
+		if len(identities) <= 1 {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorLastLogin), "set a password before unlinking the last identity")
+		}
+	}
+
+	err = a.store.RemoveIdentity(ctx, req.Uid, req.Provider)
+	if err != nil {
+		if err == store.ErrNoIdentity {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[UnlinkIdentity] RemoveIdentity error: uid=%s provider=%s err=%v", req.Uid, req.Provider, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
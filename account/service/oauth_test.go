@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestAccountService_OAuthLogin(t *testing.T) {
+	store := mock.NewMockStore()
+	s, mails := newTestService(store)
+	ctx := context.Background()
+
+	err := s.Register(ctx, &proto.RegisterRequest{Name: "foo", Email: "foo@def.com", Password: "123456"}, &proto.RegisterResponse{})
+	require.NoError(t, err)
+	err = s.Activate(ctx, &proto.ActivateRequest{Code: mails.activationCode(t)}, &proto.ActivateResponse{})
+	require.NoError(t, err)
+	loginResponse := proto.LoginResponse{}
+	err = s.Login(ctx, &proto.LoginRequest{Name: "foo", Password: "123456"}, &loginResponse)
+	require.NoError(t, err)
+
+	req := proto.OAuthLoginRequest{
+		Provider:      "sso",
+		Subject:       "1",
+		Email:         "bar@def.com",
+		EmailVerified: false,
+		Login:         "bar",
+	}
+	rsp := proto.OAuthLoginResponse{}
+	err = s.OAuthLogin(ctx, &req, &rsp)
+	requireErrorCode(t, err, http.StatusForbidden, proto.ErrorCode_ErrorEmailUnverified)
+
+	// a new account is created for an email not registered
+	req.EmailVerified = true
+	err = s.OAuthLogin(ctx, &req, &rsp)
+	require.NoError(t, err)
+	require.True(t, rsp.Created)
+	require.Equal(t, "bar", rsp.Info.Name)
+
+	// an account registered with the email is not taken over
+	req = proto.OAuthLoginRequest{
+		Provider:      "sso",
+		Subject:       "2",
+		Email:         "foo@def.com",
+		EmailVerified: true,
+		Login:         "foo",
+	}
+	rsp = proto.OAuthLoginResponse{}
+	err = s.OAuthLogin(ctx, &req, &rsp)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_ErrorEmailRegistered)
+
+	// its owner links the identity after logging in
+	req.Uid = loginResponse.Info.Id
+	err = s.OAuthLogin(ctx, &req, &rsp)
+	require.NoError(t, err)
+	require.False(t, rsp.Created)
+	require.Equal(t, "foo", rsp.Info.Name)
+
+	req.Uid = ""
+	rsp = proto.OAuthLoginResponse{}
+	err = s.OAuthLogin(ctx, &req, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, "foo", rsp.Info.Name)
+}
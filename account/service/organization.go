@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func lastOwner() error {
+	return errors.NewBadRequestError(int(proto.ErrorCode_ErrorLastOwner), "organization needs an owner")
+}
+
+func validOrgRole(role proto.OrgRole) bool {
+	return role > proto.OrgRole_OrgRoleNone && role <= proto.OrgRole_OrgOwner
+}
+
+// number of owners of an organization except uid
+func ownersLeft(members []store.OrganizationMember, uid string) int {
+	n := 0
+	for _, member := range members {
+		if member.Uid != uid && member.Role == store.OrgRoleOwner {
+			n++
+		}
+	}
+	return n
+}
+
+func (a *accountService) organization(ctx context.Context, org string) (store.Organization, error) {
+	organization, err := a.store.GetOrganization(ctx, org)
+	if err != nil {
+		if err == store.ErrNoOrganization {
+			return organization, errors.NewNotFoundError(-1, err.Error())
+		}
+		return organization, errors.NewInternalError(-1, err.Error())
+	}
+	return organization, nil
+}
+
+func (a *accountService) requireOrgOwner(ctx context.Context, org, uid string) error {
+	if _, err := a.organization(ctx, org); err != nil {
+		return err
+	}
+	role, err := a.store.GetOrganizationRole(ctx, org, uid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if role != store.OrgRoleOwner {
+		return errors.NewForbiddenError(-1, "organization owner required")
+	}
+	return nil
+}
+
+// names of accounts and organizations, those not found are missing
+func (a *accountService) accountNames(ctx context.Context, uids []string) (map[string]string, error) {
+	names := make(map[string]string, len(uids))
+	if len(uids) == 0 {
+		return names, nil
+	}
+	infos, err := a.store.GetAccountsBasicInfo(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		names[info.Id] = info.Name
+	}
+	return names, nil
+}
+
+func (a *accountService) CreateOrganization(ctx context.Context, req *proto.CreateOrganizationRequest, rsp *proto.CreateOrganizationResponse) error {
+	if !nameRegexp.MatchString(req.Name) {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	if _, ok := reservedNames[req.Name]; ok {
+		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorNameUsed), "name already used")
+	}
+
+	id, err := a.store.CreateOrganization(ctx, req.Name, req.Uid)
+	if err != nil {
+		if err == store.ErrNameUsed {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorNameUsed), err.Error())
+		}
+		log.Errorf("[CreateOrganization] CreateOrganization error: name=%s uid=%s err=%v", req.Name, req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[CreateOrganization] organization created: id=%s name=%s uid=%s", id, req.Name, req.Uid)
+	rsp.Id = id
+	return nil
+}
+
+func (a *accountService) OrganizationRole(ctx context.Context, req *proto.OrganizationRoleRequest, rsp *proto.OrganizationRoleResponse) error {
+	_, err := a.store.GetOrganization(ctx, req.Org)
+	if err != nil {
+		if err == store.ErrNoOrganization {
+			return nil
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Organization = true
+	if req.Uid == "" {
+		return nil
+	}
+	role, err := a.store.GetOrganizationRole(ctx, req.Org, req.Uid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Role = proto.OrgRole(role)
+	return nil
+}
+
+func (a *accountService) OrganizationMembers(ctx context.Context, req *proto.OrganizationMembersRequest, rsp *proto.OrganizationMembersResponse) error {
+	if _, err := a.organization(ctx, req.Org); err != nil {
+		return err
+	}
+	members, err := a.store.GetOrganizationMembers(ctx, req.Org)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	uids := make([]string, 0, len(members))
+	for _, member := range members {
+		uids = append(uids, member.Uid)
+	}
+	names, err := a.accountNames(ctx, uids)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Members = make([]*proto.OrganizationMember, 0, len(members))
+	for _, member := range members {
+		rsp.Members = append(rsp.Members, &proto.OrganizationMember{
+			Uid:       member.Uid,
+			Name:      names[member.Uid],
+			Role:      proto.OrgRole(member.Role),
+			CreatedAt: member.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (a *accountService) SetOrganizationMember(ctx context.Context, req *proto.SetOrganizationMemberRequest, rsp *proto.SetOrganizationMemberResponse) error {
+	if !validOrgRole(req.Role) {
+		return errors.NewBadRequestError(-1, "invalid role")
+	}
+	if err := a.requireOrgOwner(ctx, req.Org, req.Uid); err != nil {
+		return err
+	}
+	// organizations can not be members
+	if _, err := a.store.GetAccountInfo(ctx, req.Member); err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	members, err := a.store.GetOrganizationMembers(ctx, req.Org)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if req.Role != proto.OrgRole_OrgOwner && ownersLeft(members, req.Member) == 0 {
+		return lastOwner()
+	}
+
+	if err = a.store.SetOrganizationMember(ctx, req.Org, req.Member, store.OrgRole(req.Role)); err != nil {
+		log.Errorf("[SetOrganizationMember] SetOrganizationMember error: org=%s member=%s err=%v", req.Org, req.Member, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (a *accountService) RemoveOrganizationMember(ctx context.Context, req *proto.RemoveOrganizationMemberRequest, rsp *proto.RemoveOrganizationMemberResponse) error {
+	if req.Member == req.Uid {
+		if _, err := a.organization(ctx, req.Org); err != nil {
+			return err
+		}
+	} else if err := a.requireOrgOwner(ctx, req.Org, req.Uid); err != nil {
+		return err
+	}
+	members, err := a.store.GetOrganizationMembers(ctx, req.Org)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if ownersLeft(members, req.Member) == 0 {
+		return lastOwner()
+	}
+
+	if err = a.store.RemoveOrganizationMember(ctx, req.Org, req.Member); err != nil {
+		if err == store.ErrNoMember {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[RemoveOrganizationMember] RemoveOrganizationMember error: org=%s member=%s err=%v", req.Org, req.Member, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (a *accountService) UserOrganizations(ctx context.Context, req *proto.UserOrganizationsRequest, rsp *proto.UserOrganizationsResponse) error {
+	members, err := a.store.GetUserOrganizations(ctx, req.Uid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	orgs := make([]string, 0, len(members))
+	for _, member := range members {
+		orgs = append(orgs, member.Org)
+	}
+	names, err := a.accountNames(ctx, orgs)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Organizations = make([]*proto.UserOrganization, 0, len(members))
+	for _, member := range members {
+		rsp.Organizations = append(rsp.Organizations, &proto.UserOrganization{
+			Id:   member.Org,
+			Name: names[member.Org],
+			Role: proto.OrgRole(member.Role),
+		})
+	}
+	return nil
+}
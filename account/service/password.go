@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/mail"
+	log "github.com/sirupsen/logrus"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+const (
+	accountTokenSize   = 32
+	defaultTokenExpire = time.Hour
+)
+
+var passwordResetTemplate = mail.MustTemplate("password_reset",
+	"Reset your rfschub password",
+	`Hi {{.Name}},
+
+Open the link below to reset your password, it expires in {{.Expire}}:
+
+{{.Link}}
+
+If you did not ask for it, please ignore this mail, your password is not changed.
+`,
+	`<p>Hi {{.Name}},</p>
+<p>Click the link below to reset your password, it expires in {{.Expire}}:</p>
+<p><a href="{{.Link}}">{{.Link}}</a></p>
+<p>If you did not ask for it, please ignore this mail, your password is not changed.</p>
+`)
+
+var confirmEmailTemplate = mail.MustTemplate("confirm_email",
+	"Confirm your new rfschub email",
+	`Hi {{.Name}},
+
+Open the link below to use this address for your rfschub account, it expires in {{.Expire}}:
+
+{{.Link}}
+`,
+	`<p>Hi {{.Name}},</p>
+<p>Click the link below to use this address for your rfschub account, it expires in {{.Expire}}:</p>
+<p><a href="{{.Link}}">{{.Link}}</a></p>
+`)
+
+var emailChangedTemplate = mail.MustTemplate("email_changed",
+	"Your rfschub email was changed",
+	`Hi {{.Name}},
+
+The email of your rfschub account was changed to {{.Email}}. If you did not do it, please reset your password.
+`, "")
+
+// a random token mailed to users and the hash of it which is stored
+func newAccountToken() (token string, hash []byte, err error) {
+	b := make([]byte, accountTokenSize)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	token = hex.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+func tokenExpire() time.Duration {
+	expire, err := time.ParseDuration(config.DefaultConfig.TokenExpire)
+	if err != nil || expire <= 0 {
+		return defaultTokenExpire
+	}
+	return expire
+}
+
+func tokenLink(link, token string) string {
+	return strings.Replace(link, "{token}", neturl.QueryEscape(token), -1)
+}
+
+func (a *accountService) sendMail(ctx context.Context, template *mail.Template, to string, data interface{}) error {
+	message, err := template.Render(to, data)
+	if err != nil {
+		return err
+	}
+	return a.mailer.Send(ctx, message)
+}
+
+// RequestPasswordReset succeeds whether email is registered or not, so it
+// cannot be used to find out registered emails
+func (a *accountService) RequestPasswordReset(ctx context.Context, req *proto.RequestPasswordResetRequest, rsp *proto.RequestPasswordResetResponse) error {
+	if !emailRegexp.MatchString(req.Email) {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	token, hash, err := newAccountToken()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	expire := tokenExpire()
+	info, err := a.store.SetPasswordReset(ctx, req.Email, store.AccountToken{
+		Hash:     hash,
+		ExpireAt: time.Now().Add(expire).Unix(),
+	})
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return nil
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	err = a.sendMail(ctx, passwordResetTemplate, req.Email, struct {
+		Name   string
+		Link   string
+		Expire time.Duration
+	}{info.Name, tokenLink(config.DefaultConfig.ResetPasswordLink, token), expire})
+	if err != nil {
+		// not reported to keep emails registered secret
+		log.Warnf("[RequestPasswordReset] send mail error: uid=%s error=%v", info.Id, err)
+	}
+	return nil
+}
+
+func (a *accountService) ResetPassword(ctx context.Context, req *proto.ResetPasswordRequest, rsp *proto.ResetPasswordResponse) error {
+	if len(req.Password) < passwordMinLen {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	info, err := a.store.ResetPassword(ctx, hashToken(req.Token), req.Password, time.Now().Unix())
+	if err != nil {
+		if err == store.ErrInvalidToken {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorTokenInvalid), err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[ResetPassword] password reset: uid=%s", info.Id)
+	// sessions of the old password are logged out
+	if err = a.store.RemoveSessions(ctx, info.Id); err != nil {
+		log.Errorf("[ResetPassword] RemoveSessions error: uid=%s err=%v", info.Id, err)
+	}
+	rsp.Info = protoAccountInfo(info)
+	return nil
+}
+
+func (a *accountService) ChangePassword(ctx context.Context, req *proto.ChangePasswordRequest, rsp *proto.ChangePasswordResponse) error {
+	if len(req.NewPassword) < passwordMinLen {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	info, err := a.store.ChangePassword(ctx, req.Uid, req.OldPassword, req.NewPassword)
+	if err != nil {
+		return passwordError(err)
+	}
+	log.Infof("[ChangePassword] password changed: uid=%s", info.Id)
+	// sessions of the old password are logged out
+	if err = a.store.RemoveSessions(ctx, info.Id); err != nil {
+		log.Errorf("[ChangePassword] RemoveSessions error: uid=%s err=%v", info.Id, err)
+	}
+	rsp.Info = protoAccountInfo(info)
+	return nil
+}
+
+func (a *accountService) ChangeEmail(ctx context.Context, req *proto.ChangeEmailRequest, rsp *proto.ChangeEmailResponse) error {
+	if !emailRegexp.MatchString(req.Email) {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	token, hash, err := newAccountToken()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	expire := tokenExpire()
+	info, err := a.store.SetPendingEmail(ctx, req.Uid, req.Password, req.Email, store.AccountToken{
+		Hash:     hash,
+		ExpireAt: time.Now().Add(expire).Unix(),
+	})
+	if err != nil {
+		return passwordError(err)
+	}
+
+	err = a.sendMail(ctx, confirmEmailTemplate, req.Email, struct {
+		Name   string
+		Link   string
+		Expire time.Duration
+	}{info.Name, tokenLink(config.DefaultConfig.ConfirmEmailLink, token), expire})
+	if err != nil {
+		log.Warnf("[ChangeEmail] send mail error: uid=%s error=%v", info.Id, err)
+		return errors.NewServiceUnavailable(-1, "mail not sent, please try again later")
+	}
+	return nil
+}
+
+func (a *accountService) ConfirmEmail(ctx context.Context, req *proto.ConfirmEmailRequest, rsp *proto.ConfirmEmailResponse) error {
+	info, oldEmail, err := a.store.ConfirmEmail(ctx, hashToken(req.Token), time.Now().Unix())
+	if err != nil {
+		switch err {
+		case store.ErrInvalidToken:
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorTokenInvalid), err.Error())
+		case store.ErrEmailRegistered:
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorEmailRegistered), err.Error())
+		default:
+			return errors.NewInternalError(-1, err.Error())
+		}
+	}
+	log.Infof("[ConfirmEmail] email changed: uid=%s", info.Id)
+
+	// let the owner of the old address know in case the account is stolen
+	err = a.sendMail(ctx, emailChangedTemplate, oldEmail, struct {
+		Name  string
+		Email string
+	}{info.Name, info.Email})
+	if err != nil {
+		log.Warnf("[ConfirmEmail] send mail error: uid=%s error=%v", info.Id, err)
+	}
+	rsp.Info = protoAccountInfo(info)
+	return nil
+}
+
+func (a *accountService) TokenVersion(ctx context.Context, req *proto.TokenVersionRequest, rsp *proto.TokenVersionResponse) error {
+	version, err := a.store.GetTokenVersion(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Version = version
+	return nil
+}
+
+// errors of operations requiring the password of an account
+func passwordError(err error) error {
+	switch err {
+	case store.ErrWrongPassword:
+		return errors.NewForbiddenError(int(proto.ErrorCode_ErrorPasswordMisMatch), err.Error())
+	case store.ErrNoAccount:
+		return errors.NewNotFoundError(-1, err.Error())
+	case store.ErrEmailRegistered:
+		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorEmailRegistered), err.Error())
+	}
+	return errors.NewInternalError(-1, err.Error())
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	neturl "net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	displayNameMaxLen = 50
+	bioMaxLen         = 300
+	linkMaxLen        = 200
+	maxLinks          = 5
+)
+
+var avatarKeyRegexp = regexp.MustCompile("^[a-zA-Z0-9_.-]{1,128}$")
+
+// url of the avatar uploaded, or gravatar of email if there is none
+func avatarUrl(key, email string) string {
+	if key != "" {
+		return strings.Replace(config.DefaultConfig.AvatarLink, "{key}", neturl.PathEscape(key), -1)
+	}
+	if config.DefaultConfig.GravatarLink == "" || email == "" {
+		return ""
+	}
+	sum := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return strings.Replace(config.DefaultConfig.GravatarLink, "{hash}", hex.EncodeToString(sum[:]), -1)
+}
+
+// trimmed profile, false if any field of it is invalid
+func normalizeProfile(profile *proto.Profile) (store.Profile, bool) {
+	if profile == nil {
+		return store.Profile{}, true
+	}
+	normalized := store.Profile{
+		DisplayName: strings.TrimSpace(profile.DisplayName),
+		Bio:         strings.TrimSpace(profile.Bio),
+		Links:       make([]string, 0, len(profile.Links)),
+	}
+	if utf8.RuneCountInString(normalized.DisplayName) > displayNameMaxLen ||
+		strings.ContainsAny(normalized.DisplayName, "\r\n") ||
+		utf8.RuneCountInString(normalized.Bio) > bioMaxLen ||
+		len(profile.Links) > maxLinks {
+		return store.Profile{}, false
+	}
+	for _, link := range profile.Links {
+		link = strings.TrimSpace(link)
+		if link == "" {
+			continue
+		}
+		if !validLink(link) {
+			return store.Profile{}, false
+		}
+		normalized.Links = append(normalized.Links, link)
+	}
+	return normalized, true
+}
+
+// links are rendered in pages, only absolute http links are allowed
+func validLink(link string) bool {
+	if len(link) > linkMaxLen {
+		return false
+	}
+	u, err := neturl.Parse(link)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.User == nil
+}
+
+func protoProfile(profile store.Profile) *proto.Profile {
+	links := profile.Links
+	if links == nil {
+		links = []string{}
+	}
+	return &proto.Profile{
+		DisplayName: profile.DisplayName,
+		Bio:         profile.Bio,
+		Links:       links,
+	}
+}
+
+func (a *accountService) Profile(ctx context.Context, req *proto.ProfileRequest, rsp *proto.ProfileResponse) error {
+	info, profile, err := a.store.GetProfile(ctx, req.Name)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[Profile] GetProfile error: name=%s err=%v", req.Name, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Info = protoAccountInfo(info)
+	rsp.Profile = protoProfile(profile)
+	return nil
+}
+
+func (a *accountService) UpdateProfile(ctx context.Context, req *proto.UpdateProfileRequest, rsp *proto.UpdateProfileResponse) error {
+	profile, ok := normalizeProfile(req.Profile)
+	if !ok {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	err := a.store.UpdateProfile(ctx, req.Uid, profile)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[UpdateProfile] UpdateProfile error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Profile = protoProfile(profile)
+	return nil
+}
+
+func (a *accountService) SetAvatar(ctx context.Context, req *proto.SetAvatarRequest, rsp *proto.SetAvatarResponse) error {
+	if req.Key != "" && !avatarKeyRegexp.MatchString(req.Key) {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+	oldKey, err := a.store.SetAvatar(ctx, req.Uid, req.Key)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[SetAvatar] SetAvatar error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.OldKey = oldKey
+
+	if req.Key != "" {
+		rsp.Avatar = avatarUrl(req.Key, "")
+		return nil
+	}
+	info, err := a.store.GetAccountInfo(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[SetAvatar] GetAccountInfo error: uid=%s err=%v", req.Uid, err)
+		return nil
+	}
+	rsp.Avatar = avatarUrl("", info.Email)
+	return nil
+}
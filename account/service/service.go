@@ -2,25 +2,49 @@ package service
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
-	"fmt"
+	"github.com/lt90s/rfschub-server/account/config"
 	proto "github.com/lt90s/rfschub-server/account/proto"
 	"github.com/lt90s/rfschub-server/account/store"
 	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/mail"
+	notificationClient "github.com/lt90s/rfschub-server/notification/client"
+	"github.com/lt90s/rfschub-server/notification/proto"
+	projectClient "github.com/lt90s/rfschub-server/project/client"
+	"github.com/lt90s/rfschub-server/project/proto"
 	log "github.com/sirupsen/logrus"
 	"regexp"
 	"time"
 )
 
 type accountService struct {
-	store store.Store
+	store              store.Store
+	mailer             mail.Transport
+	throttle           loginThrottle
+	projectClient      project.ProjectService
+	notificationClient notification.NotificationService
 }
 
 func New(store store.Store) proto.AccountServiceHandler {
-	return &accountService{
-		store: store,
+	transport, err := mail.New(config.DefaultConfig.Mail)
+	if err != nil {
+		log.Panicf("create mail transport failed: err=%v", err)
+	}
+	a := &accountService{
+		store:              store,
+		mailer:             mail.NewQueue(transport, mail.QueueOptions{}),
+		throttle:           newLoginThrottle(config.DefaultConfig.LoginThrottle),
+		projectClient:      projectClient.New(projectClient.ServerConfig{ServiceName: config.DefaultConfig.Project}),
+		notificationClient: notificationClient.New(notificationClient.ServerConfig{ServiceName: config.DefaultConfig.Notification}),
+	}
+	a.setupAdmins(config.DefaultConfig.Admins)
+	if config.DefaultConfig.DeletionInterval != "" {
+		interval, err := time.ParseDuration(config.DefaultConfig.DeletionInterval)
+		if err != nil || interval <= 0 {
+			log.Panicf("invalid deletion interval: %s", config.DefaultConfig.DeletionInterval)
+		}
+		go a.runDeletions(context.Background(), interval)
 	}
+	return a
 }
 
 const (
@@ -44,16 +68,11 @@ func (a *accountService) Register(ctx context.Context, req *proto.RegisterReques
 		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorNameUsed), "name already used")
 	}
 
-	code := make([]byte, 16)
-	_, err := rand.Read(code)
+	code, err := newActivationCode()
 	if err != nil {
 		return errors.NewInternalError(-1, err.Error())
 	}
 	id, err := a.store.CreateAccount(ctx, req.Name, req.Email, req.Password, code)
-
-	activateCode := hex.EncodeToString(code) + id
-	log.Infof("[Register] activate code: name=%s code=%s", req.Name, activateCode)
-
 	if err != nil {
 		switch err {
 		case store.ErrNameUsed:
@@ -67,29 +86,69 @@ func (a *accountService) Register(ctx context.Context, req *proto.RegisterReques
 		}
 	}
 
-	// TODO: send activation email
+	a.sendActivation(ctx, req.Name, req.Email, id, code)
 	return nil
 }
 
 func (a *accountService) Login(ctx context.Context, req *proto.LoginRequest, rsp *proto.LoginResponse) error {
 	log.Debugf("login request: name=%s email=%s", req.Name, req.Email)
+	// failed logins are throttled per account and per address before the
+	// password is checked
+	now := time.Now()
+	uid := a.loginAccountId(ctx, req.Name, req.Email)
+	if err := a.checkLoginThrottle(ctx, loginKeys(uid, req.Ip), now.Unix()); err != nil {
+		return err
+	}
+
 	info, err := a.store.LoginAccount(ctx, req.Name, req.Email, req.Password)
 	if err != nil {
-		if err == store.ErrNoMatch {
+		switch err {
+		case store.ErrNoMatch:
+			a.audit(ctx, uid, auditLoginFailed, req.Ip, req.UserAgent)
+			if a.addLoginFailure(ctx, uid, req.Ip, now) {
+				a.audit(ctx, uid, auditLoginLocked, req.Ip, req.UserAgent)
+			}
 			return errors.NewUnauthorizedError(int(proto.ErrorCode_ErrorNamePasswordMisMatch), "name or password wrong")
-		} else {
+		case store.ErrNotActivate:
+			return errors.NewForbiddenError(int(proto.ErrorCode_ErrorNotActivated), err.Error())
+		default:
 			return errors.NewInternalError(-1, err.Error())
 		}
 	}
-	fmt.Println(info)
-	rsp.Info = &proto.AccountInfo{
-		Id:        info.Id,
-		Name:      info.Name,
-		CreatedAt: info.CreatedAt,
+	if info.Disabled {
+		return accountDisabled()
 	}
+	// the login completes with VerifyTotp
+	if info.TotpEnabled {
+		rsp.Info = protoAccountInfo(info)
+		return nil
+	}
+	a.loginSucceeded(ctx, info.Id, req.Ip, req.UserAgent)
+	rsp.Info = protoAccountInfo(info)
 	return nil
 }
 
+func protoAccountInfo(info store.AccountInfo) *proto.AccountInfo {
+	return &proto.AccountInfo{
+		Id:           info.Id,
+		Name:         info.Name,
+		Avatar:       avatarUrl(info.Avatar, info.Email),
+		CreatedAt:    info.CreatedAt,
+		TokenVersion: info.TokenVersion,
+		TotpEnabled:  info.TotpEnabled,
+		Role:         info.Role,
+		Organization: info.Organization,
+	}
+}
+
+func protoBasicInfo(info store.BasicInfo) *proto.BasicInfo {
+	return &proto.BasicInfo{
+		Id:     info.Id,
+		Name:   info.Name,
+		Avatar: avatarUrl(info.Avatar, info.Email),
+	}
+}
+
 func (a *accountService) AccountId(ctx context.Context, req *proto.AccountIdRequest, rsp *proto.AccountIdResponse) error {
 	now := time.Now()
 	log.Debugf("[AccountId]: name=%s", req.Username)
@@ -112,7 +171,23 @@ func (a *accountService) AccountsBasicInfo(ctx context.Context, req *proto.Accou
 	}
 
 	for _, info := range infos {
-		rsp.Infos = append(rsp.Infos, &proto.BasicInfo{Id: info.Id, Name: info.Name})
+		rsp.Infos = append(rsp.Infos, protoBasicInfo(info))
+	}
+	return nil
+}
+
+func (a *accountService) AccountsBasicInfoByNames(ctx context.Context, req *proto.AccountsBasicInfoByNamesRequest, rsp *proto.AccountsBasicInfoResponse) error {
+	log.Debugf("[AccountsBasicInfoByNames]: names=%v", req.Names)
+	if len(req.Names) == 0 {
+		return nil
+	}
+	infos, err := a.store.GetAccountsBasicInfoByNames(ctx, req.Names)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	for _, info := range infos {
+		rsp.Infos = append(rsp.Infos, protoBasicInfo(info))
 	}
 	return nil
 }
@@ -128,6 +203,8 @@ func (a *accountService) AccountInfoByName(ctx context.Context, req *proto.Accou
 	}
 	rsp.Id = info.Id
 	rsp.Name = info.Name
+	rsp.Avatar = avatarUrl(info.Avatar, info.Email)
 	rsp.CreatedAt = info.CreatedAt
+	rsp.Organization = info.Organization
 	return nil
 }
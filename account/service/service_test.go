@@ -2,15 +2,63 @@ package service
 
 import (
 	"context"
+	"github.com/lt90s/rfschub-server/account/config"
 	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
 	"github.com/lt90s/rfschub-server/account/store/mock"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/mail"
 	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/url"
+	"regexp"
 	"testing"
 )
 
+// mailbox keeps mails sent
+type mailbox struct {
+	messages []mail.Message
+}
+
+func (m *mailbox) Send(ctx context.Context, message mail.Message) error {
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+var activationCodeRe = regexp.MustCompile(`code=(\S+)`)
+
+// activation code of the last mail
+func (m *mailbox) activationCode(t *testing.T) string {
+	require.NotEmpty(t, m.messages)
+	match := activationCodeRe.FindStringSubmatch(m.messages[len(m.messages)-1].Text)
+	require.NotNil(t, match)
+	code, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+	return code
+}
+
+// New connects to other services, the service tested only has a store and
+// a mailbox
+func newTestService(store store.Store) (*accountService, *mailbox) {
+	mails := &mailbox{}
+	return &accountService{
+		store:    store,
+		mailer:   mails,
+		throttle: newLoginThrottle(config.DefaultConfig.LoginThrottle),
+	}, mails
+}
+
+func requireErrorCode(t *testing.T, err error, httpCode int, code proto.ErrorCode) {
+	require.Error(t, err)
+	e := errors.FromError(err)
+	require.Equal(t, httpCode, e.HttpCode, e.Message)
+	if code != proto.ErrorCode_Success {
+		require.Equal(t, int(code), e.Code, e.Message)
+	}
+}
+
 func TestAccountService_Register(t *testing.T) {
-	store := mock.NewMockStore()
-	s := New(store)
+	s, mails := newTestService(mock.NewMockStore())
 
 	req := proto.RegisterRequest{
 		Name: "foo",
@@ -19,37 +67,36 @@ func TestAccountService_Register(t *testing.T) {
 	ctx := context.Background()
 
 	err := s.Register(ctx, &req, &rsp)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorParameterInvalid, rsp.Code)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_Success)
 
 	req.Email = "abc.def"
 	err = s.Register(ctx, &req, &rsp)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorParameterInvalid, rsp.Code)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_Success)
 
 	req.Email = "abc@def.com"
 	err = s.Register(ctx, &req, &rsp)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorParameterInvalid, rsp.Code)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_Success)
 
 	req.Password = "123456"
 	err = s.Register(ctx, &req, &rsp)
 	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_Success, rsp.Code)
+	require.Len(t, mails.messages, 1)
+	require.Equal(t, "abc@def.com", mails.messages[0].To)
 
 	err = s.Register(ctx, &req, &rsp)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorNameUsed, rsp.Code)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_ErrorNameUsed)
 
 	req.Name = "bar"
 	err = s.Register(ctx, &req, &rsp)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorEmailRegistered, rsp.Code)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_ErrorEmailRegistered)
+
+	req.Name = "create"
+	err = s.Register(ctx, &req, &rsp)
+	requireErrorCode(t, err, http.StatusBadRequest, proto.ErrorCode_ErrorNameUsed)
 }
 
 func TestAccountService_Login(t *testing.T) {
-	store := mock.NewMockStore()
-	s := New(store)
+	s, mails := newTestService(mock.NewMockStore())
 
 	req := proto.RegisterRequest{
 		Name:     "foo",
@@ -61,17 +108,23 @@ func TestAccountService_Login(t *testing.T) {
 
 	err := s.Register(ctx, &req, &rsp)
 	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_Success, rsp.Code)
 
 	loginRequest := proto.LoginRequest{
 		Name:     "foo",
 		Password: "123456",
 	}
 	loginResponse := proto.LoginResponse{}
+	err = s.Login(ctx, &loginRequest, &loginResponse)
+	requireErrorCode(t, err, http.StatusForbidden, proto.ErrorCode_ErrorNotActivated)
+
+	activateResponse := proto.ActivateResponse{}
+	err = s.Activate(ctx, &proto.ActivateRequest{Code: mails.activationCode(t)}, &activateResponse)
+	require.NoError(t, err)
+	require.Equal(t, "foo", activateResponse.Info.Name)
+
 	err = s.Login(ctx, &loginRequest, &loginResponse)
 	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_Success, loginResponse.Code)
-	t.Log(loginResponse.Info)
+	require.Equal(t, "foo", loginResponse.Info.Name)
 
 	loginRequest = proto.LoginRequest{
 		Email:    "abc@def.com",
@@ -79,23 +132,39 @@ func TestAccountService_Login(t *testing.T) {
 	}
 	err = s.Login(ctx, &loginRequest, &loginResponse)
 	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_Success, loginResponse.Code)
-	t.Log(loginResponse.Info)
+	require.Equal(t, "foo", loginResponse.Info.Name)
 
 	loginRequest = proto.LoginRequest{
 		Name:     "foox",
 		Password: "123456",
 	}
 	err = s.Login(ctx, &loginRequest, &loginResponse)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorParameterInvalid, loginResponse.Code)
+	requireErrorCode(t, err, http.StatusUnauthorized, proto.ErrorCode_ErrorNamePasswordMisMatch)
 
 	loginRequest = proto.LoginRequest{
 		Email:    "abc@def.com",
 		Password: "1234567",
 	}
 	err = s.Login(ctx, &loginRequest, &loginResponse)
-	require.NoError(t, err)
-	require.Equal(t, proto.ErrorCode_ErrorParameterInvalid, loginResponse.Code)
+	requireErrorCode(t, err, http.StatusUnauthorized, proto.ErrorCode_ErrorNamePasswordMisMatch)
+}
+
+func TestAccountService_allAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	st := mock.NewMockStore()
+	s, _ := newTestService(st)
 
+	total := 2*maxAuditLimit + 1
+	for i := 0; i < total; i++ {
+		event := store.AuditEvent{Uid: "foo", Kind: auditLoginSucceeded, CreatedAt: int64(i)}
+		require.NoError(t, st.AddAuditEvent(ctx, event, 0))
+	}
+	require.NoError(t, st.AddAuditEvent(ctx, store.AuditEvent{Uid: "bar"}, 0))
+
+	events, err := s.allAuditEvents(ctx, "foo")
+	require.NoError(t, err)
+	require.Len(t, events, total)
+	for i, event := range events {
+		require.Equal(t, int64(total-1-i), event.CreatedAt)
+	}
 }
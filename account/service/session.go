@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	defaultSessionExpire = 30 * 24 * time.Hour
+	// a refresh token rotated within the grace period is still accepted, for
+	// requests of a client sent at the same time
+	refreshGrace = 30
+	// max length of user agents stored
+	userAgentMaxLen = 256
+)
+
+func sessionExpire() time.Duration {
+	expire, err := time.ParseDuration(config.DefaultConfig.SessionExpire)
+	if err != nil || expire <= 0 {
+		return defaultSessionExpire
+	}
+	return expire
+}
+
+func sessionMeta(userAgent, ip string) store.SessionMeta {
+	if len(userAgent) > userAgentMaxLen {
+		userAgent = userAgent[:userAgentMaxLen]
+	}
+	return store.SessionMeta{
+		UserAgent: userAgent,
+		Ip:        ip,
+	}
+}
+
+func protoSession(session store.Session) *proto.Session {
+	return &proto.Session{
+		Id:         session.Id,
+		UserAgent:  session.UserAgent,
+		Ip:         session.Ip,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		ExpireAt:   session.ExpireAt,
+	}
+}
+
+func sessionInvalid() error {
+	return errors.NewUnauthorizedError(int(proto.ErrorCode_ErrorSessionInvalid), "session revoked or expired")
+}
+
+func (a *accountService) CreateSession(ctx context.Context, req *proto.CreateSessionRequest, rsp *proto.CreateSessionResponse) error {
+	now := time.Now()
+	if err := a.store.RemoveExpiredSessions(ctx, req.Uid, now.Unix()); err != nil {
+		log.Errorf("[CreateSession] RemoveExpiredSessions error: uid=%s err=%v", req.Uid, err)
+	}
+
+	token, hash, err := newAccountToken()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	session := store.Session{
+		Uid:         req.Uid,
+		RefreshHash: hash,
+		SessionMeta: sessionMeta(req.UserAgent, req.Ip),
+		CreatedAt:   now.Unix(),
+		LastSeenAt:  now.Unix(),
+		ExpireAt:    now.Add(sessionExpire()).Unix(),
+	}
+	id, err := a.store.CreateSession(ctx, session)
+	if err != nil {
+		log.Errorf("[CreateSession] CreateSession error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Id = id
+	rsp.RefreshToken = token
+	rsp.ExpireAt = session.ExpireAt
+	return nil
+}
+
+func (a *accountService) RefreshSession(ctx context.Context, req *proto.RefreshSessionRequest, rsp *proto.RefreshSessionResponse) error {
+	if req.RefreshToken == "" {
+		return sessionInvalid()
+	}
+	token, hash, err := newAccountToken()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	now := time.Now()
+	meta := sessionMeta(req.UserAgent, req.Ip)
+	expireAt := now.Add(sessionExpire()).Unix()
+	session, rotated, err := a.store.RefreshSession(ctx, hashToken(req.RefreshToken), hash, meta, now.Unix(), expireAt, refreshGrace)
+	if err != nil {
+		if err == store.ErrNoSession {
+			return sessionInvalid()
+		}
+		log.Errorf("[RefreshSession] RefreshSession error: err=%v", err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	info, err := a.store.GetAccountInfo(ctx, session.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return sessionInvalid()
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if info.Disabled {
+		return sessionInvalid()
+	}
+
+	rsp.Info = protoAccountInfo(info)
+	rsp.Id = session.Id
+	rsp.ExpireAt = session.ExpireAt
+	if rotated {
+		rsp.RefreshToken = token
+	}
+	return nil
+}
+
+func (a *accountService) CheckSession(ctx context.Context, req *proto.CheckSessionRequest, rsp *proto.CheckSessionResponse) error {
+	_, err := a.store.UseSession(ctx, req.Uid, req.Id, time.Now().Unix())
+	if err != nil {
+		if err == store.ErrNoSession {
+			return sessionInvalid()
+		}
+		log.Errorf("[CheckSession] UseSession error: uid=%s id=%s err=%v", req.Uid, req.Id, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	info, err := a.store.GetAccountInfo(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return sessionInvalid()
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if info.Disabled {
+		return sessionInvalid()
+	}
+	rsp.TokenVersion = info.TokenVersion
+	rsp.Role = info.Role
+	return nil
+}
+
+func (a *accountService) Sessions(ctx context.Context, req *proto.SessionsRequest, rsp *proto.SessionsResponse) error {
+	sessions, err := a.store.GetSessions(ctx, req.Uid, time.Now().Unix())
+	if err != nil {
+		log.Errorf("[Sessions] GetSessions error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Sessions = make([]*proto.Session, 0, len(sessions))
+	for _, session := range sessions {
+		rsp.Sessions = append(rsp.Sessions, protoSession(session))
+	}
+	return nil
+}
+
+func (a *accountService) RevokeSession(ctx context.Context, req *proto.RevokeSessionRequest, rsp *proto.RevokeSessionResponse) error {
+	err := a.store.RemoveSession(ctx, req.Uid, req.Id)
+	if err != nil {
+		if err == store.ErrNoSession {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[RevokeSession] RemoveSession error: uid=%s id=%s err=%v", req.Uid, req.Id, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (a *accountService) RevokeSessions(ctx context.Context, req *proto.RevokeSessionsRequest, rsp *proto.RevokeSessionsResponse) error {
+	err := a.store.RemoveSessions(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[RevokeSessions] RemoveSessions error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[RevokeSessions] sessions revoked: uid=%s", req.Uid)
+	return nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const (
+	auditLoginSucceeded = "login_succeeded"
+	auditLoginFailed    = "login_failed"
+	// the account is locked out after too many failed logins
+	auditLoginLocked = "login_locked"
+
+	defaultAuditExpire = 90 * 24 * time.Hour
+	defaultAuditLimit  = 50
+	maxAuditLimit      = 200
+)
+
+// loginThrottle locks out accounts and addresses with too many failed
+// logins
+type loginThrottle struct {
+	accountFree int
+	ipFree      int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	window      time.Duration
+}
+
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
+
+func newLoginThrottle(conf config.LoginThrottleConfig) loginThrottle {
+	throttle := loginThrottle{
+		accountFree: conf.AccountFreeAttempts,
+		ipFree:      conf.IpFreeAttempts,
+		baseDelay:   parseDuration(conf.BaseDelay, 2*time.Second),
+		maxDelay:    parseDuration(conf.MaxDelay, 15*time.Minute),
+		window:      parseDuration(conf.Window, time.Hour),
+	}
+	if throttle.accountFree <= 0 {
+		throttle.accountFree = 5
+	}
+	if throttle.ipFree <= 0 {
+		throttle.ipFree = 20
+	}
+	// failures must be remembered for as long as they lock out
+	if throttle.window < throttle.maxDelay {
+		throttle.window = throttle.maxDelay
+	}
+	return throttle
+}
+
+func accountLoginKey(uid string) string {
+	return "account:" + uid
+}
+
+func ipLoginKey(ip string) string {
+	return "ip:" + ip
+}
+
+// keys counting failed logins, the account is unknown if uid is empty
+func loginKeys(uid, ip string) []string {
+	keys := make([]string, 0, 2)
+	if uid != "" {
+		keys = append(keys, accountLoginKey(uid))
+	}
+	if ip != "" {
+		keys = append(keys, ipLoginKey(ip))
+	}
+	return keys
+}
+
+func (t loginThrottle) free(key string) int {
+	if strings.HasPrefix(key, ipLoginKey("")) {
+		return t.ipFree
+	}
+	return t.accountFree
+}
+
+// delay returns how long logins are locked out after failures, baseDelay
+// for the first failure beyond free, doubled on each one after it
+func (t loginThrottle) delay(failures, free int) time.Duration {
+	if failures < free {
+		return 0
+	}
+	delay := t.baseDelay
+	for i := free; i < failures; i++ {
+		delay *= 2
+		if delay >= t.maxDelay {
+			return t.maxDelay
+		}
+	}
+	if delay > t.maxDelay {
+		return t.maxDelay
+	}
+	return delay
+}
+
+func loginThrottled(until, now int64) error {
+	wait := until - now
+	if wait < 1 {
+		wait = 1
+	}
+	message := fmt.Sprintf("too many failed logins, retry in %d seconds", wait)
+	return errors.NewTooManyRequestsError(int(proto.ErrorCode_ErrorLoginThrottled), message)
+}
+
+// id of the account of name or email, empty if there is none
+func (a *accountService) loginAccountId(ctx context.Context, name, email string) string {
+	if name != "" {
+		uid, err := a.store.GetAccountId(ctx, name)
+		if err != nil && err != store.ErrNoAccount {
+			log.Errorf("[Login] GetAccountId error: name=%s err=%v", name, err)
+		}
+		return uid
+	}
+	info, _, err := a.store.GetAccountInfoByEmail(ctx, email)
+	if err != nil && err != store.ErrNoAccount {
+		log.Errorf("[Login] GetAccountInfoByEmail error: email=%s err=%v", email, err)
+	}
+	return info.Id
+}
+
+// check if logins of keys are locked out at now
+func (a *accountService) checkLoginThrottle(ctx context.Context, keys []string, now int64) error {
+	failures, err := a.store.GetLoginFailures(ctx, keys)
+	if err != nil {
+		log.Errorf("[Login] GetLoginFailures error: keys=%v err=%v", keys, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	var until int64
+	for _, failure := range failures {
+		if failure.LockedUntil > until {
+			until = failure.LockedUntil
+		}
+	}
+	if until > now {
+		return loginThrottled(until, now)
+	}
+	return nil
+}
+
+// count a failed login of account uid from ip and lock them out if they
+// failed too often, true is returned if the account is locked out for the
+// first time
+func (a *accountService) addLoginFailure(ctx context.Context, uid, ip string, now time.Time) (locked bool) {
+	for _, key := range loginKeys(uid, ip) {
+		failure, err := a.store.AddLoginFailure(ctx, key, now.Unix(), now.Add(-a.throttle.window).Unix(), now.Add(a.throttle.window).Unix())
+		if err != nil {
+			log.Errorf("[Login] AddLoginFailure error: key=%s err=%v", key, err)
+			continue
+		}
+		free := a.throttle.free(key)
+		delay := a.throttle.delay(failure.Failures, free)
+		if delay == 0 {
+			continue
+		}
+		log.Warnf("[Login] login locked out: key=%s failures=%d delay=%v", key, failure.Failures, delay)
+		if err = a.store.LockLogin(ctx, key, now.Add(delay).Unix()); err != nil {
+			log.Errorf("[Login] LockLogin error: key=%s err=%v", key, err)
+		}
+		if failure.Failures == free && key == accountLoginKey(uid) {
+			locked = true
+		}
+	}
+	return
+}
+
+// forget failed logins of account uid and record the login
+func (a *accountService) loginSucceeded(ctx context.Context, uid, ip, userAgent string) {
+	if err := a.store.ClearLoginFailures(ctx, accountLoginKey(uid)); err != nil {
+		log.Errorf("[Login] ClearLoginFailures error: uid=%s err=%v", uid, err)
+	}
+	a.audit(ctx, uid, auditLoginSucceeded, ip, userAgent)
+}
+
+func (a *accountService) audit(ctx context.Context, uid, kind, ip, userAgent string) {
+	if uid == "" {
+		return
+	}
+	now := time.Now()
+	event := store.AuditEvent{
+		Uid:       uid,
+		Kind:      kind,
+		Ip:        ip,
+		UserAgent: sessionMeta(userAgent, ip).UserAgent,
+		CreatedAt: now.Unix(),
+	}
+	expireAt := now.Add(parseDuration(config.DefaultConfig.AuditExpire, defaultAuditExpire)).Unix()
+	if err := a.store.AddAuditEvent(ctx, event, expireAt); err != nil {
+		log.Errorf("[audit] AddAuditEvent error: uid=%s kind=%s err=%v", uid, kind, err)
+	}
+}
+
+func (a *accountService) AuditEvents(ctx context.Context, req *proto.AuditEventsRequest, rsp *proto.AuditEventsResponse) error {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	} else if limit > maxAuditLimit {
+		limit = maxAuditLimit
+	}
+	events, err := a.store.GetAuditEvents(ctx, req.Uid, 0, limit)
+	if err != nil {
+		log.Errorf("[AuditEvents] GetAuditEvents error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Events = protoAuditEvents(events)
+	return nil
+}
+
+func protoAuditEvents(events []store.AuditEvent) []*proto.AuditEvent {
+	protoEvents := make([]*proto.AuditEvent, 0, len(events))
+	for _, event := range events {
+		protoEvents = append(protoEvents, &proto.AuditEvent{
+			Kind:      event.Kind,
+			Ip:        event.Ip,
+			UserAgent: event.UserAgent,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return protoEvents
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// access tokens are told from jwt tokens by the prefix
+	accessTokenPrefix     = "rfs_"
+	accessTokenPrefixSize = 8
+	accessTokenNameMaxLen = 64
+	maxAccessTokens       = 50
+)
+
+// scopes of access tokens, admin grants all of them and annotate grants read
+var accessTokenScopes = map[string]struct{}{
+	"read":     {},
+	"annotate": {},
+	"admin":    {},
+}
+
+func newAccessToken() (token string, hash []byte, err error) {
+	token, _, err = newAccountToken()
+	if err != nil {
+		return
+	}
+	token = accessTokenPrefix + token
+	return token, hashToken(token), nil
+}
+
+// sorted scopes without duplicates, false if any of them is unknown
+func normalizeScopes(scopes []string) ([]string, bool) {
+	set := make(map[string]struct{}, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := accessTokenScopes[scope]; !ok {
+			return nil, false
+		}
+		set[scope] = struct{}{}
+	}
+	normalized := make([]string, 0, len(set))
+	for scope := range set {
+		normalized = append(normalized, scope)
+	}
+	sort.Strings(normalized)
+	return normalized, len(normalized) > 0
+}
+
+func protoAccessToken(token store.AccessToken) *proto.AccessToken {
+	return &proto.AccessToken{
+		Id:         token.Id,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.Scopes,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+	}
+}
+
+func (a *accountService) CreateAccessToken(ctx context.Context, req *proto.CreateAccessTokenRequest, rsp *proto.CreateAccessTokenResponse) error {
+	name := strings.TrimSpace(req.Name)
+	scopes, ok := normalizeScopes(req.Scopes)
+	if name == "" || len(name) > accessTokenNameMaxLen || !ok {
+		return errors.NewBadRequestError(-1, "parameter invalid")
+	}
+
+	count, err := a.store.CountAccessTokens(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[CreateAccessToken] CountAccessTokens error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if count >= maxAccessTokens {
+		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorAccessTokenLimit), "too many access tokens")
+	}
+
+	token, hash, err := newAccessToken()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	info := store.AccessToken{
+		Uid:       req.Uid,
+		Name:      name,
+		Hash:      hash,
+		Prefix:    token[:accessTokenPrefixSize],
+		Scopes:    scopes,
+		CreatedAt: time.Now().Unix(),
+	}
+	info.Id, err = a.store.CreateAccessToken(ctx, info)
+	if err != nil {
+		if err == store.ErrAccessTokenNameUsed {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorAccessTokenNameUsed), err.Error())
+		}
+		log.Errorf("[CreateAccessToken] CreateAccessToken error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Info = protoAccessToken(info)
+	rsp.Token = token
+	return nil
+}
+
+func (a *accountService) AccessTokens(ctx context.Context, req *proto.AccessTokensRequest, rsp *proto.AccessTokensResponse) error {
+	tokens, err := a.store.GetAccessTokens(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[AccessTokens] GetAccessTokens error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Tokens = make([]*proto.AccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		rsp.Tokens = append(rsp.Tokens, protoAccessToken(token))
+	}
+	return nil
+}
+
+func (a *accountService) RevokeAccessToken(ctx context.Context, req *proto.RevokeAccessTokenRequest, rsp *proto.RevokeAccessTokenResponse) error {
+	err := a.store.RemoveAccessToken(ctx, req.Uid, req.Id)
+	if err != nil {
+		if err == store.ErrNoAccessToken {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[RevokeAccessToken] RemoveAccessToken error: uid=%s id=%s err=%v", req.Uid, req.Id, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (a *accountService) VerifyAccessToken(ctx context.Context, req *proto.VerifyAccessTokenRequest, rsp *proto.VerifyAccessTokenResponse) error {
+	if !strings.HasPrefix(req.Token, accessTokenPrefix) {
+		return errors.NewUnauthorizedError(int(proto.ErrorCode_ErrorAccessTokenInvalid), "access token invalid")
+	}
+	token, err := a.store.UseAccessToken(ctx, hashToken(req.Token), time.Now().Unix())
+	if err != nil {
+		if err == store.ErrNoAccessToken {
+			return errors.NewUnauthorizedError(int(proto.ErrorCode_ErrorAccessTokenInvalid), "access token invalid")
+		}
+		log.Errorf("[VerifyAccessToken] UseAccessToken error: err=%v", err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	info, err := a.store.GetAccountInfo(ctx, token.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewUnauthorizedError(int(proto.ErrorCode_ErrorAccessTokenInvalid), "access token invalid")
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if info.Disabled {
+		return accountDisabled()
+	}
+	rsp.Info = protoAccountInfo(info)
+	rsp.Scopes = token.Scopes
+	return nil
+}
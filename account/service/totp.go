@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/lt90s/rfschub-server/account/config"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store"
+	"github.com/lt90s/rfschub-server/account/totp"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const (
+	auditTotpEnabled              = "totp_enabled"
+	auditTotpDisabled             = "totp_disabled"
+	auditRecoveryCodeUsed         = "recovery_code_used"
+	auditRecoveryCodesRegenerated = "recovery_codes_regenerated"
+
+	recoveryCodeCount = 10
+	// bytes of a recovery code, shown as two groups of hex digits
+	recoveryCodeSize = 5
+	// codes of the steps next to the current one are accepted for clocks of
+	// phones running late or early
+	totpSkew = 1
+)
+
+// recovery codes to show to users and their hashes to store
+func newRecoveryCodes() (codes []string, hashes [][]byte, err error) {
+	codes = make([]string, 0, recoveryCodeCount)
+	hashes = make([][]byte, 0, recoveryCodeCount)
+	b := make([]byte, recoveryCodeSize)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err = rand.Read(b); err != nil {
+			return
+		}
+		code := hex.EncodeToString(b)
+		codes = append(codes, code[:recoveryCodeSize]+"-"+code[recoveryCodeSize:])
+		hashes = append(hashes, hashToken(code))
+	}
+	return
+}
+
+// recovery code typed by users without separators, empty if code does not
+// look like one
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != 2*recoveryCodeSize {
+		return ""
+	}
+	if _, err := hex.DecodeString(code); err != nil {
+		return ""
+	}
+	return code
+}
+
+func totpInvalid() error {
+	return errors.NewUnauthorizedError(int(proto.ErrorCode_ErrorTotpInvalid), "two-factor code invalid")
+}
+
+func totpNotEnabled() error {
+	return errors.NewBadRequestError(int(proto.ErrorCode_ErrorTotpNotEnabled), store.ErrNoTotp.Error())
+}
+
+// verifyTotp checks a one time password or a recovery code of account uid,
+// each of them can only be used once. Guesses are throttled like failed
+// logins.
+func (a *accountService) verifyTotp(ctx context.Context, uid, code, ip, userAgent string) error {
+	now := time.Now()
+	if err := a.checkLoginThrottle(ctx, loginKeys(uid, ip), now.Unix()); err != nil {
+		return err
+	}
+
+	factor, enabled, err := a.store.GetTotp(ctx, uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[verifyTotp] GetTotp error: uid=%s err=%v", uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if !enabled {
+		return totpNotEnabled()
+	}
+
+	recovery := false
+	if step, ok := totp.Validate(factor.Secret, code, now, totpSkew); ok {
+		err = a.store.UseTotpStep(ctx, uid, step)
+	} else if rc := normalizeRecoveryCode(code); rc != "" {
+		recovery = true
+		err = a.store.UseRecoveryCode(ctx, uid, hashToken(rc))
+	} else {
+		err = store.ErrInvalidToken
+	}
+	if err != nil {
+		if err != store.ErrInvalidToken {
+			log.Errorf("[verifyTotp] use code error: uid=%s err=%v", uid, err)
+			return errors.NewInternalError(-1, err.Error())
+		}
+		a.audit(ctx, uid, auditLoginFailed, ip, userAgent)
+		if a.addLoginFailure(ctx, uid, ip, now) {
+			a.audit(ctx, uid, auditLoginLocked, ip, userAgent)
+		}
+		return totpInvalid()
+	}
+	if recovery {
+		a.audit(ctx, uid, auditRecoveryCodeUsed, ip, userAgent)
+	}
+	return nil
+}
+
+func (a *accountService) EnrollTotp(ctx context.Context, req *proto.EnrollTotpRequest, rsp *proto.EnrollTotpResponse) error {
+	info, err := a.store.GetAccountInfo(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if info.TotpEnabled {
+		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorTotpEnabled), store.ErrTotpEnabled.Error())
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if err = a.store.SetPendingTotp(ctx, req.Uid, secret); err != nil {
+		if err == store.ErrTotpEnabled {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ErrorTotpEnabled), err.Error())
+		}
+		log.Errorf("[EnrollTotp] SetPendingTotp error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Secret = totp.EncodeSecret(secret)
+	rsp.Uri = totp.ProvisioningUri(config.DefaultConfig.TotpIssuer, info.Name, secret)
+	return nil
+}
+
+func (a *accountService) ConfirmTotp(ctx context.Context, req *proto.ConfirmTotpRequest, rsp *proto.ConfirmTotpResponse) error {
+	// guesses count as failed logins of the account, like those of verifyTotp
+	now := time.Now()
+	if err := a.checkLoginThrottle(ctx, loginKeys(req.Uid, ""), now.Unix()); err != nil {
+		return err
+	}
+
+	factor, enabled, err := a.store.GetTotp(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[ConfirmTotp] GetTotp error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if enabled {
+		return errors.NewBadRequestError(int(proto.ErrorCode_ErrorTotpEnabled), store.ErrTotpEnabled.Error())
+	}
+	if len(factor.PendingSecret) == 0 {
+		return totpNotEnabled()
+	}
+	step, ok := totp.Validate(factor.PendingSecret, req.Code, now, totpSkew)
+	if !ok {
+		if a.addLoginFailure(ctx, req.Uid, "", now) {
+			a.audit(ctx, req.Uid, auditLoginLocked, "", "")
+		}
+		return totpInvalid()
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if err = a.store.EnableTotp(ctx, req.Uid, factor.PendingSecret, step, hashes); err != nil {
+		if err == store.ErrNoTotp {
+			// enrolled again in the meantime
+			return totpInvalid()
+		}
+		log.Errorf("[ConfirmTotp] EnableTotp error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	a.audit(ctx, req.Uid, auditTotpEnabled, "", "")
+	rsp.RecoveryCodes = codes
+	return nil
+}
+
+func (a *accountService) VerifyTotp(ctx context.Context, req *proto.VerifyTotpRequest, rsp *proto.VerifyTotpResponse) error {
+	if err := a.verifyTotp(ctx, req.Uid, req.Code, req.Ip, req.UserAgent); err != nil {
+		return err
+	}
+	info, err := a.store.GetAccountInfo(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[VerifyTotp] GetAccountInfo error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if info.Disabled {
+		return accountDisabled()
+	}
+	a.loginSucceeded(ctx, req.Uid, req.Ip, req.UserAgent)
+	rsp.Info = protoAccountInfo(info)
+	return nil
+}
+
+func (a *accountService) TotpStatus(ctx context.Context, req *proto.TotpStatusRequest, rsp *proto.TotpStatusResponse) error {
+	factor, enabled, err := a.store.GetTotp(ctx, req.Uid)
+	if err != nil {
+		if err == store.ErrNoAccount {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[TotpStatus] GetTotp error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Enabled = enabled
+	if enabled {
+		rsp.RecoveryCodesLeft = int32(len(factor.RecoveryCodes))
+	}
+	return nil
+}
+
+func (a *accountService) DisableTotp(ctx context.Context, req *proto.DisableTotpRequest, rsp *proto.DisableTotpResponse) error {
+	if err := a.verifyTotp(ctx, req.Uid, req.Code, "", ""); err != nil {
+		return err
+	}
+	if err := a.store.DisableTotp(ctx, req.Uid); err != nil {
+		if err == store.ErrNoTotp {
+			return totpNotEnabled()
+		}
+		log.Errorf("[DisableTotp] DisableTotp error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	a.audit(ctx, req.Uid, auditTotpDisabled, "", "")
+	return nil
+}
+
+func (a *accountService) RegenerateRecoveryCodes(ctx context.Context, req *proto.RegenerateRecoveryCodesRequest, rsp *proto.RegenerateRecoveryCodesResponse) error {
+	if err := a.verifyTotp(ctx, req.Uid, req.Code, "", ""); err != nil {
+		return err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if err = a.store.SetRecoveryCodes(ctx, req.Uid, hashes); err != nil {
+		if err == store.ErrNoTotp {
+			return totpNotEnabled()
+		}
+		log.Errorf("[RegenerateRecoveryCodes] SetRecoveryCodes error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	a.audit(ctx, req.Uid, auditRecoveryCodesRegenerated, "", "")
+	rsp.RecoveryCodes = codes
+	return nil
+}
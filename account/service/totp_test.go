@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	proto "github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/account/store/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestAccountService_ConfirmTotp_Throttled(t *testing.T) {
+	store := mock.NewMockStore()
+	s, _ := newTestService(store)
+	ctx := context.Background()
+
+	uid, err := store.CreateAccount(ctx, "foo", "foo@def.com", "123456", nil)
+	require.NoError(t, err)
+	err = s.EnrollTotp(ctx, &proto.EnrollTotpRequest{Uid: uid}, &proto.EnrollTotpResponse{})
+	require.NoError(t, err)
+
+	req := proto.ConfirmTotpRequest{Uid: uid, Code: "x"}
+	for i := 0; i < s.throttle.accountFree; i++ {
+		err = s.ConfirmTotp(ctx, &req, &proto.ConfirmTotpResponse{})
+		requireErrorCode(t, err, http.StatusUnauthorized, proto.ErrorCode_ErrorTotpInvalid)
+	}
+	err = s.ConfirmTotp(ctx, &req, &proto.ConfirmTotpResponse{})
+	requireErrorCode(t, err, http.StatusTooManyRequests, proto.ErrorCode_ErrorLoginThrottled)
+}
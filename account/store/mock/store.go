@@ -1,17 +1,27 @@
 package mock
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"github.com/lt90s/rfschub-server/account/store"
-	"strconv"
 	"sync"
 	"time"
 )
 
+// mockStore keeps accounts, login failures and audit events in memory.
+// Methods of store.Store not implemented here panic if called
 type mockStore struct {
+	store.Store
+
 	mu       sync.RWMutex
 	id       int
 	accounts map[string]accountInfo
+	// provider/subject -> identity
+	identities map[string]store.Identity
+	failures   map[string]store.LoginFailure
+	events     []store.AuditEvent
+	totps      map[string]store.Totp
 }
 
 type accountInfo struct {
@@ -19,27 +29,46 @@ type accountInfo struct {
 	name      string
 	email     string
 	password  string
+	code      []byte
+	activated bool
 	createdAt int64
 }
 
+// ids look like those of mongodb, 24 hex digits
+func (a accountInfo) uid() string {
+	return fmt.Sprintf("%024x", a.id)
+}
+
+func (a accountInfo) info() store.AccountInfo {
+	return store.AccountInfo{
+		Id:        a.uid(),
+		Name:      a.name,
+		Email:     a.email,
+		CreatedAt: a.createdAt,
+	}
+}
+
 func NewMockStore() *mockStore {
 	return &mockStore{
-		id:       100000,
-		accounts: make(map[string]accountInfo),
+		id:         100000,
+		accounts:   make(map[string]accountInfo),
+		identities: make(map[string]store.Identity),
+		failures:   make(map[string]store.LoginFailure),
+		totps:      make(map[string]store.Totp),
 	}
 }
 
-func (m *mockStore) CreateAccount(ctx context.Context, name, email, password string) error {
+func (m *mockStore) CreateAccount(ctx context.Context, name, email, password string, code []byte) (string, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	if _, ok := m.accounts[name]; ok {
-		return store.ErrNameUsed
+		return "", store.ErrNameUsed
 	}
 
 	for _, info := range m.accounts {
 		if info.email == email {
-			return store.ErrEmailRegistered
+			return "", store.ErrEmailRegistered
 		}
 	}
 
@@ -48,10 +77,31 @@ func (m *mockStore) CreateAccount(ctx context.Context, name, email, password str
 		name:      name,
 		email:     email,
 		password:  password,
+		code:      code,
 		createdAt: time.Now().Unix(),
 	}
 	m.id += 1
-	return nil
+	return m.accounts[name].uid(), nil
+}
+
+func (m *mockStore) ActivateAccount(ctx context.Context, id string, code []byte) (info store.AccountInfo, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, account := range m.accounts {
+		if account.uid() != id {
+			continue
+		}
+		if account.activated || !bytes.Equal(account.code, code) {
+			break
+		}
+		account.activated = true
+		account.code = nil
+		m.accounts[name] = account
+		return account.info(), nil
+	}
+	err = store.ErrInvalidCode
+	return
 }
 
 func (m *mockStore) LoginAccount(ctx context.Context, name, email, password string) (info store.AccountInfo, err error) {
@@ -73,11 +123,11 @@ func (m *mockStore) LoginAccount(ctx context.Context, name, email, password stri
 		err = store.ErrNoMatch
 		return
 	}
-
-	info.Id = strconv.Itoa(account.id)
-	info.Name = account.name
-	info.CreatedAt = account.createdAt
-	return
+	if !account.activated {
+		err = store.ErrNotActivate
+		return
+	}
+	return account.info(), nil
 }
 
 func (m *mockStore) GetAccountId(ctx context.Context, name string) (string, error) {
@@ -88,9 +138,181 @@ func (m *mockStore) GetAccountId(ctx context.Context, name string) (string, erro
 	if !ok {
 		return "", store.ErrNoAccount
 	}
-	return strconv.Itoa(account.id), nil
+	return account.uid(), nil
+}
+
+func (m *mockStore) GetAccountInfoByName(ctx context.Context, name string) (info store.AccountInfo, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	account, ok := m.accounts[name]
+	if !ok {
+		err = store.ErrNoAccount
+		return
+	}
+	return account.info(), nil
 }
 
 func (m *mockStore) GetAccountsBasicInfo(ctx context.Context, uids []string) (infos []store.BasicInfo, err error) {
 	return
 }
+
+func (m *mockStore) SetAdmins(ctx context.Context, names []string) error {
+	return nil
+}
+
+func (m *mockStore) GetAccountInfo(ctx context.Context, uid string) (info store.AccountInfo, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, account := range m.accounts {
+		if account.uid() == uid {
+			return account.info(), nil
+		}
+	}
+	err = store.ErrNoAccount
+	return
+}
+
+func (m *mockStore) GetAccountInfoByEmail(ctx context.Context, email string) (info store.AccountInfo, activated bool, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, account := range m.accounts {
+		if account.email == email {
+			return account.info(), account.activated, nil
+		}
+	}
+	err = store.ErrNoAccount
+	return
+}
+
+func (m *mockStore) CreateOAuthAccount(ctx context.Context, name, email string) (string, error) {
+	uid, err := m.CreateAccount(ctx, name, email, "", nil)
+	if err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	account := m.accounts[name]
+	account.activated = true
+	m.accounts[name] = account
+	return uid, nil
+}
+
+func (m *mockStore) GetIdentity(ctx context.Context, provider, subject string) (store.Identity, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	identity, ok := m.identities[provider+"/"+subject]
+	if !ok {
+		return identity, store.ErrNoIdentity
+	}
+	return identity, nil
+}
+
+func (m *mockStore) AddIdentity(ctx context.Context, identity store.Identity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := identity.Provider + "/" + identity.Subject
+	if _, ok := m.identities[key]; ok {
+		return store.ErrIdentityLinked
+	}
+	m.identities[key] = identity
+	return nil
+}
+
+func (m *mockStore) GetLoginFailures(ctx context.Context, keys []string) ([]store.LoginFailure, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	failures := make([]store.LoginFailure, 0, len(keys))
+	for _, key := range keys {
+		if failure, ok := m.failures[key]; ok {
+			failures = append(failures, failure)
+		}
+	}
+	return failures, nil
+}
+
+func (m *mockStore) AddLoginFailure(ctx context.Context, key string, now, resetBefore, expireAt int64) (store.LoginFailure, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	failure, ok := m.failures[key]
+	if !ok || failure.LastFailureAt < resetBefore {
+		failure = store.LoginFailure{Key: key}
+	}
+	failure.Failures++
+	failure.LastFailureAt = now
+	m.failures[key] = failure
+	return failure, nil
+}
+
+func (m *mockStore) LockLogin(ctx context.Context, key string, until int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	failure := m.failures[key]
+	failure.Key = key
+	failure.LockedUntil = until
+	m.failures[key] = failure
+	return nil
+}
+
+func (m *mockStore) ClearLoginFailures(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.failures, key)
+	return nil
+}
+
+func (m *mockStore) AddAuditEvent(ctx context.Context, event store.AuditEvent, expireAt int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockStore) GetAuditEvents(ctx context.Context, uid string, skip, limit int) ([]store.AuditEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	events := make([]store.AuditEvent, 0)
+	for i := len(m.events) - 1; i >= 0 && len(events) < limit; i-- {
+		if m.events[i].Uid != uid {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		events = append(events, m.events[i])
+	}
+	return events, nil
+}
+
+func (m *mockStore) GetTotp(ctx context.Context, uid string) (totp store.Totp, enabled bool, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	totp = m.totps[uid]
+	return totp, len(totp.Secret) > 0, nil
+}
+
+func (m *mockStore) SetPendingTotp(ctx context.Context, uid string, secret []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	totp := m.totps[uid]
+	if len(totp.Secret) > 0 {
+		return store.ErrTotpEnabled
+	}
+	totp.PendingSecret = secret
+	m.totps[uid] = totp
+	return nil
+}
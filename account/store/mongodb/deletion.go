@@ -0,0 +1,101 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/account/store"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (ms *mongodbStore) CheckPassword(ctx context.Context, uid, password string) error {
+	_, err := ms.verifyPassword(ctx, uid, password)
+	return err
+}
+
+func (ms *mongodbStore) updateAccount(ctx context.Context, uid string, update bson.M) error {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return store.ErrNoAccount
+	}
+	ur, err := ms.accountCollection().UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrNoAccount
+	}
+	return nil
+}
+
+func (ms *mongodbStore) ScheduleDeletion(ctx context.Context, uid string, deleteAt int64, deleteAnnotations bool) error {
+	return ms.updateAccount(ctx, uid, bson.M{"$set": bson.M{
+		"deleteAt":          deleteAt,
+		"deleteAnnotations": deleteAnnotations,
+	}})
+}
+
+func (ms *mongodbStore) CancelDeletion(ctx context.Context, uid string) error {
+	return ms.updateAccount(ctx, uid, bson.M{"$unset": bson.M{
+		"deleteAt":          "",
+		"deleteAnnotations": "",
+	}})
+}
+
+func (ms *mongodbStore) GetDueDeletions(ctx context.Context, now int64, limit int) (infos []store.AccountInfo, err error) {
+	filter := bson.M{
+		"deleteAt": bson.M{"$gt": 0, "$lte": now},
+	}
+	l := int64(limit)
+	option := &options.FindOptions{
+		Projection: accountProjection,
+		Sort:       bson.M{"deleteAt": 1},
+		Limit:      &l,
+	}
+	cursor, err := ms.accountCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc accountDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		infos = append(infos, doc.info())
+	}
+	err = cursor.Err()
+	return
+}
+
+// records of other collections go first, so that a deletion interrupted is
+// retried with the account
+func (ms *mongodbStore) DeleteAccount(ctx context.Context, uid string) error {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return store.ErrNoAccount
+	}
+	collections := []*mongo.Collection{
+		ms.identityCollection(),
+		ms.tokenCollection(),
+		ms.sessionCollection(),
+		ms.auditCollection(),
+		ms.orgMemberCollection(),
+	}
+	for _, collection := range collections {
+		if _, err = collection.DeleteMany(ctx, bson.M{"uid": uid}); err != nil {
+			return err
+		}
+	}
+
+	dr, err := ms.accountCollection().DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrNoAccount
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/account/store"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"strings"
+	"time"
+)
+
+// organizations are kept apart from accounts, they have neither email nor
+// password. Names are checked against both collections before an account
+// or organization is created, two of them created at the same time with
+// one name may both succeed.
+const (
+	organizationCollection = "organizations"
+	orgMemberCollection    = "organizationMembers"
+)
+
+func (ms *mongodbStore) organizationCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(organizationCollection)
+}
+
+func (ms *mongodbStore) orgMemberCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(orgMemberCollection)
+}
+
+func (ms *mongodbStore) setupOrganizations() {
+	unique := true
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.M{"name": 1},
+			Options: &options.IndexOptions{Unique: &unique},
+		},
+	}
+	_, err := ms.organizationCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+	models = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "org", Value: 1}, {Key: "uid", Value: 1}},
+			Options: &options.IndexOptions{Unique: &unique},
+		}, {
+			Keys: bson.M{"uid": 1},
+		},
+	}
+	_, err = ms.orgMemberCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+}
+
+func nameUsed(ctx context.Context, collection *mongo.Collection, name string) (bool, error) {
+	count, err := collection.CountDocuments(ctx, bson.M{"name": name})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+type organizationDocument struct {
+	Id                 primitive.ObjectID `bson:"_id"`
+	store.Organization `bson:",inline"`
+}
+
+func (doc organizationDocument) accountInfo() store.AccountInfo {
+	return store.AccountInfo{
+		Id:           doc.Id.Hex(),
+		Name:         doc.Name,
+		CreatedAt:    doc.CreatedAt,
+		Organization: true,
+	}
+}
+
+func (ms *mongodbStore) findOrganization(ctx context.Context, filter bson.M) (doc organizationDocument, err error) {
+	sr := ms.organizationCollection().FindOne(ctx, filter)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoOrganization
+		}
+		return
+	}
+	err = sr.Decode(&doc)
+	return
+}
+
+// basic info of organizations matching filter
+func (ms *mongodbStore) organizationsBasicInfo(ctx context.Context, filter bson.M) (infos []store.BasicInfo, err error) {
+	cursor, err := ms.organizationCollection().Find(ctx, filter)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc organizationDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		infos = append(infos, store.BasicInfo{Id: doc.Id.Hex(), Name: doc.Name})
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) CreateOrganization(ctx context.Context, name, uid string) (string, error) {
+	used, err := nameUsed(ctx, ms.accountCollection(), name)
+	if err != nil {
+		return "", err
+	}
+	if used {
+		return "", store.ErrNameUsed
+	}
+
+	now := time.Now().Unix()
+	is, err := ms.organizationCollection().InsertOne(ctx, bson.M{
+		"name":      name,
+		"createdBy": uid,
+		"createdAt": now,
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return "", store.ErrNameUsed
+		}
+		return "", err
+	}
+	id := is.InsertedID.(primitive.ObjectID).Hex()
+
+	_, err = ms.orgMemberCollection().InsertOne(ctx, store.OrganizationMember{
+		Org:       id,
+		Uid:       uid,
+		Role:      store.OrgRoleOwner,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (ms *mongodbStore) GetOrganization(ctx context.Context, id string) (store.Organization, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.Organization{}, store.ErrNoOrganization
+	}
+	doc, err := ms.findOrganization(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return store.Organization{}, err
+	}
+	org := doc.Organization
+	org.Id = id
+	return org, nil
+}
+
+func (ms *mongodbStore) GetOrganizationRole(ctx context.Context, org, uid string) (store.OrgRole, error) {
+	sr := ms.orgMemberCollection().FindOne(ctx, bson.M{"org": org, "uid": uid})
+	if err := sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return store.OrgRoleNone, nil
+		}
+		return store.OrgRoleNone, err
+	}
+	var member store.OrganizationMember
+	if err := sr.Decode(&member); err != nil {
+		return store.OrgRoleNone, err
+	}
+	return member.Role, nil
+}
+
+func (ms *mongodbStore) findOrgMembers(ctx context.Context, filter bson.M) (members []store.OrganizationMember, err error) {
+	option := &options.FindOptions{
+		Sort: bson.M{"createdAt": 1},
+	}
+	cursor, err := ms.orgMemberCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var member store.OrganizationMember
+		if err = cursor.Decode(&member); err != nil {
+			return
+		}
+		members = append(members, member)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) GetOrganizationMembers(ctx context.Context, org string) ([]store.OrganizationMember, error) {
+	return ms.findOrgMembers(ctx, bson.M{"org": org})
+}
+
+func (ms *mongodbStore) SetOrganizationMember(ctx context.Context, org, uid string, role store.OrgRole) error {
+	filter := bson.M{
+		"org": org,
+		"uid": uid,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"role": role,
+		},
+		"$setOnInsert": bson.M{
+			"createdAt": time.Now().Unix(),
+		},
+	}
+	upsert := true
+	_, err := ms.orgMemberCollection().UpdateOne(ctx, filter, update, &options.UpdateOptions{Upsert: &upsert})
+	return err
+}
+
+func (ms *mongodbStore) RemoveOrganizationMember(ctx context.Context, org, uid string) error {
+	dr, err := ms.orgMemberCollection().DeleteOne(ctx, bson.M{"org": org, "uid": uid})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrNoMember
+	}
+	return nil
+}
+
+func (ms *mongodbStore) GetUserOrganizations(ctx context.Context, uid string) ([]store.OrganizationMember, error) {
+	return ms.findOrgMembers(ctx, bson.M{"uid": uid})
+}
@@ -10,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/crypto/bcrypt"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -20,7 +21,12 @@ type mongodbStore struct {
 }
 
 const (
-	accountCollection = "accounts"
+	accountCollection  = "accounts"
+	identityCollection = "identities"
+	tokenCollection    = "accessTokens"
+	sessionCollection  = "sessions"
+	loginCollection    = "loginFailures"
+	auditCollection    = "auditEvents"
 )
 
 func NewMongodbStore() store.Store {
@@ -38,9 +44,29 @@ func (ms *mongodbStore) accountCollection() *mongo.Collection {
 	return ms.client.Database(ms.name).Collection(accountCollection)
 }
 
+func (ms *mongodbStore) identityCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(identityCollection)
+}
+
+func (ms *mongodbStore) tokenCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(tokenCollection)
+}
+
+func (ms *mongodbStore) sessionCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(sessionCollection)
+}
+
+func (ms *mongodbStore) loginCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(loginCollection)
+}
+
+func (ms *mongodbStore) auditCollection() *mongo.Collection {
+	return ms.client.Database(ms.name).Collection(auditCollection)
+}
+
 func (ms *mongodbStore) setup() {
 	iv := ms.accountCollection().Indexes()
-	unique := true
+	unique, sparse := true, true
 	models := []mongo.IndexModel{
 		{
 			Keys: bson.M{"name": 1},
@@ -52,12 +78,94 @@ func (ms *mongodbStore) setup() {
 			Options: &options.IndexOptions{
 				Unique: &unique,
 			},
+		}, {
+			Keys:    bson.M{"passwordReset.hash": 1},
+			Options: &options.IndexOptions{Sparse: &sparse},
+		}, {
+			Keys:    bson.M{"pendingEmail.hash": 1},
+			Options: &options.IndexOptions{Sparse: &sparse},
+		}, {
+			Keys:    bson.M{"deleteAt": 1},
+			Options: &options.IndexOptions{Sparse: &sparse},
 		},
 	}
 	_, err := iv.CreateMany(context.Background(), models)
 	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
 		panic(err)
 	}
+
+	// an identity links to one account, and an account has at most one
+	// identity of a provider
+	models = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "provider", Value: 1}, {Key: "subject", Value: 1}},
+			Options: &options.IndexOptions{Unique: &unique},
+		}, {
+			Keys:    bson.D{{Key: "uid", Value: 1}, {Key: "provider", Value: 1}},
+			Options: &options.IndexOptions{Unique: &unique},
+		},
+	}
+	_, err = ms.identityCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+	models = []mongo.IndexModel{
+		{
+			Keys:    bson.M{"hash": 1},
+			Options: &options.IndexOptions{Unique: &unique},
+		}, {
+			Keys:    bson.D{{Key: "uid", Value: 1}, {Key: "name", Value: 1}},
+			Options: &options.IndexOptions{Unique: &unique},
+		},
+	}
+	_, err = ms.tokenCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+	models = []mongo.IndexModel{
+		{
+			Keys:    bson.M{"refreshHash": 1},
+			Options: &options.IndexOptions{Unique: &unique},
+		}, {
+			Keys:    bson.M{"prevRefreshHash": 1},
+			Options: &options.IndexOptions{Sparse: &sparse},
+		}, {
+			Keys: bson.M{"uid": 1},
+		},
+	}
+	_, err = ms.sessionCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+
+	// records are removed by mongodb once expireAt passes
+	var expireAfter int32 = 0
+	models = []mongo.IndexModel{
+		{
+			Keys:    bson.M{"key": 1},
+			Options: &options.IndexOptions{Unique: &unique},
+		}, {
+			Keys:    bson.M{"expireAt": 1},
+			Options: &options.IndexOptions{ExpireAfterSeconds: &expireAfter},
+		},
+	}
+	_, err = ms.loginCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+	models = []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "uid", Value: 1}, {Key: "createdAt", Value: -1}},
+		}, {
+			Keys:    bson.M{"expireAt": 1},
+			Options: &options.IndexOptions{ExpireAfterSeconds: &expireAfter},
+		},
+	}
+	_, err = ms.auditCollection().Indexes().CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+	ms.setupOrganizations()
 }
 
 func (ms *mongodbStore) CreateAccount(ctx context.Context, name, email, password string, code []byte) (string, error) {
@@ -65,17 +173,27 @@ func (ms *mongodbStore) CreateAccount(ctx context.Context, name, email, password
 	if err != nil {
 		return "", err
 	}
-	// TODO: account should be activate before being valid
+	if used, err := nameUsed(ctx, ms.organizationCollection(), name); err != nil {
+		return "", err
+	} else if used {
+		return "", store.ErrNameUsed
+	}
 	is, err := ms.accountCollection().InsertOne(ctx, bson.M{
 		"name":      name,
 		"email":     email,
 		"hash":      hash,
 		"createdAt": time.Now().Unix(),
 		"code":      code,
-		"activated": true, // defaults to activated for now
+		"activated": false,
 	})
 
 	if err != nil {
+		if isDuplicateKeyError(err) {
+			if strings.Contains(err.Error(), "email") {
+				return "", store.ErrEmailRegistered
+			}
+			return "", store.ErrNameUsed
+		}
 		return "", err
 	}
 
@@ -90,48 +208,82 @@ func (ms *mongodbStore) LoginAccount(ctx context.Context, name, email, password
 		filter["email"] = email
 	}
 
-	option := &options.FindOneOptions{
-		Projection: bson.M{
-			"_id":       1,
-			"name":      1,
-			"hash":      1,
-			"createdAt": 1,
-			"activated": 1,
-		},
-	}
-	sr := ms.accountCollection().FindOne(ctx, filter, option)
-	if err = sr.Err(); err != nil {
-		if err == mongo.ErrNoDocuments {
+	doc, err := ms.findAccount(ctx, filter)
+	if err != nil {
+		if err == store.ErrNoAccount {
 			err = store.ErrNoMatch
 		}
 		return
 	}
 
-	var tmp struct {
-		Id        primitive.ObjectID `bson:"_id"`
-		Name      string             `bson:"name"`
-		Hash      []byte             `bson:"hash"`
-		CreatedAt int64              `bson:"createdAt"`
-		Activated bool               `bson:"activated"`
+	if err = bcrypt.CompareHashAndPassword(doc.Hash, []byte(password)); err != nil {
+		err = store.ErrNoMatch
+		return
 	}
 
-	if err = sr.Decode(&tmp); err != nil {
+	if !doc.Activated {
+		err = store.ErrNotActivate
 		return
 	}
+	return doc.info(), nil
+}
 
-	if err = bcrypt.CompareHashAndPassword(tmp.Hash, []byte(password)); err != nil {
-		err = store.ErrNoMatch
+// an account with its password hash
+type accountDocument struct {
+	Id                primitive.ObjectID `bson:"_id"`
+	store.AccountInfo `bson:",inline"`
+	Hash              []byte `bson:"hash"`
+	Activated         bool   `bson:"activated"`
+}
+
+var accountProjection = bson.M{
+	"_id":               1,
+	"name":              1,
+	"email":             1,
+	"hash":              1,
+	"createdAt":         1,
+	"activated":         1,
+	"tokenVersion":      1,
+	"avatar":            1,
+	"totpEnabled":       1,
+	"role":              1,
+	"disabled":          1,
+	"deleteAt":          1,
+	"deleteAnnotations": 1,
+}
+
+func (doc accountDocument) info() store.AccountInfo {
+	info := doc.AccountInfo
+	info.Id = doc.Id.Hex()
+	return info
+}
+
+func (ms *mongodbStore) findAccount(ctx context.Context, filter bson.M) (doc accountDocument, err error) {
+	sr := ms.accountCollection().FindOne(ctx, filter, &options.FindOneOptions{Projection: accountProjection})
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoAccount
+		}
 		return
 	}
+	err = sr.Decode(&doc)
+	return
+}
 
-	if !tmp.Activated {
+// find account uid and check its password
+func (ms *mongodbStore) verifyPassword(ctx context.Context, uid, password string) (doc accountDocument, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
 		err = store.ErrNoAccount
 		return
 	}
-
-	info.Id = tmp.Id.Hex()
-	info.Name = tmp.Name
-	info.CreatedAt = tmp.CreatedAt
+	doc, err = ms.findAccount(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return
+	}
+	if bcrypt.CompareHashAndPassword(doc.Hash, []byte(password)) != nil {
+		err = store.ErrWrongPassword
+	}
 	return
 }
 
@@ -145,6 +297,10 @@ func (ms *mongodbStore) GetAccountId(ctx context.Context, name string) (string,
 		},
 	}
 	sr := ms.accountCollection().FindOne(ctx, filter, option)
+	// organizations share the namespace of accounts
+	if sr.Err() == mongo.ErrNoDocuments {
+		sr = ms.organizationCollection().FindOne(ctx, filter, option)
+	}
 
 	if sr.Err() != nil {
 		if sr.Err() == mongo.ErrNoDocuments {
@@ -179,53 +335,1035 @@ func (ms *mongodbStore) GetAccountsBasicInfo(ctx context.Context, uids []string)
 		},
 	}
 
+	infos, err = ms.accountsBasicInfo(ctx, filter)
+	if err != nil || len(infos) == len(ids) {
+		return
+	}
+	orgs, err := ms.organizationsBasicInfo(ctx, filter)
+	return append(infos, orgs...), err
+}
+
+func (ms *mongodbStore) accountsBasicInfo(ctx context.Context, filter bson.M) (infos []store.BasicInfo, err error) {
 	option := &options.FindOptions{
-		Projection: bson.M{
-			"_id":  1,
-			"name": 1,
-		},
+		Projection: basicInfoProjection,
 	}
 	cursor, err := ms.accountCollection().Find(ctx, filter, option)
 
 	if err != nil {
 		return
 	}
-	var tmp struct {
-		Id   primitive.ObjectID `bson:"_id"`
-		Name string             `bson:"name"`
-	}
+	defer cursor.Close(ctx)
+
 	for cursor.Next(ctx) {
-		if err = cursor.Decode(&tmp); err != nil {
+		var doc basicInfoDocument
+		if err = cursor.Decode(&doc); err != nil {
 			return
 		}
-		infos = append(infos, store.BasicInfo{Id: tmp.Id.Hex(), Name: tmp.Name})
+		infos = append(infos, doc.info())
 	}
+	err = cursor.Err()
 	return
 }
 
+type basicInfoDocument struct {
+	Id     primitive.ObjectID `bson:"_id"`
+	Name   string             `bson:"name"`
+	Email  string             `bson:"email"`
+	Avatar string             `bson:"avatar"`
+}
+
+var basicInfoProjection = bson.M{
+	"_id":    1,
+	"name":   1,
+	"email":  1,
+	"avatar": 1,
+}
+
+func (doc basicInfoDocument) info() store.BasicInfo {
+	return store.BasicInfo{
+		Id:     doc.Id.Hex(),
+		Name:   doc.Name,
+		Email:  doc.Email,
+		Avatar: doc.Avatar,
+	}
+}
+
+func (ms *mongodbStore) GetAccountsBasicInfoByNames(ctx context.Context, names []string) (infos []store.BasicInfo, err error) {
+	filter := bson.M{
+		"name": bson.M{
+			"$in": names,
+		},
+	}
+	infos, err = ms.accountsBasicInfo(ctx, filter)
+	if err != nil || len(infos) == len(names) {
+		return
+	}
+	orgs, err := ms.organizationsBasicInfo(ctx, filter)
+	return append(infos, orgs...), err
+}
+
 func (ms *mongodbStore) GetAccountInfoByName(ctx context.Context, name string) (info store.AccountInfo, err error) {
+	doc, err := ms.findAccount(ctx, bson.M{"name": name})
+	if err == store.ErrNoAccount {
+		org, err := ms.findOrganization(ctx, bson.M{"name": name})
+		if err != nil {
+			if err == store.ErrNoOrganization {
+				err = store.ErrNoAccount
+			}
+			return info, err
+		}
+		return org.accountInfo(), nil
+	}
+	if err != nil {
+		return
+	}
+	return doc.info(), nil
+}
+
+func (ms *mongodbStore) ActivateAccount(ctx context.Context, id string, code []byte) (info store.AccountInfo, err error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		err = store.ErrInvalidCode
+		return
+	}
 	filter := bson.M{
-		"name": name,
+		"_id":       oid,
+		"code":      code,
+		"activated": false,
+	}
+	update := bson.M{
+		"$set":   bson.M{"activated": true},
+		"$unset": bson.M{"code": ""},
 	}
+	return ms.findAndUpdateAccount(ctx, filter, update, store.ErrInvalidCode)
+}
 
-	sr := ms.accountCollection().FindOne(ctx, filter)
+func (ms *mongodbStore) ResetActivationCode(ctx context.Context, email string, code []byte) (info store.AccountInfo, err error) {
+	filter := bson.M{
+		"email":     email,
+		"activated": false,
+	}
+	update := bson.M{
+		"$set": bson.M{"code": code},
+	}
+	return ms.findAndUpdateAccount(ctx, filter, update, store.ErrNoAccount)
+}
+
+// update the account matching filter and get its info after the update,
+// notFound is returned if there is no such account
+func (ms *mongodbStore) findAndUpdateAccount(ctx context.Context, filter, update bson.M, notFound error) (info store.AccountInfo, err error) {
+	after := options.After
+	option := &options.FindOneAndUpdateOptions{
+		Projection:     accountProjection,
+		ReturnDocument: &after,
+	}
+	sr := ms.accountCollection().FindOneAndUpdate(ctx, filter, update, option)
 	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = notFound
+		}
+		return
+	}
+	var doc accountDocument
+	if err = sr.Decode(&doc); err != nil {
 		return
 	}
+	return doc.info(), nil
+}
 
-	var tmp struct {
-		Id      primitive.ObjectID `bson:"_id"`
-		Created int64              `bson:"createdAt"`
+func (ms *mongodbStore) GetAccountsEmail(ctx context.Context, uids []string) (map[string]string, error) {
+	ids := make([]primitive.ObjectID, 0, len(uids))
+	for _, uid := range uids {
+		id, err := primitive.ObjectIDFromHex(uid)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	filter := bson.M{
+		"_id":       bson.M{"$in": ids},
+		"activated": true,
+	}
+	option := &options.FindOptions{
+		Projection: bson.M{
+			"_id":   1,
+			"email": 1,
+		},
+	}
+	cursor, err := ms.accountCollection().Find(ctx, filter, option)
+	if err != nil {
+		return nil, err
 	}
+	defer cursor.Close(ctx)
 
-	if err = sr.Decode(&tmp); err != nil {
+	emails := make(map[string]string, len(ids))
+	for cursor.Next(ctx) {
+		var tmp struct {
+			Id    primitive.ObjectID `bson:"_id"`
+			Email string             `bson:"email"`
+		}
+		if err = cursor.Decode(&tmp); err != nil {
+			return nil, err
+		}
+		emails[tmp.Id.Hex()] = tmp.Email
+	}
+	return emails, cursor.Err()
+}
+
+func (ms *mongodbStore) SetPasswordReset(ctx context.Context, email string, token store.AccountToken) (store.AccountInfo, error) {
+	filter := bson.M{
+		"email":     email,
+		"activated": true,
+	}
+	update := bson.M{
+		"$set": bson.M{"passwordReset": token},
+	}
+	return ms.findAndUpdateAccount(ctx, filter, update, store.ErrNoAccount)
+}
+
+func (ms *mongodbStore) ResetPassword(ctx context.Context, hash []byte, password string, now int64) (store.AccountInfo, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return store.AccountInfo{}, err
+	}
+	filter := bson.M{
+		"passwordReset.hash":     hash,
+		"passwordReset.expireAt": bson.M{"$gt": now},
+	}
+	update := bson.M{
+		"$set":   bson.M{"hash": passwordHash},
+		"$unset": bson.M{"passwordReset": ""},
+		"$inc":   bson.M{"tokenVersion": 1},
+	}
+	return ms.findAndUpdateAccount(ctx, filter, update, store.ErrInvalidToken)
+}
+
+func (ms *mongodbStore) ChangePassword(ctx context.Context, uid, oldPassword, newPassword string) (info store.AccountInfo, err error) {
+	doc, err := ms.verifyPassword(ctx, uid, oldPassword)
+	if err != nil {
+		return
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return
+	}
+	// the old hash in filter makes concurrent changes fail
+	filter := bson.M{
+		"_id":  doc.Id,
+		"hash": doc.Hash,
+	}
+	update := bson.M{
+		"$set":   bson.M{"hash": passwordHash},
+		"$unset": bson.M{"passwordReset": ""},
+		"$inc":   bson.M{"tokenVersion": 1},
+	}
+	return ms.findAndUpdateAccount(ctx, filter, update, store.ErrWrongPassword)
+}
+
+func (ms *mongodbStore) SetPendingEmail(ctx context.Context, uid, password, email string, token store.AccountToken) (info store.AccountInfo, err error) {
+	doc, err := ms.verifyPassword(ctx, uid, password)
+	if err != nil {
+		return
+	}
+	count, err := ms.accountCollection().CountDocuments(ctx, bson.M{"email": email})
+	if err != nil {
+		return
+	}
+	if count > 0 {
+		err = store.ErrEmailRegistered
+		return
+	}
+	token.Email = email
+	update := bson.M{
+		"$set": bson.M{"pendingEmail": token},
+	}
+	return ms.findAndUpdateAccount(ctx, bson.M{"_id": doc.Id}, update, store.ErrNoAccount)
+}
+
+func (ms *mongodbStore) ConfirmEmail(ctx context.Context, hash []byte, now int64) (info store.AccountInfo, oldEmail string, err error) {
+	filter := bson.M{
+		"pendingEmail.hash":     hash,
+		"pendingEmail.expireAt": bson.M{"$gt": now},
+	}
+	sr := ms.accountCollection().FindOne(ctx, filter)
+	if err = sr.Err(); err != nil {
 		if err == mongo.ErrNoDocuments {
-			err = store.ErrNoAccount
+			err = store.ErrInvalidToken
 		}
 		return
 	}
-	info.Id = tmp.Id.Hex()
-	info.Name = name
-	info.CreatedAt = tmp.Created
-	return
+	var tmp struct {
+		Id           primitive.ObjectID `bson:"_id"`
+		Email        string             `bson:"email"`
+		PendingEmail store.AccountToken `bson:"pendingEmail"`
+	}
+	if err = sr.Decode(&tmp); err != nil {
+		return
+	}
+
+	filter = bson.M{
+		"_id":               tmp.Id,
+		"pendingEmail.hash": hash,
+	}
+	update := bson.M{
+		"$set":   bson.M{"email": tmp.PendingEmail.Email},
+		"$unset": bson.M{"pendingEmail": ""},
+	}
+	info, err = ms.findAndUpdateAccount(ctx, filter, update, store.ErrInvalidToken)
+	if err != nil && isDuplicateKeyError(err) {
+		err = store.ErrEmailRegistered
+	}
+	return info, tmp.Email, err
+}
+
+func (ms *mongodbStore) GetTokenVersion(ctx context.Context, uid string) (int64, error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return 0, store.ErrNoAccount
+	}
+	doc, err := ms.findAccount(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return 0, err
+	}
+	return doc.TokenVersion, nil
+}
+
+func isDuplicateKeyError(err error) bool {
+	return strings.Contains(err.Error(), "E11000")
+}
+
+func (ms *mongodbStore) GetAccountInfo(ctx context.Context, uid string) (store.AccountInfo, error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return store.AccountInfo{}, store.ErrNoAccount
+	}
+	doc, err := ms.findAccount(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return store.AccountInfo{}, err
+	}
+	return doc.info(), nil
+}
+
+func (ms *mongodbStore) GetAccountInfoByEmail(ctx context.Context, email string) (store.AccountInfo, bool, error) {
+	doc, err := ms.findAccount(ctx, bson.M{"email": email})
+	if err != nil {
+		return store.AccountInfo{}, false, err
+	}
+	return doc.info(), doc.Activated, nil
+}
+
+func (ms *mongodbStore) CreateOAuthAccount(ctx context.Context, name, email string) (string, error) {
+	if used, err := nameUsed(ctx, ms.organizationCollection(), name); err != nil {
+		return "", err
+	} else if used {
+		return "", store.ErrNameUsed
+	}
+	is, err := ms.accountCollection().InsertOne(ctx, bson.M{
+		"name":      name,
+		"email":     email,
+		"createdAt": time.Now().Unix(),
+		"activated": true,
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			if strings.Contains(err.Error(), "email") {
+				return "", store.ErrEmailRegistered
+			}
+			return "", store.ErrNameUsed
+		}
+		return "", err
+	}
+	return is.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (ms *mongodbStore) HasPassword(ctx context.Context, uid string) (bool, error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return false, store.ErrNoAccount
+	}
+	doc, err := ms.findAccount(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return false, err
+	}
+	return len(doc.Hash) > 0, nil
+}
+
+func (ms *mongodbStore) GetIdentity(ctx context.Context, provider, subject string) (identity store.Identity, err error) {
+	sr := ms.identityCollection().FindOne(ctx, bson.M{"provider": provider, "subject": subject})
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoIdentity
+		}
+		return
+	}
+	err = sr.Decode(&identity)
+	return
+}
+
+func (ms *mongodbStore) AddIdentity(ctx context.Context, identity store.Identity) error {
+	_, err := ms.identityCollection().InsertOne(ctx, identity)
+	if err != nil && isDuplicateKeyError(err) {
+		return store.ErrIdentityLinked
+	}
+	return err
+}
+
+func (ms *mongodbStore) GetIdentities(ctx context.Context, uid string) (identities []store.Identity, err error) {
+	option := &options.FindOptions{
+		Sort: bson.M{"createdAt": 1},
+	}
+	cursor, err := ms.identityCollection().Find(ctx, bson.M{"uid": uid}, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	identities = make([]store.Identity, 0, 2)
+	for cursor.Next(ctx) {
+		var identity store.Identity
+		if err = cursor.Decode(&identity); err != nil {
+			return
+		}
+		identities = append(identities, identity)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) RemoveIdentity(ctx context.Context, uid, provider string) error {
+	dr, err := ms.identityCollection().DeleteOne(ctx, bson.M{"uid": uid, "provider": provider})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrNoIdentity
+	}
+	return nil
+}
+
+// an access token with its object id
+type accessTokenDocument struct {
+	Id                primitive.ObjectID `bson:"_id"`
+	store.AccessToken `bson:",inline"`
+}
+
+func (doc accessTokenDocument) token() store.AccessToken {
+	token := doc.AccessToken
+	token.Id = doc.Id.Hex()
+	return token
+}
+
+func (ms *mongodbStore) CreateAccessToken(ctx context.Context, token store.AccessToken) (string, error) {
+	doc := accessTokenDocument{
+		Id:          primitive.NewObjectID(),
+		AccessToken: token,
+	}
+	_, err := ms.tokenCollection().InsertOne(ctx, doc)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return "", store.ErrAccessTokenNameUsed
+		}
+		return "", err
+	}
+	return doc.Id.Hex(), nil
+}
+
+func (ms *mongodbStore) CountAccessTokens(ctx context.Context, uid string) (int64, error) {
+	return ms.tokenCollection().CountDocuments(ctx, bson.M{"uid": uid})
+}
+
+func (ms *mongodbStore) GetAccessTokens(ctx context.Context, uid string) (tokens []store.AccessToken, err error) {
+	option := &options.FindOptions{
+		Projection: bson.M{"hash": 0},
+		Sort:       bson.M{"createdAt": -1},
+	}
+	cursor, err := ms.tokenCollection().Find(ctx, bson.M{"uid": uid}, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	tokens = make([]store.AccessToken, 0, 4)
+	for cursor.Next(ctx) {
+		var doc accessTokenDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		tokens = append(tokens, doc.token())
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) RemoveAccessToken(ctx context.Context, uid, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.ErrNoAccessToken
+	}
+	dr, err := ms.tokenCollection().DeleteOne(ctx, bson.M{"_id": oid, "uid": uid})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrNoAccessToken
+	}
+	return nil
+}
+
+func (ms *mongodbStore) UseAccessToken(ctx context.Context, hash []byte, now int64) (token store.AccessToken, err error) {
+	sr := ms.tokenCollection().FindOne(ctx, bson.M{"hash": hash})
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoAccessToken
+		}
+		return
+	}
+	var doc accessTokenDocument
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	token = doc.token()
+
+	if now-token.LastUsedAt < 60 {
+		return
+	}
+	filter := bson.M{
+		"_id":        doc.Id,
+		"lastUsedAt": bson.M{"$lt": now - 60},
+	}
+	_, err = ms.tokenCollection().UpdateOne(ctx, filter, bson.M{"$set": bson.M{"lastUsedAt": now}})
+	if err != nil {
+		return
+	}
+	token.LastUsedAt = now
+	return
+}
+
+// a session with its object id
+type sessionDocument struct {
+	Id            primitive.ObjectID `bson:"_id"`
+	store.Session `bson:",inline"`
+}
+
+func (doc sessionDocument) session() store.Session {
+	session := doc.Session
+	session.Id = doc.Id.Hex()
+	return session
+}
+
+var sessionProjection = bson.M{
+	"refreshHash":     0,
+	"prevRefreshHash": 0,
+}
+
+func (ms *mongodbStore) CreateSession(ctx context.Context, session store.Session) (string, error) {
+	doc := sessionDocument{
+		Id:      primitive.NewObjectID(),
+		Session: session,
+	}
+	_, err := ms.sessionCollection().InsertOne(ctx, doc)
+	if err != nil {
+		return "", err
+	}
+	return doc.Id.Hex(), nil
+}
+
+func (ms *mongodbStore) findAndUpdateSession(ctx context.Context, filter, update bson.M) (session store.Session, err error) {
+	after := options.After
+	option := &options.FindOneAndUpdateOptions{
+		Projection:     sessionProjection,
+		ReturnDocument: &after,
+	}
+	sr := ms.sessionCollection().FindOneAndUpdate(ctx, filter, update, option)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoSession
+		}
+		return
+	}
+	var doc sessionDocument
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	return doc.session(), nil
+}
+
+func (ms *mongodbStore) RefreshSession(ctx context.Context, hash, newHash []byte, meta store.SessionMeta, now, expireAt, grace int64) (session store.Session, rotated bool, err error) {
+	filter := bson.M{
+		"refreshHash": hash,
+		"expireAt":    bson.M{"$gt": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"refreshHash":     newHash,
+			"prevRefreshHash": hash,
+			"rotatedAt":       now,
+			"userAgent":       meta.UserAgent,
+			"ip":              meta.Ip,
+			"lastSeenAt":      now,
+			"expireAt":        expireAt,
+		},
+	}
+	session, err = ms.findAndUpdateSession(ctx, filter, update)
+	if err != store.ErrNoSession {
+		return session, err == nil, err
+	}
+
+	// concurrent requests of a client refresh with the same token
+	filter = bson.M{
+		"prevRefreshHash": hash,
+		"rotatedAt":       bson.M{"$gte": now - grace},
+		"expireAt":        bson.M{"$gt": now},
+	}
+	sr := ms.sessionCollection().FindOne(ctx, filter, &options.FindOneOptions{Projection: sessionProjection})
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoSession
+		}
+		return
+	}
+	var doc sessionDocument
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	return doc.session(), false, nil
+}
+
+func (ms *mongodbStore) UseSession(ctx context.Context, uid, id string, now int64) (session store.Session, err error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		err = store.ErrNoSession
+		return
+	}
+	filter := bson.M{
+		"_id":      oid,
+		"uid":      uid,
+		"expireAt": bson.M{"$gt": now},
+	}
+	sr := ms.sessionCollection().FindOne(ctx, filter, &options.FindOneOptions{Projection: sessionProjection})
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoSession
+		}
+		return
+	}
+	var doc sessionDocument
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	session = doc.session()
+
+	if now-session.LastSeenAt < 60 {
+		return
+	}
+	filter = bson.M{
+		"_id":        oid,
+		"lastSeenAt": bson.M{"$lt": now - 60},
+	}
+	_, err = ms.sessionCollection().UpdateOne(ctx, filter, bson.M{"$set": bson.M{"lastSeenAt": now}})
+	if err != nil {
+		return
+	}
+	session.LastSeenAt = now
+	return
+}
+
+func (ms *mongodbStore) GetSessions(ctx context.Context, uid string, now int64) (sessions []store.Session, err error) {
+	filter := bson.M{
+		"uid":      uid,
+		"expireAt": bson.M{"$gt": now},
+	}
+	option := &options.FindOptions{
+		Projection: sessionProjection,
+		Sort:       bson.M{"lastSeenAt": -1},
+	}
+	cursor, err := ms.sessionCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	sessions = make([]store.Session, 0, 4)
+	for cursor.Next(ctx) {
+		var doc sessionDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		sessions = append(sessions, doc.session())
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) RemoveSession(ctx context.Context, uid, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.ErrNoSession
+	}
+	dr, err := ms.sessionCollection().DeleteOne(ctx, bson.M{"_id": oid, "uid": uid})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrNoSession
+	}
+	return nil
+}
+
+func (ms *mongodbStore) RemoveSessions(ctx context.Context, uid string) error {
+	_, err := ms.sessionCollection().DeleteMany(ctx, bson.M{"uid": uid})
+	return err
+}
+
+func (ms *mongodbStore) RemoveExpiredSessions(ctx context.Context, uid string, now int64) error {
+	_, err := ms.sessionCollection().DeleteMany(ctx, bson.M{"uid": uid, "expireAt": bson.M{"$lte": now}})
+	return err
+}
+
+func (ms *mongodbStore) GetProfile(ctx context.Context, name string) (info store.AccountInfo, profile store.Profile, err error) {
+	option := &options.FindOneOptions{
+		Projection: bson.M{
+			"_id":       1,
+			"name":      1,
+			"email":     1,
+			"createdAt": 1,
+			"avatar":    1,
+			"profile":   1,
+		},
+	}
+	sr := ms.accountCollection().FindOne(ctx, bson.M{"name": name}, option)
+	if sr.Err() == mongo.ErrNoDocuments {
+		// organizations have no profile
+		org, err := ms.findOrganization(ctx, bson.M{"name": name})
+		if err != nil {
+			if err == store.ErrNoOrganization {
+				err = store.ErrNoAccount
+			}
+			return info, profile, err
+		}
+		return org.accountInfo(), profile, nil
+	}
+	if err = sr.Err(); err != nil {
+		return
+	}
+	var doc struct {
+		accountDocument `bson:",inline"`
+		Profile         store.Profile `bson:"profile"`
+	}
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	return doc.info(), doc.Profile, nil
+}
+
+func (ms *mongodbStore) UpdateProfile(ctx context.Context, uid string, profile store.Profile) error {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return store.ErrNoAccount
+	}
+	ur, err := ms.accountCollection().UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{"profile": profile}})
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrNoAccount
+	}
+	return nil
+}
+
+func (ms *mongodbStore) SetAvatar(ctx context.Context, uid, key string) (string, error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return "", store.ErrNoAccount
+	}
+	option := &options.FindOneAndUpdateOptions{
+		Projection: bson.M{"avatar": 1},
+	}
+	sr := ms.accountCollection().FindOneAndUpdate(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{"avatar": key}}, option)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoAccount
+		}
+		return "", err
+	}
+	var doc struct {
+		Avatar string `bson:"avatar"`
+	}
+	if err = sr.Decode(&doc); err != nil {
+		return "", err
+	}
+	return doc.Avatar, nil
+}
+
+var loginFailureProjection = bson.M{
+	"_id":           0,
+	"key":           1,
+	"failures":      1,
+	"lastFailureAt": 1,
+	"lockedUntil":   1,
+}
+
+func (ms *mongodbStore) GetLoginFailures(ctx context.Context, keys []string) (failures []store.LoginFailure, err error) {
+	option := &options.FindOptions{
+		Projection: loginFailureProjection,
+	}
+	cursor, err := ms.loginCollection().Find(ctx, bson.M{"key": bson.M{"$in": keys}}, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var failure store.LoginFailure
+		if err = cursor.Decode(&failure); err != nil {
+			return
+		}
+		failures = append(failures, failure)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) AddLoginFailure(ctx context.Context, key string, now, resetBefore, expireAt int64) (failure store.LoginFailure, err error) {
+	// forget failures too old first, a lock not expired is kept
+	filter := bson.M{
+		"key":           key,
+		"lastFailureAt": bson.M{"$lt": resetBefore},
+	}
+	_, err = ms.loginCollection().UpdateOne(ctx, filter, bson.M{"$set": bson.M{"failures": 0}})
+	if err != nil {
+		return
+	}
+
+	upsert := true
+	after := options.After
+	option := &options.FindOneAndUpdateOptions{
+		Projection:     loginFailureProjection,
+		ReturnDocument: &after,
+		Upsert:         &upsert,
+	}
+	update := bson.M{
+		"$inc":         bson.M{"failures": 1},
+		"$set":         bson.M{"lastFailureAt": now},
+		"$max":         bson.M{"expireAt": time.Unix(expireAt, 0)},
+		"$setOnInsert": bson.M{"lockedUntil": int64(0)},
+	}
+	sr := ms.loginCollection().FindOneAndUpdate(ctx, bson.M{"key": key}, update, option)
+	if err = sr.Err(); err != nil {
+		return
+	}
+	err = sr.Decode(&failure)
+	return
+}
+
+func (ms *mongodbStore) LockLogin(ctx context.Context, key string, until int64) error {
+	update := bson.M{
+		"$max": bson.M{
+			"lockedUntil": until,
+			"expireAt":    time.Unix(until, 0),
+		},
+	}
+	_, err := ms.loginCollection().UpdateOne(ctx, bson.M{"key": key}, update)
+	return err
+}
+
+func (ms *mongodbStore) ClearLoginFailures(ctx context.Context, key string) error {
+	_, err := ms.loginCollection().DeleteOne(ctx, bson.M{"key": key})
+	return err
+}
+
+func (ms *mongodbStore) AddAuditEvent(ctx context.Context, event store.AuditEvent, expireAt int64) error {
+	_, err := ms.auditCollection().InsertOne(ctx, bson.M{
+		"uid":       event.Uid,
+		"kind":      event.Kind,
+		"ip":        event.Ip,
+		"userAgent": event.UserAgent,
+		"createdAt": event.CreatedAt,
+		"expireAt":  time.Unix(expireAt, 0),
+	})
+	return err
+}
+
+func (ms *mongodbStore) GetAuditEvents(ctx context.Context, uid string, skip, limit int) (events []store.AuditEvent, err error) {
+	s, l := int64(skip), int64(limit)
+	option := &options.FindOptions{
+		Projection: bson.M{"_id": 0, "expireAt": 0},
+		// events of the same second are ordered by _id so that pages do not
+		// overlap
+		Sort:  bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+		Skip:  &s,
+		Limit: &l,
+	}
+	cursor, err := ms.auditCollection().Find(ctx, bson.M{"uid": uid}, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	events = make([]store.AuditEvent, 0, limit)
+	for cursor.Next(ctx) {
+		var event store.AuditEvent
+		if err = cursor.Decode(&event); err != nil {
+			return
+		}
+		events = append(events, event)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) GetTotp(ctx context.Context, uid string) (totp store.Totp, enabled bool, err error) {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		err = store.ErrNoAccount
+		return
+	}
+	option := &options.FindOneOptions{
+		Projection: bson.M{"totp": 1, "totpEnabled": 1},
+	}
+	sr := ms.accountCollection().FindOne(ctx, bson.M{"_id": oid}, option)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrNoAccount
+		}
+		return
+	}
+	var doc struct {
+		Totp        store.Totp `bson:"totp"`
+		TotpEnabled bool       `bson:"totpEnabled"`
+	}
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	return doc.Totp, doc.TotpEnabled, nil
+}
+
+// update account uid with two-factor authentication enabled or not,
+// notMatched is returned if it does not match filter
+func (ms *mongodbStore) updateTotp(ctx context.Context, uid string, enabled bool, filter, update bson.M, notMatched error) error {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return store.ErrNoAccount
+	}
+	filter["_id"] = oid
+	if enabled {
+		filter["totpEnabled"] = true
+	} else {
+		filter["totpEnabled"] = bson.M{"$ne": true}
+	}
+	ur, err := ms.accountCollection().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return notMatched
+	}
+	return nil
+}
+
+func (ms *mongodbStore) SetPendingTotp(ctx context.Context, uid string, secret []byte) error {
+	update := bson.M{"$set": bson.M{"totp.pendingSecret": secret}}
+	return ms.updateTotp(ctx, uid, false, bson.M{}, update, store.ErrTotpEnabled)
+}
+
+func (ms *mongodbStore) EnableTotp(ctx context.Context, uid string, secret []byte, step int64, recoveryCodes [][]byte) error {
+	update := bson.M{
+		"$set": bson.M{
+			"totp": bson.M{
+				"secret":        secret,
+				"lastStep":      step,
+				"recoveryCodes": recoveryCodes,
+			},
+			"totpEnabled": true,
+		},
+	}
+	return ms.updateTotp(ctx, uid, false, bson.M{"totp.pendingSecret": secret}, update, store.ErrNoTotp)
+}
+
+func (ms *mongodbStore) UseTotpStep(ctx context.Context, uid string, step int64) error {
+	filter := bson.M{"totp.lastStep": bson.M{"$lt": step}}
+	update := bson.M{"$set": bson.M{"totp.lastStep": step}}
+	return ms.updateTotp(ctx, uid, true, filter, update, store.ErrInvalidToken)
+}
+
+func (ms *mongodbStore) UseRecoveryCode(ctx context.Context, uid string, hash []byte) error {
+	filter := bson.M{"totp.recoveryCodes": hash}
+	update := bson.M{"$pull": bson.M{"totp.recoveryCodes": hash}}
+	return ms.updateTotp(ctx, uid, true, filter, update, store.ErrInvalidToken)
+}
+
+func (ms *mongodbStore) SetRecoveryCodes(ctx context.Context, uid string, recoveryCodes [][]byte) error {
+	update := bson.M{"$set": bson.M{"totp.recoveryCodes": recoveryCodes}}
+	return ms.updateTotp(ctx, uid, true, bson.M{}, update, store.ErrNoTotp)
+}
+
+func (ms *mongodbStore) DisableTotp(ctx context.Context, uid string) error {
+	update := bson.M{
+		"$unset": bson.M{"totp": ""},
+		"$set":   bson.M{"totpEnabled": false},
+	}
+	return ms.updateTotp(ctx, uid, true, bson.M{}, update, store.ErrNoTotp)
+}
+
+func (ms *mongodbStore) SetAdmins(ctx context.Context, names []string) error {
+	if names == nil {
+		names = []string{}
+	}
+	filter := bson.M{"role": store.RoleAdmin, "name": bson.M{"$nin": names}}
+	_, err := ms.accountCollection().UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"role": ""}})
+	if err != nil || len(names) == 0 {
+		return err
+	}
+	filter = bson.M{"name": bson.M{"$in": names}}
+	_, err = ms.accountCollection().UpdateMany(ctx, filter, bson.M{"$set": bson.M{"role": store.RoleAdmin}})
+	return err
+}
+
+func (ms *mongodbStore) ListAccounts(ctx context.Context, query string, skip, limit int) (accounts []store.AdminAccount, total int64, err error) {
+	filter := bson.M{}
+	if query != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(query), Options: "i"}
+		filter["$or"] = bson.A{bson.M{"name": pattern}, bson.M{"email": pattern}}
+	}
+	s, l := int64(skip), int64(limit)
+	option := &options.FindOptions{
+		Projection: accountProjection,
+		Sort:       bson.D{{Key: "_id", Value: -1}},
+		Skip:       &s,
+		Limit:      &l,
+	}
+	cursor, err := ms.accountCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	accounts = make([]store.AdminAccount, 0, limit)
+	for cursor.Next(ctx) {
+		var doc accountDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		accounts = append(accounts, store.AdminAccount{AccountInfo: doc.info(), Activated: doc.Activated})
+	}
+	if err = cursor.Err(); err != nil {
+		return
+	}
+	total, err = ms.accountCollection().CountDocuments(ctx, filter)
+	return
+}
+
+func (ms *mongodbStore) SetAccountDisabled(ctx context.Context, uid string, disabled bool) error {
+	oid, err := primitive.ObjectIDFromHex(uid)
+	if err != nil {
+		return store.ErrNoAccount
+	}
+	ur, err := ms.accountCollection().UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{"disabled": disabled}})
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrNoAccount
+	}
+	return nil
 }
@@ -11,6 +11,141 @@ type Store interface {
 	GetAccountId(ctx context.Context, name string) (string, error)
 	GetAccountInfoByName(ctx context.Context, name string) (info AccountInfo, err error)
 	GetAccountsBasicInfo(ctx context.Context, uids []string) ([]BasicInfo, error)
+	GetAccountsBasicInfoByNames(ctx context.Context, names []string) ([]BasicInfo, error)
+	// activate account id if code matches, the code can only be used once
+	ActivateAccount(ctx context.Context, id string, code []byte) (info AccountInfo, err error)
+	// replace activation code of the account of email which is not activated
+	// yet, ErrNoAccount is returned if there is no such account
+	ResetActivationCode(ctx context.Context, email string, code []byte) (info AccountInfo, err error)
+	// emails of activated accounts by id
+	GetAccountsEmail(ctx context.Context, uids []string) (map[string]string, error)
+	// save hash of a password reset token of the activated account of email,
+	// it replaces the previous one
+	SetPasswordReset(ctx context.Context, email string, token AccountToken) (info AccountInfo, err error)
+	// set password of the account with reset token hash which is not expired
+	// at now, the token is consumed and token version bumped
+	ResetPassword(ctx context.Context, hash []byte, password string, now int64) (info AccountInfo, err error)
+	// change password if oldPassword matches, token version is bumped
+	ChangePassword(ctx context.Context, uid, oldPassword, newPassword string) (info AccountInfo, err error)
+	// save email waiting for confirmation if password matches
+	SetPendingEmail(ctx context.Context, uid, password, email string, token AccountToken) (info AccountInfo, err error)
+	// change email to the pending one of token hash not expired at now, the
+	// old email is returned
+	ConfirmEmail(ctx context.Context, hash []byte, now int64) (info AccountInfo, oldEmail string, err error)
+	GetTokenVersion(ctx context.Context, uid string) (int64, error)
+	GetAccountInfo(ctx context.Context, uid string) (info AccountInfo, err error)
+	// info of the account of email and whether it is activated
+	GetAccountInfoByEmail(ctx context.Context, email string) (info AccountInfo, activated bool, err error)
+	// create an activated account without password for an external identity
+	CreateOAuthAccount(ctx context.Context, name, email string) (string, error)
+	// whether the account can login with password
+	HasPassword(ctx context.Context, uid string) (bool, error)
+
+	// ErrNoIdentity is returned if identity is not linked
+	GetIdentity(ctx context.Context, provider, subject string) (Identity, error)
+	// link an identity, ErrIdentityLinked is returned if it is linked already
+	// or the account has an identity of the provider
+	AddIdentity(ctx context.Context, identity Identity) error
+	GetIdentities(ctx context.Context, uid string) ([]Identity, error)
+	RemoveIdentity(ctx context.Context, uid, provider string) error
+
+	// ErrAccessTokenNameUsed is returned if the account has a token of the name
+	CreateAccessToken(ctx context.Context, token AccessToken) (string, error)
+	CountAccessTokens(ctx context.Context, uid string) (int64, error)
+	GetAccessTokens(ctx context.Context, uid string) ([]AccessToken, error)
+	RemoveAccessToken(ctx context.Context, uid, id string) error
+	// find token of hash and set its last used time to now, the time is
+	// updated at most once every minute. ErrNoAccessToken is returned if
+	// there is no such token
+	UseAccessToken(ctx context.Context, hash []byte, now int64) (AccessToken, error)
+
+	CreateSession(ctx context.Context, session Session) (string, error)
+	// rotate refresh token of session from hash to newHash and extend it to
+	// expireAt. A refresh token rotated within grace seconds finds its
+	// session without rotating it again, and rotated is false.
+	// ErrNoSession is returned if there is no such session or it expired
+	RefreshSession(ctx context.Context, hash, newHash []byte, meta SessionMeta, now, expireAt, grace int64) (session Session, rotated bool, err error)
+	// find session not expired and set its last seen time to now, the time
+	// is updated at most once every minute
+	UseSession(ctx context.Context, uid, id string, now int64) (Session, error)
+	GetSessions(ctx context.Context, uid string, now int64) ([]Session, error)
+	RemoveSession(ctx context.Context, uid, id string) error
+	// remove all sessions of account
+	RemoveSessions(ctx context.Context, uid string) error
+	RemoveExpiredSessions(ctx context.Context, uid string, now int64) error
+
+	// info and profile of the account of name, the profile of an
+	// organization is empty
+	GetProfile(ctx context.Context, name string) (info AccountInfo, profile Profile, err error)
+	UpdateProfile(ctx context.Context, uid string, profile Profile) error
+	// set blob key of the avatar of account, empty to remove it. The key
+	// replaced is returned
+	SetAvatar(ctx context.Context, uid, key string) (oldKey string, err error)
+
+	// failed logins of keys, keys without failures are missing
+	GetLoginFailures(ctx context.Context, keys []string) ([]LoginFailure, error)
+	// count a failed login of key at now, failures of key are forgotten if
+	// the last one is before resetBefore. The record expires at expireAt
+	AddLoginFailure(ctx context.Context, key string, now, resetBefore, expireAt int64) (LoginFailure, error)
+	// refuse logins of key until until
+	LockLogin(ctx context.Context, key string, until int64) error
+	ClearLoginFailures(ctx context.Context, key string) error
+
+	// the event expires at expireAt
+	AddAuditEvent(ctx context.Context, event AuditEvent, expireAt int64) error
+	// get audit events of uid newest first
+	GetAuditEvents(ctx context.Context, uid string, skip, limit int) ([]AuditEvent, error)
+
+	GetTotp(ctx context.Context, uid string) (totp Totp, enabled bool, err error)
+	// save secret waiting for confirmation, ErrTotpEnabled is returned if
+	// two-factor authentication is enabled already
+	SetPendingTotp(ctx context.Context, uid string, secret []byte) error
+	// enable the pending secret if it is secret, step of the code confirming
+	// it is used
+	EnableTotp(ctx context.Context, uid string, secret []byte, step int64, recoveryCodes [][]byte) error
+	// record use of step, ErrInvalidToken is returned if step or a later
+	// one was used already
+	UseTotpStep(ctx context.Context, uid string, step int64) error
+	// consume recovery code of hash, ErrInvalidToken is returned if there is
+	// no such code
+	UseRecoveryCode(ctx context.Context, uid string, hash []byte) error
+	SetRecoveryCodes(ctx context.Context, uid string, recoveryCodes [][]byte) error
+	DisableTotp(ctx context.Context, uid string) error
+
+	// grant the admin role to accounts of names, and revoke it from others
+	SetAdmins(ctx context.Context, names []string) error
+	// accounts whose name or email contains query, newest first. total is
+	// the number of all of them
+	ListAccounts(ctx context.Context, query string, skip, limit int) (accounts []AdminAccount, total int64, err error)
+	// disabled accounts can not login, their sessions and access tokens are
+	// refused
+	SetAccountDisabled(ctx context.Context, uid string, disabled bool) error
+
+	// create an organization of name with uid as its owner, ErrNameUsed is
+	// returned if an account or organization has the name
+	CreateOrganization(ctx context.Context, name, uid string) (string, error)
+	// ErrNoOrganization is returned if there is no such organization
+	GetOrganization(ctx context.Context, id string) (Organization, error)
+	// OrgRoleNone is returned if uid is not a member of org
+	GetOrganizationRole(ctx context.Context, org, uid string) (OrgRole, error)
+	GetOrganizationMembers(ctx context.Context, org string) ([]OrganizationMember, error)
+	// add a member or change role of a member
+	SetOrganizationMember(ctx context.Context, org, uid string, role OrgRole) error
+	// ErrNoMember is returned if uid is not a member of org
+	RemoveOrganizationMember(ctx context.Context, org, uid string) error
+	// organizations uid is a member of
+	GetUserOrganizations(ctx context.Context, uid string) ([]OrganizationMember, error)
+
+	// ErrWrongPassword is returned if password does not match
+	CheckPassword(ctx context.Context, uid, password string) error
+	// delete the account at deleteAt
+	ScheduleDeletion(ctx context.Context, uid string, deleteAt int64, deleteAnnotations bool) error
+	CancelDeletion(ctx context.Context, uid string) error
+	// accounts whose deletion is due at now
+	GetDueDeletions(ctx context.Context, now int64, limit int) ([]AccountInfo, error)
+	// delete the account along with its identities, access tokens,
+	// sessions, audit events and memberships of organizations
+	DeleteAccount(ctx context.Context, uid string) error
 }
 
 var (
@@ -19,15 +154,168 @@ var (
 	ErrNoMatch         = errors.New("name or password not correct")
 	ErrNoAccount       = errors.New("account not exist")
 	ErrNotActivate     = errors.New("account not activated")
+	ErrInvalidCode     = errors.New("activation code invalid")
+	ErrInvalidToken    = errors.New("token invalid or expired")
+	ErrWrongPassword   = errors.New("password not correct")
+	ErrNoIdentity      = errors.New("identity not linked")
+	ErrIdentityLinked  = errors.New("identity already linked")
+
+	ErrNoAccessToken       = errors.New("access token not exist")
+	ErrAccessTokenNameUsed = errors.New("access token name already used")
+
+	ErrNoSession = errors.New("session not exist")
+
+	ErrNoTotp      = errors.New("two-factor authentication not enabled")
+	ErrTotpEnabled = errors.New("two-factor authentication already enabled")
+
+	ErrNoOrganization = errors.New("organization not exist")
+	ErrNoMember       = errors.New("not a member of organization")
 )
 
+// RoleAdmin is the role of accounts administrating the site, accounts
+// without a role are users
+const RoleAdmin = "admin"
+
 type AccountInfo struct {
-	Id        string `json:"id"`
-	Name      string `json:"name" bson:"name"`
-	CreatedAt int64  `json:"createdAt" bson:"createdAt"`
+	Id           string `json:"id"`
+	Name         string `json:"name" bson:"name"`
+	Email        string `json:"email" bson:"email"`
+	CreatedAt    int64  `json:"createdAt" bson:"createdAt"`
+	TokenVersion int64  `json:"tokenVersion" bson:"tokenVersion"`
+	// blob key of the avatar uploaded, empty if there is none
+	Avatar string `json:"avatar" bson:"avatar"`
+	// logins need a two-factor code
+	TotpEnabled bool   `json:"totpEnabled" bson:"totpEnabled"`
+	Role        string `json:"role" bson:"role"`
+	Disabled    bool   `json:"disabled" bson:"disabled"`
+	// the account is deleted at DeleteAt unless the deletion is cancelled, 0
+	// if it is not scheduled
+	DeleteAt int64 `json:"deleteAt" bson:"deleteAt"`
+	// annotations are deleted along with the account instead of anonymized
+	DeleteAnnotations bool `json:"deleteAnnotations" bson:"deleteAnnotations"`
+	// names of accounts and organizations are in one namespace, lookups by
+	// name may find an organization
+	Organization bool `json:"organization" bson:"-"`
+}
+
+// AdminAccount is an account as listed to admins
+type AdminAccount struct {
+	AccountInfo
+	Activated bool
+}
+
+// Profile is what users tell about themselves
+type Profile struct {
+	DisplayName string   `bson:"displayName"`
+	Bio         string   `bson:"bio"`
+	Links       []string `bson:"links"`
+}
+
+// AccountToken is a single use token mailed to users, only hash of it is
+// stored. Email is the address waiting for confirmation of email tokens.
+type AccountToken struct {
+	Hash     []byte `bson:"hash"`
+	Email    string `bson:"email,omitempty"`
+	ExpireAt int64  `bson:"expireAt"`
 }
 
 type BasicInfo struct {
-	Id   string
-	Name string
+	Id     string
+	Name   string
+	Email  string
+	Avatar string
+}
+
+// Identity is an account of an external provider linked to an account
+type Identity struct {
+	Uid       string `bson:"uid"`
+	Provider  string `bson:"provider"`
+	Subject   string `bson:"subject"`
+	Email     string `bson:"email"`
+	Login     string `bson:"login"`
+	CreatedAt int64  `bson:"createdAt"`
+}
+
+// AccessToken is a personal access token, only hash of it is stored
+type AccessToken struct {
+	Id         string   `bson:"-"`
+	Uid        string   `bson:"uid"`
+	Name       string   `bson:"name"`
+	Hash       []byte   `bson:"hash"`
+	Prefix     string   `bson:"prefix"`
+	Scopes     []string `bson:"scopes"`
+	CreatedAt  int64    `bson:"createdAt"`
+	LastUsedAt int64    `bson:"lastUsedAt"`
+}
+
+// SessionMeta is the device of a session
+type SessionMeta struct {
+	UserAgent string `bson:"userAgent"`
+	Ip        string `bson:"ip"`
+}
+
+// Session is a login of a device, only hash of its refresh token is stored
+type Session struct {
+	Id          string `bson:"-"`
+	Uid         string `bson:"uid"`
+	RefreshHash []byte `bson:"refreshHash"`
+	SessionMeta `bson:",inline"`
+	CreatedAt   int64 `bson:"createdAt"`
+	LastSeenAt  int64 `bson:"lastSeenAt"`
+	ExpireAt    int64 `bson:"expireAt"`
+}
+
+// LoginFailure counts failed logins of an account or address
+type LoginFailure struct {
+	Key           string `bson:"key"`
+	Failures      int    `bson:"failures"`
+	LastFailureAt int64  `bson:"lastFailureAt"`
+	LockedUntil   int64  `bson:"lockedUntil"`
+}
+
+// AuditEvent is a security event of an account
+type AuditEvent struct {
+	Uid       string `bson:"uid"`
+	Kind      string `bson:"kind"`
+	Ip        string `bson:"ip"`
+	UserAgent string `bson:"userAgent"`
+	CreatedAt int64  `bson:"createdAt"`
+}
+
+// Totp is the two-factor authentication of an account, only hashes of
+// recovery codes are stored
+type Totp struct {
+	Secret        []byte   `bson:"secret"`
+	PendingSecret []byte   `bson:"pendingSecret"`
+	LastStep      int64    `bson:"lastStep"`
+	RecoveryCodes [][]byte `bson:"recoveryCodes"`
+}
+
+// Organization is an account shared by a team, projects it owns are shared
+// with its members. It can not login
+type Organization struct {
+	Id        string `bson:"-"`
+	Name      string `bson:"name"`
+	CreatedBy string `bson:"createdBy"`
+	CreatedAt int64  `bson:"createdAt"`
+}
+
+// OrgRole is the role of a member of an organization, members have the same role in all
+// projects of the organization. The values are those of project roles
+type OrgRole int
+
+const (
+	OrgRoleNone OrgRole = iota
+	OrgRoleViewer
+	OrgRoleAnnotator
+	OrgRoleMaintainer
+	OrgRoleOwner
+)
+
+// OrganizationMember is an account in an organization
+type OrganizationMember struct {
+	Org       string  `bson:"org"`
+	Uid       string  `bson:"uid"`
+	Role      OrgRole `bson:"role"`
+	CreatedAt int64   `bson:"createdAt"`
 }
@@ -0,0 +1,86 @@
+// Package totp implements time based one time passwords of RFC 6238 as
+// used by authenticator apps: HMAC-SHA1, 6 digits and 30 second steps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+const (
+	Digits = 6
+	Period = 30
+	// size of secrets in bytes, as recommended by RFC 4226
+	SecretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random secret
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, SecretSize)
+	_, err := rand.Read(secret)
+	return secret, err
+}
+
+// EncodeSecret encodes secret in base32 for users to type into apps
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// Step returns the time step of t
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// Code returns the password of secret at step
+func Code(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	_, _ = mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation of RFC 4226
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+// Validate checks code against passwords of secret of the steps within skew
+// of t, the step matched is returned. Callers should refuse steps used
+// before to prevent replays.
+func Validate(secret []byte, code string, t time.Time, skew int) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+	step := Step(t)
+	for i := -skew; i <= skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(Code(secret, step+int64(i))), []byte(code)) == 1 {
+			return step + int64(i), true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningUri returns the otpauth uri of secret, which apps scan from a
+// QR code
+func ProvisioningUri(issuer, account string, secret []byte) string {
+	query := neturl.Values{}
+	query.Set("secret", EncodeSecret(secret))
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(Period))
+	label := neturl.PathEscape(issuer) + ":" + neturl.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
@@ -0,0 +1,56 @@
+package totp
+
+import (
+	"github.com/stretchr/testify/require"
+	neturl "net/url"
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	// SHA1 vectors of RFC 6238, truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	cases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, code := range cases {
+		require.Equal(t, code, Code(secret, Step(time.Unix(unix, 0))), unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(59, 0)
+
+	step, ok := Validate(secret, "287082", now, 1)
+	require.True(t, ok)
+	require.Equal(t, int64(1), step)
+
+	// the code of the previous step is accepted within skew
+	step, ok = Validate(secret, "287082", now.Add(Period*time.Second), 1)
+	require.True(t, ok)
+	require.Equal(t, int64(1), step)
+
+	_, ok = Validate(secret, "287082", now.Add(2*Period*time.Second), 1)
+	require.False(t, ok)
+	_, ok = Validate(secret, "28708", now, 1)
+	require.False(t, ok)
+	_, ok = Validate(secret, "000000", now, 1)
+	require.False(t, ok)
+}
+
+func TestProvisioningUri(t *testing.T) {
+	uri := ProvisioningUri("rfschub", "foo bar", []byte("12345678901234567890"))
+	u, err := neturl.Parse(uri)
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, "/rfschub:foo bar", u.Path)
+	require.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	require.Equal(t, "rfschub", u.Query().Get("issuer"))
+}
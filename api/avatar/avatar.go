@@ -0,0 +1,127 @@
+// Package avatar turns images uploaded by users into square avatars of a
+// fixed size
+package avatar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	// width and height of avatars
+	Size = 256
+	// images uploaded larger than this are rejected before decoding
+	MaxDimension = 4096
+)
+
+var (
+	ErrUnsupported = errors.New("image format not supported")
+	ErrTooLarge    = errors.New("image too large")
+)
+
+// Process decodes a png, jpeg or gif image of r, crops the center square of
+// it and scales it to Size. The avatar is encoded as png.
+func Process(r io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// check dimensions before allocating the image
+	conf, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+	if conf.Width <= 0 || conf.Height <= 0 {
+		return nil, ErrUnsupported
+	}
+	if conf.Width > MaxDimension || conf.Height > MaxDimension {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, Resize(Crop(img), Size)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Key is the blob key of avatar of uid. Keys change with the content, so
+// avatars can be cached forever, and users uploading the same image do
+// not share a blob.
+func Key(uid string, avatar []byte) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, uid)
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write(avatar)
+	return hex.EncodeToString(h.Sum(nil)) + ".png"
+}
+
+// Crop returns the largest square of the center of img
+func Crop(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	min := image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2)
+	square := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(square, square.Bounds(), img, min, draw.Src)
+	return square
+}
+
+// Resize scales the square img to size x size. Each pixel is the average
+// of the pixels it covers, which is nearest neighbour when enlarging.
+func Resize(img *image.RGBA, size int) *image.RGBA {
+	side := img.Bounds().Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	if side == 0 {
+		return dst
+	}
+	for y := 0; y < size; y++ {
+		y0, y1 := span(y, side, size)
+		for x := 0; x < size; x++ {
+			x0, x1 := span(x, side, size)
+			// colors are premultiplied, averaging them weights by alpha
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := img.Pix[sy*img.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					sum[0] += int(p[0])
+					sum[1] += int(p[1])
+					sum[2] += int(p[2])
+					sum[3] += int(p[3])
+				}
+			}
+			n := (y1 - y0) * (x1 - x0)
+			d := dst.Pix[y*dst.Stride+x*4:]
+			for i := 0; i < 4; i++ {
+				d[i] = uint8((sum[i] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
+
+// source pixels [start, end) covered by pixel i of size scaled from side
+func span(i, side, size int) (start, end int) {
+	start = i * side / size
+	end = (i + 1) * side / size
+	if end <= start {
+		end = start + 1
+	}
+	return
+}
@@ -0,0 +1,78 @@
+package avatar
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestCrop(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 30, 10))
+	// the center square is red, the rest blue
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 30; x++ {
+			if x >= 10 && x < 20 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+	square := Crop(img)
+	require.Equal(t, image.Rect(0, 0, 10, 10), square.Bounds())
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 10; x++ {
+			require.Equal(t, color.RGBA{R: 255, A: 255}, square.RGBAAt(x, y))
+		}
+	}
+}
+
+func TestResize(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if (x+y)%2 == 0 {
+				img.Set(x, y, color.RGBA{R: 200, G: 100, B: 0, A: 255})
+			}
+		}
+	}
+	// pixels of a checkerboard average half transparent
+	small := Resize(img, 2)
+	require.Equal(t, image.Rect(0, 0, 2, 2), small.Bounds())
+	require.Equal(t, color.RGBA{R: 100, G: 50, B: 0, A: 128}, small.RGBAAt(1, 1))
+
+	large := Resize(img, 8)
+	require.Equal(t, img.RGBAAt(0, 0), large.RGBAAt(1, 1))
+	require.Equal(t, img.RGBAAt(1, 0), large.RGBAAt(2, 0))
+	require.Equal(t, img.RGBAAt(3, 3), large.RGBAAt(7, 7))
+}
+
+func TestProcess(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 600, 300)), nil))
+	data, err := Process(&buf)
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, Size, Size), img.Bounds())
+
+	_, err = Process(strings.NewReader("not an image"))
+	require.Equal(t, ErrUnsupported, err)
+
+	buf.Reset()
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, MaxDimension+1, 1))))
+	_, err = Process(&buf)
+	require.Equal(t, ErrTooLarge, err)
+}
+
+func TestKey(t *testing.T) {
+	data := []byte("avatar")
+	require.Equal(t, Key("a", data), Key("a", data))
+	require.NotEqual(t, Key("a", data), Key("b", data))
+	require.True(t, strings.HasSuffix(Key("a", data), ".png"))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/lt90s/rfschub-server/gits/proto"
 	indexClient "github.com/lt90s/rfschub-server/index/client"
 	"github.com/lt90s/rfschub-server/index/proto"
+	notificationClient "github.com/lt90s/rfschub-server/notification/client"
+	"github.com/lt90s/rfschub-server/notification/proto"
 	projectClient "github.com/lt90s/rfschub-server/project/client"
 	"github.com/lt90s/rfschub-server/project/proto"
 	repoClient "github.com/lt90s/rfschub-server/repository/client"
@@ -15,21 +17,23 @@ import (
 )
 
 type Client struct {
-	GitClient     gits.GitsService
-	RepoClient    repository.RepositoryService
-	AccountClient account.AccountService
-	ProjectClient project.ProjectService
-	IndexClient   index.IndexService
+	GitClient          gits.GitsService
+	RepoClient         repository.RepositoryService
+	AccountClient      account.AccountService
+	ProjectClient      project.ProjectService
+	IndexClient        index.IndexService
+	NotificationClient notification.NotificationService
 }
 
 var DefaultClient = NewClient(config.DefaultConfig.Client)
 
 func NewClient(conf config.ClientConfig) *Client {
 	return &Client{
-		GitClient:     gitsClient.New(conf.Services.Git),
-		RepoClient:    repoClient.New(conf.Services.Repository),
-		AccountClient: accountClient.New(conf.Services.Account),
-		ProjectClient: projectClient.New(conf.Services.Project),
-		IndexClient:   indexClient.New(conf.Services.Index),
+		GitClient:          gitsClient.New(conf.Services.Git),
+		RepoClient:         repoClient.New(conf.Services.Repository),
+		AccountClient:      accountClient.New(conf.Services.Account),
+		ProjectClient:      projectClient.New(conf.Services.Project),
+		IndexClient:        indexClient.New(conf.Services.Index),
+		NotificationClient: notificationClient.New(conf.Services.Notification),
 	}
 }
@@ -9,6 +9,8 @@ import (
 func main() {
 	logrus.SetLevel(logrus.DebugLevel)
 	router := gin.Default()
+	// X-Forwarded-For is read from trusted proxies only, see middlewares.ClientIP
+	router.ForwardedByClientIP = false
 
 	route.SetupRouter(router)
 
@@ -2,8 +2,11 @@ package config
 
 import (
 	accountClient "github.com/lt90s/rfschub-server/account/client"
+	"github.com/lt90s/rfschub-server/api/oauth"
+	"github.com/lt90s/rfschub-server/api/signing"
 	gitsClient "github.com/lt90s/rfschub-server/gits/client"
 	indexClient "github.com/lt90s/rfschub-server/index/client"
+	notificationClient "github.com/lt90s/rfschub-server/notification/client"
 	projectClient "github.com/lt90s/rfschub-server/project/client"
 	repoClient "github.com/lt90s/rfschub-server/repository/client"
 	"github.com/micro/go-config"
@@ -12,9 +15,23 @@ import (
 	"os"
 )
 
+const (
+	ModeDevelopment = "development"
+	// the api refuses to start with mock oauth providers or the default jwt
+	// key in production
+	ModeProduction = "production"
+)
+
 type ApiConfig struct {
+	Mode   string       `json:"mode"`
 	Client ClientConfig `json:"client"`
 	Jwt    JwtConfig    `json:"jwt"`
+	OAuth  OAuthConfig  `json:"oauth"`
+	Blob   BlobConfig   `json:"blob"`
+	// addresses or CIDRs of reverse proxies in front of the api. The client
+	// address is read from X-Forwarded-For of requests from them only,
+	// otherwise it is the address of the connection
+	TrustedProxies []string `json:"trustedProxies"`
 }
 
 type ClientConfig struct {
@@ -23,15 +40,42 @@ type ClientConfig struct {
 
 type JwtConfig struct {
 	Realm string `json:"realm"`
-	Key   []byte `json:"key"`
+	// HS256 secret used if there are no keys
+	Key []byte `json:"key"`
+	// lifetime of jwt tokens, like "15m". sessions refresh them with the
+	// refresh token cookie
+	Timeout string `json:"timeout"`
+	// the latest active key signs tokens, and keys replaced verify tokens
+	// until they expire
+	Keys []signing.KeyConfig `json:"keys"`
+}
+
+type OAuthConfig struct {
+	// url the api is served at, callback urls of providers are
+	// {baseUrl}/account/oauth/{name}/callback
+	BaseUrl string `json:"baseUrl"`
+	// where users are redirected after login, {error} in FailureUrl is
+	// replaced with the reason
+	SuccessUrl string `json:"successUrl"`
+	FailureUrl string `json:"failureUrl"`
+	// where users with two-factor authentication are redirected to enter
+	// their code
+	TotpUrl   string                 `json:"totpUrl"`
+	Providers []oauth.ProviderConfig `json:"providers"`
+}
+
+type BlobConfig struct {
+	// directory files uploaded by users, like avatars, are saved in
+	Dir string `json:"dir"`
 }
 
 type ServiceConfig struct {
-	Git        gitsClient.ServerConfig    `json:"git"`
-	Repository repoClient.ServerConfig    `json:"repository"`
-	Account    accountClient.ServerConfig `json:"account"`
-	Project    projectClient.ServerConfig `json:"project"`
-	Index      indexClient.ServerConfig   `json:"index"`
+	Git          gitsClient.ServerConfig         `json:"git"`
+	Repository   repoClient.ServerConfig         `json:"repository"`
+	Account      accountClient.ServerConfig      `json:"account"`
+	Project      projectClient.ServerConfig      `json:"project"`
+	Index        indexClient.ServerConfig        `json:"index"`
+	Notification notificationClient.ServerConfig `json:"notification"`
 }
 
 var (
@@ -39,6 +83,7 @@ var (
 )
 
 var DefaultConfig = ApiConfig{
+	Mode: ModeDevelopment,
 	Client: ClientConfig{
 		Services: ServiceConfig{
 			Git: gitsClient.ServerConfig{
@@ -56,11 +101,24 @@ var DefaultConfig = ApiConfig{
 			Index: indexClient.ServerConfig{
 				ServiceName: "IndexService",
 			},
+			Notification: notificationClient.ServerConfig{
+				ServiceName: "NotificationService",
+			},
 		},
 	},
 	Jwt: JwtConfig{
-		Realm: "rfschub.com",
-		Key:   DefaultJwtKey,
+		Realm:   "rfschub.com",
+		Key:     DefaultJwtKey,
+		Timeout: "15m",
+	},
+	OAuth: OAuthConfig{
+		BaseUrl:    "http://127.0.0.1:8888",
+		SuccessUrl: "http://127.0.0.1:8080/",
+		FailureUrl: "http://127.0.0.1:8080/login?error={error}",
+		TotpUrl:    "http://127.0.0.1:8080/login/totp",
+	},
+	Blob: BlobConfig{
+		Dir: "data/blobs",
 	},
 }
 
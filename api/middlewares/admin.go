@@ -0,0 +1,45 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+const (
+	// RoleAdmin is the role of accounts administrating the site
+	RoleAdmin = "admin"
+
+	roleKey = "accountRole"
+)
+
+// AccountMatchResult is the account authenticated and its role
+type AccountMatchResult struct {
+	Id   string
+	Role string
+}
+
+// GetAccount returns the account authenticated, must be logged in to use
+// this
+func GetAccount(c *gin.Context) AccountMatchResult {
+	return AccountMatchResult{
+		Id:   GetUserId(c),
+		Role: c.GetString(roleKey),
+	}
+}
+
+// RequireAdmin rejects accounts without the admin role, it must be used
+// after AuthMiddleware. The role is checked with the session on every
+// request, so that revoking it takes effect at once.
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(roleKey) != RoleAdmin {
+			c.Abort()
+			c.JSON(http.StatusForbidden, gin.H{
+				"code":    http.StatusForbidden,
+				"message": "admin role required",
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,32 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	router := gin.New()
+	router.GET("/admin", func(c *gin.Context) {
+		c.Set("userID", "uid")
+		if role := c.Query("role"); role != "" {
+			c.Set(roleKey, role)
+		}
+		c.Next()
+	}, RequireAdmin(), func(c *gin.Context) {
+		c.String(http.StatusOK, GetAccount(c).Id+":"+GetAccount(c).Role)
+	})
+
+	for role, code := range map[string]int{"": http.StatusForbidden, "user": http.StatusForbidden, RoleAdmin: http.StatusOK} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin?role="+role, nil))
+		require.Equal(t, code, w.Code, role)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin?role=admin", nil))
+	require.Equal(t, "uid:admin", w.Body.String())
+}
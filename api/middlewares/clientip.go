@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/api/config"
+	log "github.com/sirupsen/logrus"
+	"net"
+	"strings"
+)
+
+var (
+	// proxies X-Forwarded-For is read from
+	trustedProxies = newTrustedProxies(config.DefaultConfig.TrustedProxies)
+)
+
+func newTrustedProxies(proxies []string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				log.Panicf("invalid trusted proxy: %s", proxy)
+			}
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			log.Panicf("invalid trusted proxy: %s", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+func isTrusted(ip net.IP, trusted []*net.IPNet) bool {
+	for _, ipNet := range trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// address of the client connecting from remoteAddr. Addresses appended to
+// X-Forwarded-For by trusted proxies are followed from the right, the first
+// one not trusted is the client. Clients can forge the header, so it is not
+// read unless the connection is from a trusted proxy
+func clientIP(remoteAddr, forwardedFor string, trusted []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(strings.TrimSpace(remoteAddr))
+	if err != nil {
+		return ""
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isTrusted(ip, trusted) || forwardedFor == "" {
+		return host
+	}
+
+	forwarded := strings.Split(forwardedFor, ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if addr == nil {
+			break
+		}
+		ip = addr
+		if !isTrusted(ip, trusted) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+// ClientIP is the address of the client of request, use it instead of
+// gin's ClientIP which trusts X-Forwarded-For of any request
+func ClientIP(c *gin.Context) string {
+	return clientIP(c.Request.RemoteAddr, c.GetHeader("X-Forwarded-For"), trustedProxies)
+}
@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	trusted := newTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1", "::1"})
+
+	// X-Forwarded-For of clients connecting directly is ignored
+	require.Equal(t, "1.2.3.4", clientIP("1.2.3.4:5678", "", trusted))
+	require.Equal(t, "1.2.3.4", clientIP("1.2.3.4:5678", "5.6.7.8", trusted))
+	require.Equal(t, "1.2.3.4", clientIP("1.2.3.4:5678", "5.6.7.8", nil))
+
+	// addresses appended by trusted proxies are followed
+	require.Equal(t, "5.6.7.8", clientIP("192.168.1.1:80", "5.6.7.8", trusted))
+	require.Equal(t, "5.6.7.8", clientIP("10.1.2.3:80", "9.9.9.9, 5.6.7.8, 10.0.0.2", trusted))
+	require.Equal(t, "5.6.7.8", clientIP("[::1]:80", "5.6.7.8", trusted))
+	require.Equal(t, "10.0.0.3", clientIP("10.1.2.3:80", "10.0.0.3, 10.0.0.2", trusted))
+	require.Equal(t, "192.168.1.1", clientIP("192.168.1.1:80", "", trusted))
+	require.Equal(t, "10.0.0.2", clientIP("10.1.2.3:80", "garbage, 10.0.0.2", trusted))
+
+	require.Equal(t, "", clientIP("garbage", "", trusted))
+}
+
+func TestNewTrustedProxies(t *testing.T) {
+	require.Panics(t, func() { newTrustedProxies([]string{"10.0.0.0/33"}) })
+	require.Panics(t, func() { newTrustedProxies([]string{"localhost"}) })
+}
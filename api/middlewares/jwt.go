@@ -1,23 +1,40 @@
 package middlewares
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/appleboy/gin-jwt"
 	"github.com/gin-gonic/gin"
 	"github.com/lt90s/rfschub-server/account/proto"
 	"github.com/lt90s/rfschub-server/api/config"
+	"github.com/lt90s/rfschub-server/api/signing"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
 	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"net/http"
 	"time"
 )
 
 const (
 	identityKey = "ID"
 	cookieKey   = "JWTToken"
+	// cookies are sent over https only
+	SecureCookie = true
+	// claim of account token version, tokens issued before password changes
+	// have an older version
+	versionKey = "ver"
+	// set when a token is rejected for its version
+	staleTokenKey = "staleToken"
+	// claim of session id
+	sessionKey = "sid"
+
+	defaultJwtTimeout = 15 * time.Minute
 )
 
 var (
-	JWTMiddleware = NewJwtMiddleware()
+	// keys signing jwt tokens
+	keySet = newKeySet()
 )
 
 type loginRequest struct {
@@ -26,71 +43,209 @@ type loginRequest struct {
 	Password string `json:"password"`
 }
 
-type AccountMatchResult struct {
-	Id   string
-	Role string
+func newKeySet() *signing.KeySet {
+	keys, err := loadKeys(config.DefaultConfig.Jwt, config.DefaultConfig.Mode)
+	if err != nil {
+		log.Panicf("load jwt keys failed: err=%v", err)
+	}
+	set, err := signing.NewKeySet(keys, jwtTimeout())
+	if err != nil {
+		log.Panicf("create jwt key set failed: err=%v", err)
+	}
+	return set
 }
 
-func NewJwtMiddleware() *jwt.GinJWTMiddleware {
-	authenticator := func(c *gin.Context) (interface{}, error) {
-		var request loginRequest
-		if err := c.ShouldBindJSON(&request); err != nil {
-			return nil, jwt.ErrMissingLoginValues
-		}
+// keys of conf, the single key of older configs is checked like HS256 keys of
+// conf.Keys
+func loadKeys(conf config.JwtConfig, mode string) ([]*signing.Key, error) {
+	confs := conf.Keys
+	if len(confs) == 0 {
+		// tokens of the key are signed with kid "default"
+		confs = []signing.KeyConfig{{
+			Id:        "default",
+			Algorithm: signing.AlgorithmHS256,
+			Secret:    string(conf.Key),
+		}}
+	}
+	keys, err := signing.LoadKeys(confs)
+	if err != nil {
+		return nil, err
+	}
 
-		if (request.Name == "" && request.Email == "") || request.Password == "" {
-			return nil, jwt.ErrMissingLoginValues
+	if mode == config.ModeProduction {
+		for _, key := range keys {
+			if secret, ok := key.VerifyKey.([]byte); ok && bytes.Equal(secret, config.DefaultJwtKey) {
+				return nil, fmt.Errorf("the default jwt key is refused in production mode, configure jwt.keys")
+			}
 		}
+	}
+	return keys, nil
+}
 
-		req := account.LoginRequest{
-			Name:     request.Name,
-			Email:    request.Email,
-			Password: request.Password,
-		}
+func authenticate(c *gin.Context) (*sessionData, error) {
+	var request loginRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		return nil, jwt.ErrMissingLoginValues
+	}
+
+	if (request.Name == "" && request.Email == "") || request.Password == "" {
+		return nil, jwt.ErrMissingLoginValues
+	}
+
+	req := account.LoginRequest{
+		Name:      request.Name,
+		Email:     request.Email,
+		Password:  request.Password,
+		Ip:        ClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+	}
 
-		client := GetClient(c)
-		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-		defer cancel()
-		rsp, err := client.AccountClient.Login(ctx, &req)
-		if err != nil {
-			return nil, jwt.ErrFailedAuthentication
+	client := GetClient(c)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rsp, err := client.AccountClient.Login(ctx, &req)
+	if err != nil {
+		// users locked out are told when to retry
+		if e := errors.FromError(err); e.Code == int(account.ErrorCode_ErrorLoginThrottled) {
+			return nil, e
+		}
+		return nil, jwt.ErrFailedAuthentication
+	}
+	if rsp.Info.TotpEnabled {
+		if err = StartTotpChallenge(c, rsp.Info); err != nil {
+			return nil, err
 		}
-		fmt.Println(rsp.Info)
-		return rsp.Info, nil
-	}
-
-	middleware := &jwt.GinJWTMiddleware{
-		Realm:             config.DefaultConfig.Jwt.Realm,
-		SigningAlgorithm:  "HS256",
-		Key:               config.DefaultConfig.Jwt.Key,
-		Timeout:           7 * 24 * time.Hour,
-		IdentityHandler:   identityHandler,
-		Authenticator:     authenticator,
-		PayloadFunc:       payloadFunc,
-		SendCookie:        true,
-		SecureCookie:      true,
-		SendAuthorization: false,
-		TokenLookup:       "cookie:" + cookieKey,
-	}
-	if err := middleware.MiddlewareInit(); err != nil {
-		panic(err)
-	}
-	return middleware
+		return nil, errors.NewUnauthorizedError(int(account.ErrorCode_ErrorTotpRequired), "two-factor code required")
+	}
+	sid, err := createSession(c, rsp.Info)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionData{info: rsp.Info, sid: sid}, nil
+}
+
+// LoginHandler logs in with name or email and password, and starts a
+// session. Accounts with two-factor authentication get a challenge cookie
+// and ErrorTotpRequired instead, see TotpLoginHandler.
+func LoginHandler(c *gin.Context) {
+	data, err := authenticate(c)
+	if e, ok := err.(errors.Error); ok {
+		c.AbortWithStatusJSON(e.HttpCode, gin.H{
+			"code":    e.Code,
+			"message": e.Message,
+		})
+		return
+	}
+	if err != nil {
+		c.Header("WWW-Authenticate", "JWT realm="+config.DefaultConfig.Jwt.Realm)
+		c.Abort()
+		unauthorized(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	loginResponse(c, data)
+}
+
+// set the jwt cookie of a session logged in and return the token
+func loginResponse(c *gin.Context, data *sessionData) {
+	token, expire, err := setTokenCookie(c, data)
+	if err != nil {
+		unauthorized(c, http.StatusUnauthorized, jwt.ErrFailedTokenCreation.Error())
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"code":   http.StatusOK,
+		"token":  token,
+		"expire": expire.Format(time.RFC3339),
+	})
+}
+
+// generate a token of session data signed by the key active
+func generateToken(data *sessionData) (string, time.Time, error) {
+	now := time.Now()
+	expire := now.Add(jwtTimeout())
+	claims := jwtGo.MapClaims(payloadFunc(data))
+	claims["exp"] = expire.Unix()
+	claims["orig_iat"] = now.Unix()
+	token, err := keySet.Sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expire, nil
+}
+
+// authenticate users with the jwt cookie, tokens of sessions revoked, or
+// issued before the account changed password are rejected
+func cookieAuth(c *gin.Context) {
+	claims, _ := cookieClaims(c)
+	if claims == nil {
+		c.Header("WWW-Authenticate", "JWT realm="+config.DefaultConfig.Jwt.Realm)
+		c.Abort()
+		unauthorized(c, http.StatusUnauthorized, "token invalid or expired")
+		return
+	}
+
+	uid, _ := claims["id"].(string)
+	c.Set("JWT_PAYLOAD", jwt.MapClaims(claims))
+	c.Set("userID", uid)
+	if !tokenValid(c, uid, claims) {
+		c.Set(staleTokenKey, true)
+		c.Abort()
+		unauthorized(c, http.StatusForbidden, jwt.ErrForbidden.Error())
+		return
+	}
+	c.Next()
+}
+
+func jwtTimeout() time.Duration {
+	timeout, err := time.ParseDuration(config.DefaultConfig.Jwt.Timeout)
+	if err != nil || timeout <= 0 {
+		return defaultJwtTimeout
+	}
+	return timeout
 }
 
 func payloadFunc(data interface{}) jwt.MapClaims {
-	if info, ok := data.(*account.AccountInfo); ok {
+	if data, ok := data.(*sessionData); ok {
 		return jwt.MapClaims{
-			"id":        info.Id,
-			"name":      info.Name,
-			"createdAt": info.CreatedAt,
+			"id":        data.info.Id,
+			"name":      data.info.Name,
+			"createdAt": data.info.CreatedAt,
+			versionKey:  data.info.TokenVersion,
+			sessionKey:  data.sid,
 		}
 	}
 	return jwt.MapClaims{}
 }
 
-func identityHandler(claims jwtGo.MapClaims) interface{} {
-	return claims["id"]
+func tokenValid(c *gin.Context, uid string, claims jwtGo.MapClaims) bool {
+	sid, _ := claims[sessionKey].(string)
+	if uid == "" || sid == "" {
+		return false
+	}
+	// challenge tokens are not logins
+	if _, ok := claims[purposeKey]; ok {
+		return false
+	}
+	version, _ := claims[versionKey].(float64)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rsp, err := GetClient(c).AccountClient.CheckSession(ctx, &account.CheckSessionRequest{Uid: uid, Id: sid})
+	if err != nil || int64(version) != rsp.TokenVersion {
+		return false
+	}
+	c.Set(roleKey, rsp.Role)
+	return true
+}
+
+func unauthorized(c *gin.Context, code int, message string) {
+	if c.GetBool(staleTokenKey) {
+		code, message = http.StatusUnauthorized, "token expired, please login again"
+	}
+	c.JSON(code, gin.H{
+		"code":    code,
+		"message": message,
+	})
 }
 
 // must be logined to use this
@@ -102,29 +257,30 @@ func GetUserId(c *gin.Context) string {
 	return uid.(string)
 }
 
+// user id of the access token or jwt cookie of an optional login, empty if
+// the user is not logged in
 func ExtractUserId(c *gin.Context) string {
-	cookie, _ := c.Cookie(cookieKey)
-	if cookie == "" {
-		return ""
-	}
-	token, err := jwtGo.Parse(cookie, func(token *jwtGo.Token) (i interface{}, e error) {
-		return config.DefaultConfig.Jwt.Key, nil
-	})
-	if err != nil {
-		return ""
-	}
-	claims, ok := token.Claims.(jwtGo.MapClaims)
-	if !ok {
-		return ""
+	if token := bearerToken(c); token != "" {
+		rsp, ok := verifyAccessToken(c, token)
+		if !ok {
+			return ""
+		}
+		c.Set(scopesKey, rsp.Scopes)
+		return rsp.Info.Id
 	}
 
-	value, ok := claims["id"]
-	if !ok {
+	claims, expired := cookieClaims(c)
+	if expired {
+		claims, _ = refreshSession(c)
+		id, _ := claims["id"].(string)
+		return id
+	}
+	if claims == nil {
 		return ""
 	}
 
-	id, ok := value.(string)
-	if !ok {
+	id, ok := claims["id"].(string)
+	if !ok || !tokenValid(c, id, claims) {
 		return ""
 	}
 	return id
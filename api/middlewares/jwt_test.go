@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"github.com/lt90s/rfschub-server/api/config"
+	"github.com/lt90s/rfschub-server/api/signing"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLoadKeys(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	keys, err := loadKeys(config.JwtConfig{Key: secret}, config.ModeProduction)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, "default", keys[0].Id)
+	require.Equal(t, secret, keys[0].SignKey)
+
+	// the single key is checked like keys of jwt.keys
+	_, err = loadKeys(config.JwtConfig{Key: secret[:31]}, config.ModeDevelopment)
+	require.Error(t, err)
+	_, err = loadKeys(config.JwtConfig{}, config.ModeDevelopment)
+	require.Error(t, err)
+
+	// the default key is only used in development
+	_, err = loadKeys(config.JwtConfig{Key: config.DefaultJwtKey}, config.ModeDevelopment)
+	require.NoError(t, err)
+	_, err = loadKeys(config.JwtConfig{Key: config.DefaultJwtKey}, config.ModeProduction)
+	require.Error(t, err)
+	keyConfig := signing.KeyConfig{Id: "1", Algorithm: signing.AlgorithmHS256, Secret: string(config.DefaultJwtKey)}
+	_, err = loadKeys(config.JwtConfig{Keys: []signing.KeyConfig{keyConfig}}, config.ModeProduction)
+	require.Error(t, err)
+}
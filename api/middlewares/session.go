@@ -0,0 +1,159 @@
+package middlewares
+
+import (
+	"context"
+	"github.com/appleboy/gin-jwt"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"time"
+)
+
+const (
+	refreshCookieKey = "RefreshToken"
+)
+
+// account logged in and its session, the payload of jwt tokens
+type sessionData struct {
+	info *account.AccountInfo
+	sid  string
+}
+
+// create a session of the device of request and set its refresh token cookie
+func createSession(c *gin.Context, info *account.AccountInfo) (string, error) {
+	req := &account.CreateSessionRequest{
+		Uid:       info.Id,
+		UserAgent: c.Request.UserAgent(),
+		Ip:        ClientIP(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rsp, err := GetClient(c).AccountClient.CreateSession(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	setRefreshCookie(c, rsp.RefreshToken, rsp.ExpireAt)
+	return rsp.Id, nil
+}
+
+func setRefreshCookie(c *gin.Context, token string, expireAt int64) {
+	maxAge := int(expireAt - time.Now().Unix())
+	c.SetCookie(refreshCookieKey, token, maxAge, "/", "", SecureCookie, true)
+}
+
+func setTokenCookie(c *gin.Context, data *sessionData) (string, time.Time, error) {
+	token, expire, err := generateToken(data)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	maxAge := int(time.Until(expire).Seconds())
+	c.SetCookie(cookieKey, token, maxAge, "/", "", SecureCookie, true)
+	return token, expire, nil
+}
+
+// StartSession creates a session of account info and sets its cookies, like
+// login does
+func StartSession(c *gin.Context, info *account.AccountInfo) error {
+	sid, err := createSession(c, info)
+	if err != nil {
+		return err
+	}
+	_, _, err = setTokenCookie(c, &sessionData{info: info, sid: sid})
+	return err
+}
+
+// ClearSession removes the session cookies
+func ClearSession(c *gin.Context) {
+	c.SetCookie(cookieKey, "", -1, "/", "", SecureCookie, true)
+	c.SetCookie(refreshCookieKey, "", -1, "/", "", SecureCookie, true)
+}
+
+// refreshSession issues a new jwt token of the session of the refresh token
+// cookie, and returns its claims
+func refreshSession(c *gin.Context) (jwtGo.MapClaims, bool) {
+	refreshToken, _ := c.Cookie(refreshCookieKey)
+	if refreshToken == "" {
+		return nil, false
+	}
+	req := &account.RefreshSessionRequest{
+		RefreshToken: refreshToken,
+		UserAgent:    c.Request.UserAgent(),
+		Ip:           ClientIP(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rsp, err := GetClient(c).AccountClient.RefreshSession(ctx, req)
+	if err != nil {
+		return nil, false
+	}
+
+	if rsp.RefreshToken != "" {
+		setRefreshCookie(c, rsp.RefreshToken, rsp.ExpireAt)
+	}
+	data := &sessionData{info: rsp.Info, sid: rsp.Id}
+	if _, _, err = setTokenCookie(c, data); err != nil {
+		return nil, false
+	}
+	c.Set(roleKey, rsp.Info.Role)
+	claims := jwtGo.MapClaims(payloadFunc(data))
+	claims["id"] = rsp.Info.Id
+	return claims, true
+}
+
+// RefreshSession refreshes the session of the refresh token cookie, and sets
+// claims of the new token like JWTMiddleware does
+func RefreshSession(c *gin.Context) bool {
+	claims, ok := refreshSession(c)
+	if !ok {
+		return false
+	}
+	c.Set("JWT_PAYLOAD", jwt.MapClaims(claims))
+	c.Set("userID", claims["id"])
+	return true
+}
+
+func parseTokenCookie(c *gin.Context) (*jwtGo.Token, error) {
+	cookie, _ := c.Cookie(cookieKey)
+	if cookie == "" {
+		return nil, jwt.ErrEmptyCookieToken
+	}
+	return keySet.Parse(cookie)
+}
+
+// claims of a valid jwt cookie, expired is true if the cookie is missing or
+// expired, the session may be refreshed then
+func cookieClaims(c *gin.Context) (claims jwtGo.MapClaims, expired bool) {
+	token, err := parseTokenCookie(c)
+	if err == jwt.ErrEmptyCookieToken {
+		return nil, true
+	}
+	if err != nil {
+		ve, ok := err.(*jwtGo.ValidationError)
+		return nil, ok && ve.Errors == jwtGo.ValidationErrorExpired
+	}
+	claims, _ = token.Claims.(jwtGo.MapClaims)
+	return claims, false
+}
+
+// SessionId returns the session of the jwt cookie, which may be expired, or
+// empty if there is none
+func SessionId(c *gin.Context) (uid, sid string) {
+	token, err := parseTokenCookie(c)
+	if err != nil {
+		ve, ok := err.(*jwtGo.ValidationError)
+		if !ok || ve.Errors != jwtGo.ValidationErrorExpired {
+			return "", ""
+		}
+	}
+	claims, _ := token.Claims.(jwtGo.MapClaims)
+	uid, _ = claims["id"].(string)
+	sid, _ = claims[sessionKey].(string)
+	return
+}
+
+// CurrentSessionId returns the session of the user authenticated, empty if
+// the user is authenticated with an access token
+func CurrentSessionId(c *gin.Context) string {
+	sid, _ := jwt.ExtractClaims(c)[sessionKey].(string)
+	return sid
+}
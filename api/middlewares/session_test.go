@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/api/signing"
+	"github.com/stretchr/testify/require"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func tokenContext(t *testing.T, keys *signing.KeySet, exp time.Time) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	if keys == nil {
+		return c
+	}
+	s, err := keys.Sign(jwtGo.MapClaims{
+		"id":       "uid",
+		sessionKey: "sid",
+		"exp":      exp.Unix(),
+	})
+	require.NoError(t, err)
+	c.Request.AddCookie(&http.Cookie{Name: cookieKey, Value: s})
+	return c
+}
+
+func TestCookieClaims(t *testing.T) {
+	key := keySet
+	// a key of the same id and another secret
+	another, err := signing.NewKeySet([]*signing.Key{{
+		Id:        "default",
+		Algorithm: signing.AlgorithmHS256,
+		SignKey:   []byte("another key"),
+		VerifyKey: []byte("another key"),
+	}}, time.Minute)
+	require.NoError(t, err)
+
+	claims, expired := cookieClaims(tokenContext(t, nil, time.Time{}))
+	require.Nil(t, claims)
+	require.True(t, expired)
+
+	c := tokenContext(t, key, time.Now().Add(time.Minute))
+	claims, expired = cookieClaims(c)
+	require.False(t, expired)
+	require.Equal(t, "uid", claims["id"])
+	uid, sid := SessionId(c)
+	require.Equal(t, "uid", uid)
+	require.Equal(t, "sid", sid)
+
+	// expired tokens may be refreshed and still tell their session
+	c = tokenContext(t, key, time.Now().Add(-time.Minute))
+	claims, expired = cookieClaims(c)
+	require.Nil(t, claims)
+	require.True(t, expired)
+	_, sid = SessionId(c)
+	require.Equal(t, "sid", sid)
+
+	c = tokenContext(t, another, time.Now().Add(-time.Minute))
+	claims, expired = cookieClaims(c)
+	require.Nil(t, claims)
+	require.False(t, expired)
+	_, sid = SessionId(c)
+	require.Equal(t, "", sid)
+}
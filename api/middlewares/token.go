@@ -0,0 +1,132 @@
+package middlewares
+
+import (
+	"context"
+	"github.com/appleboy/gin-jwt"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/config"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// scopes of personal access tokens, admin grants all of them and annotate
+// grants read. users logged in with cookies have all scopes.
+const (
+	ScopeRead     = "read"
+	ScopeAnnotate = "annotate"
+	ScopeAdmin    = "admin"
+)
+
+const (
+	accessTokenPrefix = "rfs_"
+	bearerPrefix      = "Bearer "
+	scopesKey         = "accessTokenScopes"
+)
+
+// AuthMiddleware authenticates users with personal access tokens of the
+// Authorization header, or the jwt cookie which is refreshed if it expired.
+// Access tokens need read scope for GET requests and annotate scope for
+// others.
+func AuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := bearerToken(c)
+		if token == "" {
+			if _, expired := cookieClaims(c); expired && RefreshSession(c) {
+				c.Next()
+				return
+			}
+			cookieAuth(c)
+			return
+		}
+
+		rsp, ok := verifyAccessToken(c, token)
+		if !ok {
+			c.Header("WWW-Authenticate", "Bearer realm="+config.DefaultConfig.Jwt.Realm)
+			c.Abort()
+			unauthorized(c, http.StatusUnauthorized, "access token invalid")
+			return
+		}
+		c.Set("JWT_PAYLOAD", jwt.MapClaims{
+			"id":        rsp.Info.Id,
+			"name":      rsp.Info.Name,
+			"createdAt": rsp.Info.CreatedAt,
+		})
+		c.Set("userID", rsp.Info.Id)
+		c.Set(roleKey, rsp.Info.Role)
+		c.Set(scopesKey, rsp.Scopes)
+
+		if !HasScope(c, methodScope(c.Request.Method)) {
+			forbidden(c)
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireScope rejects access tokens without scope, it must be used after
+// AuthMiddleware
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !HasScope(c, scope) {
+			forbidden(c)
+			return
+		}
+		c.Next()
+	}
+}
+
+// HasScope tells whether the user authenticated has scope
+func HasScope(c *gin.Context, scope string) bool {
+	value, ok := c.Get(scopesKey)
+	if !ok {
+		return true
+	}
+	scopes, _ := value.([]string)
+	for _, s := range scopes {
+		if s == scope || s == ScopeAdmin || (s == ScopeAnnotate && scope == ScopeRead) {
+			return true
+		}
+	}
+	return false
+}
+
+func methodScope(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeRead
+	}
+	return ScopeAnnotate
+}
+
+func forbidden(c *gin.Context) {
+	c.Abort()
+	c.JSON(http.StatusForbidden, gin.H{
+		"code":    http.StatusForbidden,
+		"message": "access token scope insufficient",
+	})
+}
+
+// personal access token of the Authorization header
+func bearerToken(c *gin.Context) string {
+	header := c.GetHeader("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return ""
+	}
+	token := strings.TrimSpace(header[len(bearerPrefix):])
+	if !strings.HasPrefix(token, accessTokenPrefix) {
+		return ""
+	}
+	return token
+}
+
+func verifyAccessToken(c *gin.Context, token string) (*account.VerifyAccessTokenResponse, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rsp, err := GetClient(c).AccountClient.VerifyAccessToken(ctx, &account.VerifyAccessTokenRequest{Token: token})
+	if err != nil {
+		return nil, false
+	}
+	return rsp, true
+}
@@ -0,0 +1,44 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHasScope(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	// cookie logins have all scopes
+	require.True(t, HasScope(c, ScopeAdmin))
+
+	c.Set(scopesKey, []string{ScopeRead})
+	require.True(t, HasScope(c, ScopeRead))
+	require.False(t, HasScope(c, ScopeAnnotate))
+	require.False(t, HasScope(c, ScopeAdmin))
+
+	c.Set(scopesKey, []string{ScopeAnnotate})
+	require.True(t, HasScope(c, ScopeRead))
+	require.True(t, HasScope(c, ScopeAnnotate))
+	require.False(t, HasScope(c, ScopeAdmin))
+
+	c.Set(scopesKey, []string{ScopeAdmin})
+	require.True(t, HasScope(c, ScopeRead))
+	require.True(t, HasScope(c, ScopeAdmin))
+}
+
+func TestBearerToken(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Equal(t, "", bearerToken(c))
+
+	c.Request.Header.Set("Authorization", "Bearer eyJhbGciOi.x.y")
+	require.Equal(t, "", bearerToken(c))
+
+	c.Request.Header.Set("Authorization", "Bearer rfs_0123")
+	require.Equal(t, "rfs_0123", bearerToken(c))
+
+	require.Equal(t, ScopeRead, methodScope(http.MethodGet))
+	require.Equal(t, ScopeAnnotate, methodScope(http.MethodPost))
+}
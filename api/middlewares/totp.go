@@ -0,0 +1,106 @@
+package middlewares
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/common/errors"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"net/http"
+	"time"
+)
+
+const (
+	totpChallengeCookie = "TotpChallenge"
+	// the challenge cookie is only sent to the second step of login
+	totpChallengePath   = "/account/login/totp"
+	totpChallengeExpire = 5 * time.Minute
+	// claim telling challenge tokens from session tokens
+	purposeKey  = "purpose"
+	purposeTotp = "totp"
+)
+
+type totpLoginRequest struct {
+	Code string `json:"code"`
+}
+
+// StartTotpChallenge sets a short lived challenge cookie of account info
+// whose password is verified, the login completes with TotpLoginHandler
+func StartTotpChallenge(c *gin.Context, info *account.AccountInfo) error {
+	expire := time.Now().Add(totpChallengeExpire)
+	token, err := keySet.Sign(jwtGo.MapClaims{
+		"id":       info.Id,
+		purposeKey: purposeTotp,
+		"exp":      expire.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	c.SetCookie(totpChallengeCookie, token, int(totpChallengeExpire.Seconds()), totpChallengePath, "", SecureCookie, true)
+	return nil
+}
+
+// account of the challenge cookie, empty if it is missing or expired
+func totpChallenge(c *gin.Context) string {
+	cookie, _ := c.Cookie(totpChallengeCookie)
+	if cookie == "" {
+		return ""
+	}
+	token, err := keySet.Parse(cookie)
+	if err != nil {
+		return ""
+	}
+	claims, _ := token.Claims.(jwtGo.MapClaims)
+	if purpose, _ := claims[purposeKey].(string); purpose != purposeTotp {
+		return ""
+	}
+	uid, _ := claims["id"].(string)
+	return uid
+}
+
+// TotpLoginHandler completes a login of the challenge cookie with a two
+// factor code, and starts a session like LoginHandler
+func TotpLoginHandler(c *gin.Context) {
+	var request totpLoginRequest
+	if err := c.ShouldBindJSON(&request); err != nil || request.Code == "" {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"code":    http.StatusBadRequest,
+			"message": "invalid parameters",
+		})
+		return
+	}
+	uid := totpChallenge(c)
+	if uid == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"code":    http.StatusUnauthorized,
+			"message": "two-factor challenge invalid or expired, please login again",
+		})
+		return
+	}
+
+	req := &account.VerifyTotpRequest{
+		Uid:       uid,
+		Code:      request.Code,
+		Ip:        ClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rsp, err := GetClient(c).AccountClient.VerifyTotp(ctx, req)
+	if err != nil {
+		e := errors.FromError(err)
+		c.AbortWithStatusJSON(e.HttpCode, gin.H{
+			"code":    e.Code,
+			"message": e.Message,
+		})
+		return
+	}
+	c.SetCookie(totpChallengeCookie, "", -1, totpChallengePath, "", SecureCookie, true)
+
+	sid, err := createSession(c, rsp.Info)
+	if err != nil {
+		unauthorized(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	loginResponse(c, &sessionData{info: rsp.Info, sid: sid})
+}
@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/stretchr/testify/require"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTotpChallenge(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	require.NoError(t, StartTotpChallenge(c, &account.AccountInfo{Id: "uid"}))
+
+	c, _ = gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, totpChallengePath, nil)
+	for _, cookie := range w.Result().Cookies() {
+		require.Equal(t, totpChallengePath, cookie.Path)
+		c.Request.AddCookie(cookie)
+	}
+	require.Equal(t, "uid", totpChallenge(c))
+
+	// session tokens are not challenges, and challenges are not sessions
+	s, err := keySet.Sign(jwtGo.MapClaims{
+		"id":       "uid",
+		sessionKey: "sid",
+		"exp":      time.Now().Add(time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+	c.Request = httptest.NewRequest(http.MethodPost, totpChallengePath, nil)
+	c.Request.AddCookie(&http.Cookie{Name: totpChallengeCookie, Value: s})
+	require.Equal(t, "", totpChallenge(c))
+
+	claims := jwtGo.MapClaims{"id": "uid", sessionKey: "sid", purposeKey: purposeTotp}
+	require.False(t, tokenValid(c, "uid", claims))
+}
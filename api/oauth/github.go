@@ -0,0 +1,81 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const (
+	githubAuthUrl  = "https://github.com/login/oauth/authorize"
+	githubTokenUrl = "https://github.com/login/oauth/access_token"
+	githubApiUrl   = "https://api.github.com"
+)
+
+type githubProvider struct {
+	conf   ProviderConfig
+	client *http.Client
+}
+
+func newGithubProvider(conf ProviderConfig, client *http.Client) *githubProvider {
+	if conf.AuthUrl == "" {
+		conf.AuthUrl = githubAuthUrl
+	}
+	if conf.TokenUrl == "" {
+		conf.TokenUrl = githubTokenUrl
+	}
+	if conf.UserInfoUrl == "" {
+		conf.UserInfoUrl = githubApiUrl + "/user"
+	}
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{"read:user", "user:email"}
+	}
+	return &githubProvider{conf: conf, client: client}
+}
+
+func (p *githubProvider) Name() string {
+	return p.conf.Name
+}
+
+func (p *githubProvider) AuthCodeUrl(ctx context.Context, redirectUrl, state, nonce, verifier string) (string, error) {
+	return authCodeUrl(p.conf.AuthUrl, p.conf, redirectUrl, state, "", verifier)
+}
+
+func (p *githubProvider) Exchange(ctx context.Context, redirectUrl, code, nonce, verifier string) (Identity, error) {
+	identity := Identity{Provider: p.conf.Name}
+	token, err := exchangeCode(ctx, p.client, p.conf.TokenUrl, p.conf, redirectUrl, code, verifier)
+	if err != nil {
+		return identity, err
+	}
+
+	var user struct {
+		Id    int64  `json:"id"`
+		Login string `json:"login"`
+	}
+	if err = getJSON(ctx, p.client, p.conf.UserInfoUrl, token.AccessToken, &user); err != nil {
+		return identity, err
+	}
+	if user.Id == 0 {
+		return identity, ErrNoSubject
+	}
+	identity.Subject = strconv.FormatInt(user.Id, 10)
+	identity.Login = user.Login
+
+	// the email of user profile is the public one which is not necessarily
+	// verified, the primary one of emails is used instead
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err = getJSON(ctx, p.client, p.conf.UserInfoUrl+"/emails", token.AccessToken, &emails); err != nil {
+		return identity, err
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+		}
+	}
+	return identity, nil
+}
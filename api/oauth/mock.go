@@ -0,0 +1,242 @@
+package oauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	mockKeyId      = "mock"
+	mockCodeExpire = time.Minute
+	mockEmailHost  = "mock.local"
+)
+
+var mockLoginRegexp = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_]{0,16}$")
+
+// MockProvider is an OpenID Connect provider for development and tests, it
+// signs in anyone as the login name they enter without a password. Users
+// get verified emails of host mock.local.
+type MockProvider struct {
+	issuer       string
+	clientId     string
+	clientSecret string
+	key          *rsa.PrivateKey
+	mux          *http.ServeMux
+
+	mu     sync.Mutex
+	codes  map[string]mockCode
+	tokens map[string]string // access token to login
+}
+
+type mockCode struct {
+	login       string
+	nonce       string
+	redirectUrl string
+	challenge   string
+	expireAt    time.Time
+}
+
+// NewMockProvider creates a provider served at issuer, clients must use
+// clientId and clientSecret
+func NewMockProvider(issuer, clientId, clientSecret string) (*MockProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	m := &MockProvider{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		key:          key,
+		mux:          http.NewServeMux(),
+		codes:        make(map[string]mockCode),
+		tokens:       make(map[string]string),
+	}
+	m.mux.HandleFunc("/.well-known/openid-configuration", m.discovery)
+	m.mux.HandleFunc("/authorize", m.authorize)
+	m.mux.HandleFunc("/token", m.token)
+	m.mux.HandleFunc("/userinfo", m.userInfo)
+	m.mux.HandleFunc("/jwks", m.jwks)
+	return m, nil
+}
+
+// ServeHTTP serves paths relative to the issuer
+func (m *MockProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mux.ServeHTTP(w, r)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func (m *MockProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                m.issuer,
+		"authorization_endpoint":                m.issuer + "/authorize",
+		"token_endpoint":                        m.issuer + "/token",
+		"userinfo_endpoint":                     m.issuer + "/userinfo",
+		"jwks_uri":                              m.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+var mockLoginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html><head><title>Mock login</title></head><body>
+<form method="get" action="authorize">
+{{range $key, $values := .}}{{range $values}}<input type="hidden" name="{{$key}}" value="{{.}}">
+{{end}}{{end}}<label>Login as <input name="login" autofocus></label>
+<button type="submit">Sign in</button>
+</form>
+</body></html>
+`))
+
+// authorize signs in user of query parameter login, a form asking for it is
+// shown if it is missing
+func (m *MockProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectUrl, err := url.Parse(query.Get("redirect_uri"))
+	if query.Get("client_id") != m.clientId || err != nil || !redirectUrl.IsAbs() {
+		http.Error(w, "invalid client or redirect uri", http.StatusBadRequest)
+		return
+	}
+	if query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE with S256 is required", http.StatusBadRequest)
+		return
+	}
+	login := query.Get("login")
+	if login == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = mockLoginPage.Execute(w, query)
+		return
+	}
+	if !mockLoginRegexp.MatchString(login) {
+		http.Error(w, "invalid login", http.StatusBadRequest)
+		return
+	}
+
+	code, err := randomString(16)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	m.mu.Lock()
+	m.codes[code] = mockCode{
+		login:       login,
+		nonce:       query.Get("nonce"),
+		redirectUrl: redirectUrl.String(),
+		challenge:   query.Get("code_challenge"),
+		expireAt:    time.Now().Add(mockCodeExpire),
+	}
+	m.mu.Unlock()
+
+	callback := redirectUrl.Query()
+	callback.Set("code", code)
+	callback.Set("state", query.Get("state"))
+	redirectUrl.RawQuery = callback.Encode()
+	http.Redirect(w, r, redirectUrl.String(), http.StatusFound)
+}
+
+func (m *MockProvider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.PostFormValue("client_id") != m.clientId || r.PostFormValue("client_secret") != m.clientSecret {
+		tokenError(w, "invalid_client")
+		return
+	}
+	m.mu.Lock()
+	code, ok := m.codes[r.PostFormValue("code")]
+	delete(m.codes, r.PostFormValue("code"))
+	m.mu.Unlock()
+	if !ok || time.Now().After(code.expireAt) || r.PostFormValue("grant_type") != "authorization_code" ||
+		r.PostFormValue("redirect_uri") != code.redirectUrl || CodeChallenge(r.PostFormValue("code_verifier")) != code.challenge {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	accessToken, err := randomString(16)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	now := time.Now()
+	idToken := jwtGo.NewWithClaims(jwtGo.SigningMethodRS256, jwtGo.MapClaims{
+		"iss":                m.issuer,
+		"sub":                code.login,
+		"aud":                m.clientId,
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Hour).Unix(),
+		"nonce":              code.nonce,
+		"email":              code.login + "@" + mockEmailHost,
+		"email_verified":     true,
+		"preferred_username": code.login,
+	})
+	idToken.Header["kid"] = mockKeyId
+	signed, err := idToken.SignedString(m.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	m.mu.Lock()
+	m.tokens[accessToken] = code.login
+	m.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+func (m *MockProvider) userInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	m.mu.Lock()
+	login, ok := m.tokens[accessToken]
+	m.mu.Unlock()
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"sub":                login,
+		"email":              login + "@" + mockEmailHost,
+		"email_verified":     true,
+		"preferred_username": login,
+	})
+}
+
+func (m *MockProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	key := m.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kid": mockKeyId,
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+}
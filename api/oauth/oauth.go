@@ -0,0 +1,202 @@
+// Package oauth implements the OAuth2 authorization code flow with PKCE
+// against GitHub and OpenID Connect providers, and a mock OpenID Connect
+// provider for development and tests.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	KindGithub = "github"
+	KindOIDC   = "oidc"
+	// an OpenID Connect provider served by MockProvider
+	KindMock = "mock"
+)
+
+type ProviderConfig struct {
+	// name of provider in urls, like /account/oauth/{name}
+	Name         string `json:"name"`
+	Kind         string `json:"kind"`
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	// issuer of OpenID Connect providers, endpoints are discovered from it
+	Issuer string `json:"issuer"`
+	// endpoints override the default or discovered ones if not empty
+	AuthUrl     string   `json:"authUrl"`
+	TokenUrl    string   `json:"tokenUrl"`
+	UserInfoUrl string   `json:"userInfoUrl"`
+	Scopes      []string `json:"scopes"`
+}
+
+// Identity is a user authenticated by a provider
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	// preferred user name, used to name new accounts
+	Login string
+}
+
+type Provider interface {
+	Name() string
+	// url of the authorization endpoint the user is redirected to
+	AuthCodeUrl(ctx context.Context, redirectUrl, state, nonce, verifier string) (string, error)
+	// exchange code for a token and get the identity of the user, nonce and
+	// verifier are the ones used for the authorization url
+	Exchange(ctx context.Context, redirectUrl, code, nonce, verifier string) (Identity, error)
+}
+
+var (
+	ErrUnknownKind = errors.New("unknown oauth provider kind")
+	ErrNoSubject   = errors.New("provider returned no user id")
+)
+
+// NewProvider creates provider of conf, client is used for requests to the
+// provider and defaults to a client with a timeout
+func NewProvider(conf ProviderConfig, client *http.Client) (Provider, error) {
+	if conf.Name == "" || conf.ClientId == "" {
+		return nil, fmt.Errorf("oauth provider name or client id missing")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	switch conf.Kind {
+	case KindGithub:
+		return newGithubProvider(conf, client), nil
+	case KindOIDC, KindMock:
+		if conf.Issuer == "" {
+			return nil, fmt.Errorf("issuer of oauth provider %s missing", conf.Name)
+		}
+		return newOIDCProvider(conf, client), nil
+	}
+	return nil, ErrUnknownKind
+}
+
+// NewState returns random state, nonce and PKCE verifier of an authorization
+func NewState() (state, nonce, verifier string, err error) {
+	if state, err = randomString(16); err != nil {
+		return
+	}
+	if nonce, err = randomString(16); err != nil {
+		return
+	}
+	verifier, err = randomString(32)
+	return
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge is the S256 PKCE challenge of verifier
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func authCodeUrl(endpoint string, conf ProviderConfig, redirectUrl, state, nonce, verifier string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", conf.ClientId)
+	query.Set("redirect_uri", redirectUrl)
+	query.Set("scope", strings.Join(conf.Scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", CodeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	if nonce != "" {
+		query.Set("nonce", nonce)
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	IdToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchange authorization code for tokens at endpoint
+func exchangeCode(ctx context.Context, client *http.Client, endpoint string, conf ProviderConfig, redirectUrl, code, verifier string) (tokenResponse, error) {
+	var token tokenResponse
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectUrl},
+		"client_id":     {conf.ClientId},
+		"client_secret": {conf.ClientSecret},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return token, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err = doJSON(ctx, client, req, &token); err != nil {
+		return token, err
+	}
+	if token.Error != "" {
+		return token, fmt.Errorf("token request failed: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return token, errors.New("token request failed: no access token")
+	}
+	return token, nil
+}
+
+// get json from endpoint with access token
+func getJSON(ctx context.Context, client *http.Client, endpoint, accessToken string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	return doJSON(ctx, client, req, v)
+}
+
+func doJSON(ctx context.Context, client *http.Client, req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	rsp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	// token errors are json of status 400
+	if rsp.StatusCode != http.StatusOK && rsp.StatusCode != http.StatusBadRequest {
+		return fmt.Errorf("%s %s: status %d", req.Method, req.URL.Path, rsp.StatusCode)
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("%s %s: %v", req.Method, req.URL.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const redirectUrl = "http://127.0.0.1:8888/account/oauth/mock/callback"
+
+// authorize as login and get the code from the redirect to the callback
+func authorize(t *testing.T, provider Provider, login, state, nonce, verifier string) string {
+	authUrl, err := provider.AuthCodeUrl(context.Background(), redirectUrl, state, nonce, verifier)
+	require.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	rsp, err := client.Get(authUrl + "&login=" + login)
+	require.NoError(t, err)
+	rsp.Body.Close()
+	require.Equal(t, http.StatusFound, rsp.StatusCode)
+
+	location, err := url.Parse(rsp.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, state, location.Query().Get("state"))
+	return location.Query().Get("code")
+}
+
+func TestMockProvider(t *testing.T) {
+	server := httptest.NewServer(nil)
+	defer server.Close()
+	mock, err := NewMockProvider(server.URL, "rfschub", "secret")
+	require.NoError(t, err)
+	server.Config.Handler = mock
+
+	provider, err := NewProvider(ProviderConfig{
+		Name:         "mock",
+		Kind:         KindMock,
+		ClientId:     "rfschub",
+		ClientSecret: "secret",
+		Issuer:       server.URL,
+	}, nil)
+	require.NoError(t, err)
+
+	state, nonce, verifier, err := NewState()
+	require.NoError(t, err)
+	code := authorize(t, provider, "alice", state, nonce, verifier)
+	identity, err := provider.Exchange(context.Background(), redirectUrl, code, nonce, verifier)
+	require.NoError(t, err)
+	require.Equal(t, Identity{
+		Provider:      "mock",
+		Subject:       "alice",
+		Email:         "alice@mock.local",
+		EmailVerified: true,
+		Login:         "alice",
+	}, identity)
+
+	// codes are single use
+	_, err = provider.Exchange(context.Background(), redirectUrl, code, nonce, verifier)
+	require.Error(t, err)
+
+	// PKCE verifier must match
+	code = authorize(t, provider, "alice", state, nonce, verifier)
+	_, err = provider.Exchange(context.Background(), redirectUrl, code, nonce, "other")
+	require.Error(t, err)
+
+	// nonce of id token must match
+	code = authorize(t, provider, "alice", state, nonce, verifier)
+	_, err = provider.Exchange(context.Background(), redirectUrl, code, "other", verifier)
+	require.Equal(t, ErrInvalidIdToken, err)
+
+	// id tokens signed by another key are rejected
+	other, err := NewMockProvider(server.URL, "rfschub", "secret")
+	require.NoError(t, err)
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/jwks" {
+			mock.ServeHTTP(w, r)
+			return
+		}
+		other.ServeHTTP(w, r)
+	})
+	code = authorize(t, provider, "alice", state, nonce, verifier)
+	_, err = provider.Exchange(context.Background(), redirectUrl, code, nonce, verifier)
+	require.Equal(t, ErrInvalidIdToken, err)
+}
+
+func TestGithubProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "code" || r.PostFormValue("client_secret") != "secret" {
+			writeJSON(w, http.StatusOK, map[string]string{"error": "bad_verification_code"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"access_token": "token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 42, "login": "octocat", "email": "public@example.com"})
+	})
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"email": "public@example.com", "primary": false, "verified": true},
+			{"email": "octocat@example.com", "primary": true, "verified": true},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, err := NewProvider(ProviderConfig{
+		Name:         "github",
+		Kind:         KindGithub,
+		ClientId:     "rfschub",
+		ClientSecret: "secret",
+		TokenUrl:     server.URL + "/login/oauth/access_token",
+		UserInfoUrl:  server.URL + "/user",
+	}, nil)
+	require.NoError(t, err)
+
+	authUrl, err := provider.AuthCodeUrl(context.Background(), redirectUrl, "state", "nonce", "verifier")
+	require.NoError(t, err)
+	u, err := url.Parse(authUrl)
+	require.NoError(t, err)
+	require.Equal(t, "github.com", u.Host)
+	require.Equal(t, CodeChallenge("verifier"), u.Query().Get("code_challenge"))
+	require.Equal(t, "read:user user:email", u.Query().Get("scope"))
+
+	identity, err := provider.Exchange(context.Background(), redirectUrl, "code", "", "verifier")
+	require.NoError(t, err)
+	require.Equal(t, Identity{
+		Provider:      "github",
+		Subject:       "42",
+		Email:         "octocat@example.com",
+		EmailVerified: true,
+		Login:         "octocat",
+	}, identity)
+
+	_, err = provider.Exchange(context.Background(), redirectUrl, "wrong", "", "verifier")
+	require.Error(t, err)
+}
@@ -0,0 +1,220 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// keys of a provider are fetched again for an unknown key id at most once
+// in this interval
+const jwksRefreshInterval = time.Minute
+
+var ErrInvalidIdToken = errors.New("invalid id token")
+
+type oidcProvider struct {
+	conf   ProviderConfig
+	client *http.Client
+
+	mu         sync.Mutex
+	discovered bool
+	jwksUrl    string
+	keys       map[string]*rsa.PublicKey
+	keysAt     time.Time
+}
+
+func newOIDCProvider(conf ProviderConfig, client *http.Client) *oidcProvider {
+	conf.Issuer = strings.TrimSuffix(conf.Issuer, "/")
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &oidcProvider{conf: conf, client: client}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.conf.Name
+}
+
+// fill endpoints from the discovery document of issuer once
+func (p *oidcProvider) discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovered {
+		return nil
+	}
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserinfoEndpoint      string `json:"userinfo_endpoint"`
+		JwksUri               string `json:"jwks_uri"`
+	}
+	err := getJSON(ctx, p.client, p.conf.Issuer+"/.well-known/openid-configuration", "", &doc)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.conf.Issuer {
+		return fmt.Errorf("issuer mismatch: %s", doc.Issuer)
+	}
+	if p.conf.AuthUrl == "" {
+		p.conf.AuthUrl = doc.AuthorizationEndpoint
+	}
+	if p.conf.TokenUrl == "" {
+		p.conf.TokenUrl = doc.TokenEndpoint
+	}
+	if p.conf.UserInfoUrl == "" {
+		p.conf.UserInfoUrl = doc.UserinfoEndpoint
+	}
+	p.jwksUrl = doc.JwksUri
+	p.discovered = true
+	return nil
+}
+
+func (p *oidcProvider) AuthCodeUrl(ctx context.Context, redirectUrl, state, nonce, verifier string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	return authCodeUrl(p.conf.AuthUrl, p.conf, redirectUrl, state, nonce, verifier)
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, redirectUrl, code, nonce, verifier string) (Identity, error) {
+	identity := Identity{Provider: p.conf.Name}
+	if err := p.discover(ctx); err != nil {
+		return identity, err
+	}
+	token, err := exchangeCode(ctx, p.client, p.conf.TokenUrl, p.conf, redirectUrl, code, verifier)
+	if err != nil {
+		return identity, err
+	}
+	claims, err := p.verifyIdToken(ctx, token.IdToken, nonce)
+	if err != nil {
+		return identity, err
+	}
+
+	identity.Subject, _ = claims["sub"].(string)
+	if identity.Subject == "" {
+		return identity, ErrNoSubject
+	}
+	fillIdentity(&identity, claims)
+	// id tokens may carry the subject only, the rest is in user info
+	if identity.Email == "" && p.conf.UserInfoUrl != "" {
+		var info map[string]interface{}
+		if err = getJSON(ctx, p.client, p.conf.UserInfoUrl, token.AccessToken, &info); err != nil {
+			return identity, err
+		}
+		if sub, _ := info["sub"].(string); sub != identity.Subject {
+			return identity, errors.New("user info subject mismatch")
+		}
+		fillIdentity(&identity, info)
+	}
+	return identity, nil
+}
+
+func fillIdentity(identity *Identity, claims map[string]interface{}) {
+	if email, ok := claims["email"].(string); ok && email != "" {
+		identity.Email = email
+		identity.EmailVerified, _ = claims["email_verified"].(bool)
+	}
+	for _, key := range []string{"preferred_username", "nickname", "name"} {
+		if login, ok := claims[key].(string); ok && login != "" && identity.Login == "" {
+			identity.Login = login
+		}
+	}
+}
+
+// verify signature, issuer, audience, expiry and nonce of an id token
+func (p *oidcProvider) verifyIdToken(ctx context.Context, idToken, nonce string) (jwtGo.MapClaims, error) {
+	if idToken == "" {
+		return nil, ErrInvalidIdToken
+	}
+	parser := &jwtGo.Parser{ValidMethods: []string{"RS256"}}
+	token, err := parser.Parse(idToken, func(token *jwtGo.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidIdToken
+	}
+	claims := token.Claims.(jwtGo.MapClaims)
+	if iss, _ := claims["iss"].(string); strings.TrimSuffix(iss, "/") != p.conf.Issuer {
+		return nil, ErrInvalidIdToken
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) || !hasAudience(claims["aud"], p.conf.ClientId) {
+		return nil, ErrInvalidIdToken
+	}
+	if claimed, _ := claims["nonce"].(string); nonce != "" && claimed != nonce {
+		return nil, ErrInvalidIdToken
+	}
+	return claims, nil
+}
+
+// aud of a token is a string or an array of strings
+func hasAudience(aud interface{}, clientId string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientId
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// public key kid of provider, keys are fetched again if kid is unknown
+func (p *oidcProvider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(p.keysAt) < jwksRefreshInterval {
+		return nil, ErrInvalidIdToken
+	}
+	p.keysAt = time.Now()
+
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := getJSON(ctx, p.client, p.jwksUrl, "", &jwks); err != nil {
+		return nil, err
+	}
+	p.keys = make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) > 4 {
+			continue
+		}
+		p.keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrInvalidIdToken
+}
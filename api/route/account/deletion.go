@@ -0,0 +1,81 @@
+package account
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/micro/go-micro/client"
+	"net/http"
+	"time"
+)
+
+// exports are built from data of several services
+const exportTimeout = 2 * time.Minute
+
+func setupDeletionRouter(router *gin.Engine, auth, admin gin.HandlerFunc) {
+	router.GET("/account/export", auth, admin, exportAccount)
+	router.GET("/account/deletion", auth, admin, getAccountDeletion)
+	router.POST("/account/deletion", auth, admin, requestAccountDeletion)
+	router.POST("/account/deletion/cancel", auth, admin, cancelAccountDeletion)
+}
+
+// the export is downloaded as a zip of json files
+func exportAccount(c *gin.Context) {
+	req := &account.ExportAccountRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	timeout := client.WithRequestTimeout(exportTimeout)
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.ExportAccount(context.Background(), req, timeout)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="rfschub-`+rsp.Name+`.zip"`)
+	c.Data(http.StatusOK, "application/zip", rsp.Archive)
+}
+
+func getAccountDeletion(c *gin.Context) {
+	req := &account.AccountDeletionRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.AccountDeletion(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func requestAccountDeletion(c *gin.Context) {
+	var req account.RequestAccountDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.RequestAccountDeletion(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func cancelAccountDeletion(c *gin.Context) {
+	req := &account.CancelAccountDeletionRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.CancelAccountDeletion(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
@@ -53,21 +53,130 @@ func registerAccount(c *gin.Context) {
 	middlewares.SetData(c, rsp)
 }
 
-func getSelfInfo(c *gin.Context) {
-	claim := jwt.ExtractClaims(c)
-	middlewares.SetData(c, claim)
+func activateAccount(c *gin.Context) {
+	var req account.ActivateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.Activate(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
 }
 
-func getUserInfo(c *gin.Context) {
-	user := c.Param("name")
+func resendActivation(c *gin.Context) {
+	var req account.ResendActivationRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !emailRegexp.MatchString(req.Email) {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
 
 	client := middlewares.GetClient(c)
-	rsp, err := client.AccountClient.AccountInfoByName(context.Background(), &account.AccountName{Name: user})
+	_, err := client.AccountClient.ResendActivation(context.Background(), &req)
 	if err != nil {
 		middlewares.SetError(c, errors.FromError(err))
 		return
 	}
+	middlewares.SetData(c, gin.H{})
+}
 
-	middlewares.SetData(c, rsp)
+func requestPasswordReset(c *gin.Context) {
+	var req account.RequestPasswordResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !emailRegexp.MatchString(req.Email) {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.RequestPasswordReset(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func resetPassword(c *gin.Context) {
+	var req account.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" || len(req.Password) < passwordMinSize {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.ResetPassword(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+// change password of the user logged in, all sessions of the user are
+// logged out and a new one is started for the current client
+func changePassword(c *gin.Context) {
+	var req account.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil || len(req.NewPassword) < passwordMinSize {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.AccountClient.ChangePassword(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	if err = middlewares.StartSession(c, rsp.Info); err != nil {
+		middlewares.SetError(c, errors.NewInternalError(-1, err.Error()))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func changeEmail(c *gin.Context) {
+	var req account.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !emailRegexp.MatchString(req.Email) {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.AccountClient.ChangeEmail(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func confirmEmail(c *gin.Context) {
+	var req account.ConfirmEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.ConfirmEmail(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
 
+func getSelfInfo(c *gin.Context) {
+	claim := jwt.ExtractClaims(c)
+	claim["role"] = middlewares.GetAccount(c).Role
+	middlewares.SetData(c, claim)
 }
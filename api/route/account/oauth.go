@@ -0,0 +1,236 @@
+package account
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/config"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/api/oauth"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+const (
+	oauthStateCookie = "OAuthState"
+	oauthStatePath   = "/account/oauth"
+	oauthStateExpire = 10 * time.Minute
+	// path the mock provider is served at
+	oauthMockPath = "/oauth/mock"
+)
+
+// reasons of failed logins passed to the failure url
+const (
+	oauthErrorState           = "invalid_state"
+	oauthErrorDenied          = "access_denied"
+	oauthErrorProvider        = "provider_error"
+	oauthErrorIdentityLinked  = "identity_linked"
+	oauthErrorEmailUnverified = "email_unverified"
+	oauthErrorEmailRegistered = "email_registered"
+	oauthErrorFailed          = "login_failed"
+)
+
+var oauthProviders = make(map[string]oauth.Provider)
+
+// providers in the order of config
+var oauthProviderNames []string
+
+func setupOAuthRouter(router *gin.Engine, auth, admin gin.HandlerFunc) {
+	conf := config.DefaultConfig.OAuth
+	baseUrl := strings.TrimSuffix(conf.BaseUrl, "/")
+	for _, pc := range conf.Providers {
+		// names are used in paths and the state cookie
+		if strings.ContainsAny(pc.Name, "./?#") {
+			log.Panicf("invalid oauth provider name: name=%s", pc.Name)
+		}
+		if pc.Kind == oauth.KindMock {
+			// anyone can login as any user of it, with any email
+			if config.DefaultConfig.Mode == config.ModeProduction {
+				log.Panicf("mock oauth provider %s enabled in production mode", pc.Name)
+			}
+			if pc.Issuer == "" {
+				pc.Issuer = baseUrl + oauthMockPath
+			}
+			mock, err := oauth.NewMockProvider(pc.Issuer, pc.ClientId, pc.ClientSecret)
+			if err != nil {
+				log.Panicf("create mock oauth provider failed: err=%v", err)
+			}
+			log.Warnf("mock oauth provider %s enabled, anyone can login as any user of it", pc.Name)
+			router.Any(oauthMockPath+"/*path", gin.WrapH(http.StripPrefix(oauthMockPath, mock)))
+		}
+		provider, err := oauth.NewProvider(pc, nil)
+		if err != nil {
+			log.Panicf("create oauth provider failed: name=%s err=%v", pc.Name, err)
+		}
+		if _, ok := oauthProviders[pc.Name]; ok {
+			log.Panicf("duplicate oauth provider: name=%s", pc.Name)
+		}
+		oauthProviders[pc.Name] = provider
+		oauthProviderNames = append(oauthProviderNames, pc.Name)
+	}
+
+	router.GET("/account/oauth", getOAuthProviders)
+	router.GET("/account/oauth/:provider", oauthLogin)
+	router.GET("/account/oauth/:provider/callback", oauthCallback)
+	router.GET("/account/identities", auth, admin, getIdentities)
+	router.POST("/account/identity/unlink", auth, admin, unlinkIdentity)
+}
+
+func oauthRedirectUrl(provider string) string {
+	return strings.TrimSuffix(config.DefaultConfig.OAuth.BaseUrl, "/") + oauthStatePath + "/" + provider + "/callback"
+}
+
+func getOAuthProviders(c *gin.Context) {
+	middlewares.SetData(c, gin.H{
+		"providers": oauthProviderNames,
+	})
+}
+
+// redirect user to the authorization endpoint of provider, a user logged in
+// links the identity to their account
+func oauthLogin(c *gin.Context) {
+	provider, ok := oauthProviders[c.Param("provider")]
+	if !ok {
+		middlewares.SetError(c, errors.NewNotFoundError(-1, "oauth provider not found"))
+		return
+	}
+
+	state, nonce, verifier, err := oauth.NewState()
+	if err != nil {
+		middlewares.SetError(c, errors.NewInternalError(-1, err.Error()))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	url, err := provider.AuthCodeUrl(ctx, oauthRedirectUrl(provider.Name()), state, nonce, verifier)
+	if err != nil {
+		log.Errorf("[oauthLogin] AuthCodeUrl error: provider=%s err=%v", provider.Name(), err)
+		middlewares.SetError(c, errors.NewServiceUnavailable(-1, "oauth provider unavailable"))
+		return
+	}
+
+	value := strings.Join([]string{provider.Name(), state, nonce, verifier}, ".")
+	c.SetCookie(oauthStateCookie, value, int(oauthStateExpire.Seconds()), oauthStatePath, "", middlewares.SecureCookie, true)
+	c.Redirect(http.StatusFound, url)
+}
+
+func oauthCallback(c *gin.Context) {
+	provider, ok := oauthProviders[c.Param("provider")]
+	if !ok {
+		middlewares.SetError(c, errors.NewNotFoundError(-1, "oauth provider not found"))
+		return
+	}
+
+	// the state cookie is used once
+	value, _ := c.Cookie(oauthStateCookie)
+	c.SetCookie(oauthStateCookie, "", -1, oauthStatePath, "", middlewares.SecureCookie, true)
+
+	parts := strings.Split(value, ".")
+	if len(parts) != 4 || parts[0] != provider.Name() || parts[1] == "" || parts[1] != c.Query("state") {
+		oauthFailure(c, oauthErrorState)
+		return
+	}
+	if reason := c.Query("error"); reason != "" {
+		log.Debugf("[oauthCallback] provider returned error: provider=%s error=%s", provider.Name(), reason)
+		if reason == oauthErrorDenied {
+			oauthFailure(c, oauthErrorDenied)
+		} else {
+			oauthFailure(c, oauthErrorProvider)
+		}
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	identity, err := provider.Exchange(ctx, oauthRedirectUrl(provider.Name()), c.Query("code"), parts[2], parts[3])
+	if err != nil {
+		log.Errorf("[oauthCallback] Exchange error: provider=%s err=%v", provider.Name(), err)
+		oauthFailure(c, oauthErrorProvider)
+		return
+	}
+
+	req := &account.OAuthLoginRequest{
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		Login:         identity.Login,
+		Uid:           middlewares.ExtractUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.OAuthLogin(ctx, req)
+	if err != nil {
+		oauthFailure(c, oauthLoginError(errors.FromError(err)))
+		return
+	}
+	// users linking an identity are logged in already
+	if req.Uid == "" && rsp.Info.TotpEnabled {
+		if err = middlewares.StartTotpChallenge(c, rsp.Info); err != nil {
+			log.Errorf("[oauthCallback] StartTotpChallenge error: uid=%s err=%v", rsp.Info.Id, err)
+			oauthFailure(c, oauthErrorFailed)
+			return
+		}
+		c.Redirect(http.StatusFound, config.DefaultConfig.OAuth.TotpUrl)
+		return
+	}
+	if req.Uid == "" {
+		if err = middlewares.StartSession(c, rsp.Info); err != nil {
+			log.Errorf("[oauthCallback] StartSession error: uid=%s err=%v", rsp.Info.Id, err)
+			oauthFailure(c, oauthErrorFailed)
+			return
+		}
+	}
+	c.Redirect(http.StatusFound, config.DefaultConfig.OAuth.SuccessUrl)
+}
+
+func oauthLoginError(err errors.Error) string {
+	switch account.ErrorCode(err.Code) {
+	case account.ErrorCode_ErrorIdentityLinked:
+		return oauthErrorIdentityLinked
+	case account.ErrorCode_ErrorEmailUnverified:
+		return oauthErrorEmailUnverified
+	case account.ErrorCode_ErrorEmailRegistered:
+		return oauthErrorEmailRegistered
+	}
+	log.Errorf("[oauthCallback] OAuthLogin error: err=%v", err)
+	return oauthErrorFailed
+}
+
+func oauthFailure(c *gin.Context, reason string) {
+	url := strings.Replace(config.DefaultConfig.OAuth.FailureUrl, "{error}", neturl.QueryEscape(reason), -1)
+	c.Redirect(http.StatusFound, url)
+}
+
+func getIdentities(c *gin.Context) {
+	req := &account.IdentitiesRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.Identities(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func unlinkIdentity(c *gin.Context) {
+	var req account.UnlinkIdentityRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Provider == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.UnlinkIdentity(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
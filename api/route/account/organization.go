@@ -0,0 +1,143 @@
+package account
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+)
+
+func setupOrganizationRouter(router *gin.Engine, auth, admin gin.HandlerFunc) {
+	router.POST("/organization", auth, admin, createOrganization)
+	router.GET("/organization/members", getOrganizationMembers)
+	router.POST("/organization/member", auth, admin, setOrganizationMember)
+	router.POST("/organization/member/remove", auth, admin, removeOrganizationMember)
+	router.GET("/organizations", auth, getUserOrganizations)
+}
+
+// id of the organization of name, organizations share the namespace of
+// accounts
+func organizationId(ctx context.Context, c *gin.Context, name string) (string, bool) {
+	client := middlewares.GetClient(c)
+	info, err := client.AccountClient.AccountInfoByName(ctx, &account.AccountName{Name: name})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return "", false
+	}
+	if !info.Organization {
+		middlewares.SetError(c, errors.NewNotFoundError(-1, "organization not exist"))
+		return "", false
+	}
+	return info.Id, true
+}
+
+func createOrganization(c *gin.Context) {
+	var req account.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.CreateOrganization(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func getOrganizationMembers(c *gin.Context) {
+	ctx := context.Background()
+	org, ok := organizationId(ctx, c, c.Query("name"))
+	if !ok {
+		return
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.OrganizationMembers(ctx, &account.OrganizationMembersRequest{Org: org})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func setOrganizationMember(c *gin.Context) {
+	var data struct {
+		Org      string          `json:"org"`
+		Username string          `json:"username"`
+		Role     account.OrgRole `json:"role"`
+	}
+	if err := c.ShouldBindJSON(&data); err != nil || data.Username == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	ctx := context.Background()
+	org, ok := organizationId(ctx, c, data.Org)
+	if !ok {
+		return
+	}
+	client := middlewares.GetClient(c)
+	idRsp, err := client.AccountClient.AccountId(ctx, &account.AccountIdRequest{Username: data.Username})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+
+	_, err = client.AccountClient.SetOrganizationMember(ctx, &account.SetOrganizationMemberRequest{
+		Uid:    middlewares.GetUserId(c),
+		Org:    org,
+		Member: idRsp.Uid,
+		Role:   data.Role,
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+// members are removed by owners, or leave by themselves
+func removeOrganizationMember(c *gin.Context) {
+	var data struct {
+		Org    string `json:"org"`
+		Member string `json:"member"`
+	}
+	if err := c.ShouldBindJSON(&data); err != nil || data.Member == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	ctx := context.Background()
+	org, ok := organizationId(ctx, c, data.Org)
+	if !ok {
+		return
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.RemoveOrganizationMember(ctx, &account.RemoveOrganizationMemberRequest{
+		Uid:    middlewares.GetUserId(c),
+		Org:    org,
+		Member: data.Member,
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func getUserOrganizations(c *gin.Context) {
+	req := &account.UserOrganizationsRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.UserOrganizations(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
@@ -0,0 +1,207 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/avatar"
+	"github.com/lt90s/rfschub-server/api/config"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/blob"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/project/proto"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	avatarField = "avatar"
+	// max size of images uploaded as avatars
+	avatarMaxSize = 5 << 20
+	// avatar keys change with their content
+	avatarCacheControl = "public, max-age=31536000, immutable"
+)
+
+var blobStore blob.Store
+
+func setupProfileRouter(router *gin.Engine, auth, admin gin.HandlerFunc) {
+	var err error
+	blobStore, err = blob.NewLocalStore(config.DefaultConfig.Blob.Dir)
+	if err != nil {
+		log.Panicf("create blob store failed: dir=%s err=%v", config.DefaultConfig.Blob.Dir, err)
+	}
+
+	router.GET("/account/info/:name", getUserInfo)
+	router.GET("/account/activity/:name", getUserActivity)
+	router.POST("/account/profile", auth, admin, updateProfile)
+	router.POST("/account/avatar", auth, admin, uploadAvatar)
+	router.POST("/account/avatar/remove", auth, admin, removeAvatar)
+	router.GET("/avatar/:key", getAvatar)
+}
+
+func getUserInfo(c *gin.Context) {
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.Profile(context.Background(), &account.ProfileRequest{Name: c.Param("name")})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func getUserActivity(c *gin.Context) {
+	before, err := strconv.ParseInt(c.DefaultQuery("before", "0"), 10, 64)
+	if err != nil || before < 0 {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+	idRsp, err := client.AccountClient.AccountsBasicInfoByNames(ctx, &account.AccountsBasicInfoByNamesRequest{
+		Names: []string{c.Param("name")},
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	if len(idRsp.Infos) == 0 {
+		middlewares.SetError(c, errors.NewNotFoundError(-1, "account not exist"))
+		return
+	}
+
+	rsp, err := client.ProjectClient.UserActivity(ctx, &project.UserActivityRequest{
+		Uid:    idRsp.Infos[0].Id,
+		Before: before,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func updateProfile(c *gin.Context) {
+	var profile account.Profile
+	if err := c.ShouldBindJSON(&profile); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req := &account.UpdateProfileRequest{
+		Uid:     middlewares.GetUserId(c),
+		Profile: &profile,
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.UpdateProfile(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+// uploadAvatar resizes the image of the multipart field avatar and saves it
+// as the avatar of the user, the avatar replaced is removed
+func uploadAvatar(c *gin.Context) {
+	// leave room for the rest of the multipart body
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, avatarMaxSize+64<<10)
+	header, err := c.FormFile(avatarField)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "avatar missing or too large"))
+		return
+	}
+	if header.Size > avatarMaxSize {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "avatar too large"))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		middlewares.SetError(c, errors.NewInternalError(-1, err.Error()))
+		return
+	}
+	defer file.Close()
+
+	data, err := avatar.Process(io.LimitReader(file, avatarMaxSize))
+	if err != nil {
+		if err == avatar.ErrUnsupported || err == avatar.ErrTooLarge {
+			middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		} else {
+			middlewares.SetError(c, errors.NewInternalError(-1, err.Error()))
+		}
+		return
+	}
+
+	uid := middlewares.GetUserId(c)
+	key := avatar.Key(uid, data)
+	if err = blobStore.Put(key, bytes.NewReader(data)); err != nil {
+		log.Errorf("[uploadAvatar] Put error: uid=%s key=%s err=%v", uid, key, err)
+		middlewares.SetError(c, errors.NewInternalError(-1, err.Error()))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.SetAvatar(context.Background(), &account.SetAvatarRequest{Uid: uid, Key: key})
+	if err != nil {
+		_ = blobStore.Delete(key)
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	if rsp.OldKey != key {
+		deleteAvatar(uid, rsp.OldKey)
+	}
+	middlewares.SetData(c, gin.H{
+		"avatar": rsp.Avatar,
+	})
+}
+
+func removeAvatar(c *gin.Context) {
+	uid := middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.SetAvatar(context.Background(), &account.SetAvatarRequest{Uid: uid})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	deleteAvatar(uid, rsp.OldKey)
+	middlewares.SetData(c, gin.H{
+		"avatar": rsp.Avatar,
+	})
+}
+
+func deleteAvatar(uid, key string) {
+	if key == "" {
+		return
+	}
+	if err := blobStore.Delete(key); err != nil {
+		log.Errorf("[deleteAvatar] Delete error: uid=%s key=%s err=%v", uid, key, err)
+	}
+}
+
+func getAvatar(c *gin.Context) {
+	key := c.Param("key")
+	b, err := blobStore.Open(key)
+	if err != nil {
+		if err == blob.ErrNotExist {
+			middlewares.SetError(c, errors.NewNotFoundError(-1, "avatar not exist"))
+		} else {
+			middlewares.SetError(c, errors.NewInternalError(-1, err.Error()))
+		}
+		return
+	}
+	defer b.Close()
+
+	c.Header("Cache-Control", avatarCacheControl)
+	c.Header("X-Content-Type-Options", "nosniff")
+	http.ServeContent(c.Writer, c.Request, key, time.Time{}, b)
+}
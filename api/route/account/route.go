@@ -6,8 +6,35 @@ import (
 )
 
 func SetupAccountRouter(router *gin.Engine) {
-	router.POST("/account/login", middlewares.JWTMiddleware.LoginHandler)
+	auth := middlewares.AuthMiddleware()
+	// account settings can not be changed with access tokens of lower scopes
+	admin := middlewares.RequireScope(middlewares.ScopeAdmin)
+
+	router.POST("/account/login", middlewares.LoginHandler)
+	router.POST("/account/refresh", refreshSession)
+	router.POST("/account/logout", logout)
 	router.POST("/account/register", registerAccount)
-	router.GET("/account/info", middlewares.JWTMiddleware.MiddlewareFunc(), getSelfInfo)
-	router.GET("/account/info/:name", getUserInfo)
+	router.POST("/account/activate", activateAccount)
+	router.POST("/account/activate/resend", resendActivation)
+	router.GET("/account/info", auth, getSelfInfo)
+	router.POST("/account/password/reset/request", requestPasswordReset)
+	router.POST("/account/password/reset", resetPassword)
+	router.POST("/account/password", auth, admin, changePassword)
+	router.POST("/account/email", auth, admin, changeEmail)
+	router.POST("/account/email/confirm", confirmEmail)
+
+	router.GET("/account/tokens", auth, admin, getAccessTokens)
+	router.POST("/account/token", auth, admin, createAccessToken)
+	router.POST("/account/token/revoke", auth, admin, revokeAccessToken)
+
+	router.GET("/account/sessions", auth, admin, getSessions)
+	router.POST("/account/session/revoke", auth, admin, revokeSession)
+	router.POST("/account/sessions/revoke", auth, admin, revokeSessions)
+	router.GET("/account/audit", auth, admin, getAuditEvents)
+
+	setupOAuthRouter(router, auth, admin)
+	setupProfileRouter(router, auth, admin)
+	setupTotpRouter(router, auth, admin)
+	setupOrganizationRouter(router, auth, admin)
+	setupDeletionRouter(router, auth, admin)
 }
@@ -0,0 +1,112 @@
+package account
+
+import (
+	"context"
+	"github.com/appleboy/gin-jwt"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+)
+
+// issue a new jwt token of the session of the refresh token cookie
+func refreshSession(c *gin.Context) {
+	if !middlewares.RefreshSession(c) {
+		middlewares.ClearSession(c)
+		middlewares.SetError(c, errors.NewUnauthorizedError(int(account.ErrorCode_ErrorSessionInvalid), "session revoked or expired"))
+		return
+	}
+	middlewares.SetData(c, jwt.ExtractClaims(c))
+}
+
+// revoke the current session, users are logged out even if it fails
+func logout(c *gin.Context) {
+	uid, sid := middlewares.SessionId(c)
+	middlewares.ClearSession(c)
+	if sid == "" {
+		middlewares.SetData(c, gin.H{})
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.RevokeSession(context.Background(), &account.RevokeSessionRequest{Uid: uid, Id: sid})
+	if err != nil {
+		log.Debugf("[logout] RevokeSession error: uid=%s sid=%s err=%v", uid, sid, err)
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func getSessions(c *gin.Context) {
+	req := &account.SessionsRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.Sessions(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+
+	sid := middlewares.CurrentSessionId(c)
+	for _, session := range rsp.Sessions {
+		session.Current = session.Id == sid
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func revokeSession(c *gin.Context) {
+	var req account.RevokeSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Id == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.RevokeSession(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	if middlewares.CurrentSessionId(c) == req.Id {
+		middlewares.ClearSession(c)
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+// log out all sessions of the user, including the current one
+func revokeSessions(c *gin.Context) {
+	req := &account.RevokeSessionsRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.RevokeSessions(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.ClearSession(c)
+	middlewares.SetData(c, gin.H{})
+}
+
+func getAuditEvents(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req := &account.AuditEventsRequest{
+		Uid:   middlewares.GetUserId(c),
+		Limit: int32(limit),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.AuditEvents(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
@@ -0,0 +1,57 @@
+package account
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+)
+
+func getAccessTokens(c *gin.Context) {
+	req := &account.AccessTokensRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.AccessTokens(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+// the token is in the response only, it can not be got later
+func createAccessToken(c *gin.Context) {
+	var req account.CreateAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" || len(req.Scopes) == 0 {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.CreateAccessToken(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func revokeAccessToken(c *gin.Context) {
+	var req account.RevokeAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Id == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.RevokeAccessToken(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
@@ -0,0 +1,111 @@
+package account
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+)
+
+type totpCodeRequest struct {
+	Code string `json:"code"`
+}
+
+func setupTotpRouter(router *gin.Engine, auth, admin gin.HandlerFunc) {
+	router.POST("/account/login/totp", middlewares.TotpLoginHandler)
+	router.GET("/account/totp", auth, admin, getTotpStatus)
+	router.POST("/account/totp/enroll", auth, admin, enrollTotp)
+	router.POST("/account/totp/confirm", auth, admin, confirmTotp)
+	router.POST("/account/totp/disable", auth, admin, disableTotp)
+	router.POST("/account/totp/recovery-codes", auth, admin, regenerateRecoveryCodes)
+}
+
+func bindTotpCode(c *gin.Context) (string, bool) {
+	var req totpCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Code == "" {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+		return "", false
+	}
+	return req.Code, true
+}
+
+func getTotpStatus(c *gin.Context) {
+	req := &account.TotpStatusRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.TotpStatus(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func enrollTotp(c *gin.Context) {
+	req := &account.EnrollTotpRequest{
+		Uid: middlewares.GetUserId(c),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.EnrollTotp(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func confirmTotp(c *gin.Context) {
+	code, ok := bindTotpCode(c)
+	if !ok {
+		return
+	}
+	req := &account.ConfirmTotpRequest{
+		Uid:  middlewares.GetUserId(c),
+		Code: code,
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.ConfirmTotp(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func disableTotp(c *gin.Context) {
+	code, ok := bindTotpCode(c)
+	if !ok {
+		return
+	}
+	req := &account.DisableTotpRequest{
+		Uid:  middlewares.GetUserId(c),
+		Code: code,
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.DisableTotp(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func regenerateRecoveryCodes(c *gin.Context) {
+	code, ok := bindTotpCode(c)
+	if !ok {
+		return
+	}
+	req := &account.RegenerateRecoveryCodesRequest{
+		Uid:  middlewares.GetUserId(c),
+		Code: code,
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.RegenerateRecoveryCodes(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
@@ -0,0 +1,175 @@
+package admin
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/url"
+	"github.com/lt90s/rfschub-server/gits/proto"
+	"github.com/lt90s/rfschub-server/index/proto"
+	"github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/repository/proto"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+)
+
+func invalidParameters(c *gin.Context) {
+	middlewares.SetError(c, errors.NewBadRequestError(-1, "invalid parameters"))
+}
+
+func listAccounts(c *gin.Context) {
+	skip, err := strconv.Atoi(c.DefaultQuery("skip", "0"))
+	if err != nil {
+		invalidParameters(c)
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		invalidParameters(c)
+		return
+	}
+	req := &account.ListAccountsRequest{
+		Query: c.Query("query"),
+		Skip:  int32(skip),
+		Limit: int32(limit),
+	}
+	client := middlewares.GetClient(c)
+	rsp, err := client.AccountClient.ListAccounts(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func setAccountDisabled(c *gin.Context) {
+	var req account.SetAccountDisabledRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Account == "" {
+		invalidParameters(c)
+		return
+	}
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	_, err := client.AccountClient.SetAccountDisabled(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func deleteProject(c *gin.Context) {
+	var req project.AdminDeleteProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Pid == "" {
+		invalidParameters(c)
+		return
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.ProjectClient.AdminDeleteProject(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	log.Infof("[deleteProject] project deleted by admin: pid=%s admin=%s", req.Pid, middlewares.GetUserId(c))
+	middlewares.SetData(c, gin.H{})
+}
+
+func deleteAnnotation(c *gin.Context) {
+	var req project.AdminDeleteAnnotationRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Id == "" {
+		invalidParameters(c)
+		return
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.ProjectClient.AdminDeleteAnnotation(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	log.Infof("[deleteAnnotation] annotation deleted by admin: id=%s admin=%s", req.Id, middlewares.GetUserId(c))
+	middlewares.SetData(c, gin.H{})
+}
+
+// tasks running in gits, index and repository services. A service which
+// can not be reached is reported in errors and the others are still shown
+func getQueues(c *gin.Context) {
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+	failures := gin.H{}
+
+	var clones []*gits.CloneTask
+	if rsp, err := client.GitClient.ListClones(ctx, &gits.ListClonesRequest{}); err != nil {
+		failures["clone"] = errors.FromError(err).Message
+	} else {
+		clones = rsp.Clones
+	}
+	var indexing []*index.IndexTask
+	if rsp, err := client.IndexClient.ListIndexTasks(ctx, &index.ListIndexTasksRequest{}); err != nil {
+		failures["index"] = errors.FromError(err).Message
+	} else {
+		indexing = rsp.Tasks
+	}
+	var syncing []*repository.SyncTask
+	if rsp, err := client.RepoClient.ListSyncTasks(ctx, &repository.ListSyncTasksRequest{}); err != nil {
+		failures["sync"] = errors.FromError(err).Message
+	} else {
+		syncing = rsp.Tasks
+	}
+
+	middlewares.SetData(c, gin.H{
+		"clone":  clones,
+		"index":  indexing,
+		"sync":   syncing,
+		"errors": failures,
+	})
+}
+
+func recloneRepository(c *gin.Context) {
+	var data struct {
+		Repo string `json:"repo"`
+	}
+	if err := c.ShouldBindJSON(&data); err != nil {
+		invalidParameters(c)
+		return
+	}
+	repo, ok := url.NormalizeRepoUrl(data.Repo)
+	if !ok {
+		invalidParameters(c)
+		return
+	}
+	client := middlewares.GetClient(c)
+	_, err := client.GitClient.Clone(context.Background(), &gits.CloneRequest{Url: repo, Force: true})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	log.Infof("[recloneRepository] reclone by admin: url=%s admin=%s", repo, middlewares.GetUserId(c))
+	middlewares.SetData(c, gin.H{})
+}
+
+func reindexRepository(c *gin.Context) {
+	var data struct {
+		Repo string `json:"repo"`
+		Hash string `json:"hash"`
+	}
+	if err := c.ShouldBindJSON(&data); err != nil || data.Hash == "" {
+		invalidParameters(c)
+		return
+	}
+	repo, ok := url.NormalizeRepoUrl(data.Repo)
+	if !ok {
+		invalidParameters(c)
+		return
+	}
+	client := middlewares.GetClient(c)
+	req := &index.IndexRepositoryRequest{Url: repo, Hash: data.Hash, Force: true}
+	_, err := client.IndexClient.IndexRepository(context.Background(), req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	log.Infof("[reindexRepository] reindex by admin: url=%s hash=%s admin=%s", repo, data.Hash, middlewares.GetUserId(c))
+	middlewares.SetData(c, gin.H{})
+}
@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+)
+
+// SetupAdminRouter sets up routes of accounts with the admin role, access
+// tokens need admin scope to use them
+func SetupAdminRouter(router *gin.Engine) {
+	group := router.Group("/admin",
+		middlewares.AuthMiddleware(),
+		middlewares.RequireScope(middlewares.ScopeAdmin),
+		middlewares.RequireAdmin(),
+	)
+
+	group.GET("accounts", listAccounts)
+	group.POST("account/disable", setAccountDisabled)
+
+	group.POST("project/delete", deleteProject)
+	group.POST("annotation/delete", deleteAnnotation)
+
+	group.GET("queues", getQueues)
+	group.POST("repository/reclone", recloneRepository)
+	group.POST("repository/reindex", reindexRepository)
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/lt90s/rfschub-server/api/client"
 	"github.com/lt90s/rfschub-server/api/middlewares"
 	"github.com/lt90s/rfschub-server/api/route/account"
+	"github.com/lt90s/rfschub-server/api/route/admin"
+	"github.com/lt90s/rfschub-server/api/route/notification"
 	"github.com/lt90s/rfschub-server/api/route/project"
 	"github.com/lt90s/rfschub-server/api/route/repository"
 )
@@ -16,4 +18,6 @@ func SetupRouter(router *gin.Engine) {
 	account.SetupAccountRouter(router)
 	repository.SetupRepositoryRoute(router)
 	project.SetupProjectRouter(router)
+	notification.SetupNotificationRouter(router)
+	admin.SetupAdminRouter(router)
 }
@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/notification/proto"
+	"strconv"
+)
+
+func getInbox(c *gin.Context) {
+	unreadOnly := c.Query("unread") == "true"
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "page must be number"))
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "0"))
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "pageSize must be number"))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	rsp, err := client.NotificationClient.Inbox(context.Background(), &notification.InboxRequest{
+		Uid:        middlewares.GetUserId(c),
+		UnreadOnly: unreadOnly,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func markRead(c *gin.Context) {
+	var req notification.MarkReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.NotificationClient.MarkRead(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func getPreference(c *gin.Context) {
+	client := middlewares.GetClient(c)
+	rsp, err := client.NotificationClient.GetPreference(context.Background(), &notification.GetPreferenceRequest{
+		Uid: middlewares.GetUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	// false values are omitted from json of proto messages
+	middlewares.SetData(c, gin.H{
+		"annotation": rsp.Annotation,
+		"reply":      rsp.Reply,
+		"mention":    rsp.Mention,
+		"digest":     rsp.Digest,
+	})
+}
+
+func setPreference(c *gin.Context) {
+	var preference notification.Preference
+	if err := c.ShouldBindJSON(&preference); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	_, err := client.NotificationClient.SetPreference(context.Background(), &notification.SetPreferenceRequest{
+		Uid:        middlewares.GetUserId(c),
+		Preference: &preference,
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
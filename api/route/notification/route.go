@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+)
+
+func SetupNotificationRouter(router *gin.Engine) {
+	authFunc := middlewares.AuthMiddleware()
+
+	router.GET("/notifications", authFunc, getInbox)
+	router.POST("/notification/read", authFunc, markRead)
+	router.GET("/notification/preference", authFunc, getPreference)
+	router.POST("/notification/preference", authFunc, setPreference)
+}
@@ -19,10 +19,14 @@ import (
 
 func createProject(c *gin.Context) {
 	var data struct {
-		Url    string `json:"url"`
-		Hash   string `json:"hash"`
-		Name   string `json:"name"`
-		Branch bool   `json:"branch"`
+		Url        string             `json:"url"`
+		Hash       string             `json:"hash"`
+		Name       string             `json:"name"`
+		Branch     bool               `json:"branch"`
+		Visibility project.Visibility `json:"visibility"`
+		// name of the organization owning the project, the user owns it if
+		// empty
+		Org string `json:"org"`
 	}
 
 	err := c.ShouldBindJSON(&data)
@@ -51,12 +55,28 @@ func createProject(c *gin.Context) {
 		return
 	}
 
+	var owner string
+	if data.Org != "" {
+		info, err := client.AccountClient.AccountInfoByName(ctx, &account.AccountName{Name: data.Org})
+		if err != nil {
+			middlewares.SetError(c, errors.FromError(err))
+			return
+		}
+		if !info.Organization {
+			middlewares.SetError(c, errors.NewNotFoundError(-1, "organization not exist"))
+			return
+		}
+		owner = info.Id
+	}
+
 	newRsp, err := client.ProjectClient.NewProject(ctx, &project.NewProjectRequest{
-		Uid:    middlewares.GetUserId(c),
-		Url:    data.Url,
-		Hash:   data.Hash,
-		Name:   data.Name,
-		Branch: data.Branch,
+		Uid:        middlewares.GetUserId(c),
+		Url:        data.Url,
+		Hash:       data.Hash,
+		Name:       data.Name,
+		Branch:     data.Branch,
+		Visibility: data.Visibility,
+		Owner:      owner,
 	})
 
 	if err != nil {
@@ -104,7 +124,10 @@ func getUserProjects(c *gin.Context) {
 		return
 	}
 
-	rsp, err := client.ProjectClient.ListProjects(ctx, &project.ListProjectsRequest{Uid: idRsp.Uid})
+	rsp, err := client.ProjectClient.ListProjects(ctx, &project.ListProjectsRequest{
+		Uid:    idRsp.Uid,
+		Viewer: middlewares.ExtractUserId(c),
+	})
 	if err != nil {
 		middlewares.SetError(c, errors.FromError(err))
 		return
@@ -113,18 +136,86 @@ func getUserProjects(c *gin.Context) {
 	middlewares.SetData(c, rsp)
 }
 
+func setProjectVisibility(c *gin.Context) {
+	var req project.SetProjectVisibilityRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	_, err = client.ProjectClient.SetProjectVisibility(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, gin.H{})
+	}
+}
+
+func rebaseProject(c *gin.Context) {
+	var req project.RebaseProjectRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	rsp, err := client.ProjectClient.RebaseProject(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, rsp)
+	}
+}
+
+func setProjectIgnore(c *gin.Context) {
+	var req project.SetProjectIgnoreRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	_, err = client.ProjectClient.SetProjectIgnore(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, gin.H{})
+	}
+}
+
 func searchSymbol(c *gin.Context) {
 	client := middlewares.GetClient(c)
 	ctx := context.Background()
 
-	repo := c.Query("repo")
-	hash := c.Query("hash")
 	name := c.Query("name")
 
+	access, err := client.ProjectClient.ProjectAccess(ctx, &project.ProjectAccessRequest{
+		Pid: c.Query("pid"),
+		Uid: middlewares.ExtractUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+
 	rsp, err := client.IndexClient.SearchSymbol(ctx, &index.SearchSymbolRequest{
-		Url:    repo,
-		Hash:   hash,
+		Url:    access.Url,
+		Hash:   access.Hash,
 		Symbol: name,
+		Ignore: access.Ignore,
 	})
 
 	if err != nil {
@@ -135,6 +226,30 @@ func searchSymbol(c *gin.Context) {
 	middlewares.SetData(c, rsp)
 }
 
+func getLanguageStats(c *gin.Context) {
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	access, err := client.ProjectClient.ProjectAccess(ctx, &project.ProjectAccessRequest{
+		Pid: c.Query("pid"),
+		Uid: middlewares.ExtractUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+
+	rsp, err := client.IndexClient.LanguageStats(ctx, &index.LanguageStatsRequest{
+		Url:  access.Url,
+		Hash: access.Hash,
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
 func addAnnotation(c *gin.Context) {
 	var req project.AddAnnotationRequest
 	err := c.ShouldBindJSON(&req)
@@ -156,6 +271,101 @@ func addAnnotation(c *gin.Context) {
 	}
 }
 
+func updateAnnotation(c *gin.Context) {
+	var req project.UpdateAnnotationRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	_, err = client.ProjectClient.UpdateAnnotation(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, gin.H{})
+	}
+}
+
+func deleteAnnotation(c *gin.Context) {
+	var req project.DeleteAnnotationRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	_, err = client.ProjectClient.DeleteAnnotation(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, gin.H{})
+	}
+}
+
+func resolveAnnotation(c *gin.Context) {
+	var req project.ResolveAnnotationRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	_, err = client.ProjectClient.ResolveAnnotation(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, gin.H{})
+	}
+}
+
+func reactAnnotation(c *gin.Context) {
+	var req project.ReactAnnotationRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	_, err = client.ProjectClient.ReactAnnotation(ctx, &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+	} else {
+		middlewares.SetData(c, gin.H{})
+	}
+}
+
+func getAnnotationRevisions(c *gin.Context) {
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	rsp, err := client.ProjectClient.GetAnnotationRevisions(ctx, &project.GetAnnotationRevisionsRequest{
+		Id:  c.Query("id"),
+		Uid: middlewares.ExtractUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
 func getAnnotationLines(c *gin.Context) {
 	pid := c.Query("pid")
 	file := c.Query("file")
@@ -166,6 +376,7 @@ func getAnnotationLines(c *gin.Context) {
 	rsp, err := client.ProjectClient.GetAnnotationLines(ctx, &project.GetAnnotationLinesRequest{
 		Pid:  pid,
 		File: file,
+		Uid:  middlewares.ExtractUserId(c),
 	})
 	if err != nil {
 		middlewares.SetError(c, errors.FromError(err))
@@ -178,12 +389,23 @@ func getAnnotations(c *gin.Context) {
 	pid := c.Query("pid")
 	file := c.Query("file")
 	lineNumber := c.Query("line")
+	outdated := c.Query("outdated") == "true"
 
 	line, err := strconv.Atoi(lineNumber)
-	if err != nil {
+	if err != nil && !outdated {
 		middlewares.SetError(c, errors.NewBadRequestError(-1, "line must be number"))
 		return
 	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "page must be number"))
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "0"))
+	if err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "pageSize must be number"))
+		return
+	}
 
 	client := middlewares.GetClient(c)
 	ctx := context.Background()
@@ -192,6 +414,10 @@ func getAnnotations(c *gin.Context) {
 		Pid:        pid,
 		File:       file,
 		LineNumber: int32(line),
+		Uid:        middlewares.ExtractUserId(c),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		Outdated:   outdated,
 	})
 	if err != nil {
 		middlewares.SetError(c, errors.FromError(err))
@@ -211,6 +437,7 @@ func getLatestAnnotations(c *gin.Context) {
 	rsp, err := client.ProjectClient.GetLatestAnnotations(ctx, &project.GetLatestAnnotationsRequest{
 		Pid:    pid,
 		Parent: parent,
+		Uid:    middlewares.ExtractUserId(c),
 	})
 
 	if err != nil {
@@ -252,7 +479,9 @@ func getProjectDirectory(c *gin.Context) {
 	client := middlewares.GetClient(c)
 	ctx := context.Background()
 
-	info, err := doGetProjectInfo(ctx, client, "", user, repo, name)
+	uid := middlewares.ExtractUserId(c)
+
+	info, err := doGetProjectInfo(ctx, client, uid, user, repo, name)
 	if err != nil {
 		log.Warnf("doGetProjectInfo error: user=%s repo=%s name=%s path=%s error=%v", user, repo, name, path, err)
 		middlewares.SetError(c, errors.FromError(err))
@@ -263,6 +492,7 @@ func getProjectDirectory(c *gin.Context) {
 	aRsp, err := client.ProjectClient.GetLatestAnnotations(ctx, &project.GetLatestAnnotationsRequest{
 		Pid:    info.Id,
 		Parent: path,
+		Uid:    uid,
 	})
 
 	if err != nil {
@@ -311,6 +541,7 @@ func getProjectBlob(c *gin.Context) {
 	lRsp, err := client.ProjectClient.GetAnnotationLines(ctx, &project.GetAnnotationLinesRequest{
 		Pid:  info.Id,
 		File: file,
+		Uid:  uid,
 	})
 	if err != nil {
 		middlewares.SetError(c, errors.FromError(err))
@@ -0,0 +1,247 @@
+package project
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/api/middlewares"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/project/proto"
+)
+
+func inviteMember(c *gin.Context) {
+	var data struct {
+		Pid      string       `json:"pid"`
+		Username string       `json:"username"`
+		Role     project.Role `json:"role"`
+	}
+	if err := c.ShouldBindJSON(&data); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	client := middlewares.GetClient(c)
+	ctx := context.Background()
+
+	info, err := client.AccountClient.AccountInfoByName(ctx, &account.AccountName{Name: data.Username})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	// members of organizations join through the organization
+	if info.Organization {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, "organizations can not be members"))
+		return
+	}
+
+	rsp, err := client.ProjectClient.InviteMember(ctx, &project.InviteMemberRequest{
+		Pid:     data.Pid,
+		Uid:     middlewares.GetUserId(c),
+		Invitee: info.Id,
+		Role:    data.Role,
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func createInviteLink(c *gin.Context) {
+	var req project.CreateInviteLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.CreateInviteLink(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func listInviteLinks(c *gin.Context) {
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.ListInviteLinks(context.Background(), &project.ListInviteLinksRequest{
+		Pid: c.Query("pid"),
+		Uid: middlewares.GetUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func revokeInviteLink(c *gin.Context) {
+	var req project.RevokeInviteLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.ProjectClient.RevokeInviteLink(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func joinByLink(c *gin.Context) {
+	var req project.JoinByLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.JoinByLink(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func listInvitations(c *gin.Context) {
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.ListInvitations(context.Background(), &project.ListInvitationsRequest{
+		Uid: middlewares.GetUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func replyInvitation(c *gin.Context) {
+	var req project.ReplyInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.ProjectClient.ReplyInvitation(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func requestJoin(c *gin.Context) {
+	var req project.RequestJoinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.RequestJoin(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func listJoinRequests(c *gin.Context) {
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.ListJoinRequests(context.Background(), &project.ListJoinRequestsRequest{
+		Pid: c.Query("pid"),
+		Uid: middlewares.GetUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func reviewJoinRequest(c *gin.Context) {
+	var req project.ReviewJoinRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.ProjectClient.ReviewJoinRequest(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func listMembers(c *gin.Context) {
+	client := middlewares.GetClient(c)
+
+	rsp, err := client.ProjectClient.ListMembers(context.Background(), &project.ListMembersRequest{
+		Pid: c.Query("pid"),
+		Uid: middlewares.ExtractUserId(c),
+	})
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, rsp)
+}
+
+func removeMember(c *gin.Context) {
+	var req project.RemoveMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.ProjectClient.RemoveMember(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
+
+func setMemberRole(c *gin.Context) {
+	var req project.SetMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.SetError(c, errors.NewBadRequestError(-1, err.Error()))
+		return
+	}
+
+	req.Uid = middlewares.GetUserId(c)
+	client := middlewares.GetClient(c)
+
+	_, err := client.ProjectClient.SetMemberRole(context.Background(), &req)
+	if err != nil {
+		middlewares.SetError(c, errors.FromError(err))
+		return
+	}
+	middlewares.SetData(c, gin.H{})
+}
@@ -6,17 +6,40 @@ import (
 )
 
 func SetupProjectRouter(router *gin.Engine) {
-	authFunc := middlewares.JWTMiddleware.MiddlewareFunc()
+	authFunc := middlewares.AuthMiddleware()
 
 	router.POST("/project", authFunc, createProject)
 	router.GET("/project", getProjectInfo)
 	router.GET("/project/list", getUserProjects)
+	router.POST("/project/ignore", authFunc, setProjectIgnore)
+	router.POST("/project/visibility", authFunc, setProjectVisibility)
+	router.POST("/project/rebase", authFunc, rebaseProject)
 	router.GET("/project/symbol", searchSymbol)
+	router.GET("/project/languages", getLanguageStats)
 	router.POST("/project/annotation", authFunc, addAnnotation)
+	router.POST("/project/annotation/update", authFunc, updateAnnotation)
+	router.POST("/project/annotation/delete", authFunc, deleteAnnotation)
+	router.POST("/project/annotation/resolve", authFunc, resolveAnnotation)
+	router.POST("/project/annotation/react", authFunc, reactAnnotation)
+	router.GET("/project/annotation/revisions", getAnnotationRevisions)
 	router.GET("/project/annotation/lines", getAnnotationLines)
 	router.GET("/project/annotations", getAnnotations)
 	router.GET("/project/annotation/latest", getLatestAnnotations)
 
+	router.GET("/project/members", listMembers)
+	router.POST("/project/member/invite", authFunc, inviteMember)
+	router.POST("/project/member/link", authFunc, createInviteLink)
+	router.GET("/project/member/links", authFunc, listInviteLinks)
+	router.POST("/project/member/link/revoke", authFunc, revokeInviteLink)
+	router.POST("/project/member/join", authFunc, joinByLink)
+	router.POST("/project/member/request", authFunc, requestJoin)
+	router.GET("/project/member/requests", authFunc, listJoinRequests)
+	router.POST("/project/member/request/review", authFunc, reviewJoinRequest)
+	router.POST("/project/member/remove", authFunc, removeMember)
+	router.POST("/project/member/role", authFunc, setMemberRole)
+	router.GET("/project/invitations", authFunc, listInvitations)
+	router.POST("/project/invitation/reply", authFunc, replyInvitation)
+
 	router.GET("/project/directory", getProjectDirectory)
 	router.GET("/project/blob", getProjectBlob)
 }
@@ -6,7 +6,7 @@ import (
 )
 
 func SetupRepositoryRoute(router *gin.Engine) {
-	auth := middlewares.JWTMiddleware.MiddlewareFunc()
+	auth := middlewares.AuthMiddleware()
 
 	group := router.Group("/repository")
 
@@ -0,0 +1,48 @@
+package signing
+
+import (
+	"errors"
+	"golang.org/x/crypto/ed25519"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+)
+
+// SigningMethodEdDSA signs tokens with Ed25519 keys, which jwt-go does not
+// support
+var SigningMethodEdDSA = &signingMethodEdDSA{}
+
+var ErrEdDSAVerification = errors.New("ed25519: verification error")
+
+type signingMethodEdDSA struct{}
+
+func init() {
+	jwtGo.RegisterSigningMethod(AlgorithmEdDSA, func() jwtGo.SigningMethod {
+		return SigningMethodEdDSA
+	})
+}
+
+func (m *signingMethodEdDSA) Alg() string {
+	return AlgorithmEdDSA
+}
+
+func (m *signingMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok || len(privateKey) != ed25519.PrivateKeySize {
+		return "", jwtGo.ErrInvalidKeyType
+	}
+	return jwtGo.EncodeSegment(ed25519.Sign(privateKey, []byte(signingString))), nil
+}
+
+func (m *signingMethodEdDSA) Verify(signingString, signature string, key interface{}) error {
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok || len(publicKey) != ed25519.PublicKeySize {
+		return jwtGo.ErrInvalidKeyType
+	}
+	sig, err := jwtGo.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(publicKey, []byte(signingString), sig) {
+		return ErrEdDSAVerification
+	}
+	return nil
+}
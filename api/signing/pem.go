@@ -0,0 +1,114 @@
+package signing
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ed25519"
+)
+
+var oidEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+
+// PKCS #8 private key, x509 of go 1.12 does not parse Ed25519 keys
+type pkcs8 struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// PKIX public key
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// parse a PEM encoded key of algorithm, signKey is nil for public keys
+func parsePEM(algorithm string, material []byte) (signKey, verifyKey interface{}, err error) {
+	block, _ := pem.Decode(material)
+	if block == nil {
+		return nil, nil, errors.New("no PEM data")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if algorithm != AlgorithmRS256 {
+			break
+		}
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return rsaKeys(key)
+	case "PRIVATE KEY":
+		var info pkcs8
+		if _, err := asn1.Unmarshal(block.Bytes, &info); err != nil {
+			return nil, nil, err
+		}
+		if info.Algorithm.Algorithm.Equal(oidEd25519) {
+			if algorithm != AlgorithmEdDSA {
+				break
+			}
+			var seed []byte
+			if _, err := asn1.Unmarshal(info.PrivateKey, &seed); err != nil {
+				return nil, nil, err
+			}
+			if len(seed) != ed25519.SeedSize {
+				return nil, nil, errors.New("invalid Ed25519 private key")
+			}
+			key := ed25519.NewKeyFromSeed(seed)
+			return key, key.Public().(ed25519.PublicKey), nil
+		}
+		if algorithm != AlgorithmRS256 {
+			break
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			break
+		}
+		return rsaKeys(rsaKey)
+	case "PUBLIC KEY":
+		var info publicKeyInfo
+		if _, err := asn1.Unmarshal(block.Bytes, &info); err != nil {
+			return nil, nil, err
+		}
+		if info.Algorithm.Algorithm.Equal(oidEd25519) {
+			if algorithm != AlgorithmEdDSA || len(info.PublicKey.Bytes) != ed25519.PublicKeySize {
+				break
+			}
+			return nil, ed25519.PublicKey(info.PublicKey.Bytes), nil
+		}
+		if algorithm != AlgorithmRS256 {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			break
+		}
+		if rsaKey.N.BitLen() < minRSABits {
+			return nil, nil, fmt.Errorf("RSA key shorter than %d bits", minRSABits)
+		}
+		return nil, rsaKey, nil
+	default:
+		return nil, nil, fmt.Errorf("PEM type %s not supported", block.Type)
+	}
+	return nil, nil, fmt.Errorf("key is not a %s key", algorithm)
+}
+
+func rsaKeys(key *rsa.PrivateKey) (interface{}, interface{}, error) {
+	if key.N.BitLen() < minRSABits {
+		return nil, nil, fmt.Errorf("RSA key shorter than %d bits", minRSABits)
+	}
+	return key, &key.PublicKey, nil
+}
@@ -0,0 +1,235 @@
+// Package signing signs and verifies jwt tokens with a set of keys. Keys
+// are identified by the kid header of tokens and rotate on a schedule: the
+// latest key active signs new tokens, and keys replaced keep verifying
+// tokens until the ones they signed expire.
+package signing
+
+import (
+	"errors"
+	"fmt"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	// clocks of servers sharing keys may differ this much
+	clockSkew = time.Minute
+	// min length of HS256 secrets
+	minSecretSize = 32
+	// min size of RS256 keys in bits
+	minRSABits = 2048
+)
+
+var (
+	ErrNoSigningKey = errors.New("no signing key active")
+	ErrUnknownKey   = errors.New("token signed by an unknown or retired key")
+)
+
+// KeyConfig is a key and its schedule. Key material is read from File, or
+// the environment variable Env, or Secret. HS256 keys are raw secrets, RS256
+// and EdDSA keys are PEM encoded private keys, or public keys which verify
+// tokens only.
+type KeyConfig struct {
+	Id        string `json:"id"`
+	Algorithm string `json:"algorithm"`
+	File      string `json:"file"`
+	Env       string `json:"env"`
+	Secret    string `json:"secret"`
+	// time the key starts signing tokens in RFC 3339, like
+	// "2019-06-01T00:00:00Z", empty if it is active from the start
+	ActiveFrom string `json:"activeFrom"`
+}
+
+// Key is a key to sign and verify tokens, SignKey is nil for verifying only
+// keys
+type Key struct {
+	Id         string
+	Algorithm  string
+	ActiveFrom time.Time
+	SignKey    interface{}
+	VerifyKey  interface{}
+}
+
+// KeySet signs tokens with the key active and verifies tokens with the keys
+// not retired
+type KeySet struct {
+	// sorted by ActiveFrom
+	keys []*Key
+	// lifetime of tokens, keys replaced retire after it
+	lifetime time.Duration
+	now      func() time.Time
+}
+
+// LoadKey reads key material of conf
+func LoadKey(conf KeyConfig) (*Key, error) {
+	if conf.Id == "" {
+		return nil, errors.New("key id missing")
+	}
+	key := &Key{
+		Id:        conf.Id,
+		Algorithm: conf.Algorithm,
+	}
+	if conf.ActiveFrom != "" {
+		t, err := time.Parse(time.RFC3339, conf.ActiveFrom)
+		if err != nil {
+			return nil, fmt.Errorf("activeFrom of key %s invalid: %v", conf.Id, err)
+		}
+		key.ActiveFrom = t
+	}
+
+	material, err := keyMaterial(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	switch conf.Algorithm {
+	case AlgorithmHS256:
+		secret := []byte(strings.TrimSpace(string(material)))
+		if len(secret) < minSecretSize {
+			return nil, fmt.Errorf("secret of key %s shorter than %d bytes", conf.Id, minSecretSize)
+		}
+		key.SignKey, key.VerifyKey = secret, secret
+	case AlgorithmRS256, AlgorithmEdDSA:
+		key.SignKey, key.VerifyKey, err = parsePEM(conf.Algorithm, material)
+		if err != nil {
+			return nil, fmt.Errorf("key %s invalid: %v", conf.Id, err)
+		}
+	default:
+		return nil, fmt.Errorf("algorithm %q of key %s not supported", conf.Algorithm, conf.Id)
+	}
+	return key, nil
+}
+
+func keyMaterial(conf KeyConfig) ([]byte, error) {
+	switch {
+	case conf.File != "":
+		material, err := ioutil.ReadFile(conf.File)
+		if err != nil {
+			return nil, fmt.Errorf("read key %s failed: %v", conf.Id, err)
+		}
+		return material, nil
+	case conf.Env != "":
+		material := os.Getenv(conf.Env)
+		if material == "" {
+			return nil, fmt.Errorf("environment variable %s of key %s is empty", conf.Env, conf.Id)
+		}
+		return []byte(material), nil
+	case conf.Secret != "":
+		return []byte(conf.Secret), nil
+	}
+	return nil, fmt.Errorf("material of key %s missing", conf.Id)
+}
+
+// LoadKeys reads key material of confs
+func LoadKeys(confs []KeyConfig) ([]*Key, error) {
+	keys := make([]*Key, 0, len(confs))
+	for _, conf := range confs {
+		key, err := LoadKey(conf)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// NewKeySet creates a key set of keys, tokens it signs expire after lifetime
+func NewKeySet(keys []*Key, lifetime time.Duration) (*KeySet, error) {
+	ids := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := ids[key.Id]; ok {
+			return nil, fmt.Errorf("duplicate key id %s", key.Id)
+		}
+		ids[key.Id] = struct{}{}
+	}
+
+	sorted := make([]*Key, len(keys))
+	copy(sorted, keys)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActiveFrom.Before(sorted[j].ActiveFrom)
+	})
+	s := &KeySet{
+		keys:     sorted,
+		lifetime: lifetime,
+		now:      time.Now,
+	}
+	if s.signer(s.now()) == nil {
+		return nil, ErrNoSigningKey
+	}
+	return s, nil
+}
+
+// Keys returns keys of the set
+func (s *KeySet) Keys() []*Key {
+	return s.keys
+}
+
+// the latest key active which can sign
+func (s *KeySet) signer(now time.Time) *Key {
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		key := s.keys[i]
+		if key.SignKey != nil && !key.ActiveFrom.After(now) {
+			return key
+		}
+	}
+	return nil
+}
+
+// key kid if it may have signed tokens not expired at now
+func (s *KeySet) verifier(kid string, now time.Time) *Key {
+	for i, key := range s.keys {
+		if key.Id != kid {
+			continue
+		}
+		if key.ActiveFrom.After(now.Add(clockSkew)) {
+			return nil
+		}
+		// a key is replaced when the next signing key is active
+		for _, next := range s.keys[i+1:] {
+			if next.SignKey != nil && !next.ActiveFrom.After(now) {
+				if now.After(next.ActiveFrom.Add(s.lifetime + clockSkew)) {
+					return nil
+				}
+				break
+			}
+		}
+		return key
+	}
+	return nil
+}
+
+// Sign signs claims with the key active
+func (s *KeySet) Sign(claims jwtGo.MapClaims) (string, error) {
+	key := s.signer(s.now())
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+	token := jwtGo.NewWithClaims(jwtGo.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.Id
+	return token.SignedString(key.SignKey)
+}
+
+// Parse verifies token with the key of its kid header and returns it. Like
+// jwtGo.Parse, the token is returned with a *jwtGo.ValidationError if only
+// its claims are invalid.
+func (s *KeySet) Parse(token string) (*jwtGo.Token, error) {
+	return jwtGo.Parse(token, func(t *jwtGo.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key := s.verifier(kid, s.now())
+		if key == nil {
+			return nil, ErrUnknownKey
+		}
+		if t.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("token of key %s signed by %s", kid, t.Method.Alg())
+		}
+		return key.VerifyKey, nil
+	})
+}
@@ -0,0 +1,160 @@
+package signing
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+	jwtGo "gopkg.in/dgrijalva/jwt-go.v3"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func rsaPEM(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func ed25519PEM(t *testing.T) (private, public string) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	seed, err := asn1.Marshal(priv.Seed())
+	require.NoError(t, err)
+	der, err := asn1.Marshal(pkcs8{Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidEd25519}, PrivateKey: seed})
+	require.NoError(t, err)
+	private = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	der, err = asn1.Marshal(publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidEd25519},
+		PublicKey: asn1.BitString{Bytes: pub, BitLength: len(pub) * 8},
+	})
+	require.NoError(t, err)
+	public = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	return
+}
+
+func TestLoadKey(t *testing.T) {
+	_, err := LoadKey(KeyConfig{Id: "a", Algorithm: AlgorithmHS256, Secret: "short"})
+	require.Error(t, err)
+
+	_, err = LoadKey(KeyConfig{Id: "a", Algorithm: "none", Secret: testSecret})
+	require.Error(t, err)
+
+	_, err = LoadKey(KeyConfig{Id: "a", Algorithm: AlgorithmRS256, Secret: testSecret})
+	require.Error(t, err)
+
+	os.Setenv("SIGNING_TEST_KEY", rsaPEM(t))
+	defer os.Unsetenv("SIGNING_TEST_KEY")
+	key, err := LoadKey(KeyConfig{Id: "a", Algorithm: AlgorithmRS256, Env: "SIGNING_TEST_KEY"})
+	require.NoError(t, err)
+	require.IsType(t, &rsa.PrivateKey{}, key.SignKey)
+	require.IsType(t, &rsa.PublicKey{}, key.VerifyKey)
+
+	// an RSA key is not an Ed25519 key
+	_, err = LoadKey(KeyConfig{Id: "a", Algorithm: AlgorithmEdDSA, Env: "SIGNING_TEST_KEY"})
+	require.Error(t, err)
+}
+
+func TestKeySet_SignParse(t *testing.T) {
+	private, public := ed25519PEM(t)
+	confs := []KeyConfig{
+		{Id: "hs", Algorithm: AlgorithmHS256, Secret: testSecret},
+		{Id: "rs", Algorithm: AlgorithmRS256, Secret: rsaPEM(t)},
+		{Id: "ed", Algorithm: AlgorithmEdDSA, Secret: private},
+	}
+	for _, conf := range confs {
+		key, err := LoadKey(conf)
+		require.NoError(t, err)
+		s, err := NewKeySet([]*Key{key}, time.Minute)
+		require.NoError(t, err)
+
+		signed, err := s.Sign(jwtGo.MapClaims{"id": "foo"})
+		require.NoError(t, err)
+		token, err := s.Parse(signed)
+		require.NoError(t, err)
+		require.Equal(t, conf.Id, token.Header["kid"])
+		require.Equal(t, conf.Algorithm, token.Method.Alg())
+		require.Equal(t, "foo", token.Claims.(jwtGo.MapClaims)["id"])
+	}
+
+	// public keys only verify
+	key, err := LoadKey(KeyConfig{Id: "ed", Algorithm: AlgorithmEdDSA, Secret: public})
+	require.NoError(t, err)
+	require.Nil(t, key.SignKey)
+	_, err = NewKeySet([]*Key{key}, time.Minute)
+	require.Equal(t, ErrNoSigningKey, err)
+
+	signer, err := LoadKey(confs[2])
+	require.NoError(t, err)
+	s, err := NewKeySet([]*Key{signer}, time.Minute)
+	require.NoError(t, err)
+	signed, err := s.Sign(jwtGo.MapClaims{"id": "foo"})
+	require.NoError(t, err)
+
+	// the key replaced by hs just now verifies tokens it signed
+	hs, err := LoadKey(KeyConfig{Id: "hs", Algorithm: AlgorithmHS256, Secret: testSecret, ActiveFrom: time.Now().Format(time.RFC3339)})
+	require.NoError(t, err)
+	verifier, err := NewKeySet([]*Key{key, hs}, time.Minute)
+	require.NoError(t, err)
+	_, err = verifier.Parse(signed)
+	require.NoError(t, err)
+
+	// tokens must use the algorithm of their key
+	forged := jwtGo.NewWithClaims(jwtGo.SigningMethodHS256, jwtGo.MapClaims{"id": "foo"})
+	forged.Header["kid"] = "ed"
+	forgedString, err := forged.SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	_, err = verifier.Parse(forgedString)
+	require.Error(t, err)
+
+	// tokens without kid are rejected
+	forged = jwtGo.NewWithClaims(jwtGo.SigningMethodHS256, jwtGo.MapClaims{"id": "foo"})
+	forgedString, err = forged.SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	_, err = verifier.Parse(forgedString)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), ErrUnknownKey.Error()))
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	start := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	old, err := LoadKey(KeyConfig{Id: "old", Algorithm: AlgorithmHS256, Secret: testSecret})
+	require.NoError(t, err)
+	next, err := LoadKey(KeyConfig{Id: "next", Algorithm: AlgorithmHS256, Secret: testSecret + "next", ActiveFrom: start.Format(time.RFC3339)})
+	require.NoError(t, err)
+
+	lifetime := 15 * time.Minute
+	s, err := NewKeySet([]*Key{next, old}, lifetime)
+	require.NoError(t, err)
+
+	require.Equal(t, old, s.signer(start.Add(-time.Second)))
+	require.Equal(t, next, s.signer(start))
+
+	// keys not active yet verify tokens of servers with clocks ahead
+	require.Nil(t, s.verifier("next", start.Add(-time.Hour)))
+	require.Equal(t, next, s.verifier("next", start.Add(-time.Second)))
+
+	// old keys verify tokens until they expire
+	require.Equal(t, old, s.verifier("old", start.Add(lifetime)))
+	require.Nil(t, s.verifier("old", start.Add(lifetime+clockSkew+time.Second)))
+	require.Nil(t, s.verifier("unknown", start))
+
+	s.now = func() time.Time { return start.Add(-time.Second) }
+	signed, err := s.Sign(jwtGo.MapClaims{})
+	require.NoError(t, err)
+	s.now = func() time.Time { return start.Add(time.Minute) }
+	_, err = s.Parse(signed)
+	require.NoError(t, err)
+	s.now = func() time.Time { return start.Add(time.Hour) }
+	_, err = s.Parse(signed)
+	require.Error(t, err)
+}
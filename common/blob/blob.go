@@ -0,0 +1,110 @@
+// Package blob stores files uploaded by users, like avatars, by key
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var (
+	ErrNotExist   = errors.New("blob not exist")
+	ErrInvalidKey = errors.New("blob key invalid")
+)
+
+// keys are file names, they can not refer to other directories
+var keyRegexp = regexp.MustCompile("^[a-zA-Z0-9_-][a-zA-Z0-9_.-]{0,127}$")
+
+type Blob interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+type Store interface {
+	// save content of r as key, a blob of the same key is replaced
+	Put(key string, r io.Reader) error
+	// ErrNotExist is returned if there is no blob of key
+	Open(key string) (Blob, error)
+	// removing a blob not exist is not an error
+	Delete(key string) error
+}
+
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore creates a store saving blobs as files in dir
+func NewLocalStore(dir string) (Store, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("blob directory missing")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &localStore{dir: dir}, nil
+}
+
+func (s *localStore) path(key string) (string, error) {
+	if !keyRegexp.MatchString(key) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+// Put writes a temporary file and renames it, readers never see a blob
+// partially written
+func (s *localStore) Put(key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(s.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (s *localStore) Open(key string) (Blob, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, ErrNotExist
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *localStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
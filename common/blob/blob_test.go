@@ -0,0 +1,46 @@
+package blob
+
+import (
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blob")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewLocalStore(dir)
+	require.NoError(t, err)
+
+	_, err = s.Open("foo.png")
+	require.Equal(t, ErrNotExist, err)
+
+	require.NoError(t, s.Put("foo.png", strings.NewReader("hello")))
+	require.NoError(t, s.Put("foo.png", strings.NewReader("world")))
+	b, err := s.Open("foo.png")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(b)
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+	require.Equal(t, "world", string(data))
+
+	// temporary files are renamed or removed
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	require.NoError(t, s.Delete("foo.png"))
+	require.NoError(t, s.Delete("foo.png"))
+	_, err = s.Open("foo.png")
+	require.Equal(t, ErrNotExist, err)
+
+	for _, key := range []string{"", "../foo", "a/b", ".hidden", "..", strings.Repeat("a", 129)} {
+		require.Equal(t, ErrInvalidKey, s.Put(key, strings.NewReader("x")), key)
+		_, err = s.Open(key)
+		require.Equal(t, ErrNotExist, err, key)
+	}
+}
@@ -49,6 +49,20 @@ func NewUnauthorizedError(code int, message string) error {
 	return New(code, http.StatusUnauthorized, message)
 }
 
+func NewForbiddenError(code int, message string) error {
+	if code == -1 {
+		code = http.StatusForbidden
+	}
+	return New(code, http.StatusForbidden, message)
+}
+
+func NewTooManyRequestsError(code int, message string) error {
+	if code == -1 {
+		code = http.StatusTooManyRequests
+	}
+	return New(code, http.StatusTooManyRequests, message)
+}
+
 func NewInternalError(code int, message string) error {
 	if code == -1 {
 		code = http.StatusInternalServerError
@@ -0,0 +1,70 @@
+package glob
+
+import (
+	"path"
+	"strings"
+)
+
+// Match reports whether the slash separated file name matches pattern,
+// following .gitignore rules:
+//   - a pattern without slash, except a trailing one, matches at any level
+//   - a leading slash anchors the pattern to the root
+//   - a trailing slash only matches directories, i.e. files under it
+//   - "**" matches zero or more directories
+//   - a pattern matching a directory matches all files under it
+func Match(pattern, name string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return false
+	}
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	if strings.HasPrefix(pattern, "/") {
+		pattern = strings.TrimPrefix(pattern, "/")
+	} else if !strings.Contains(pattern, "/") {
+		pattern = "**/" + pattern
+	}
+
+	ps := strings.Split(pattern, "/")
+	ns := strings.Split(strings.Trim(name, "/"), "/")
+	if !dirOnly && matchSegments(ps, ns) {
+		return true
+	}
+	// one of the parent directories matches
+	return len(ns) > 1 && matchSegments(append(ps, "**"), ns[:len(ns)-1])
+}
+
+// MatchAny reports whether name matches any of patterns
+func MatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(ps, ns []string) bool {
+	for len(ps) > 0 {
+		if ps[0] == "**" {
+			ps = ps[1:]
+			if len(ps) == 0 {
+				return true
+			}
+			for i := 0; i <= len(ns); i++ {
+				if matchSegments(ps, ns[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(ns) == 0 {
+			return false
+		}
+		if ok, err := path.Match(ps[0], ns[0]); err != nil || !ok {
+			return false
+		}
+		ps, ns = ps[1:], ns[1:]
+	}
+	return len(ns) == 0
+}
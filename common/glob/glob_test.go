@@ -0,0 +1,32 @@
+package glob
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.pb.go", "index/proto/index.pb.go", true},
+		{"*.pb.go", "index/proto/index.go", false},
+		{"vendor/", "vendor/github.com/a/b.go", true},
+		{"vendor/", "a/vendor/b.go", true},
+		{"vendor/", "vendor", false},
+		{"vendor", "vendor", true},
+		{"/vendor", "a/vendor/b.go", false},
+		{"/vendor", "vendor/b.go", true},
+		{"docs/*.md", "docs/a.md", true},
+		{"docs/*.md", "x/docs/a.md", false},
+		{"docs/**/*.md", "docs/a/b/c.md", true},
+		{"**/testdata/**", "a/b/testdata/c.txt", true},
+		{"*.min.js", "static/app.min.js", true},
+		{"", "a.go", false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.match, Match(c.pattern, c.name), "pattern=%s name=%s", c.pattern, c.name)
+	}
+}
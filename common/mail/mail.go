@@ -0,0 +1,146 @@
+// Package mail sends emails of services. Messages are rendered from
+// templates and delivered through a Transport: SMTP in production, or a
+// file or stdout transport in development and tests. Queue wraps a
+// transport to send in background and retry failed deliveries.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	// plain text body, required
+	Text string
+	// optional html alternative of Text
+	HTML string
+}
+
+type Transport interface {
+	Send(ctx context.Context, message Message) error
+}
+
+type Config struct {
+	// smtp, file or stdout
+	Transport string `json:"transport"`
+	// sender address, like "rfschub <noreply@example.com>"
+	From string `json:"from"`
+	// directory file transport writes mails to
+	Dir  string     `json:"dir"`
+	Smtp SmtpConfig `json:"smtp"`
+}
+
+type SmtpConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+var (
+	ErrInvalidAddress = errors.New("invalid mail address")
+	ErrEmptyMessage   = errors.New("mail has no subject or text")
+)
+
+// New creates the transport of conf
+func New(conf Config) (Transport, error) {
+	if _, err := mail.ParseAddress(conf.From); err != nil {
+		return nil, fmt.Errorf("invalid mail sender %q: %v", conf.From, err)
+	}
+	switch conf.Transport {
+	case "smtp":
+		return newSmtpTransport(conf)
+	case "file":
+		return newFileTransport(conf)
+	case "stdout", "":
+		return newStdoutTransport(conf), nil
+	}
+	return nil, fmt.Errorf("unknown mail transport %q", conf.Transport)
+}
+
+func (m Message) validate() error {
+	if strings.ContainsAny(m.To, "\r\n") {
+		return ErrInvalidAddress
+	}
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return ErrInvalidAddress
+	}
+	if strings.TrimSpace(m.Subject) == "" || strings.TrimSpace(m.Text) == "" {
+		return ErrEmptyMessage
+	}
+	return nil
+}
+
+// encode message as a MIME mail from sender, with the html body as an
+// alternative part if there is one
+func (m Message) encode(from string, now time.Time) ([]byte, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	subject := strings.Join(strings.Fields(m.Subject), " ")
+	header := []string{
+		"From: " + from,
+		"To: " + m.To,
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"Date: " + now.Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+	}
+	buf.WriteString(strings.Join(header, "\r\n") + "\r\n")
+
+	if m.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		if err := writeQuotedPrintable(&buf, m.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	buf.WriteString("Content-Type: multipart/alternative; boundary=" + w.Boundary() + "\r\n\r\n")
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	}
+	for _, part := range parts {
+		pw, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err = writeQuotedPrintable(pw, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(w interface{ Write([]byte) (int, error) }, s string) error {
+	qw := quotedprintable.NewWriter(w)
+	if _, err := qw.Write([]byte(s)); err != nil {
+		return err
+	}
+	return qw.Close()
+}
@@ -0,0 +1,105 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMessage_Encode(t *testing.T) {
+	now := time.Date(2019, 5, 1, 8, 0, 0, 0, time.UTC)
+	m := Message{To: "foo@example.com", Subject: "你好\r\nBcc: x@y.com", Text: "hello"}
+	data, err := m.encode("rfschub <noreply@example.com>", now)
+	require.NoError(t, err)
+	mail := string(data)
+	require.Contains(t, mail, "From: rfschub <noreply@example.com>\r\n")
+	require.Contains(t, mail, "Subject: =?utf-8?q?=E4=BD=A0=E5=A5=BD_Bcc:_x@y.com?=\r\n")
+	require.Contains(t, mail, "Content-Type: text/plain; charset=utf-8\r\n")
+	require.True(t, strings.HasSuffix(mail, "\r\n\r\nhello"))
+
+	m.HTML = "<p>hello</p>"
+	data, err = m.encode("noreply@example.com", now)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "Content-Type: multipart/alternative; boundary=")
+	require.Contains(t, string(data), "Content-Type: text/html; charset=utf-8")
+
+	_, err = Message{To: "foo@example.com\r\nBcc: x@y.com", Subject: "a", Text: "b"}.encode("a@b.com", now)
+	require.Equal(t, ErrInvalidAddress, err)
+	_, err = Message{To: "foo@example.com", Text: "b"}.encode("a@b.com", now)
+	require.Equal(t, ErrEmptyMessage, err)
+}
+
+func TestTemplate_Render(t *testing.T) {
+	tpl := MustTemplate("greeting", "Hi {{.Name}}", "Hello {{.Name}}", "<p>Hello {{.Name}}</p>")
+	m, err := tpl.Render("foo@example.com", map[string]string{"Name": "<b>"})
+	require.NoError(t, err)
+	require.Equal(t, "Hi <b>", m.Subject)
+	require.Equal(t, "Hello <b>", m.Text)
+	require.Equal(t, "<p>Hello &lt;b&gt;</p>", m.HTML)
+}
+
+func TestFileTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mail")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	transport, err := New(Config{Transport: "file", From: "noreply@example.com", Dir: dir})
+	require.NoError(t, err)
+	err = transport.Send(context.Background(), Message{To: "Foo <foo@example.com>", Subject: "a", Text: "b"})
+	require.NoError(t, err)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.True(t, strings.HasSuffix(files[0].Name(), "-foo@example.com.eml"))
+
+	_, err = New(Config{Transport: "pigeon", From: "noreply@example.com"})
+	require.Error(t, err)
+	_, err = New(Config{Transport: "stdout", From: "noreply"})
+	require.Error(t, err)
+}
+
+type flakyTransport struct {
+	mu       sync.Mutex
+	failures int
+	sent     []Message
+	attempts int
+}
+
+func (t *flakyTransport) Send(ctx context.Context, message Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.attempts++
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("connection refused")
+	}
+	t.sent = append(t.sent, message)
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	transport := &flakyTransport{failures: 2}
+	q := NewQueue(transport, QueueOptions{Attempts: 3, Backoff: time.Millisecond})
+	m := Message{To: "foo@example.com", Subject: "a", Text: "b"}
+	require.NoError(t, q.Send(context.Background(), m))
+	require.Equal(t, ErrInvalidAddress, q.Send(context.Background(), Message{To: "foo", Subject: "a", Text: "b"}))
+	q.Close()
+	require.Equal(t, []Message{m}, transport.sent)
+	require.Equal(t, 3, transport.attempts)
+	require.Equal(t, ErrQueueClosed, q.Send(context.Background(), m))
+
+	// dropped after all attempts failed
+	transport = &flakyTransport{failures: 5}
+	q = NewQueue(transport, QueueOptions{Attempts: 2, Backoff: time.Millisecond})
+	require.NoError(t, q.Send(context.Background(), m))
+	q.Close()
+	require.Empty(t, transport.sent)
+	require.Equal(t, 2, transport.attempts)
+}
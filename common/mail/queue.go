@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+var (
+	ErrQueueFull   = errors.New("mail queue is full")
+	ErrQueueClosed = errors.New("mail queue is closed")
+)
+
+type QueueOptions struct {
+	// capacity of the queue, defaults to 1024
+	Size int
+	// number of mails sent concurrently, defaults to 2
+	Workers int
+	// times a mail is tried before it is dropped, defaults to 5
+	Attempts int
+	// delay before the first retry, doubled for each further retry,
+	// defaults to 10 seconds
+	Backoff time.Duration
+	// timeout of a delivery, defaults to 30 seconds
+	Timeout time.Duration
+}
+
+// Queue is a Transport sending mails through another one in background,
+// failed mails are retried with exponential backoff
+type Queue struct {
+	transport Transport
+	opts      QueueOptions
+	messages  chan queuedMessage
+
+	mu      sync.Mutex
+	closed  bool
+	pending sync.WaitGroup
+	workers sync.WaitGroup
+}
+
+type queuedMessage struct {
+	message Message
+	attempt int
+}
+
+func NewQueue(transport Transport, opts QueueOptions) *Queue {
+	if opts.Size <= 0 {
+		opts.Size = 1024
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = 2
+	}
+	if opts.Attempts <= 0 {
+		opts.Attempts = 5
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 10 * time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 30 * time.Second
+	}
+	q := &Queue{
+		transport: transport,
+		opts:      opts,
+		messages:  make(chan queuedMessage, opts.Size),
+	}
+	for i := 0; i < opts.Workers; i++ {
+		q.workers.Add(1)
+		go q.work()
+	}
+	return q
+}
+
+// Send validates and queues message, it does not wait for the delivery
+func (q *Queue) Send(ctx context.Context, message Message) error {
+	if err := message.validate(); err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+	q.pending.Add(1)
+	select {
+	case q.messages <- queuedMessage{message: message}:
+		return nil
+	default:
+		q.pending.Done()
+		return ErrQueueFull
+	}
+}
+
+// Close stops accepting mails and waits until queued mails are delivered or
+// dropped, including the ones waiting for a retry
+func (q *Queue) Close() {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	q.closed = true
+	q.mu.Unlock()
+
+	q.pending.Wait()
+	close(q.messages)
+	q.workers.Wait()
+}
+
+func (q *Queue) work() {
+	defer q.workers.Done()
+	for m := range q.messages {
+		q.deliver(m)
+	}
+}
+
+func (q *Queue) deliver(m queuedMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), q.opts.Timeout)
+	err := q.transport.Send(ctx, m.message)
+	cancel()
+	if err == nil {
+		q.pending.Done()
+		return
+	}
+
+	m.attempt++
+	if m.attempt >= q.opts.Attempts || err == ErrInvalidAddress || err == ErrEmptyMessage {
+		log.Warnf("[Queue] drop mail: to=%s subject=%q attempts=%d error=%v", m.message.To, m.message.Subject, m.attempt, err)
+		q.pending.Done()
+		return
+	}
+	delay := q.opts.Backoff << uint(m.attempt-1)
+	log.Infof("[Queue] send mail error, retry in %v: to=%s attempt=%d error=%v", delay, m.message.To, m.attempt, err)
+	time.AfterFunc(delay, func() {
+		q.messages <- m
+	})
+}
@@ -0,0 +1,69 @@
+package mail
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Template renders messages of a kind. Subject and text are text templates,
+// html is an optional html template so values are escaped.
+type Template struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// NewTemplate parses templates of subject, text and html, html may be empty
+func NewTemplate(name, subject, text, html string) (*Template, error) {
+	var err error
+	t := &Template{}
+	if t.subject, err = texttemplate.New(name + ".subject").Parse(subject); err != nil {
+		return nil, err
+	}
+	if t.text, err = texttemplate.New(name + ".text").Parse(text); err != nil {
+		return nil, err
+	}
+	if html != "" {
+		if t.html, err = htmltemplate.New(name + ".html").Parse(html); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// MustTemplate is like NewTemplate but panics on errors, for templates
+// defined in code
+func MustTemplate(name, subject, text, html string) *Template {
+	t, err := NewTemplate(name, subject, text, html)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Render renders the message to address to with data
+func (t *Template) Render(to string, data interface{}) (Message, error) {
+	message := Message{To: to}
+	var buf bytes.Buffer
+	if err := t.subject.Execute(&buf, data); err != nil {
+		return message, err
+	}
+	message.Subject = strings.TrimSpace(buf.String())
+
+	buf.Reset()
+	if err := t.text.Execute(&buf, data); err != nil {
+		return message, err
+	}
+	message.Text = buf.String()
+
+	if t.html != nil {
+		buf.Reset()
+		if err := t.html.Execute(&buf, data); err != nil {
+			return message, err
+		}
+		message.HTML = buf.String()
+	}
+	return message, nil
+}
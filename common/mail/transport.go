@@ -0,0 +1,150 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type smtpTransport struct {
+	conf Config
+	// bare address of conf.From used as the envelope sender
+	sender string
+}
+
+func newSmtpTransport(conf Config) (Transport, error) {
+	if conf.Smtp.Host == "" {
+		return nil, fmt.Errorf("smtp host missing")
+	}
+	if conf.Smtp.Port == 0 {
+		conf.Smtp.Port = 587
+	}
+	from, _ := mail.ParseAddress(conf.From)
+	return &smtpTransport{conf: conf, sender: from.Address}, nil
+}
+
+// Send delivers message with STARTTLS if the server supports it, or over
+// TLS from the start on port 465
+func (t *smtpTransport) Send(ctx context.Context, message Message) error {
+	data, err := message.encode(t.conf.From, time.Now())
+	if err != nil {
+		return err
+	}
+	to, _ := mail.ParseAddress(message.To)
+	conf := t.conf.Smtp
+	addr := net.JoinHostPort(conf.Host, strconv.Itoa(conf.Port))
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	var conn net.Conn
+	if conf.Port == 465 {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: conf.Host})
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, conf.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok && conf.Port != 465 {
+		if err = c.StartTLS(&tls.Config{ServerName: conf.Host}); err != nil {
+			return err
+		}
+	}
+	if conf.Username != "" {
+		if err = c.Auth(smtp.PlainAuth("", conf.Username, conf.Password, conf.Host)); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(t.sender); err != nil {
+		return err
+	}
+	if err = c.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// writerTransport writes mails to w one after another, for development
+type writerTransport struct {
+	from string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+func newStdoutTransport(conf Config) Transport {
+	return &writerTransport{from: conf.From, w: os.Stdout}
+}
+
+func (t *writerTransport) Send(ctx context.Context, message Message) error {
+	data, err := message.encode(t.from, time.Now())
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err = fmt.Fprintf(t.w, "----- mail to %s -----\r\n%s\r\n----- end of mail -----\r\n", message.To, data)
+	return err
+}
+
+// fileTransport writes each mail to a file of dir, for development and tests
+type fileTransport struct {
+	from string
+	dir  string
+}
+
+func newFileTransport(conf Config) (Transport, error) {
+	dir := conf.Dir
+	if dir == "" {
+		dir = "mails"
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileTransport{from: conf.From, dir: dir}, nil
+}
+
+func (t *fileTransport) Send(ctx context.Context, message Message) error {
+	now := time.Now()
+	data, err := message.encode(t.from, now)
+	if err != nil {
+		return err
+	}
+	to, _ := mail.ParseAddress(message.To)
+	name := strconv.FormatInt(now.UnixNano(), 10) + "-" + strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == os.PathSeparator {
+			return '_'
+		}
+		return r
+	}, to.Address) + ".eml"
+	return ioutil.WriteFile(filepath.Join(t.dir, name), data, 0644)
+}
@@ -0,0 +1,364 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+type blockKind int
+
+const (
+	paragraphBlock blockKind = iota
+	headingBlock
+	codeBlock
+	quoteBlock
+	listBlock
+	thematicBlock
+)
+
+type block struct {
+	kind     blockKind
+	level    int        // heading level
+	text     string     // inline text of paragraph and heading, content of code block
+	info     string     // info string of fenced code block
+	ordered  bool       // ordered list
+	start    int        // start number of ordered list
+	loose    bool       // list items are separated by blank lines
+	children []*block   // blocks of quote
+	items    [][]*block // blocks of list items
+}
+
+var thematicBreakRe = regexp.MustCompile(`^(?:(?:\*[ ]*){3,}|(?:-[ ]*){3,}|(?:_[ ]*){3,})$`)
+
+// split source into lines with tabs expanded
+func splitLines(src string) []string {
+	src = strings.Replace(src, "\r\n", "\n", -1)
+	src = strings.Replace(src, "\r", "\n", -1)
+	lines := strings.Split(strings.TrimRight(src, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = expandTabs(line)
+	}
+	return lines
+}
+
+// expand tabs to spaces with tab stops of 4 columns
+func expandTabs(line string) string {
+	if !strings.Contains(line, "\t") {
+		return line
+	}
+	var b strings.Builder
+	column := 0
+	for _, r := range line {
+		if r == '\t' {
+			n := 4 - column%4
+			b.WriteString(strings.Repeat(" ", n))
+			column += n
+			continue
+		}
+		b.WriteRune(r)
+		column++
+	}
+	return b.String()
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// remove at most n spaces of indentation
+func unindent(line string, n int) string {
+	i := 0
+	for i < n && i < len(line) && line[i] == ' ' {
+		i++
+	}
+	return line[i:]
+}
+
+func parseATXHeading(s string) (level int, text string, ok bool) {
+	for level < len(s) && s[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(s) && s[level] != ' ') {
+		return 0, "", false
+	}
+	text = strings.TrimSpace(s[level:])
+	// optional closing sequence
+	if t := strings.TrimRight(text, "#"); t == "" || strings.HasSuffix(t, " ") {
+		text = strings.TrimSpace(t)
+	}
+	return level, text, true
+}
+
+func parseFence(s string) (fence, info string, ok bool) {
+	if len(s) < 3 || (s[0] != '`' && s[0] != '~') {
+		return "", "", false
+	}
+	n := len(s) - len(strings.TrimLeft(s, s[:1]))
+	if n < 3 {
+		return "", "", false
+	}
+	info = strings.TrimSpace(s[n:])
+	if s[0] == '`' && strings.Contains(info, "`") {
+		return "", "", false
+	}
+	return s[:n], info, true
+}
+
+func isClosingFence(line, fence string) bool {
+	if indentation(line) >= 4 {
+		return false
+	}
+	s := strings.TrimSpace(line)
+	return len(s) >= len(fence) && strings.Trim(s, fence[:1]) == ""
+}
+
+// parse list item marker of s, offset is the width of the marker and the
+// spaces following it. delimiter is the bullet of unordered lists, or '.'
+// or ')' of ordered ones.
+func parseListMarker(s string) (delimiter byte, ordered bool, start, offset int, ok bool) {
+	n := 0
+	if s != "" && (s[0] == '-' || s[0] == '+' || s[0] == '*') {
+		delimiter, n = s[0], 1
+	} else {
+		for n < len(s) && n < 10 && s[n] >= '0' && s[n] <= '9' {
+			start = start*10 + int(s[n]-'0')
+			n++
+		}
+		if n == 0 || n > 9 || n >= len(s) || (s[n] != '.' && s[n] != ')') {
+			return 0, false, 0, 0, false
+		}
+		delimiter, ordered = s[n], true
+		n++
+	}
+	if n < len(s) && s[n] != ' ' {
+		return 0, false, 0, 0, false
+	}
+	spaces := indentation(s[n:])
+	if spaces == 0 || spaces > 4 || n+spaces == len(s) {
+		// an empty item, or content of item is indented code
+		spaces = 1
+	}
+	return delimiter, ordered, start, n + spaces, true
+}
+
+// setext heading level of an underline, 0 if line is not one
+func setextLevel(line string) int {
+	if indentation(line) >= 4 {
+		return 0
+	}
+	s := strings.TrimSpace(line)
+	switch {
+	case s == "":
+		return 0
+	case strings.Trim(s, "=") == "":
+		return 1
+	case strings.Trim(s, "-") == "":
+		return 2
+	}
+	return 0
+}
+
+// whether line starts a block which ends a paragraph
+func interruptsParagraph(line string) bool {
+	if isBlank(line) {
+		return true
+	}
+	indent := indentation(line)
+	if indent >= 4 {
+		return false
+	}
+	s := line[indent:]
+	if _, _, ok := parseATXHeading(s); ok {
+		return true
+	}
+	if _, _, ok := parseFence(s); ok {
+		return true
+	}
+	if thematicBreakRe.MatchString(s) || s[0] == '>' {
+		return true
+	}
+	_, ordered, start, offset, ok := parseListMarker(s)
+	return ok && offset < len(s) && (!ordered || start == 1)
+}
+
+func parseBlocks(lines []string) []*block {
+	blocks := make([]*block, 0, 4)
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlank(line) {
+			i++
+			continue
+		}
+
+		indent := indentation(line)
+		if indent >= 4 {
+			b, next := parseIndentedCode(lines, i)
+			blocks, i = append(blocks, b), next
+			continue
+		}
+
+		s := line[indent:]
+		if fence, info, ok := parseFence(s); ok {
+			b, next := parseFencedCode(lines, i, indent, fence, info)
+			blocks, i = append(blocks, b), next
+			continue
+		}
+		if level, text, ok := parseATXHeading(s); ok {
+			blocks = append(blocks, &block{kind: headingBlock, level: level, text: text})
+			i++
+			continue
+		}
+		if thematicBreakRe.MatchString(s) {
+			blocks = append(blocks, &block{kind: thematicBlock})
+			i++
+			continue
+		}
+		if s[0] == '>' {
+			b, next := parseQuote(lines, i)
+			blocks, i = append(blocks, b), next
+			continue
+		}
+		if _, _, _, _, ok := parseListMarker(s); ok {
+			b, next := parseList(lines, i)
+			blocks, i = append(blocks, b), next
+			continue
+		}
+		b, next := parseParagraph(lines, i)
+		blocks, i = append(blocks, b), next
+	}
+	return blocks
+}
+
+func parseIndentedCode(lines []string, i int) (*block, int) {
+	code := make([]string, 0, 4)
+	for ; i < len(lines) && (isBlank(lines[i]) || indentation(lines[i]) >= 4); i++ {
+		code = append(code, unindent(lines[i], 4))
+	}
+	for len(code) > 0 && isBlank(code[len(code)-1]) {
+		code = code[:len(code)-1]
+	}
+	return &block{kind: codeBlock, text: strings.Join(code, "\n") + "\n"}, i
+}
+
+func parseFencedCode(lines []string, i, indent int, fence, info string) (*block, int) {
+	code := make([]string, 0, 4)
+	for i++; i < len(lines); i++ {
+		if isClosingFence(lines[i], fence) {
+			i++
+			break
+		}
+		code = append(code, unindent(lines[i], indent))
+	}
+	text := strings.Join(code, "\n")
+	if len(code) > 0 {
+		text += "\n"
+	}
+	return &block{kind: codeBlock, text: text, info: info}, i
+}
+
+func parseQuote(lines []string, i int) (*block, int) {
+	quoted := make([]string, 0, 4)
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		indent := indentation(line)
+		if indent < 4 && strings.HasPrefix(line[indent:], ">") {
+			q := line[indent+1:]
+			quoted = append(quoted, strings.TrimPrefix(q, " "))
+			continue
+		}
+		// lazy continuation of a paragraph
+		if len(quoted) > 0 && !isBlank(quoted[len(quoted)-1]) && !interruptsParagraph(line) {
+			quoted = append(quoted, line)
+			continue
+		}
+		break
+	}
+	return &block{kind: quoteBlock, children: parseBlocks(quoted)}, i
+}
+
+// marker of the list item line starts, ok is false if it starts none
+func lineListMarker(line string) (delimiter byte, ordered, ok bool) {
+	indent := indentation(line)
+	if indent >= 4 || thematicBreakRe.MatchString(line[indent:]) {
+		return 0, false, false
+	}
+	delimiter, ordered, _, _, ok = parseListMarker(line[indent:])
+	return
+}
+
+func parseList(lines []string, i int) (*block, int) {
+	first := lines[i][indentation(lines[i]):]
+	delimiter, ordered, start, _, _ := parseListMarker(first)
+	list := &block{kind: listBlock, ordered: ordered, start: start}
+
+	blankBefore := false
+	for i < len(lines) {
+		line := lines[i]
+		if d, o, ok := lineListMarker(line); !ok || d != delimiter || o != ordered {
+			break
+		}
+		indent := indentation(line)
+		_, _, _, offset, _ := parseListMarker(line[indent:])
+		if blankBefore {
+			list.loose = true
+		}
+
+		width := indent + offset
+		item := []string{""}
+		if width < len(line) {
+			item[0] = line[width:]
+		}
+		for i++; i < len(lines); i++ {
+			next := lines[i]
+			if isBlank(next) {
+				item = append(item, "")
+			} else if indentation(next) >= width {
+				item = append(item, next[width:])
+			} else if _, _, ok := lineListMarker(next); ok {
+				// the next item, or the first one of another list. Unlike
+				// paragraphs out of lists, an item is ended by any marker
+				break
+			} else if !isBlank(item[len(item)-1]) && !interruptsParagraph(next) {
+				// lazy continuation of a paragraph
+				item = append(item, strings.TrimLeft(next, " "))
+			} else {
+				break
+			}
+		}
+
+		blanks := 0
+		for len(item) > 1 && isBlank(item[len(item)-1]) {
+			item = item[:len(item)-1]
+			blanks++
+		}
+		blankBefore = blanks > 0
+		for _, l := range item {
+			if isBlank(l) {
+				list.loose = true
+			}
+		}
+		list.items = append(list.items, parseBlocks(item))
+	}
+	return list, i
+}
+
+func parseParagraph(lines []string, i int) (*block, int) {
+	text := make([]string, 0, 4)
+	for start := i; i < len(lines); i++ {
+		line := lines[i]
+		if i > start {
+			if level := setextLevel(line); level > 0 {
+				return &block{kind: headingBlock, level: level, text: strings.TrimSpace(strings.Join(text, "\n"))}, i + 1
+			}
+			if interruptsParagraph(line) {
+				break
+			}
+		}
+		text = append(text, strings.TrimLeft(line, " "))
+	}
+	return &block{kind: paragraphBlock, text: strings.TrimRight(strings.Join(text, "\n"), " ")}, i
+}
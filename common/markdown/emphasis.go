@@ -0,0 +1,164 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// delimRun is a run of * or _ which may open or close emphasis
+type delimRun struct {
+	c        byte
+	length   int
+	canOpen  bool
+	canClose bool
+	// delimiters of the run not matched yet, they are written as text
+	count int
+	// lengths of emphasis opened and closed by the run in order of matching,
+	// 1 for em and 2 for strong
+	opens  []int
+	closes []int
+	// removed from the stack of delimiters, it matches no more
+	removed bool
+}
+
+func isWhiteRune(r rune) bool {
+	return unicode.IsSpace(r)
+}
+
+func isPunctRune(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// new delimiter run at s[i:end], the start and end of s count as white space
+func newDelimRun(s string, i, end int) *delimRun {
+	before, after := '\n', '\n'
+	if i > 0 {
+		before, _ = utf8.DecodeLastRuneInString(s[:i])
+	}
+	if end < len(s) {
+		after, _ = utf8.DecodeRuneInString(s[end:])
+	}
+	left := !isWhiteRune(after) && (!isPunctRune(after) || isWhiteRune(before) || isPunctRune(before))
+	right := !isWhiteRune(before) && (!isPunctRune(before) || isWhiteRune(after) || isPunctRune(after))
+
+	run := &delimRun{c: s[i], length: end - i, count: end - i}
+	if run.c == '*' {
+		run.canOpen, run.canClose = left, right
+	} else {
+		// _ does not emphasize within words
+		run.canOpen = left && (!right || isPunctRune(before))
+		run.canClose = right && (!left || isPunctRune(after))
+	}
+	return run
+}
+
+// scan the delimiter runs of s, keyed by their start. Code spans, links and
+// autolinks are skipped the same way as they are rendered, emphasis does
+// not cross them.
+func scanDelimRuns(s string) (runs map[int]*delimRun, order []*delimRun) {
+	runs = make(map[int]*delimRun)
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && (s[i+1] == '\n' || isPunct(s[i+1])):
+			i += 2
+		case c == '`':
+			_, i, _ = scanCodeSpan(s, i)
+		case c == '!' && i+1 < len(s) && s[i+1] == '[', c == '[':
+			start := i
+			if c == '!' {
+				start++
+			}
+			if _, _, _, end, ok := parseLink(s, start); ok {
+				i = end
+			} else {
+				i++
+			}
+		case c == '<':
+			if m := autolinkRe.FindString(s[i:]); m != "" {
+				i += len(m)
+			} else if m := emailRe.FindString(s[i:]); m != "" {
+				i += len(m)
+			} else {
+				i++
+			}
+		case c == '*' || c == '_':
+			end := i + len(s[i:]) - len(strings.TrimLeft(s[i:], s[i:i+1]))
+			run := newDelimRun(s, i, end)
+			runs[i] = run
+			order = append(order, run)
+			i = end
+		default:
+			i++
+		}
+	}
+	return
+}
+
+// match openers and closers of runs with the process emphasis algorithm of
+// CommonMark: each closer is matched with the nearest opener before it, and
+// runs between them are removed
+func matchEmphasis(runs []*delimRun) {
+	// openers are not searched at or below the bottom again for closers of
+	// the same kind, there is none
+	bottoms := make(map[[3]int]int)
+	for ci, closer := range runs {
+		if !closer.canClose {
+			continue
+		}
+		for closer.count > 0 {
+			canOpen := 0
+			if closer.canOpen {
+				canOpen = 1
+			}
+			key := [3]int{int(closer.c), canOpen, closer.length % 3}
+			bottom, ok := bottoms[key]
+			if !ok {
+				bottom = -1
+			}
+
+			oi := ci - 1
+			for ; oi > bottom; oi-- {
+				opener := runs[oi]
+				if opener.removed || opener.count == 0 || opener.c != closer.c || !opener.canOpen {
+					continue
+				}
+				// the rule of 3 of runs which can both open and close
+				if (opener.canClose || closer.canOpen) && (opener.length+closer.length)%3 == 0 &&
+					!(opener.length%3 == 0 && closer.length%3 == 0) {
+					continue
+				}
+				break
+			}
+			if oi <= bottom {
+				bottoms[key] = ci - 1
+				break
+			}
+
+			opener := runs[oi]
+			n := 1
+			if opener.count >= 2 && closer.count >= 2 {
+				n = 2
+			}
+			opener.count -= n
+			closer.count -= n
+			opener.opens = append(opener.opens, n)
+			closer.closes = append(closer.closes, n)
+			for _, run := range runs[oi+1 : ci] {
+				run.removed = true
+			}
+		}
+	}
+}
+
+func emphasisTag(n int, closing bool) string {
+	tag := "em"
+	if n == 2 {
+		tag = "strong"
+	}
+	if closing {
+		return "</" + tag + ">"
+	}
+	return "<" + tag + ">"
+}
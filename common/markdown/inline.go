@@ -0,0 +1,433 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	autolinkRe = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.\-]{1,31}:[^\s<>]*)>`)
+	emailRe    = regexp.MustCompile(`^<([A-Za-z0-9.!#$%&'*+/=?^_{|}~\-]+@[A-Za-z0-9](?:[A-Za-z0-9\-]{0,61}[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9\-]{0,61}[A-Za-z0-9])?)*)>`)
+	// path/to/file.go#L10 or path/to/file.go#L10-L20
+	codeRefRe     = regexp.MustCompile(`([A-Za-z0-9_.\-/]+)#L([0-9]{1,9})(?:-L?([0-9]{1,9}))?\b`)
+	fullCodeRefRe = regexp.MustCompile(`^` + codeRefRe.String() + `$`)
+	// @name, name is the same as an account name
+	mentionRe = regexp.MustCompile(`@([a-zA-Z][a-zA-Z0-9_]{0,16})`)
+)
+
+func isPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("$+<=>^`|~", c) != -1
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\n'
+}
+
+// inline renders inline content of a block
+type inline struct {
+	r      *renderer
+	inLink bool
+	text   strings.Builder
+	// delimiter runs of emphasis keyed by their start
+	runs map[int]*delimRun
+}
+
+func (r *renderer) inline(s string, inLink bool) {
+	runs, order := scanDelimRuns(s)
+	matchEmphasis(order)
+	in := &inline{r: r, inLink: inLink, runs: runs}
+	in.render(s)
+	in.flush()
+}
+
+// write pending text, entity references are decoded and code references
+// are linked
+func (in *inline) flush() {
+	if in.text.Len() == 0 {
+		return
+	}
+	text := html.UnescapeString(in.text.String())
+	in.text.Reset()
+	if in.r.mentioned != nil && !in.inLink {
+		in.r.collectMentions(text)
+	}
+	if in.inLink || in.r.plain || in.r.opts.CodeLink == nil {
+		in.r.text(text)
+		return
+	}
+
+	last := 0
+	for _, m := range codeRefRe.FindAllStringSubmatchIndex(text, -1) {
+		if m[0] > 0 && strings.IndexByte(":@#", text[m[0]-1]) != -1 {
+			continue
+		}
+		link := in.r.codeLink(text[m[0]:m[1]])
+		if link == "" {
+			continue
+		}
+		in.r.text(text[last:m[0]])
+		in.r.raw(`<a href="` + link + `">`)
+		in.r.text(text[m[0]:m[1]])
+		in.r.raw("</a>")
+		last = m[1]
+	}
+	in.r.text(text[last:])
+}
+
+// collect names mentioned in text, a mention must not follow a word
+// character like the @ of an email address, or be followed by one
+func (r *renderer) collectMentions(text string) {
+	isWord := func(c byte) bool {
+		return isAlnum(c) || c == '_'
+	}
+	for _, m := range mentionRe.FindAllStringSubmatchIndex(text, -1) {
+		if m[0] > 0 && (isWord(text[m[0]-1]) || text[m[0]-1] == '@') {
+			continue
+		}
+		if m[1] < len(text) && (isWord(text[m[1]]) || text[m[1]] == '@') {
+			continue
+		}
+		name := text[m[2]:m[3]]
+		if !r.mentioned[name] && len(r.mentions) < maxMentions {
+			r.mentioned[name] = true
+			r.mentions = append(r.mentions, name)
+		}
+	}
+}
+
+func (in *inline) render(s string) {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\n':
+			in.flush()
+			in.r.lineBreak()
+			i += 2
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			in.flush()
+			in.r.text(s[i+1 : i+2])
+			i += 2
+		case c == '`':
+			i = in.codeSpan(s, i)
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			if end, ok := in.link(s, i+1, true); ok {
+				i = end
+			} else {
+				in.text.WriteByte(c)
+				i++
+			}
+		case c == '[':
+			if end, ok := in.link(s, i, false); ok {
+				i = end
+			} else {
+				in.text.WriteByte(c)
+				i++
+			}
+		case c == '<':
+			i = in.autolink(s, i)
+		case c == '*' || c == '_':
+			i = in.emphasis(s, i)
+		case c == '\n':
+			pending := in.text.String()
+			trimmed := strings.TrimRight(pending, " ")
+			in.text.Reset()
+			in.text.WriteString(trimmed)
+			if len(pending)-len(trimmed) >= 2 {
+				in.flush()
+				in.r.lineBreak()
+			} else {
+				in.text.WriteByte('\n')
+			}
+			i++
+		default:
+			in.text.WriteByte(c)
+			i++
+		}
+	}
+}
+
+// end of the code span starting at s[i], content is the code. ok is false
+// if the backtick run is not closed.
+func scanCodeSpan(s string, i int) (content string, end int, ok bool) {
+	n := len(s[i:]) - len(strings.TrimLeft(s[i:], "`"))
+	for j := i + n; j < len(s); {
+		k := strings.IndexByte(s[j:], '`')
+		if k == -1 {
+			break
+		}
+		j += k
+		m := len(s[j:]) - len(strings.TrimLeft(s[j:], "`"))
+		if m == n {
+			content = strings.Replace(s[i+n:j], "\n", " ", -1)
+			if len(content) > 1 && content[0] == ' ' && content[len(content)-1] == ' ' && strings.Trim(content, " ") != "" {
+				content = content[1 : len(content)-1]
+			}
+			return content, j + m, true
+		}
+		j += m
+	}
+	return "", i + n, false
+}
+
+func (in *inline) codeSpan(s string, i int) int {
+	content, end, ok := scanCodeSpan(s, i)
+	if !ok {
+		in.text.WriteString(s[i:end])
+		return end
+	}
+	in.flush()
+	link := ""
+	if !in.inLink && !in.r.plain && in.r.opts.CodeLink != nil && fullCodeRefRe.MatchString(content) {
+		link = in.r.codeLink(content)
+	}
+	if link != "" {
+		in.r.raw(`<a href="` + link + `">`)
+	}
+	in.r.raw("<code>")
+	in.r.text(content)
+	in.r.raw("</code>")
+	if link != "" {
+		in.r.raw("</a>")
+	}
+	return end
+}
+
+// parse inline link `[label](destination "title")` at s[i] == '['
+func parseLink(s string, i int) (label, dest, title string, end int, ok bool) {
+	depth, j := 0, i
+loop:
+	for ; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '`':
+			if _, e, closed := scanCodeSpan(s, j); closed {
+				j = e - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				break loop
+			}
+		}
+	}
+	if j >= len(s) || j+1 >= len(s) || s[j+1] != '(' {
+		return
+	}
+	label = s[i+1 : j]
+
+	k := j + 2
+	skipSpaces := func() {
+		for k < len(s) && isSpace(s[k]) {
+			k++
+		}
+	}
+	skipSpaces()
+	if k < len(s) && s[k] == '<' {
+		gt := strings.IndexAny(s[k+1:], ">\n<")
+		if gt == -1 || s[k+1+gt] != '>' {
+			return
+		}
+		dest = s[k+1 : k+1+gt]
+		k += gt + 2
+	} else {
+		start, parens := k, 0
+		for ; k < len(s) && s[k] > ' '; k++ {
+			if s[k] == '\\' && k+1 < len(s) {
+				k++
+			} else if s[k] == '(' {
+				parens++
+			} else if s[k] == ')' {
+				if parens == 0 {
+					break
+				}
+				parens--
+			}
+		}
+		dest = s[start:k]
+	}
+
+	hasSpace := k < len(s) && isSpace(s[k])
+	skipSpaces()
+	if hasSpace && k < len(s) && (s[k] == '"' || s[k] == '\'' || s[k] == '(') {
+		closer := s[k]
+		if closer == '(' {
+			closer = ')'
+		}
+		start := k + 1
+		for k = start; k < len(s) && s[k] != closer; k++ {
+			if s[k] == '\\' {
+				k++
+			}
+		}
+		if k >= len(s) {
+			return
+		}
+		title = html.UnescapeString(unescapePunct(s[start:k]))
+		k++
+		skipSpaces()
+	}
+	if k >= len(s) || s[k] != ')' {
+		return
+	}
+	return label, html.UnescapeString(unescapePunct(dest)), title, k + 1, true
+}
+
+// remove backslashes escaping punctuations
+func unescapePunct(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && isPunct(s[i+1]) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func (in *inline) link(s string, i int, image bool) (int, bool) {
+	label, dest, title, end, ok := parseLink(s, i)
+	if !ok {
+		return 0, false
+	}
+	in.flush()
+
+	if image {
+		alt := in.r.plainText(label)
+		src, safe := sanitizeURL(dest)
+		if !safe || in.r.plain {
+			in.r.text(alt)
+			return end, true
+		}
+		in.r.raw(`<img src="` + src + `" alt="`)
+		in.r.text(alt)
+		in.r.raw(`"`)
+		if title != "" {
+			in.r.raw(` title="`)
+			in.r.text(title)
+			in.r.raw(`"`)
+		}
+		in.r.raw(" />")
+		return end, true
+	}
+
+	href, safe := "", false
+	if !in.inLink && !in.r.plain {
+		if in.r.opts.CodeLink != nil && fullCodeRefRe.MatchString(dest) {
+			href = in.r.codeLink(dest)
+			safe = href != ""
+		}
+		if !safe {
+			href, safe = sanitizeURL(dest)
+		}
+	}
+	if !safe {
+		// label of a link is not searched for mentions in plain text
+		in.r.inline(label, in.inLink || in.r.plain)
+		return end, true
+	}
+	in.r.raw(`<a href="` + href + `"`)
+	if title != "" {
+		in.r.raw(` title="`)
+		in.r.text(title)
+		in.r.raw(`"`)
+	}
+	if isExternal(href) {
+		in.r.raw(` rel="nofollow noopener"`)
+	}
+	in.r.raw(">")
+	in.r.inline(label, true)
+	in.r.raw("</a>")
+	return end, true
+}
+
+func (in *inline) autolink(s string, i int) int {
+	var href, text string
+	if m := autolinkRe.FindStringSubmatch(s[i:]); m != nil {
+		href, text = m[1], m[1]
+	} else if m := emailRe.FindStringSubmatch(s[i:]); m != nil {
+		href, text = "mailto:"+m[1], m[1]
+	} else {
+		in.text.WriteByte('<')
+		return i + 1
+	}
+	end := i + len(text) + 2
+
+	href, safe := sanitizeURL(href)
+	if in.inLink || !safe {
+		in.text.WriteString(text)
+		return end
+	}
+	in.flush()
+	if in.r.plain {
+		in.r.text(text)
+		return end
+	}
+	in.r.raw(`<a href="` + href + `"`)
+	if isExternal(href) {
+		in.r.raw(` rel="nofollow noopener"`)
+	}
+	in.r.raw(">")
+	in.r.text(text)
+	in.r.raw("</a>")
+	return end
+}
+
+// write the delimiter run starting at s[i]: emphasis it closes, delimiters
+// not matched, then emphasis it opens
+func (in *inline) emphasis(s string, i int) int {
+	run := in.runs[i]
+	if len(run.closes) > 0 {
+		in.flush()
+		for _, n := range run.closes {
+			in.r.raw(emphasisTag(n, true))
+		}
+	}
+	in.text.WriteString(strings.Repeat(s[i:i+1], run.count))
+	if len(run.opens) > 0 {
+		in.flush()
+		// the emphasis matched last is the outermost
+		for j := len(run.opens) - 1; j >= 0; j-- {
+			in.r.raw(emphasisTag(run.opens[j], false))
+		}
+	}
+	return i + run.length
+}
+
+// link of a code reference, empty if it is not linked
+func (r *renderer) codeLink(ref string) string {
+	m := fullCodeRefRe.FindStringSubmatch(ref)
+	if m == nil {
+		return ""
+	}
+	file := strings.TrimLeft(strings.TrimPrefix(m[1], "./"), "/")
+	if file == "" || strings.Contains(file, "//") {
+		return ""
+	}
+	start, _ := strconv.Atoi(m[2])
+	end := start
+	if m[3] != "" {
+		end, _ = strconv.Atoi(m[3])
+	}
+	if start == 0 || end < start {
+		return ""
+	}
+	link, ok := sanitizeURL(r.opts.CodeLink(file, start, end))
+	if !ok {
+		return ""
+	}
+	return link
+}
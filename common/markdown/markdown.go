@@ -0,0 +1,61 @@
+// Package markdown renders annotation text written in CommonMark to HTML.
+//
+// Blocks supported are paragraphs, ATX and setext headings, fenced and
+// indented code, block quotes, lists and thematic breaks. Inlines supported
+// are code spans, emphasis, inline links, images, autolinks, entity
+// references and hard line breaks. Link reference definitions and raw HTML
+// are not, raw HTML is escaped instead of passed through.
+//
+// The output is sanitized: it only contains the tags p, h1-h6, blockquote,
+// ul, ol, li, pre, code, hr, br, em, strong, a and img, and link and image
+// urls are either relative or of scheme http, https or mailto.
+package markdown
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type Options struct {
+	// CodeLink returns the link of a code reference to lines start to end of
+	// file, like path/to/file.go#L10 or path/to/file.go#L10-L20. Code
+	// references are not linked if it is nil or returns an empty link.
+	CodeLink func(file string, start, end int) string
+}
+
+// Render renders markdown src to sanitized HTML
+func Render(src string, opts Options) string {
+	r := &renderer{opts: opts}
+	r.blocks(parseBlocks(splitLines(src)), false)
+	return r.buf.String()
+}
+
+// PlainText returns text of markdown src without markup, blocks are
+// separated by new lines
+func PlainText(src string) string {
+	r := &renderer{plain: true}
+	r.blocks(parseBlocks(splitLines(src)), false)
+	return strings.TrimSpace(r.buf.String())
+}
+
+// maximum number of names returned by Mentions
+const maxMentions = 20
+
+// Mentions returns names mentioned as @name in markdown src in order of
+// appearance without duplicates. Mentions in code and links are ignored.
+func Mentions(src string) []string {
+	r := &renderer{plain: true, mentioned: make(map[string]bool)}
+	r.blocks(parseBlocks(splitLines(src)), false)
+	return r.mentions
+}
+
+// Summary returns plain text of markdown src in one line, truncated to at
+// most n runes with an ellipsis
+func Summary(src string, n int) string {
+	text := strings.Join(strings.Fields(PlainText(src)), " ")
+	if n <= 0 || utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	runes := []rune(text)
+	return strings.TrimSpace(string(runes[:n-1])) + "…"
+}
@@ -0,0 +1,129 @@
+package markdown
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	cases := []struct {
+		src  string
+		html string
+	}{
+		{"hello *world*", "<p>hello <em>world</em></p>\n"},
+		{"**bold** and __strong__ and ***both***", "<p><strong>bold</strong> and <strong>strong</strong> and <em><strong>both</strong></em></p>\n"},
+		{"snake_case_name * not emphasis *", "<p>snake_case_name * not emphasis *</p>\n"},
+		{"# Title #\n\nSub\n---", "<h1>Title</h1>\n<h2>Sub</h2>\n"},
+		{"a\nb  \nc\\\nd", "<p>a\nb<br />\nc<br />\nd</p>\n"},
+		{"use `a < b` here", "<p>use <code>a &lt; b</code> here</p>\n"},
+		{"```go\nfmt.Println(\"<hi>\")\n```", "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;hi&gt;&#34;)\n</code></pre>\n"},
+		{"    indented\n    code", "<pre><code>indented\ncode\n</code></pre>\n"},
+		{"> quoted\nlazy\n\n***", "<blockquote>\n<p>quoted\nlazy</p>\n</blockquote>\n<hr />\n"},
+		{"- a\n- b\n  - c\n- d", "<ul>\n<li>a</li>\n<li>b\n<ul>\n<li>c</li>\n</ul>\n</li>\n<li>d</li>\n</ul>\n"},
+		{"1. a\n2. b\n4) c", "<ol>\n<li>a</li>\n<li>b</li>\n</ol>\n<ol start=\"4\">\n<li>c</li>\n</ol>\n"},
+		{"- a\n- b\n+ c", "<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n<ul>\n<li>c</li>\n</ul>\n"},
+		{"3. a\n\n4. b", "<ol start=\"3\">\n<li>\n<p>a</p>\n</li>\n<li>\n<p>b</p>\n</li>\n</ol>\n"},
+		{"[site](https://example.com \"Title\") and <https://a.b/c>", "<p><a href=\"https://example.com\" title=\"Title\" rel=\"nofollow noopener\">site</a> and <a href=\"https://a.b/c\" rel=\"nofollow noopener\">https://a.b/c</a></p>\n"},
+		{"![logo](/logo.png)", "<p><img src=\"/logo.png\" alt=\"logo\" /></p>\n"},
+		{"\\*not\\* &amp; &copy;", "<p>*not* &amp; ©</p>\n"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.html, Render(c.src, Options{}), c.src)
+	}
+}
+
+func TestRender_Emphasis(t *testing.T) {
+	cases := []struct {
+		src  string
+		html string
+	}{
+		{"*a **b** c*", "<p><em>a <strong>b</strong> c</em></p>\n"},
+		{"**a *b* c**", "<p><strong>a <em>b</em> c</strong></p>\n"},
+		{"*foo**bar**baz*", "<p><em>foo<strong>bar</strong>baz</em></p>\n"},
+		{"*foo**bar*", "<p><em>foo**bar</em></p>\n"},
+		{"**foo*", "<p>*<em>foo</em></p>\n"},
+		{"*foo**", "<p><em>foo</em>*</p>\n"},
+		{"_foo_bar_ and *a_b*", "<p><em>foo_bar</em> and <em>a_b</em></p>\n"},
+		{"*a `*` b*", "<p><em>a <code>*</code> b</em></p>\n"},
+		{"*[a*](/x)", "<p>*<a href=\"/x\">a*</a></p>\n"},
+		{"*a *b c* d*", "<p><em>a <em>b c</em> d</em></p>\n"},
+		{"***a** b*", "<p><em><strong>a</strong> b</em></p>\n"},
+		{"***a* b**", "<p><strong><em>a</em> b</strong></p>\n"},
+		{"**a*b*c**", "<p><strong>a<em>b</em>c</strong></p>\n"},
+		{"*(*a*)*", "<p><em>(<em>a</em>)</em></p>\n"},
+		{"_a *b_ c*", "<p><em>a *b</em> c*</p>\n"},
+		{"*foo _bar* baz_", "<p><em>foo _bar</em> baz_</p>\n"},
+		// overlapping runs: a closer takes the nearest opener and what is
+		// left of a run is matched later, one delimiter at a time since
+		// the other run has only one left
+		{"*a **b* c**", "<p><em>a <em><em>b</em> c</em></em></p>\n"},
+		{"**a *b** c*", "<p><em><em>a <em>b</em></em> c</em></p>\n"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.html, Render(c.src, Options{}), c.src)
+	}
+}
+
+func TestRender_Sanitize(t *testing.T) {
+	cases := []struct {
+		src  string
+		html string
+	}{
+		{"<script>alert(1)</script>", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n"},
+		{"<img src=x onerror=alert(1)>", "<p>&lt;img src=x onerror=alert(1)&gt;</p>\n"},
+		{"[click](javascript:alert(1))", "<p>click</p>\n"},
+		{"[click](JaVa&#9;ScRiPt:alert(1))", "<p>click</p>\n"},
+		{"![x](data:image/png;base64,AAAA)", "<p>x</p>\n"},
+		{"<javascript:alert(1)>", "<p>javascript:alert(1)</p>\n"},
+		{"[x](\"onmouseover=\"alert(1))", "<p><a href=\"&#34;onmouseover=&#34;alert(1)\">x</a></p>\n"},
+		{"[x](/\\evil.com) [y](\\\\\\\\evil.com) [z](//evil.com)", "<p><a href=\"//evil.com\" rel=\"nofollow noopener\">x</a> <a href=\"//evil.com\" rel=\"nofollow noopener\">y</a> <a href=\"//evil.com\" rel=\"nofollow noopener\">z</a></p>\n"},
+		{"[x](/&#9;/evil.com) [y](HTTPS://evil.com)", "<p><a href=\"//evil.com\" rel=\"nofollow noopener\">x</a> <a href=\"HTTPS://evil.com\" rel=\"nofollow noopener\">y</a></p>\n"},
+		{"```\"><script>\nx\n```", "<pre><code class=\"language-script\">x\n</code></pre>\n"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.html, Render(c.src, Options{}), c.src)
+	}
+}
+
+func TestRender_CodeLink(t *testing.T) {
+	opts := Options{CodeLink: func(file string, start, end int) string {
+		return fmt.Sprintf("/view/%s#L%d-L%d", file, start, end)
+	}}
+	cases := []struct {
+		src  string
+		html string
+	}{
+		{"see path/to/file.go#L10.", "<p>see <a href=\"/view/path/to/file.go#L10-L10\">path/to/file.go#L10</a>.</p>\n"},
+		{"range `main.go#L3-L5`", "<p>range <a href=\"/view/main.go#L3-L5\"><code>main.go#L3-L5</code></a></p>\n"},
+		{"[here](./a.go#L2)", "<p><a href=\"/view/a.go#L2-L2\">here</a></p>\n"},
+		{"not https://x.com/a.go#L1 nor a.go#L1x", "<p>not https://x.com/a.go#L1 nor a.go#L1x</p>\n"},
+		{"[a.go#L1](https://x.com)", "<p><a href=\"https://x.com\" rel=\"nofollow noopener\">a.go#L1</a></p>\n"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.html, Render(c.src, opts), c.src)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	require.Equal(t, "Title some bold text and code", Summary("# Title\n\nsome **bold** text\nand `code`", 64))
+	require.Equal(t, "link", Summary("[link](https://example.com)", 64))
+	require.Equal(t, "你好世界…", Summary("你好世界你好世界", 5))
+	require.Equal(t, "abc", Summary("abc", 3))
+}
+
+func TestMentions(t *testing.T) {
+	cases := []struct {
+		src   string
+		names []string
+	}{
+		{"@alice and @bob_2, again @alice", []string{"alice", "bob_2"}},
+		{"**@carol**: ping\n\n- @dave", []string{"carol", "dave"}},
+		{"mail me at me@example.com or @@x", nil},
+		{"`@code` and\n\n    @indented\n\n[@link](https://x.com) <https://x.com/@auto>", nil},
+		{"@a_name_longer_than_allowed @1abc", nil},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.names, Mentions(c.src), c.src)
+	}
+}
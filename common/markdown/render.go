@@ -0,0 +1,122 @@
+package markdown
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+type renderer struct {
+	buf   strings.Builder
+	opts  Options
+	plain bool // write text only, without markup and escaping
+
+	// names mentioned in text outside of code and links, collected by Mentions
+	mentions  []string
+	mentioned map[string]bool
+}
+
+func (r *renderer) raw(s string) {
+	if !r.plain {
+		r.buf.WriteString(s)
+	}
+}
+
+func (r *renderer) text(s string) {
+	if r.plain {
+		r.buf.WriteString(s)
+	} else {
+		r.buf.WriteString(html.EscapeString(s))
+	}
+}
+
+func (r *renderer) lineBreak() {
+	if r.plain {
+		r.buf.WriteByte('\n')
+	} else {
+		r.buf.WriteString("<br />\n")
+	}
+}
+
+// plain text of inline content
+func (r *renderer) plainText(s string) string {
+	pr := &renderer{opts: r.opts, plain: true}
+	pr.inline(s, true)
+	return pr.buf.String()
+}
+
+func (r *renderer) blocks(blocks []*block, tight bool) {
+	for i, b := range blocks {
+		switch b.kind {
+		case paragraphBlock:
+			if tight {
+				r.inline(b.text, false)
+				if i < len(blocks)-1 {
+					r.buf.WriteByte('\n')
+				}
+				continue
+			}
+			r.raw("<p>")
+			r.inline(b.text, false)
+			r.raw("</p>")
+		case headingBlock:
+			tag := "h" + strconv.Itoa(b.level)
+			r.raw("<" + tag + ">")
+			r.inline(b.text, false)
+			r.raw("</" + tag + ">")
+		case codeBlock:
+			r.raw("<pre><code")
+			if language := codeLanguage(b.info); language != "" {
+				r.raw(` class="language-` + language + `"`)
+			}
+			r.raw(">")
+			r.text(b.text)
+			r.raw("</code></pre>")
+		case quoteBlock:
+			r.raw("<blockquote>\n")
+			r.blocks(b.children, false)
+			r.raw("</blockquote>")
+		case listBlock:
+			r.list(b)
+		case thematicBlock:
+			r.raw("<hr />")
+		}
+		r.buf.WriteByte('\n')
+	}
+}
+
+func (r *renderer) list(b *block) {
+	tag := "ul"
+	if b.ordered {
+		tag = "ol"
+	}
+	r.raw("<" + tag)
+	if b.ordered && b.start != 1 {
+		r.raw(` start="` + strconv.Itoa(b.start) + `"`)
+	}
+	r.raw(">\n")
+	for _, item := range b.items {
+		r.raw("<li>")
+		if len(item) > 0 && (b.loose || item[0].kind != paragraphBlock) {
+			r.buf.WriteByte('\n')
+		}
+		r.blocks(item, !b.loose)
+		r.raw("</li>\n")
+	}
+	r.raw("</" + tag + ">")
+}
+
+// language of a fenced code block is the first word of its info string,
+// only letters, digits and "+-#._" are kept
+func codeLanguage(info string) string {
+	fields := strings.Fields(info)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.Map(func(c rune) rune {
+		if c < 128 && (isAlnum(byte(c)) || strings.ContainsRune("+-#._", c)) {
+			return c
+		}
+		return -1
+	}, fields[0])
+}
@@ -0,0 +1,56 @@
+package markdown
+
+import (
+	"html"
+	"strings"
+)
+
+var safeSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// sanitizeURL returns the attribute escaped url if it is relative or its
+// scheme is safe, javascript: and data: urls are refused for example
+func sanitizeURL(url string) (string, bool) {
+	// browsers remove tabs and new lines anywhere in urls, and control
+	// characters and spaces around them
+	url = strings.Map(func(c rune) rune {
+		if c == '\t' || c == '\n' || c == '\r' {
+			return -1
+		}
+		return c
+	}, url)
+	url = strings.TrimFunc(url, func(c rune) bool {
+		return c <= ' '
+	})
+	// browsers treat leading backslashes as slashes, "/\\host" is "//host"
+	if n := len(url) - len(strings.TrimLeft(url, "/\\")); n > 0 {
+		url = strings.Repeat("/", n) + url[n:]
+	}
+
+	// browsers ignore control characters and spaces in schemes
+	cleaned := strings.Map(func(c rune) rune {
+		if c <= ' ' || c == 0x7f {
+			return -1
+		}
+		return c
+	}, url)
+	if cleaned == "" {
+		return "", false
+	}
+	if i := strings.IndexAny(cleaned, ":/?#"); i != -1 && cleaned[i] == ':' {
+		if !safeSchemes[strings.ToLower(cleaned[:i])] {
+			return "", false
+		}
+	}
+	return html.EscapeString(strings.Replace(url, " ", "%20", -1)), true
+}
+
+// links to other sites are not followed by search engines, urls starting
+// with two slashes are of other sites too
+func isExternal(url string) bool {
+	url = strings.ToLower(url)
+	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "//")
+}
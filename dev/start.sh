@@ -25,7 +25,7 @@ fi
 
 echo "start to build"
 
-services="account gits index project repository syntect api"
+services="account gits index notification project repository syntect api"
 
 for service in ${services}
 do
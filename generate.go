@@ -6,3 +6,4 @@ package rfschub_server
 //go:generate protoc --proto_path=project/proto --micro_out=project/proto --go_out=project/proto project/proto/project.proto
 //go:generate protoc --proto_path=index/proto --micro_out=index/proto --go_out=index/proto index/proto/index.proto
 //go:generate protoc --proto_path=syntect/proto --micro_out=syntect/proto --go_out=syntect/proto syntect/proto/syntect.proto
+//go:generate protoc --proto_path=notification/proto --micro_out=notification/proto --go_out=notification/proto notification/proto/notification.proto
@@ -46,6 +46,12 @@ type GitsService interface {
 	GetRepositoryFiles(ctx context.Context, in *GetRepositoryFilesRequest, opts ...client.CallOption) (*GetRepositoryFilesResponse, error)
 	// get file content
 	GetRepositoryBlob(ctx context.Context, in *GetRepositoryBlobRequest, opts ...client.CallOption) (*GetRepositoryBlobResponse, error)
+	// get changed files between two commits
+	DiffCommits(ctx context.Context, in *DiffCommitsRequest, opts ...client.CallOption) (*DiffCommitsResponse, error)
+	// get changed lines of a file between two commits
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...client.CallOption) (*DiffFileResponse, error)
+	// list repositories being cloned
+	ListClones(ctx context.Context, in *ListClonesRequest, opts ...client.CallOption) (*ListClonesResponse, error)
 }
 
 type gitsService struct {
@@ -160,6 +166,36 @@ func (c *gitsService) GetRepositoryBlob(ctx context.Context, in *GetRepositoryBl
 	return out, nil
 }
 
+func (c *gitsService) DiffCommits(ctx context.Context, in *DiffCommitsRequest, opts ...client.CallOption) (*DiffCommitsResponse, error) {
+	req := c.c.NewRequest(c.name, "Gits.DiffCommits", in)
+	out := new(DiffCommitsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitsService) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...client.CallOption) (*DiffFileResponse, error) {
+	req := c.c.NewRequest(c.name, "Gits.DiffFile", in)
+	out := new(DiffFileResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitsService) ListClones(ctx context.Context, in *ListClonesRequest, opts ...client.CallOption) (*ListClonesResponse, error) {
+	req := c.c.NewRequest(c.name, "Gits.ListClones", in)
+	out := new(ListClonesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Gits service
 
 type GitsHandler interface {
@@ -175,6 +211,12 @@ type GitsHandler interface {
 	GetRepositoryFiles(context.Context, *GetRepositoryFilesRequest, *GetRepositoryFilesResponse) error
 	// get file content
 	GetRepositoryBlob(context.Context, *GetRepositoryBlobRequest, *GetRepositoryBlobResponse) error
+	// get changed files between two commits
+	DiffCommits(context.Context, *DiffCommitsRequest, *DiffCommitsResponse) error
+	// get changed lines of a file between two commits
+	DiffFile(context.Context, *DiffFileRequest, *DiffFileResponse) error
+	// list repositories being cloned
+	ListClones(context.Context, *ListClonesRequest, *ListClonesResponse) error
 }
 
 func RegisterGitsHandler(s server.Server, hdlr GitsHandler, opts ...server.HandlerOption) error {
@@ -185,6 +227,9 @@ func RegisterGitsHandler(s server.Server, hdlr GitsHandler, opts ...server.Handl
 		GetNamedCommits(ctx context.Context, in *GetNamedCommitsRequest, out *GetNamedCommitsResponse) error
 		GetRepositoryFiles(ctx context.Context, in *GetRepositoryFilesRequest, out *GetRepositoryFilesResponse) error
 		GetRepositoryBlob(ctx context.Context, in *GetRepositoryBlobRequest, out *GetRepositoryBlobResponse) error
+		DiffCommits(ctx context.Context, in *DiffCommitsRequest, out *DiffCommitsResponse) error
+		DiffFile(ctx context.Context, in *DiffFileRequest, out *DiffFileResponse) error
+		ListClones(ctx context.Context, in *ListClonesRequest, out *ListClonesResponse) error
 	}
 	type Gits struct {
 		gits
@@ -251,3 +296,15 @@ func (h *gitsHandler) GetRepositoryFiles(ctx context.Context, in *GetRepositoryF
 func (h *gitsHandler) GetRepositoryBlob(ctx context.Context, in *GetRepositoryBlobRequest, out *GetRepositoryBlobResponse) error {
 	return h.GitsHandler.GetRepositoryBlob(ctx, in, out)
 }
+
+func (h *gitsHandler) DiffCommits(ctx context.Context, in *DiffCommitsRequest, out *DiffCommitsResponse) error {
+	return h.GitsHandler.DiffCommits(ctx, in, out)
+}
+
+func (h *gitsHandler) DiffFile(ctx context.Context, in *DiffFileRequest, out *DiffFileResponse) error {
+	return h.GitsHandler.DiffFile(ctx, in, out)
+}
+
+func (h *gitsHandler) ListClones(ctx context.Context, in *ListClonesRequest, out *ListClonesResponse) error {
+	return h.GitsHandler.ListClones(ctx, in, out)
+}
@@ -26,6 +26,7 @@ const (
 	ErrorCode_RepoNotExist   ErrorCode = 100002
 	ErrorCode_GitsBusy       ErrorCode = 100003
 	ErrorCode_RepoCloning    ErrorCode = 100004
+	ErrorCode_CommitInvalid  ErrorCode = 100005
 )
 
 var ErrorCode_name = map[int32]string{
@@ -34,6 +35,7 @@ var ErrorCode_name = map[int32]string{
 	100002: "RepoNotExist",
 	100003: "GitsBusy",
 	100004: "RepoCloning",
+	100005: "CommitInvalid",
 }
 var ErrorCode_value = map[string]int32{
 	"Success":        0,
@@ -41,13 +43,40 @@ var ErrorCode_value = map[string]int32{
 	"RepoNotExist":   100002,
 	"GitsBusy":       100003,
 	"RepoCloning":    100004,
+	"CommitInvalid":  100005,
 }
 
 func (x ErrorCode) String() string {
 	return proto.EnumName(ErrorCode_name, int32(x))
 }
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{0}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{0}
+}
+
+type DiffStatus int32
+
+const (
+	DiffStatus_Modified DiffStatus = 0
+	DiffStatus_Added    DiffStatus = 1
+	DiffStatus_Deleted  DiffStatus = 2
+)
+
+var DiffStatus_name = map[int32]string{
+	0: "Modified",
+	1: "Added",
+	2: "Deleted",
+}
+var DiffStatus_value = map[string]int32{
+	"Modified": 0,
+	"Added":    1,
+	"Deleted":  2,
+}
+
+func (x DiffStatus) String() string {
+	return proto.EnumName(DiffStatus_name, int32(x))
+}
+func (DiffStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{1}
 }
 
 type CloneStatus int32
@@ -73,11 +102,14 @@ func (x CloneStatus) String() string {
 	return proto.EnumName(CloneStatus_name, int32(x))
 }
 func (CloneStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{1}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{2}
 }
 
 type CloneRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// clone a cloned repository again, the old clone is replaced once the
+	// new one completes
+	Force                bool     `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -87,7 +119,7 @@ func (m *CloneRequest) Reset()         { *m = CloneRequest{} }
 func (m *CloneRequest) String() string { return proto.CompactTextString(m) }
 func (*CloneRequest) ProtoMessage()    {}
 func (*CloneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{0}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{0}
 }
 func (m *CloneRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloneRequest.Unmarshal(m, b)
@@ -114,6 +146,13 @@ func (m *CloneRequest) GetUrl() string {
 	return ""
 }
 
+func (m *CloneRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type CloneResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -124,7 +163,7 @@ func (m *CloneResponse) Reset()         { *m = CloneResponse{} }
 func (m *CloneResponse) String() string { return proto.CompactTextString(m) }
 func (*CloneResponse) ProtoMessage()    {}
 func (*CloneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{1}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{1}
 }
 func (m *CloneResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloneResponse.Unmarshal(m, b)
@@ -155,7 +194,7 @@ func (m *GetCloneStatusRequest) Reset()         { *m = GetCloneStatusRequest{} }
 func (m *GetCloneStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCloneStatusRequest) ProtoMessage()    {}
 func (*GetCloneStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{2}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{2}
 }
 func (m *GetCloneStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCloneStatusRequest.Unmarshal(m, b)
@@ -194,7 +233,7 @@ func (m *GetCloneStatusResponse) Reset()         { *m = GetCloneStatusResponse{}
 func (m *GetCloneStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCloneStatusResponse) ProtoMessage()    {}
 func (*GetCloneStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{3}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{3}
 }
 func (m *GetCloneStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCloneStatusResponse.Unmarshal(m, b)
@@ -229,8 +268,10 @@ func (m *GetCloneStatusResponse) GetProgress() string {
 }
 
 type ArchiveRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	Commit               string   `protobuf:"bytes,2,opt,name=commit" json:"commit,omitempty"`
+	Url    string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Commit string `protobuf:"bytes,2,opt,name=commit" json:"commit,omitempty"`
+	// only archive these paths if not empty
+	Paths                []string `protobuf:"bytes,3,rep,name=paths" json:"paths,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -240,7 +281,7 @@ func (m *ArchiveRequest) Reset()         { *m = ArchiveRequest{} }
 func (m *ArchiveRequest) String() string { return proto.CompactTextString(m) }
 func (*ArchiveRequest) ProtoMessage()    {}
 func (*ArchiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{4}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{4}
 }
 func (m *ArchiveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ArchiveRequest.Unmarshal(m, b)
@@ -274,6 +315,13 @@ func (m *ArchiveRequest) GetCommit() string {
 	return ""
 }
 
+func (m *ArchiveRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 type ArchiveResponse struct {
 	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -285,7 +333,7 @@ func (m *ArchiveResponse) Reset()         { *m = ArchiveResponse{} }
 func (m *ArchiveResponse) String() string { return proto.CompactTextString(m) }
 func (*ArchiveResponse) ProtoMessage()    {}
 func (*ArchiveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{5}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{5}
 }
 func (m *ArchiveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ArchiveResponse.Unmarshal(m, b)
@@ -323,7 +371,7 @@ func (m *GetNamedCommitsRequest) Reset()         { *m = GetNamedCommitsRequest{}
 func (m *GetNamedCommitsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNamedCommitsRequest) ProtoMessage()    {}
 func (*GetNamedCommitsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{6}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{6}
 }
 func (m *GetNamedCommitsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNamedCommitsRequest.Unmarshal(m, b)
@@ -361,7 +409,7 @@ func (m *GetNamedCommitsResponse) Reset()         { *m = GetNamedCommitsResponse
 func (m *GetNamedCommitsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNamedCommitsResponse) ProtoMessage()    {}
 func (*GetNamedCommitsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{7}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{7}
 }
 func (m *GetNamedCommitsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNamedCommitsResponse.Unmarshal(m, b)
@@ -400,7 +448,7 @@ func (m *GetRepositoryFilesRequest) Reset()         { *m = GetRepositoryFilesReq
 func (m *GetRepositoryFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRepositoryFilesRequest) ProtoMessage()    {}
 func (*GetRepositoryFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{8}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{8}
 }
 func (m *GetRepositoryFilesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRepositoryFilesRequest.Unmarshal(m, b)
@@ -445,7 +493,7 @@ func (m *GetRepositoryFilesResponse) Reset()         { *m = GetRepositoryFilesRe
 func (m *GetRepositoryFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRepositoryFilesResponse) ProtoMessage()    {}
 func (*GetRepositoryFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{9}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{9}
 }
 func (m *GetRepositoryFilesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRepositoryFilesResponse.Unmarshal(m, b)
@@ -485,7 +533,7 @@ func (m *GetRepositoryBlobRequest) Reset()         { *m = GetRepositoryBlobReque
 func (m *GetRepositoryBlobRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRepositoryBlobRequest) ProtoMessage()    {}
 func (*GetRepositoryBlobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{10}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{10}
 }
 func (m *GetRepositoryBlobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRepositoryBlobRequest.Unmarshal(m, b)
@@ -538,7 +586,7 @@ func (m *GetRepositoryBlobResponse) Reset()         { *m = GetRepositoryBlobResp
 func (m *GetRepositoryBlobResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRepositoryBlobResponse) ProtoMessage()    {}
 func (*GetRepositoryBlobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{11}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{11}
 }
 func (m *GetRepositoryBlobResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRepositoryBlobResponse.Unmarshal(m, b)
@@ -585,7 +633,7 @@ func (m *NamedCommit) Reset()         { *m = NamedCommit{} }
 func (m *NamedCommit) String() string { return proto.CompactTextString(m) }
 func (*NamedCommit) ProtoMessage()    {}
 func (*NamedCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{12}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{12}
 }
 func (m *NamedCommit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NamedCommit.Unmarshal(m, b)
@@ -629,6 +677,7 @@ func (m *NamedCommit) GetBranch() bool {
 type FileEntry struct {
 	File                 string   `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
 	Dir                  bool     `protobuf:"varint,2,opt,name=dir" json:"dir,omitempty"`
+	Hash                 string   `protobuf:"bytes,3,opt,name=hash" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -638,7 +687,7 @@ func (m *FileEntry) Reset()         { *m = FileEntry{} }
 func (m *FileEntry) String() string { return proto.CompactTextString(m) }
 func (*FileEntry) ProtoMessage()    {}
 func (*FileEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_gits_4bc68d6fa6ca183e, []int{13}
+	return fileDescriptor_gits_178f3572ce999cc0, []int{13}
 }
 func (m *FileEntry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileEntry.Unmarshal(m, b)
@@ -672,6 +721,455 @@ func (m *FileEntry) GetDir() bool {
 	return false
 }
 
+func (m *FileEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type DiffCommitsRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffCommitsRequest) Reset()         { *m = DiffCommitsRequest{} }
+func (m *DiffCommitsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffCommitsRequest) ProtoMessage()    {}
+func (*DiffCommitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{14}
+}
+func (m *DiffCommitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffCommitsRequest.Unmarshal(m, b)
+}
+func (m *DiffCommitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffCommitsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DiffCommitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffCommitsRequest.Merge(dst, src)
+}
+func (m *DiffCommitsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffCommitsRequest.Size(m)
+}
+func (m *DiffCommitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffCommitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffCommitsRequest proto.InternalMessageInfo
+
+func (m *DiffCommitsRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DiffCommitsRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DiffCommitsRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type DiffCommitsResponse struct {
+	Entries              []*DiffEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DiffCommitsResponse) Reset()         { *m = DiffCommitsResponse{} }
+func (m *DiffCommitsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffCommitsResponse) ProtoMessage()    {}
+func (*DiffCommitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{15}
+}
+func (m *DiffCommitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffCommitsResponse.Unmarshal(m, b)
+}
+func (m *DiffCommitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffCommitsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DiffCommitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffCommitsResponse.Merge(dst, src)
+}
+func (m *DiffCommitsResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffCommitsResponse.Size(m)
+}
+func (m *DiffCommitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffCommitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffCommitsResponse proto.InternalMessageInfo
+
+func (m *DiffCommitsResponse) GetEntries() []*DiffEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type DiffEntry struct {
+	File                 string     `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Status               DiffStatus `protobuf:"varint,2,opt,name=status,enum=gits.DiffStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DiffEntry) Reset()         { *m = DiffEntry{} }
+func (m *DiffEntry) String() string { return proto.CompactTextString(m) }
+func (*DiffEntry) ProtoMessage()    {}
+func (*DiffEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{16}
+}
+func (m *DiffEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffEntry.Unmarshal(m, b)
+}
+func (m *DiffEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffEntry.Marshal(b, m, deterministic)
+}
+func (dst *DiffEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffEntry.Merge(dst, src)
+}
+func (m *DiffEntry) XXX_Size() int {
+	return xxx_messageInfo_DiffEntry.Size(m)
+}
+func (m *DiffEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffEntry proto.InternalMessageInfo
+
+func (m *DiffEntry) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *DiffEntry) GetStatus() DiffStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DiffStatus_Modified
+}
+
+type DiffFileRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to" json:"to,omitempty"`
+	File                 string   `protobuf:"bytes,4,opt,name=file" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
+func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffFileRequest) ProtoMessage()    {}
+func (*DiffFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{17}
+}
+func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffFileRequest.Unmarshal(m, b)
+}
+func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffFileRequest.Marshal(b, m, deterministic)
+}
+func (dst *DiffFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileRequest.Merge(dst, src)
+}
+func (m *DiffFileRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffFileRequest.Size(m)
+}
+func (m *DiffFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffFileRequest proto.InternalMessageInfo
+
+func (m *DiffFileRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DiffFileRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DiffFileRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *DiffFileRequest) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+type DiffFileResponse struct {
+	Hunks []*DiffHunk `protobuf:"bytes,1,rep,name=hunks" json:"hunks,omitempty"`
+	// binary files have no hunks
+	Binary               bool     `protobuf:"varint,2,opt,name=binary" json:"binary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
+func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffFileResponse) ProtoMessage()    {}
+func (*DiffFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{18}
+}
+func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffFileResponse.Unmarshal(m, b)
+}
+func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffFileResponse.Marshal(b, m, deterministic)
+}
+func (dst *DiffFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileResponse.Merge(dst, src)
+}
+func (m *DiffFileResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffFileResponse.Size(m)
+}
+func (m *DiffFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffFileResponse proto.InternalMessageInfo
+
+func (m *DiffFileResponse) GetHunks() []*DiffHunk {
+	if m != nil {
+		return m.Hunks
+	}
+	return nil
+}
+
+func (m *DiffFileResponse) GetBinary() bool {
+	if m != nil {
+		return m.Binary
+	}
+	return false
+}
+
+// oldLines lines from oldStart are replaced by newLines lines from newStart,
+// if oldLines is 0, lines are inserted after oldStart
+type DiffHunk struct {
+	OldStart             int32    `protobuf:"varint,1,opt,name=oldStart" json:"oldStart,omitempty"`
+	OldLines             int32    `protobuf:"varint,2,opt,name=oldLines" json:"oldLines,omitempty"`
+	NewStart             int32    `protobuf:"varint,3,opt,name=newStart" json:"newStart,omitempty"`
+	NewLines             int32    `protobuf:"varint,4,opt,name=newLines" json:"newLines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffHunk) Reset()         { *m = DiffHunk{} }
+func (m *DiffHunk) String() string { return proto.CompactTextString(m) }
+func (*DiffHunk) ProtoMessage()    {}
+func (*DiffHunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{19}
+}
+func (m *DiffHunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffHunk.Unmarshal(m, b)
+}
+func (m *DiffHunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffHunk.Marshal(b, m, deterministic)
+}
+func (dst *DiffHunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffHunk.Merge(dst, src)
+}
+func (m *DiffHunk) XXX_Size() int {
+	return xxx_messageInfo_DiffHunk.Size(m)
+}
+func (m *DiffHunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffHunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffHunk proto.InternalMessageInfo
+
+func (m *DiffHunk) GetOldStart() int32 {
+	if m != nil {
+		return m.OldStart
+	}
+	return 0
+}
+
+func (m *DiffHunk) GetOldLines() int32 {
+	if m != nil {
+		return m.OldLines
+	}
+	return 0
+}
+
+func (m *DiffHunk) GetNewStart() int32 {
+	if m != nil {
+		return m.NewStart
+	}
+	return 0
+}
+
+func (m *DiffHunk) GetNewLines() int32 {
+	if m != nil {
+		return m.NewLines
+	}
+	return 0
+}
+
+type ListClonesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListClonesRequest) Reset()         { *m = ListClonesRequest{} }
+func (m *ListClonesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListClonesRequest) ProtoMessage()    {}
+func (*ListClonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{20}
+}
+func (m *ListClonesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClonesRequest.Unmarshal(m, b)
+}
+func (m *ListClonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClonesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListClonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClonesRequest.Merge(dst, src)
+}
+func (m *ListClonesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListClonesRequest.Size(m)
+}
+func (m *ListClonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClonesRequest proto.InternalMessageInfo
+
+type CloneTask struct {
+	Url       string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Progress  string `protobuf:"bytes,2,opt,name=progress" json:"progress,omitempty"`
+	StartedAt int64  `protobuf:"varint,3,opt,name=startedAt" json:"startedAt,omitempty"`
+	// cloning a cloned repository again
+	Force                bool     `protobuf:"varint,4,opt,name=force" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloneTask) Reset()         { *m = CloneTask{} }
+func (m *CloneTask) String() string { return proto.CompactTextString(m) }
+func (*CloneTask) ProtoMessage()    {}
+func (*CloneTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{21}
+}
+func (m *CloneTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneTask.Unmarshal(m, b)
+}
+func (m *CloneTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneTask.Marshal(b, m, deterministic)
+}
+func (dst *CloneTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneTask.Merge(dst, src)
+}
+func (m *CloneTask) XXX_Size() int {
+	return xxx_messageInfo_CloneTask.Size(m)
+}
+func (m *CloneTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneTask proto.InternalMessageInfo
+
+func (m *CloneTask) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CloneTask) GetProgress() string {
+	if m != nil {
+		return m.Progress
+	}
+	return ""
+}
+
+func (m *CloneTask) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *CloneTask) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type ListClonesResponse struct {
+	Clones               []*CloneTask `protobuf:"bytes,1,rep,name=clones" json:"clones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListClonesResponse) Reset()         { *m = ListClonesResponse{} }
+func (m *ListClonesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClonesResponse) ProtoMessage()    {}
+func (*ListClonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gits_178f3572ce999cc0, []int{22}
+}
+func (m *ListClonesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClonesResponse.Unmarshal(m, b)
+}
+func (m *ListClonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClonesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListClonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClonesResponse.Merge(dst, src)
+}
+func (m *ListClonesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListClonesResponse.Size(m)
+}
+func (m *ListClonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClonesResponse proto.InternalMessageInfo
+
+func (m *ListClonesResponse) GetClones() []*CloneTask {
+	if m != nil {
+		return m.Clones
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CloneRequest)(nil), "gits.CloneRequest")
 	proto.RegisterType((*CloneResponse)(nil), "gits.CloneResponse")
@@ -687,50 +1185,82 @@ func init() {
 	proto.RegisterType((*GetRepositoryBlobResponse)(nil), "gits.GetRepositoryBlobResponse")
 	proto.RegisterType((*NamedCommit)(nil), "gits.NamedCommit")
 	proto.RegisterType((*FileEntry)(nil), "gits.FileEntry")
+	proto.RegisterType((*DiffCommitsRequest)(nil), "gits.DiffCommitsRequest")
+	proto.RegisterType((*DiffCommitsResponse)(nil), "gits.DiffCommitsResponse")
+	proto.RegisterType((*DiffEntry)(nil), "gits.DiffEntry")
+	proto.RegisterType((*DiffFileRequest)(nil), "gits.DiffFileRequest")
+	proto.RegisterType((*DiffFileResponse)(nil), "gits.DiffFileResponse")
+	proto.RegisterType((*DiffHunk)(nil), "gits.DiffHunk")
+	proto.RegisterType((*ListClonesRequest)(nil), "gits.ListClonesRequest")
+	proto.RegisterType((*CloneTask)(nil), "gits.CloneTask")
+	proto.RegisterType((*ListClonesResponse)(nil), "gits.ListClonesResponse")
 	proto.RegisterEnum("gits.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterEnum("gits.DiffStatus", DiffStatus_name, DiffStatus_value)
 	proto.RegisterEnum("gits.CloneStatus", CloneStatus_name, CloneStatus_value)
 }
 
-func init() { proto.RegisterFile("gits.proto", fileDescriptor_gits_4bc68d6fa6ca183e) }
-
-var fileDescriptor_gits_4bc68d6fa6ca183e = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x4e, 0xdb, 0x40,
-	0x10, 0x25, 0x04, 0x9c, 0x30, 0xa1, 0x89, 0x99, 0x02, 0x4d, 0xdd, 0x0b, 0xc8, 0x52, 0x25, 0xa0,
-	0x12, 0xa2, 0xf0, 0x52, 0xf5, 0xad, 0xa0, 0x10, 0x55, 0xa8, 0x79, 0x30, 0x45, 0xed, 0x5b, 0xe5,
-	0xc4, 0xdb, 0x64, 0x55, 0x67, 0x37, 0xdd, 0xdd, 0xd0, 0xe6, 0x23, 0xf2, 0x11, 0xbd, 0x7c, 0x47,
-	0xbf, 0xad, 0xda, 0xf5, 0xda, 0x38, 0x89, 0x53, 0x89, 0xb7, 0x99, 0xd9, 0x33, 0x67, 0xce, 0xec,
-	0xfa, 0x18, 0xa0, 0x4f, 0x95, 0x3c, 0x1e, 0x09, 0xae, 0x38, 0xae, 0xe9, 0xd8, 0xdf, 0x87, 0xcd,
-	0x8b, 0x98, 0x33, 0x12, 0x90, 0x6f, 0x63, 0x22, 0x15, 0xba, 0x50, 0x1e, 0x8b, 0xb8, 0x59, 0xda,
-	0x2f, 0x1d, 0x6c, 0x04, 0x3a, 0xf4, 0x1b, 0xf0, 0xc0, 0x22, 0xe4, 0x88, 0x33, 0x49, 0xfc, 0x43,
-	0xd8, 0x69, 0x13, 0x65, 0x6a, 0xd7, 0x2a, 0x54, 0x63, 0xb9, 0xbc, 0xf7, 0x33, 0xec, 0xce, 0x43,
-	0x13, 0x12, 0x3c, 0x04, 0x47, 0x9a, 0x8a, 0x81, 0xd7, 0x4f, 0xb7, 0x8e, 0x8d, 0xb4, 0x3c, 0xd4,
-	0x02, 0xd0, 0x83, 0xea, 0x48, 0xf0, 0xbe, 0x20, 0x52, 0x36, 0x57, 0x0d, 0x77, 0x96, 0xfb, 0x6f,
-	0xa0, 0xfe, 0x56, 0xf4, 0x06, 0xf4, 0x76, 0xf9, 0x02, 0xb8, 0x0b, 0x4e, 0x8f, 0x0f, 0x87, 0x54,
-	0xd9, 0x6e, 0x9b, 0xf9, 0x2f, 0xa0, 0x91, 0xf5, 0x5a, 0x55, 0x08, 0x6b, 0x51, 0xa8, 0x42, 0xd3,
-	0xbd, 0x19, 0x98, 0xd8, 0x3f, 0x32, 0x3b, 0x74, 0xc2, 0x21, 0x89, 0x2e, 0x4c, 0xe3, 0x7f, 0xf6,
-	0xbd, 0x84, 0x47, 0x0b, 0x58, 0x4b, 0xfd, 0x12, 0x2a, 0xc9, 0x5c, 0xbd, 0x71, 0xf9, 0xa0, 0x96,
-	0x6e, 0x9c, 0x03, 0x07, 0x29, 0xc2, 0x6f, 0xc1, 0xe3, 0x36, 0x51, 0x01, 0x19, 0x71, 0x49, 0x15,
-	0x17, 0x93, 0x4b, 0x1a, 0x13, 0x79, 0xff, 0x0d, 0xdb, 0xe0, 0x15, 0xd1, 0x64, 0x4f, 0x50, 0x21,
-	0x4c, 0x09, 0x4a, 0x52, 0x45, 0x8d, 0x44, 0x91, 0x46, 0xb5, 0x98, 0x12, 0x93, 0x20, 0x3d, 0xf7,
-	0x3f, 0x41, 0x73, 0x86, 0xe8, 0x3c, 0xe6, 0xdd, 0x7b, 0xcb, 0xd1, 0xb7, 0xfb, 0x85, 0xc6, 0xa4,
-	0x59, 0x36, 0x55, 0x13, 0xfb, 0x57, 0x73, 0x9b, 0x26, 0xcc, 0x56, 0x61, 0x53, 0xdf, 0x19, 0x53,
-	0x84, 0x29, 0x4b, 0x9f, 0xa6, 0xb8, 0x0d, 0xeb, 0xa3, 0x38, 0xa4, 0xcc, 0x4c, 0xa8, 0x06, 0x49,
-	0xe2, 0xbf, 0x87, 0x5a, 0xee, 0x3a, 0xf5, 0x3c, 0x16, 0x0e, 0x89, 0xed, 0x35, 0xb1, 0xae, 0x0d,
-	0x42, 0x39, 0xb0, 0xca, 0x4c, 0xac, 0xf5, 0x76, 0x45, 0xc8, 0x7a, 0x03, 0xa3, 0xac, 0x1a, 0xd8,
-	0xcc, 0x7f, 0x05, 0x1b, 0xd9, 0x5d, 0x64, 0xe2, 0x4b, 0x77, 0xe2, 0xf5, 0xea, 0x11, 0x15, 0x56,
-	0x83, 0x0e, 0x8f, 0x08, 0x6c, 0xb4, 0x84, 0xe0, 0xe2, 0x82, 0x47, 0x04, 0x6b, 0x50, 0xb9, 0x1e,
-	0xf7, 0x7a, 0x44, 0x4a, 0x77, 0x05, 0xb7, 0xa1, 0xae, 0xb7, 0xbc, 0x11, 0xf1, 0x3b, 0x76, 0x1b,
-	0xc6, 0x34, 0x72, 0x7f, 0x4e, 0x1d, 0x44, 0xd8, 0xd4, 0xd5, 0x0e, 0x57, 0xad, 0x1f, 0x54, 0x2a,
-	0xf7, 0xd7, 0xd4, 0xc1, 0x3a, 0x54, 0xdb, 0x54, 0xc9, 0xf3, 0xb1, 0x9c, 0xb8, 0xbf, 0xa7, 0x0e,
-	0x6e, 0x41, 0x4d, 0x63, 0xb4, 0x35, 0x28, 0xeb, 0xbb, 0x7f, 0xa6, 0xce, 0xd1, 0x19, 0xd4, 0x72,
-	0x4e, 0xd1, 0x83, 0x6e, 0xd8, 0x57, 0xc6, 0xbf, 0x33, 0x77, 0x45, 0x27, 0x29, 0xb4, 0x84, 0x00,
-	0x8e, 0x01, 0x46, 0xee, 0xea, 0xe9, 0xdf, 0x32, 0xac, 0x69, 0x62, 0x3c, 0x81, 0x75, 0x53, 0x44,
-	0xcc, 0x99, 0xce, 0x3e, 0xa7, 0xf7, 0x70, 0xa6, 0x66, 0x1f, 0xe2, 0x0a, 0xea, 0xb3, 0x3e, 0xc6,
-	0x27, 0x09, 0xac, 0xf0, 0x47, 0xe0, 0x3d, 0x2d, 0x3e, 0xb4, 0x64, 0xaf, 0xa1, 0x62, 0x7d, 0x87,
-	0xdb, 0x09, 0x70, 0xd6, 0xc2, 0xde, 0xce, 0x5c, 0x35, 0xe9, 0x3b, 0x29, 0x61, 0x07, 0x1a, 0x73,
-	0xf6, 0xc2, 0xbb, 0x51, 0x05, 0x0e, 0xf5, 0x9e, 0x2d, 0x39, 0xb5, 0x4a, 0x3e, 0x02, 0x2e, 0xfa,
-	0x03, 0xf7, 0xb2, 0xa6, 0x62, 0x03, 0x7a, 0xfb, 0xcb, 0x01, 0x96, 0xf8, 0x03, 0x6c, 0x2d, 0x7c,
-	0xd5, 0xf8, 0xbc, 0xa0, 0x2d, 0x67, 0x24, 0x6f, 0x6f, 0xe9, 0x79, 0xc2, 0xda, 0x75, 0xcc, 0x8f,
-	0xfb, 0xec, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28, 0xa3, 0x4c, 0x41, 0xc6, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("gits.proto", fileDescriptor_gits_178f3572ce999cc0) }
+
+var fileDescriptor_gits_178f3572ce999cc0 = []byte{
+	// 947 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x6e, 0x1b, 0x37,
+	0x13, 0xb6, 0x4e, 0x6b, 0x69, 0xe4, 0x48, 0x6b, 0xfa, 0x10, 0x79, 0xff, 0xfc, 0x8d, 0x41, 0xb4,
+	0xa8, 0xe2, 0x02, 0x41, 0xe0, 0x00, 0x45, 0x7b, 0x51, 0xb4, 0xb6, 0xa3, 0xb8, 0x69, 0x12, 0x23,
+	0x58, 0x27, 0x68, 0x81, 0x5e, 0x14, 0x6b, 0x2d, 0x65, 0x11, 0x5e, 0x91, 0x2a, 0x49, 0x25, 0x75,
+	0xde, 0x41, 0x0f, 0xd1, 0xd3, 0x6b, 0x16, 0x05, 0x0f, 0x7b, 0x90, 0xb4, 0x76, 0x10, 0xf4, 0x8e,
+	0x33, 0xf3, 0xf1, 0xdb, 0x6f, 0x86, 0xe4, 0xcc, 0x02, 0x5c, 0x52, 0x25, 0x1f, 0x4e, 0x05, 0x57,
+	0x1c, 0xd5, 0xf5, 0x1a, 0x7f, 0x09, 0x1b, 0x27, 0x09, 0x67, 0x24, 0x24, 0xbf, 0xce, 0x88, 0x54,
+	0xc8, 0x87, 0xda, 0x4c, 0x24, 0xbd, 0xca, 0x7e, 0xa5, 0xdf, 0x0a, 0xf5, 0x12, 0x6d, 0x43, 0x63,
+	0xc4, 0xc5, 0x90, 0xf4, 0xaa, 0xfb, 0x95, 0x7e, 0x33, 0xb4, 0x06, 0xee, 0xc2, 0x1d, 0xb7, 0x4f,
+	0x4e, 0x39, 0x93, 0x04, 0x3f, 0x80, 0x9d, 0x53, 0xa2, 0x8c, 0xef, 0x5c, 0x45, 0x6a, 0x26, 0x6f,
+	0x64, 0xc4, 0xbf, 0xc0, 0xee, 0x32, 0xd4, 0x92, 0xa0, 0x07, 0xe0, 0x49, 0xe3, 0x31, 0xf0, 0xce,
+	0xe1, 0xe6, 0x43, 0x23, 0xb8, 0x08, 0x75, 0x00, 0x14, 0x40, 0x73, 0x2a, 0xf8, 0xa5, 0x20, 0x52,
+	0x1a, 0x65, 0xad, 0x30, 0xb3, 0xf1, 0x2b, 0xe8, 0x1c, 0x89, 0xe1, 0x98, 0xbe, 0xbd, 0x25, 0xad,
+	0x5d, 0xf0, 0x86, 0x7c, 0x32, 0xa1, 0xca, 0xed, 0x76, 0x96, 0x4e, 0x77, 0x1a, 0xa9, 0xb1, 0xec,
+	0xd5, 0xf6, 0x6b, 0xfd, 0x56, 0x68, 0x0d, 0xfc, 0x19, 0x74, 0x33, 0x46, 0xa7, 0x15, 0x41, 0x3d,
+	0x8e, 0x54, 0x64, 0x38, 0x37, 0x42, 0xb3, 0xc6, 0x07, 0x26, 0xb3, 0xb3, 0x68, 0x42, 0xe2, 0x13,
+	0x43, 0x77, 0x4b, 0x15, 0x9e, 0xc2, 0xdd, 0x15, 0xac, 0xa3, 0xfe, 0x02, 0xd6, 0xad, 0x1a, 0x5d,
+	0x87, 0x5a, 0xbf, 0x9d, 0xd6, 0xa1, 0x00, 0x0e, 0x53, 0x04, 0x1e, 0xc0, 0xde, 0x29, 0x51, 0x21,
+	0x99, 0x72, 0x49, 0x15, 0x17, 0xd7, 0x4f, 0x69, 0x42, 0xe4, 0x47, 0xe7, 0x8d, 0x4f, 0x21, 0x28,
+	0xa3, 0xc9, 0x0e, 0x66, 0x9d, 0x30, 0x25, 0x28, 0x49, 0x15, 0x75, 0xad, 0x22, 0x8d, 0x1a, 0x30,
+	0x25, 0xae, 0xc3, 0x34, 0x8e, 0x7f, 0x82, 0xde, 0x02, 0xd1, 0x71, 0xc2, 0x2f, 0x3e, 0xfe, 0x18,
+	0x10, 0xd4, 0x47, 0x34, 0x21, 0xbd, 0x9a, 0xf1, 0x9a, 0x35, 0x7e, 0x0e, 0x7b, 0x25, 0xcc, 0x4e,
+	0x61, 0x4f, 0xd7, 0x8c, 0x29, 0xc2, 0x94, 0xa3, 0x4f, 0x4d, 0x73, 0xa2, 0x49, 0x44, 0x59, 0x7a,
+	0x81, 0x8d, 0x81, 0x5f, 0x42, 0xbb, 0x50, 0x4e, 0xfd, 0x3d, 0x16, 0x4d, 0x88, 0xdb, 0x6b, 0xd6,
+	0xda, 0x37, 0x8e, 0xe4, 0xd8, 0x29, 0x33, 0x6b, 0xad, 0xf7, 0x42, 0x44, 0x6c, 0x38, 0x36, 0xca,
+	0x9a, 0xa1, 0xb3, 0xf0, 0x00, 0x5a, 0x59, 0x2d, 0x32, 0xf1, 0x95, 0x5c, 0xbc, 0x4e, 0x3d, 0xa6,
+	0xc2, 0x69, 0xd0, 0xcb, 0x8c, 0xbe, 0x96, 0xd3, 0xe3, 0x1f, 0x00, 0x3d, 0xa1, 0xa3, 0xd1, 0x87,
+	0x2e, 0x8f, 0xf9, 0x82, 0xe0, 0x93, 0x54, 0x9a, 0x5e, 0xa3, 0x0e, 0x54, 0x15, 0x77, 0x6c, 0x55,
+	0xc5, 0xf1, 0x77, 0xb0, 0xb5, 0xc0, 0xf5, 0x81, 0xa3, 0xd4, 0xd8, 0xa5, 0xa3, 0x7c, 0x06, 0xad,
+	0xcc, 0x5b, 0x9a, 0x54, 0x3f, 0x7b, 0xaf, 0x55, 0xf3, 0x5e, 0xfd, 0x9c, 0x6a, 0xf1, 0xb9, 0xe2,
+	0x9f, 0xa1, 0xab, 0xbd, 0xba, 0x46, 0xff, 0x29, 0xab, 0x4c, 0x46, 0xbd, 0x70, 0x31, 0x5e, 0x81,
+	0x9f, 0x93, 0xbb, 0x34, 0x3f, 0x85, 0xc6, 0x78, 0xc6, 0xae, 0xd2, 0x24, 0x3b, 0xb9, 0xb2, 0xef,
+	0x67, 0xec, 0x2a, 0xb4, 0x41, 0x73, 0x9c, 0x94, 0x45, 0xe2, 0xda, 0x1d, 0x8c, 0xb3, 0xf0, 0x7b,
+	0x68, 0xa6, 0x50, 0xdd, 0x69, 0x78, 0x12, 0x9f, 0xab, 0x48, 0xd8, 0xab, 0xd5, 0x08, 0x33, 0xdb,
+	0xc5, 0x5e, 0x50, 0x46, 0x6c, 0x09, 0x1a, 0x61, 0x66, 0xeb, 0x18, 0x23, 0xef, 0xec, 0xbe, 0x9a,
+	0x8d, 0xa5, 0xb6, 0x8b, 0xd9, 0x7d, 0xf5, 0x2c, 0x66, 0x6c, 0xbc, 0x05, 0x9b, 0x2f, 0xa8, 0xb4,
+	0xfd, 0x31, 0xbd, 0x02, 0x78, 0x02, 0x2d, 0xe3, 0x78, 0x1d, 0xc9, 0xab, 0x92, 0xca, 0xdd, 0xd2,
+	0x0d, 0xd1, 0x3d, 0x68, 0x49, 0xfd, 0x51, 0x12, 0x1f, 0x59, 0x21, 0xb5, 0x30, 0x77, 0xe4, 0xed,
+	0xbd, 0x5e, 0x6c, 0xef, 0xdf, 0x00, 0x2a, 0x6a, 0x70, 0x35, 0xfd, 0x1c, 0xbc, 0xa1, 0xf1, 0x2c,
+	0xde, 0x9c, 0x4c, 0x58, 0xe8, 0xc2, 0x07, 0xef, 0xa1, 0x35, 0x10, 0x82, 0x8b, 0x13, 0x1e, 0x13,
+	0xd4, 0x86, 0xf5, 0xf3, 0xd9, 0x70, 0x48, 0xa4, 0xf4, 0xd7, 0xd0, 0x36, 0x74, 0xf4, 0x03, 0x7e,
+	0x23, 0x92, 0x67, 0xec, 0x6d, 0x94, 0xd0, 0xd8, 0xff, 0x7d, 0xee, 0x21, 0x04, 0x1b, 0xda, 0x7b,
+	0xc6, 0xd5, 0xe0, 0x37, 0x2a, 0x95, 0xff, 0xc7, 0xdc, 0x43, 0x1d, 0x68, 0x9e, 0x52, 0x25, 0x8f,
+	0x67, 0xf2, 0xda, 0xff, 0x73, 0xee, 0xa1, 0x4d, 0x68, 0x6b, 0x8c, 0xfe, 0x18, 0x65, 0x97, 0xfe,
+	0x5f, 0x73, 0x0f, 0x6d, 0xc1, 0x1d, 0x7b, 0xbb, 0x53, 0xae, 0xbf, 0xe7, 0xde, 0xc1, 0x21, 0x40,
+	0x7e, 0xff, 0xd0, 0x06, 0x34, 0x5f, 0xf2, 0x98, 0x8e, 0x28, 0x89, 0xfd, 0x35, 0xd4, 0x82, 0xc6,
+	0x51, 0x1c, 0x93, 0xd8, 0xaf, 0x68, 0x55, 0x4f, 0x48, 0x42, 0x14, 0x89, 0xfd, 0xea, 0xc1, 0x63,
+	0x68, 0x17, 0x66, 0x8c, 0x8e, 0xbd, 0x61, 0x57, 0x8c, 0xbf, 0x63, 0xfe, 0x9a, 0x36, 0xd2, 0x6f,
+	0x56, 0x10, 0x80, 0x67, 0x80, 0xb1, 0x5f, 0x3d, 0xfc, 0xa7, 0x0e, 0x75, 0xad, 0x10, 0x3d, 0x82,
+	0x86, 0x71, 0x22, 0x54, 0xa8, 0x87, 0x3b, 0xb8, 0x60, 0x6b, 0xc1, 0xe7, 0x0a, 0xf9, 0x1c, 0x3a,
+	0x8b, 0x13, 0x10, 0xfd, 0xcf, 0xc2, 0x4a, 0x47, 0x68, 0x70, 0xaf, 0x3c, 0xe8, 0xc8, 0xbe, 0x82,
+	0x75, 0x37, 0x9b, 0xd0, 0xb6, 0x05, 0x2e, 0x0e, 0xbf, 0x60, 0x67, 0xc9, 0x6b, 0xf7, 0x3d, 0xaa,
+	0xa0, 0x33, 0xe8, 0x2e, 0x8d, 0x20, 0x94, 0x7f, 0xaa, 0x64, 0x8a, 0x05, 0xff, 0xbf, 0x21, 0xea,
+	0x94, 0xfc, 0x08, 0x68, 0x75, 0x86, 0xa0, 0xfb, 0xd9, 0xa6, 0xf2, 0x21, 0x15, 0xec, 0xdf, 0x0c,
+	0x70, 0xc4, 0xaf, 0x61, 0x73, 0xa5, 0xf3, 0xa3, 0x4f, 0x4a, 0xb6, 0x15, 0x86, 0x4d, 0x70, 0xff,
+	0xc6, 0xb8, 0x63, 0x3d, 0x86, 0x76, 0xa1, 0x41, 0xa2, 0x5e, 0xde, 0x22, 0x96, 0xd2, 0xde, 0x2b,
+	0x89, 0x38, 0x8e, 0xaf, 0x6d, 0xa3, 0xd0, 0x72, 0xd1, 0x4e, 0x0e, 0x2b, 0xf4, 0xb9, 0x60, 0x77,
+	0xd9, 0xed, 0xb6, 0x7e, 0x0b, 0x90, 0xbf, 0x31, 0x74, 0xd7, 0xa2, 0x56, 0x5e, 0x7e, 0xd0, 0x5b,
+	0x0d, 0x58, 0x82, 0x0b, 0xcf, 0xfc, 0xc8, 0x3d, 0xfe, 0x77, 0x00, 0xef, 0x6f, 0xbc, 0x54, 0xd6,
+	0x09, 0x00, 0x00,
 }
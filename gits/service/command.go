@@ -14,6 +14,8 @@ import (
 	"os/exec"
 	"path"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +31,7 @@ var (
 	errorRepositoryCloned   = errors.New("repository cloned")
 	errorRepositoryNotExist = errors.New("repository not exist")
 	errorFileNotFound       = errors.New("file not found")
+	errorCommitInvalid      = errors.New("commit invalid")
 )
 
 type gitCommander struct {
@@ -44,8 +47,10 @@ type gitCommander struct {
 }
 
 type cloneProgress struct {
-	progress string
-	err      error
+	progress  string
+	err       error
+	startedAt int64
+	force     bool
 }
 
 type progressUpdater func(progress string)
@@ -179,10 +184,10 @@ func (g *gitCommander) urlToLocal(repoUrl string) (string, error) {
 	return path.Join(g.conf.Data, p), nil
 }
 
-func (g *gitCommander) prepareClone(ctx context.Context, url string, dst string) error {
+func (g *gitCommander) prepareClone(ctx context.Context, url string, dst string, force bool) error {
 	// first check if already cloned
 	_, err := os.Stat(dst)
-	if err == nil {
+	if err == nil && !force {
 		return errorRepositoryCloned
 	}
 	// then check if url is been cloning
@@ -194,7 +199,7 @@ func (g *gitCommander) prepareClone(ctx context.Context, url string, dst string)
 		return errorRepositoryCloning
 	}
 
-	g.status[url] = cloneProgress{progress: "prepare cloning"}
+	g.status[url] = cloneProgress{progress: "prepare cloning", startedAt: time.Now().Unix(), force: force}
 
 	log.Debug(g.status)
 
@@ -214,19 +219,35 @@ func (g *gitCommander) isRepositoryCloned(url string) bool {
 	return false
 }
 
-func (g *gitCommander) clone(ctx context.Context, url string) error {
+// setProgress updates progress of url being cloned
+func (g *gitCommander) setProgress(url, progress string, err error) {
+	g.statusMutex.Lock()
+	defer g.statusMutex.Unlock()
+	if p, ok := g.status[url]; ok {
+		p.progress, p.err = progress, err
+		g.status[url] = p
+	}
+}
+
+// clone url in background, a cloned repository is cloned again if force is
+// true. The new clone replaces the old one once it completes, so that the
+// old one can be used meanwhile.
+func (g *gitCommander) clone(ctx context.Context, url string, force bool) error {
 	dstDir, err := g.urlToLocal(url)
 	if err != nil {
 		return err
 	}
 
-	err = g.prepareClone(ctx, url, dstDir)
+	err = g.prepareClone(ctx, url, dstDir, force)
 	if err != nil {
 		return err
 	}
 
 	// try acquire sema
 	if !g.cloneSem.TryAcquire(1) {
+		g.statusMutex.Lock()
+		delete(g.status, url)
+		g.statusMutex.Unlock()
 		return errorGitBusy
 	}
 
@@ -235,8 +256,7 @@ func (g *gitCommander) clone(ctx context.Context, url string) error {
 
 	updater := func(progress string) {
 		log.Debugf("update progress: url=%s, progress=%s", url, progress)
-		// need lock here?
-		g.status[url] = cloneProgress{progress: progress}
+		g.setProgress(url, progress, nil)
 	}
 
 	// run git command
@@ -265,12 +285,11 @@ func (g *gitCommander) clone(ctx context.Context, url string) error {
 
 		err = cmd.Run()
 		if err == nil {
-			err = os.Rename(tmpDir, dstDir)
+			err = replaceDir(tmpDir, dstDir)
 		}
 		if err != nil {
-			// need lock here?
 			log.Warnf("clone repository error: url=%s error=%s", url, err.Error())
-			g.status[url] = cloneProgress{err: err}
+			g.setProgress(url, "", err)
 		}
 		log.Debugf("clone repository success: url=%s dir=%s time=%v", url, dstDir, time.Since(now))
 	}()
@@ -278,6 +297,47 @@ func (g *gitCommander) clone(ctx context.Context, url string) error {
 	return nil
 }
 
+// move directory src to dst, replacing dst if it exists
+func replaceDir(src, dst string) error {
+	if _, err := os.Stat(dst); err != nil {
+		return os.Rename(src, dst)
+	}
+	old := dst + "_old"
+	if err := os.RemoveAll(old); err != nil {
+		return err
+	}
+	if err := os.Rename(dst, old); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err != nil {
+		_ = os.Rename(old, dst)
+		return err
+	}
+	return os.RemoveAll(old)
+}
+
+// repositories being cloned, oldest first
+func (g *gitCommander) listClones() []*proto.CloneTask {
+	g.statusMutex.RLock()
+	defer g.statusMutex.RUnlock()
+	clones := make([]*proto.CloneTask, 0, len(g.status))
+	for url, p := range g.status {
+		clones = append(clones, &proto.CloneTask{
+			Url:       url,
+			Progress:  p.progress,
+			StartedAt: p.startedAt,
+			Force:     p.force,
+		})
+	}
+	sort.Slice(clones, func(i, j int) bool {
+		if clones[i].StartedAt != clones[j].StartedAt {
+			return clones[i].StartedAt < clones[j].StartedAt
+		}
+		return clones[i].Url < clones[j].Url
+	})
+	return clones
+}
+
 func (g *gitCommander) cloneStatus(ctx context.Context, url string) (status proto.CloneStatus, progress string) {
 	status = proto.CloneStatus_Unknown
 	if g.isRepositoryCloned(url) {
@@ -384,6 +444,7 @@ func (f *fileEntryWriter) Write(p []byte) (int, error) {
 		f.entries = append(f.entries, &proto.FileEntry{
 			File: strings.Trim(parts[3], ""),
 			Dir:  isDir,
+			Hash: parts[2],
 		})
 	}
 	f.lw.lines = f.lw.lines[:0]
@@ -500,7 +561,7 @@ func (g *gitCommander) getRepositoryBlob(ctx context.Context, url, commit, file
 	return
 }
 
-func (g *gitCommander) archive(ctx context.Context, url, commit string, writer io.Writer) error {
+func (g *gitCommander) archive(ctx context.Context, url, commit string, paths []string, writer io.Writer) error {
 	// try acquire archive sema
 	if !g.archiveSem.TryAcquire(1) {
 		return errorGitBusy
@@ -515,16 +576,169 @@ func (g *gitCommander) archive(ctx context.Context, url, commit string, writer i
 	}
 	dir, _ := g.urlToLocal(url)
 
-	cmd := exec.CommandContext(ctx, g.conf.Path,
+	// paths are file names, not pathspecs
+	args := []string{
+		"--literal-pathspecs",
 		"archive",
 		"--worktree-attributes",
 		"--format=tar",
 		//"-0",
 		commit,
-		"--")
+		"--",
+	}
+	cmd := exec.CommandContext(ctx, g.conf.Path, append(args, paths...)...)
 	cmd.Dir = dir
 	cmd.Stdout = writer
 	//cmd.Stderr = writer
 
 	return cmd.Run()
 }
+
+// get changed files between commit `from` and `to`, renames are reported as
+// a deletion plus an addition
+func (g *gitCommander) diffCommits(ctx context.Context, url, from, to string) (entries []*proto.DiffEntry, err error) {
+	if !isObjectId(from) || !isObjectId(to) {
+		err = errorCommitInvalid
+		return
+	}
+	// try acquire sema
+	if !g.otherSem.TryAcquire(1) {
+		err = errorGitBusy
+		return
+	}
+	defer g.otherSem.Release(1)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(g.conf.DefaultTimeout)*time.Second)
+	defer cancel()
+
+	if !g.isRepositoryCloned(url) {
+		err = errorRepositoryNotExist
+		return
+	}
+	dir, _ := g.urlToLocal(url)
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, g.conf.Path, "diff", "--name-status", "--no-renames", "-z", from, to, "--")
+	cmd.Dir = dir
+	cmd.Stdout = &out
+
+	if err = cmd.Run(); err != nil {
+		return
+	}
+	entries = parseDiffNameStatus(out.Bytes())
+	log.Debugf("diffCommits: dir=%s from=%s to=%s entries=%d", dir, from, to, len(entries))
+	return
+}
+
+var objectIdRegex = regexp.MustCompile("^([0-9a-f]{40}|[0-9a-f]{64})$")
+
+// commits passed to git before `--` must be full object ids, or they may be
+// parsed as options
+func isObjectId(commit string) bool {
+	return objectIdRegex.MatchString(commit)
+}
+
+// output of `git diff --name-status -z` is: status NUL path NUL ...
+func parseDiffNameStatus(p []byte) []*proto.DiffEntry {
+	fields := bytes.Split(bytes.TrimSuffix(p, []byte{0}), []byte{0})
+	entries := make([]*proto.DiffEntry, 0, len(fields)/2)
+	for i := 0; i+1 < len(fields); i += 2 {
+		var status proto.DiffStatus
+		switch string(fields[i]) {
+		case "A":
+			status = proto.DiffStatus_Added
+		case "D":
+			status = proto.DiffStatus_Deleted
+		default:
+			status = proto.DiffStatus_Modified
+		}
+		entries = append(entries, &proto.DiffEntry{
+			File:   string(fields[i+1]),
+			Status: status,
+		})
+	}
+	return entries
+}
+
+// get changed lines of file between commit `from` and `to`
+func (g *gitCommander) diffFile(ctx context.Context, url, from, to, file string) (hunks []*proto.DiffHunk, binary bool, err error) {
+	if !isObjectId(from) || !isObjectId(to) {
+		err = errorCommitInvalid
+		return
+	}
+	// try acquire sema
+	if !g.otherSem.TryAcquire(1) {
+		err = errorGitBusy
+		return
+	}
+	defer g.otherSem.Release(1)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(g.conf.DefaultTimeout)*time.Second)
+	defer cancel()
+
+	if !g.isRepositoryCloned(url) {
+		err = errorRepositoryNotExist
+		return
+	}
+	dir, _ := g.urlToLocal(url)
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, g.conf.Path, "--literal-pathspecs", "diff", "-U0", "--no-renames", "--no-color", "--no-ext-diff", from, to, "--", file)
+	cmd.Dir = dir
+	cmd.Stdout = &out
+
+	if err = cmd.Run(); err != nil {
+		return
+	}
+	hunks, binary = parseDiffHunks(out.Bytes())
+	log.Debugf("diffFile: dir=%s from=%s to=%s file=%s hunks=%d binary=%v", dir, from, to, file, len(hunks), binary)
+	return
+}
+
+// parse hunk headers `@@ -oldStart[,oldLines] +newStart[,newLines] @@` of
+// `git diff -U0` output, a line count defaults to 1 if omitted
+func parseDiffHunks(p []byte) (hunks []*proto.DiffHunk, binary bool) {
+	hunks = make([]*proto.DiffHunk, 0, 8)
+	for _, line := range bytes.Split(p, []byte{'\n'}) {
+		if bytes.HasPrefix(line, []byte("Binary files ")) {
+			binary = true
+			continue
+		}
+		if !bytes.HasPrefix(line, []byte("@@ -")) {
+			continue
+		}
+		fields := strings.Fields(string(line))
+		if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+			continue
+		}
+		oldStart, oldLines, ok1 := parseHunkRange(fields[1][1:])
+		newStart, newLines, ok2 := parseHunkRange(fields[2][1:])
+		if !ok1 || !ok2 {
+			continue
+		}
+		hunks = append(hunks, &proto.DiffHunk{
+			OldStart: oldStart,
+			OldLines: oldLines,
+			NewStart: newStart,
+			NewLines: newLines,
+		})
+	}
+	return
+}
+
+func parseHunkRange(s string) (start, lines int32, ok bool) {
+	lines = 1
+	if i := strings.IndexByte(s, ','); i != -1 {
+		n, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return
+		}
+		lines = int32(n)
+		s = s[:i]
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return
+	}
+	return int32(n), lines, true
+}
@@ -1,12 +1,19 @@
 package service
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"github.com/lt90s/rfschub-server/gits/config"
 	proto "github.com/lt90s/rfschub-server/gits/proto"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"path"
+	"sort"
 	"strconv"
 	"testing"
 )
@@ -20,7 +27,8 @@ func TestCommand_newGitCommander(t *testing.T) {
 		Path: "/path/not/exist",
 	}
 	require.Panics(t, func() { _ = newGitCommander(conf) })
-	conf.Path = "/usr/local/bin/git"
+	requireGit(t)
+	conf.Path = testConf.Path
 	require.NotPanics(t, func() { _ = newGitCommander(conf) })
 }
 
@@ -45,14 +53,24 @@ var testConf = config.CommandConf{
 	Path: "/usr/local/bin/git",
 	Data: "/tmp/git",
 	Concurrency: config.CommandConcurrency{
-		Clone: 1,
-		Other: 1,
+		Clone:   1,
+		Archive: 1,
+		Other:   1,
 	},
 	CloneTimeout:   600,
+	ArchiveTimeout: 60,
 	DefaultTimeout: 60,
 }
 
+// skip tests which need the git binary of testConf
+func requireGit(t *testing.T) {
+	if _, err := os.Stat(testConf.Path); err != nil {
+		t.Skipf("git not found: path=%s", testConf.Path)
+	}
+}
+
 func TestCommand_Clone_CloneStatus_NameCommits(t *testing.T) {
+	requireGit(t)
 	commander := newGitCommander(testConf)
 	url := "https://github.com/lt90s/goanalytics"
 	dir, err := commander.urlToLocal(url)
@@ -63,20 +81,20 @@ func TestCommand_Clone_CloneStatus_NameCommits(t *testing.T) {
 	status, _ := commander.cloneStatus(ctx, url)
 	require.Equal(t, proto.CloneStatus_Unknown, status)
 
-	err = commander.clone(ctx, url)
+	err = commander.clone(ctx, url, false)
 	require.NoError(t, err)
 
 	status, _ = commander.cloneStatus(ctx, url)
 	require.Equal(t, proto.CloneStatus_Cloning, status)
 
-	err = commander.clone(context.Background(), url)
+	err = commander.clone(context.Background(), url, false)
 	require.Equal(t, errorRepositoryCloning, err, commander.status)
 
-	err = commander.clone(context.Background(), "https://github.com/lt90s/goanalytics-web")
+	err = commander.clone(context.Background(), "https://github.com/lt90s/goanalytics-web", false)
 	require.Equal(t, errorGitBusy, err)
 
 	commander.wait()
-	err = commander.clone(context.Background(), url)
+	err = commander.clone(context.Background(), url, false)
 	require.Equal(t, errorRepositoryCloned, err)
 
 	status, _ = commander.cloneStatus(ctx, url)
@@ -100,3 +118,166 @@ func TestCommand_Clone_CloneStatus_NameCommits(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, plain)
 }
+
+func TestReplaceDir(t *testing.T) {
+	root, err := ioutil.TempDir("", "gits")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	src, dst := path.Join(root, "repo_tmp"), path.Join(root, "repo")
+	require.NoError(t, os.MkdirAll(src, 0755))
+	require.NoError(t, ioutil.WriteFile(path.Join(src, "HEAD"), []byte("old"), 0644))
+	require.NoError(t, replaceDir(src, dst))
+
+	// a clone made again replaces the old one
+	require.NoError(t, os.MkdirAll(src, 0755))
+	require.NoError(t, ioutil.WriteFile(path.Join(src, "HEAD"), []byte("new"), 0644))
+	require.NoError(t, replaceDir(src, dst))
+
+	content, err := ioutil.ReadFile(path.Join(dst, "HEAD"))
+	require.NoError(t, err)
+	require.Equal(t, "new", string(content))
+	for _, dir := range []string{src, dst + "_old"} {
+		_, err = os.Stat(dir)
+		require.True(t, os.IsNotExist(err), dir)
+	}
+}
+
+func TestCommand_listClones(t *testing.T) {
+	commander := &gitCommander{status: map[string]cloneProgress{
+		"https://github.com/b/b": {progress: "Receiving objects", startedAt: 2},
+		"https://github.com/a/a": {progress: "prepare cloning", startedAt: 2, force: true},
+		"https://github.com/c/c": {startedAt: 1},
+	}}
+	clones := commander.listClones()
+	require.Len(t, clones, 3)
+	require.Equal(t, "https://github.com/c/c", clones[0].Url)
+	require.Equal(t, "https://github.com/a/a", clones[1].Url)
+	require.True(t, clones[1].Force)
+	require.Equal(t, "Receiving objects", clones[2].Progress)
+}
+
+func TestParseDiffNameStatus(t *testing.T) {
+	out := []byte("M\x00main.go\x00A\x00dir/new file.go\x00D\x00old.go\x00T\x00link\x00")
+	entries := parseDiffNameStatus(out)
+	require.Len(t, entries, 4)
+	require.Equal(t, "main.go", entries[0].File)
+	require.Equal(t, proto.DiffStatus_Modified, entries[0].Status)
+	require.Equal(t, "dir/new file.go", entries[1].File)
+	require.Equal(t, proto.DiffStatus_Added, entries[1].Status)
+	require.Equal(t, "old.go", entries[2].File)
+	require.Equal(t, proto.DiffStatus_Deleted, entries[2].Status)
+	require.Equal(t, proto.DiffStatus_Modified, entries[3].Status)
+
+	require.Len(t, parseDiffNameStatus(nil), 0)
+}
+
+func TestCommand_diffCommits_InvalidCommit(t *testing.T) {
+	commander := &gitCommander{}
+	commit := "0123456789abcdef0123456789abcdef01234567"
+	for _, c := range []string{"--output=/tmp/diff", "-p", "HEAD", "0123456", ""} {
+		_, err := commander.diffCommits(context.Background(), "https://github.com/a/b", c, commit)
+		require.Equal(t, errorCommitInvalid, err, c)
+		_, err = commander.diffCommits(context.Background(), "https://github.com/a/b", commit, c)
+		require.Equal(t, errorCommitInvalid, err, c)
+	}
+}
+
+func TestCommand_diffFile_InvalidCommit(t *testing.T) {
+	commander := &gitCommander{}
+	commit := "0123456789abcdef0123456789abcdef01234567"
+	for _, c := range []string{"--output=/tmp/diff", "-p", "HEAD", "0123456", ""} {
+		_, _, err := commander.diffFile(context.Background(), "https://github.com/a/b", c, commit, "main.go")
+		require.Equal(t, errorCommitInvalid, err, c)
+		_, _, err = commander.diffFile(context.Background(), "https://github.com/a/b", commit, c, "main.go")
+		require.Equal(t, errorCommitInvalid, err, c)
+	}
+}
+
+// commit files to a local repository at where url is cloned
+func newLocalRepository(t *testing.T, url string, files ...string) (*gitCommander, string) {
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		t.Skip("git not found")
+	}
+	// newGitCommander sets global git config
+	t.Setenv("HOME", t.TempDir())
+
+	conf := testConf
+	conf.Path = gitPath
+	conf.Data = t.TempDir()
+	commander := newGitCommander(conf)
+	dir, err := commander.urlToLocal(url)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	git := func(args ...string) string {
+		cmd := exec.Command(gitPath, args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return string(bytes.TrimSpace(out))
+	}
+	git("init", "-q")
+	for _, file := range files {
+		require.NoError(t, os.WriteFile(path.Join(dir, file), []byte(file), 0644))
+	}
+	git("add", "-A")
+	git("-c", "user.name=test", "-c", "user.email=test@test", "commit", "-q", "-m", "init")
+	return commander, git("rev-parse", "HEAD")
+}
+
+func TestCommand_archive_LiteralPaths(t *testing.T) {
+	url := "https://github.com/a/b"
+	commander, commit := newLocalRepository(t, url, "f*.go", "foo.go", "[a].go", "a.go", ":(glob)x")
+
+	for _, paths := range [][]string{{"f*.go"}, {"[a].go"}, {":(glob)x"}, {"f*.go", "a.go"}} {
+		var buf bytes.Buffer
+		require.NoError(t, commander.archive(context.Background(), url, commit, paths, &buf))
+
+		files := make([]string, 0)
+		reader := tar.NewReader(&buf)
+		for {
+			header, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			if header.Typeflag == tar.TypeReg {
+				files = append(files, header.Name)
+			}
+		}
+		sort.Strings(files)
+		expected := append([]string(nil), paths...)
+		sort.Strings(expected)
+		require.Equal(t, expected, files)
+	}
+}
+
+func TestParseDiffHunks(t *testing.T) {
+	out := []byte(`diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -3 +3 @@ import (
+-	"fmt"
++	"log"
+@@ -10,0 +11,2 @@ func main() {
++	a := 1
++	b := 2
+@@ -20,3 +22,0 @@ func foo() {
+-	x
+-	y
+-	z
+`)
+	hunks, binary := parseDiffHunks(out)
+	require.False(t, binary)
+	require.Len(t, hunks, 3)
+	require.Equal(t, proto.DiffHunk{OldStart: 3, OldLines: 1, NewStart: 3, NewLines: 1}, *hunks[0])
+	require.Equal(t, proto.DiffHunk{OldStart: 10, OldLines: 0, NewStart: 11, NewLines: 2}, *hunks[1])
+	require.Equal(t, proto.DiffHunk{OldStart: 20, OldLines: 3, NewStart: 22, NewLines: 0}, *hunks[2])
+
+	hunks, binary = parseDiffHunks([]byte("Binary files a/logo.png and b/logo.png differ\n"))
+	require.True(t, binary)
+	require.Len(t, hunks, 0)
+}
@@ -29,7 +29,7 @@ func (g *GitService) Clone(ctx context.Context, req *proto.CloneRequest, rsp *pr
 	if !ok {
 		return errRepositoryUrlInvalid
 	}
-	err := g.commander.clone(ctx, repoUrl)
+	err := g.commander.clone(ctx, repoUrl, req.Force)
 	if err != nil {
 		if err == errorGitBusy {
 			return errors.NewServiceUnavailable(int(proto.ErrorCode_GitsBusy), err.Error())
@@ -57,6 +57,11 @@ func (g GitService) GetCloneStatus(ctx context.Context, req *proto.GetCloneStatu
 	return nil
 }
 
+func (g GitService) ListClones(ctx context.Context, req *proto.ListClonesRequest, rsp *proto.ListClonesResponse) error {
+	rsp.Clones = g.commander.listClones()
+	return nil
+}
+
 func (g GitService) GetNamedCommits(ctx context.Context, req *proto.GetNamedCommitsRequest, rsp *proto.GetNamedCommitsResponse) error {
 	log.Debugf("query branches and tags: url=%s", req.Url)
 	repoUrl, ok := url.NormalizeRepoUrl(req.Url)
@@ -111,7 +116,6 @@ func (g GitService) GetRepositoryBlob(ctx context.Context, req *proto.GetReposit
 		} else {
 			return errors.NewInternalError(-1, err.Error())
 		}
-		return nil
 	}
 	log.Debugf("get repository blob: url=%s commit=%s file=%s content=%s plain=%v", req.Url, req.Commit, req.File, content, plain)
 	rsp.Content = content
@@ -119,6 +123,53 @@ func (g GitService) GetRepositoryBlob(ctx context.Context, req *proto.GetReposit
 	return nil
 }
 
+func (g GitService) DiffCommits(ctx context.Context, req *proto.DiffCommitsRequest, rsp *proto.DiffCommitsResponse) error {
+	log.Debugf("diff commits: url=%s from=%s to=%s", req.Url, req.From, req.To)
+	repoUrl, ok := url.NormalizeRepoUrl(req.Url)
+	if !ok {
+		return errRepositoryUrlInvalid
+	}
+	entries, err := g.commander.diffCommits(ctx, repoUrl, req.From, req.To)
+	if err != nil {
+		log.Warnf("diff commits: url=%s from=%s to=%s err=%s", req.Url, req.From, req.To, err.Error())
+		if err == errorRepositoryNotExist {
+			return errors.NewNotFoundError(int(proto.ErrorCode_RepoNotExist), "repository not exist")
+		} else if err == errorCommitInvalid {
+			return errors.NewBadRequestError(int(proto.ErrorCode_CommitInvalid), err.Error())
+		} else if err == errorGitBusy {
+			return errors.NewServiceUnavailable(int(proto.ErrorCode_GitsBusy), err.Error())
+		} else {
+			return errors.NewInternalError(-1, err.Error())
+		}
+	}
+	rsp.Entries = entries
+	return nil
+}
+
+func (g GitService) DiffFile(ctx context.Context, req *proto.DiffFileRequest, rsp *proto.DiffFileResponse) error {
+	log.Debugf("diff file: url=%s from=%s to=%s file=%s", req.Url, req.From, req.To, req.File)
+	repoUrl, ok := url.NormalizeRepoUrl(req.Url)
+	if !ok {
+		return errRepositoryUrlInvalid
+	}
+	hunks, binary, err := g.commander.diffFile(ctx, repoUrl, req.From, req.To, req.File)
+	if err != nil {
+		log.Warnf("diff file: url=%s from=%s to=%s file=%s err=%s", req.Url, req.From, req.To, req.File, err.Error())
+		if err == errorRepositoryNotExist {
+			return errors.NewNotFoundError(int(proto.ErrorCode_RepoNotExist), "repository not exist")
+		} else if err == errorCommitInvalid {
+			return errors.NewBadRequestError(int(proto.ErrorCode_CommitInvalid), err.Error())
+		} else if err == errorGitBusy {
+			return errors.NewServiceUnavailable(int(proto.ErrorCode_GitsBusy), err.Error())
+		} else {
+			return errors.NewInternalError(-1, err.Error())
+		}
+	}
+	rsp.Hunks = hunks
+	rsp.Binary = binary
+	return nil
+}
+
 type archiveWriter struct {
 	stream proto.Gits_ArchiveStream
 }
@@ -136,7 +187,7 @@ func (g GitService) Archive(ctx context.Context, req *proto.ArchiveRequest, stre
 		//return errors.New("repository url invalid")
 	}
 
-	err := g.commander.archive(ctx, repoUrl, req.Commit, archiveWriter{stream: stream})
+	err := g.commander.archive(ctx, repoUrl, req.Commit, req.Paths, archiveWriter{stream: stream})
 	if err != nil {
 		log.Warnf("get repository archive: url=%s commit=%s err=%s", req.Url, req.Commit, err.Error())
 
@@ -12,14 +12,30 @@ type GitService struct {
 }
 
 type IndexConfig struct {
-	Name        string        `json:"name"`        // index service name
-	Concurrency int           `json:"concurrency"` // how many indexers can run concurrently
-	Path        string        `json:"path"`        // universal-ctags binary path
-	Timeout     int           `json:"expire"`      // index task timeout (second)
-	Size        int64         `json:"size"`        // max file size to index
-	Gits        GitService    `json:"gits"`        // git service name
-	Store       string        `json:"store"`
-	Mongodb     MongodbConfig `json:"mongodb"`
+	Name        string                   `json:"name"`        // index service name
+	Concurrency int                      `json:"concurrency"` // how many indexers can run concurrently
+	Path        string                   `json:"path"`        // universal-ctags binary path
+	Timeout     int                      `json:"expire"`      // index task timeout (second)
+	Size        int64                    `json:"size"`        // max file size to index
+	Gits        GitService               `json:"gits"`        // git service name
+	Store       string                   `json:"store"`
+	Mongodb     MongodbConfig            `json:"mongodb"`
+	Indexers    map[string]IndexerConfig `json:"indexers"`  // indexer backends by name
+	Languages   map[string]string        `json:"languages"` // language to indexer name, "*" for the others
+	Ignore      []string                 `json:"ignore"`    // globs of files not to index
+	Generated   []string                 `json:"generated"` // globs of generated files, besides linguist-generated
+	Vendored    []string                 `json:"vendored"`  // globs of vendored files, besides linguist-vendored
+}
+
+// IndexerConfig configures an indexer backend.
+// Type is one of the registered backend types: "ctags", "go" or "external".
+// An external tool, tree-sitter based one for example, is run once per file
+// with the file name appended to Args, reads file content from stdin and
+// writes one JSON symbol per line to stdout.
+type IndexerConfig struct {
+	Type string   `json:"type"`
+	Path string   `json:"path"` // binary path, ctags defaults to IndexConfig.Path
+	Args []string `json:"args"`
 }
 
 type MongodbConfig struct {
@@ -41,6 +57,18 @@ var DefaultConfig = IndexConfig{
 		Uri:      "mongodb://127.0.0.1:27017",
 		Database: "rfschub",
 	},
+	Indexers: map[string]IndexerConfig{
+		"ctags": {Type: "ctags"},
+		"go":    {Type: "go"},
+	},
+	// the go indexer is opt-in with "Go": "go", blobs of Go files are indexed
+	// again by it
+	Languages: map[string]string{
+		"*": "ctags",
+	},
+	Ignore:    []string{"node_modules/", "bower_components/", "*.min.js", "*.min.css"},
+	Generated: []string{"*.pb.go", "*.micro.go", "*_pb2.py", "*.pb.cc", "*.pb.h"},
+	Vendored:  []string{"vendor/", "third_party/"},
 }
 
 func init() {
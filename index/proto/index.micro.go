@@ -37,6 +37,10 @@ type IndexService interface {
 	IndexRepository(ctx context.Context, in *IndexRepositoryRequest, opts ...client.CallOption) (*IndexRepositoryResponse, error)
 	IndexStatus(ctx context.Context, in *IndexStatusRequest, opts ...client.CallOption) (*IndexStatusResponse, error)
 	SearchSymbol(ctx context.Context, in *SearchSymbolRequest, opts ...client.CallOption) (*SearchSymbolResponse, error)
+	// get language breakdown of an indexed commit
+	LanguageStats(ctx context.Context, in *LanguageStatsRequest, opts ...client.CallOption) (*LanguageStatsResponse, error)
+	// list commits being indexed
+	ListIndexTasks(ctx context.Context, in *ListIndexTasksRequest, opts ...client.CallOption) (*ListIndexTasksResponse, error)
 }
 
 type indexService struct {
@@ -87,12 +91,36 @@ func (c *indexService) SearchSymbol(ctx context.Context, in *SearchSymbolRequest
 	return out, nil
 }
 
+func (c *indexService) LanguageStats(ctx context.Context, in *LanguageStatsRequest, opts ...client.CallOption) (*LanguageStatsResponse, error) {
+	req := c.c.NewRequest(c.name, "Index.LanguageStats", in)
+	out := new(LanguageStatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexService) ListIndexTasks(ctx context.Context, in *ListIndexTasksRequest, opts ...client.CallOption) (*ListIndexTasksResponse, error) {
+	req := c.c.NewRequest(c.name, "Index.ListIndexTasks", in)
+	out := new(ListIndexTasksResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Index service
 
 type IndexHandler interface {
 	IndexRepository(context.Context, *IndexRepositoryRequest, *IndexRepositoryResponse) error
 	IndexStatus(context.Context, *IndexStatusRequest, *IndexStatusResponse) error
 	SearchSymbol(context.Context, *SearchSymbolRequest, *SearchSymbolResponse) error
+	// get language breakdown of an indexed commit
+	LanguageStats(context.Context, *LanguageStatsRequest, *LanguageStatsResponse) error
+	// list commits being indexed
+	ListIndexTasks(context.Context, *ListIndexTasksRequest, *ListIndexTasksResponse) error
 }
 
 func RegisterIndexHandler(s server.Server, hdlr IndexHandler, opts ...server.HandlerOption) error {
@@ -100,6 +128,8 @@ func RegisterIndexHandler(s server.Server, hdlr IndexHandler, opts ...server.Han
 		IndexRepository(ctx context.Context, in *IndexRepositoryRequest, out *IndexRepositoryResponse) error
 		IndexStatus(ctx context.Context, in *IndexStatusRequest, out *IndexStatusResponse) error
 		SearchSymbol(ctx context.Context, in *SearchSymbolRequest, out *SearchSymbolResponse) error
+		LanguageStats(ctx context.Context, in *LanguageStatsRequest, out *LanguageStatsResponse) error
+		ListIndexTasks(ctx context.Context, in *ListIndexTasksRequest, out *ListIndexTasksResponse) error
 	}
 	type Index struct {
 		index
@@ -123,3 +153,11 @@ func (h *indexHandler) IndexStatus(ctx context.Context, in *IndexStatusRequest,
 func (h *indexHandler) SearchSymbol(ctx context.Context, in *SearchSymbolRequest, out *SearchSymbolResponse) error {
 	return h.IndexHandler.SearchSymbol(ctx, in, out)
 }
+
+func (h *indexHandler) LanguageStats(ctx context.Context, in *LanguageStatsRequest, out *LanguageStatsResponse) error {
+	return h.IndexHandler.LanguageStats(ctx, in, out)
+}
+
+func (h *indexHandler) ListIndexTasks(ctx context.Context, in *ListIndexTasksRequest, out *ListIndexTasksResponse) error {
+	return h.IndexHandler.ListIndexTasks(ctx, in, out)
+}
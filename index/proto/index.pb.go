@@ -44,7 +44,7 @@ func (x ErrorCode) String() string {
 	return proto.EnumName(ErrorCode_name, int32(x))
 }
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{0}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{0}
 }
 
 type StatusCode int32
@@ -70,12 +70,14 @@ func (x StatusCode) String() string {
 	return proto.EnumName(StatusCode_name, int32(x))
 }
 func (StatusCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{1}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{1}
 }
 
 type IndexRepositoryRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	Hash                 string   `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	Url  string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Hash string `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	// index an indexed commit again, its blobs are indexed again too
+	Force                bool     `protobuf:"varint,3,opt,name=force" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -85,7 +87,7 @@ func (m *IndexRepositoryRequest) Reset()         { *m = IndexRepositoryRequest{}
 func (m *IndexRepositoryRequest) String() string { return proto.CompactTextString(m) }
 func (*IndexRepositoryRequest) ProtoMessage()    {}
 func (*IndexRepositoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{0}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{0}
 }
 func (m *IndexRepositoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexRepositoryRequest.Unmarshal(m, b)
@@ -119,6 +121,13 @@ func (m *IndexRepositoryRequest) GetHash() string {
 	return ""
 }
 
+func (m *IndexRepositoryRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type IndexRepositoryResponse struct {
 	Indexed              bool     `protobuf:"varint,1,opt,name=indexed" json:"indexed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -130,7 +139,7 @@ func (m *IndexRepositoryResponse) Reset()         { *m = IndexRepositoryResponse
 func (m *IndexRepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*IndexRepositoryResponse) ProtoMessage()    {}
 func (*IndexRepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{1}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{1}
 }
 func (m *IndexRepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexRepositoryResponse.Unmarshal(m, b)
@@ -169,7 +178,7 @@ func (m *IndexStatusRequest) Reset()         { *m = IndexStatusRequest{} }
 func (m *IndexStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*IndexStatusRequest) ProtoMessage()    {}
 func (*IndexStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{2}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{2}
 }
 func (m *IndexStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexStatusRequest.Unmarshal(m, b)
@@ -214,7 +223,7 @@ func (m *IndexStatusResponse) Reset()         { *m = IndexStatusResponse{} }
 func (m *IndexStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*IndexStatusResponse) ProtoMessage()    {}
 func (*IndexStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{3}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{3}
 }
 func (m *IndexStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexStatusResponse.Unmarshal(m, b)
@@ -242,9 +251,11 @@ func (m *IndexStatusResponse) GetStatus() StatusCode {
 }
 
 type SearchSymbolRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	Hash                 string   `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
-	Symbol               string   `protobuf:"bytes,3,opt,name=symbol" json:"symbol,omitempty"`
+	Url    string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Hash   string `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	Symbol string `protobuf:"bytes,3,opt,name=symbol" json:"symbol,omitempty"`
+	// symbols in files matching these globs are excluded
+	Ignore               []string `protobuf:"bytes,4,rep,name=ignore" json:"ignore,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -254,7 +265,7 @@ func (m *SearchSymbolRequest) Reset()         { *m = SearchSymbolRequest{} }
 func (m *SearchSymbolRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchSymbolRequest) ProtoMessage()    {}
 func (*SearchSymbolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{4}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{4}
 }
 func (m *SearchSymbolRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SearchSymbolRequest.Unmarshal(m, b)
@@ -295,6 +306,13 @@ func (m *SearchSymbolRequest) GetSymbol() string {
 	return ""
 }
 
+func (m *SearchSymbolRequest) GetIgnore() []string {
+	if m != nil {
+		return m.Ignore
+	}
+	return nil
+}
+
 type SearchSymbolResponse struct {
 	Symbols              []*SymbolResult `protobuf:"bytes,1,rep,name=symbols" json:"symbols,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -306,7 +324,7 @@ func (m *SearchSymbolResponse) Reset()         { *m = SearchSymbolResponse{} }
 func (m *SearchSymbolResponse) String() string { return proto.CompactTextString(m) }
 func (*SearchSymbolResponse) ProtoMessage()    {}
 func (*SearchSymbolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{5}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{5}
 }
 func (m *SearchSymbolResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SearchSymbolResponse.Unmarshal(m, b)
@@ -340,6 +358,10 @@ type SymbolResult struct {
 	LineBefore           string   `protobuf:"bytes,4,opt,name=lineBefore" json:"lineBefore,omitempty"`
 	LineAfter            string   `protobuf:"bytes,5,opt,name=lineAfter" json:"lineAfter,omitempty"`
 	Kind                 string   `protobuf:"bytes,6,opt,name=kind" json:"kind,omitempty"`
+	Signature            string   `protobuf:"bytes,7,opt,name=signature" json:"signature,omitempty"`
+	Doc                  string   `protobuf:"bytes,8,opt,name=doc" json:"doc,omitempty"`
+	Generated            bool     `protobuf:"varint,9,opt,name=generated" json:"generated,omitempty"`
+	Vendored             bool     `protobuf:"varint,10,opt,name=vendored" json:"vendored,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -349,7 +371,7 @@ func (m *SymbolResult) Reset()         { *m = SymbolResult{} }
 func (m *SymbolResult) String() string { return proto.CompactTextString(m) }
 func (*SymbolResult) ProtoMessage()    {}
 func (*SymbolResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_index_6e0745d1d2361d1a, []int{6}
+	return fileDescriptor_index_34d46b4002de9eeb, []int{6}
 }
 func (m *SymbolResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SymbolResult.Unmarshal(m, b)
@@ -411,6 +433,310 @@ func (m *SymbolResult) GetKind() string {
 	return ""
 }
 
+func (m *SymbolResult) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *SymbolResult) GetDoc() string {
+	if m != nil {
+		return m.Doc
+	}
+	return ""
+}
+
+func (m *SymbolResult) GetGenerated() bool {
+	if m != nil {
+		return m.Generated
+	}
+	return false
+}
+
+func (m *SymbolResult) GetVendored() bool {
+	if m != nil {
+		return m.Vendored
+	}
+	return false
+}
+
+type LanguageStatsRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LanguageStatsRequest) Reset()         { *m = LanguageStatsRequest{} }
+func (m *LanguageStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*LanguageStatsRequest) ProtoMessage()    {}
+func (*LanguageStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_index_34d46b4002de9eeb, []int{7}
+}
+func (m *LanguageStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LanguageStatsRequest.Unmarshal(m, b)
+}
+func (m *LanguageStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LanguageStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *LanguageStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanguageStatsRequest.Merge(dst, src)
+}
+func (m *LanguageStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_LanguageStatsRequest.Size(m)
+}
+func (m *LanguageStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanguageStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanguageStatsRequest proto.InternalMessageInfo
+
+func (m *LanguageStatsRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *LanguageStatsRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type LanguageStatsResponse struct {
+	Stats                []*LanguageStat `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LanguageStatsResponse) Reset()         { *m = LanguageStatsResponse{} }
+func (m *LanguageStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*LanguageStatsResponse) ProtoMessage()    {}
+func (*LanguageStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_index_34d46b4002de9eeb, []int{8}
+}
+func (m *LanguageStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LanguageStatsResponse.Unmarshal(m, b)
+}
+func (m *LanguageStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LanguageStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *LanguageStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanguageStatsResponse.Merge(dst, src)
+}
+func (m *LanguageStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_LanguageStatsResponse.Size(m)
+}
+func (m *LanguageStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanguageStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanguageStatsResponse proto.InternalMessageInfo
+
+func (m *LanguageStatsResponse) GetStats() []*LanguageStat {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type LanguageStat struct {
+	Language             string   `protobuf:"bytes,1,opt,name=language" json:"language,omitempty"`
+	Files                int64    `protobuf:"varint,2,opt,name=files" json:"files,omitempty"`
+	Bytes                int64    `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	Lines                int64    `protobuf:"varint,4,opt,name=lines" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LanguageStat) Reset()         { *m = LanguageStat{} }
+func (m *LanguageStat) String() string { return proto.CompactTextString(m) }
+func (*LanguageStat) ProtoMessage()    {}
+func (*LanguageStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_index_34d46b4002de9eeb, []int{9}
+}
+func (m *LanguageStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LanguageStat.Unmarshal(m, b)
+}
+func (m *LanguageStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LanguageStat.Marshal(b, m, deterministic)
+}
+func (dst *LanguageStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanguageStat.Merge(dst, src)
+}
+func (m *LanguageStat) XXX_Size() int {
+	return xxx_messageInfo_LanguageStat.Size(m)
+}
+func (m *LanguageStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanguageStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanguageStat proto.InternalMessageInfo
+
+func (m *LanguageStat) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *LanguageStat) GetFiles() int64 {
+	if m != nil {
+		return m.Files
+	}
+	return 0
+}
+
+func (m *LanguageStat) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *LanguageStat) GetLines() int64 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+type ListIndexTasksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListIndexTasksRequest) Reset()         { *m = ListIndexTasksRequest{} }
+func (m *ListIndexTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIndexTasksRequest) ProtoMessage()    {}
+func (*ListIndexTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_index_34d46b4002de9eeb, []int{10}
+}
+func (m *ListIndexTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexTasksRequest.Unmarshal(m, b)
+}
+func (m *ListIndexTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexTasksRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListIndexTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexTasksRequest.Merge(dst, src)
+}
+func (m *ListIndexTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIndexTasksRequest.Size(m)
+}
+func (m *ListIndexTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexTasksRequest proto.InternalMessageInfo
+
+type IndexTask struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	StartedAt            int64    `protobuf:"varint,3,opt,name=startedAt" json:"startedAt,omitempty"`
+	Force                bool     `protobuf:"varint,4,opt,name=force" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexTask) Reset()         { *m = IndexTask{} }
+func (m *IndexTask) String() string { return proto.CompactTextString(m) }
+func (*IndexTask) ProtoMessage()    {}
+func (*IndexTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_index_34d46b4002de9eeb, []int{11}
+}
+func (m *IndexTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexTask.Unmarshal(m, b)
+}
+func (m *IndexTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexTask.Marshal(b, m, deterministic)
+}
+func (dst *IndexTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexTask.Merge(dst, src)
+}
+func (m *IndexTask) XXX_Size() int {
+	return xxx_messageInfo_IndexTask.Size(m)
+}
+func (m *IndexTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexTask proto.InternalMessageInfo
+
+func (m *IndexTask) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *IndexTask) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *IndexTask) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *IndexTask) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type ListIndexTasksResponse struct {
+	Tasks                []*IndexTask `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListIndexTasksResponse) Reset()         { *m = ListIndexTasksResponse{} }
+func (m *ListIndexTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIndexTasksResponse) ProtoMessage()    {}
+func (*ListIndexTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_index_34d46b4002de9eeb, []int{12}
+}
+func (m *ListIndexTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexTasksResponse.Unmarshal(m, b)
+}
+func (m *ListIndexTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexTasksResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListIndexTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexTasksResponse.Merge(dst, src)
+}
+func (m *ListIndexTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIndexTasksResponse.Size(m)
+}
+func (m *ListIndexTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexTasksResponse proto.InternalMessageInfo
+
+func (m *ListIndexTasksResponse) GetTasks() []*IndexTask {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IndexRepositoryRequest)(nil), "index.IndexRepositoryRequest")
 	proto.RegisterType((*IndexRepositoryResponse)(nil), "index.IndexRepositoryResponse")
@@ -419,41 +745,62 @@ func init() {
 	proto.RegisterType((*SearchSymbolRequest)(nil), "index.SearchSymbolRequest")
 	proto.RegisterType((*SearchSymbolResponse)(nil), "index.SearchSymbolResponse")
 	proto.RegisterType((*SymbolResult)(nil), "index.SymbolResult")
+	proto.RegisterType((*LanguageStatsRequest)(nil), "index.LanguageStatsRequest")
+	proto.RegisterType((*LanguageStatsResponse)(nil), "index.LanguageStatsResponse")
+	proto.RegisterType((*LanguageStat)(nil), "index.LanguageStat")
+	proto.RegisterType((*ListIndexTasksRequest)(nil), "index.ListIndexTasksRequest")
+	proto.RegisterType((*IndexTask)(nil), "index.IndexTask")
+	proto.RegisterType((*ListIndexTasksResponse)(nil), "index.ListIndexTasksResponse")
 	proto.RegisterEnum("index.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("index.StatusCode", StatusCode_name, StatusCode_value)
 }
 
-func init() { proto.RegisterFile("index.proto", fileDescriptor_index_6e0745d1d2361d1a) }
-
-var fileDescriptor_index_6e0745d1d2361d1a = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xc5, 0x75, 0x93, 0x34, 0x93, 0x36, 0x75, 0x26, 0xa8, 0x98, 0x00, 0x51, 0xe4, 0x53, 0xa8,
-	0x44, 0x0f, 0xe9, 0x8d, 0x03, 0x82, 0x42, 0x05, 0xbd, 0x00, 0xb2, 0xc5, 0x07, 0x24, 0xf1, 0x84,
-	0x58, 0xb8, 0xde, 0xb0, 0xbb, 0x96, 0xc8, 0xe7, 0xc0, 0x89, 0xef, 0xe8, 0xd7, 0xf0, 0x01, 0x7c,
-	0x00, 0xda, 0xd9, 0x35, 0x75, 0x20, 0x3d, 0xe4, 0x36, 0xf3, 0xe6, 0xbd, 0x37, 0xb3, 0x9e, 0x31,
-	0x74, 0xb2, 0x22, 0xa5, 0x6f, 0x67, 0x2b, 0x29, 0xb4, 0xc0, 0x06, 0x27, 0xd1, 0x0b, 0x38, 0xb9,
-	0x32, 0x41, 0x4c, 0x2b, 0xa1, 0x32, 0x2d, 0xe4, 0x3a, 0xa6, 0xaf, 0x25, 0x29, 0x8d, 0x01, 0xf8,
-	0xa5, 0xcc, 0x43, 0x6f, 0xe4, 0x8d, 0xdb, 0xb1, 0x09, 0x11, 0x61, 0x7f, 0x39, 0x55, 0xcb, 0x70,
-	0x8f, 0x21, 0x8e, 0xa3, 0x73, 0x78, 0xf0, 0x9f, 0x5e, 0xad, 0x44, 0xa1, 0x08, 0x43, 0x68, 0x71,
-	0x0f, 0x4a, 0xd9, 0xe4, 0x20, 0xae, 0xd2, 0xe8, 0x39, 0x20, 0x8b, 0x12, 0x3d, 0xd5, 0xa5, 0xda,
-	0xad, 0xe1, 0x4b, 0xe8, 0x6f, 0x68, 0x5d, 0xb3, 0xa7, 0xd0, 0x54, 0x8c, 0x84, 0xfe, 0xc8, 0x1b,
-	0x77, 0x27, 0xbd, 0x33, 0xfb, 0x58, 0x4b, 0x7b, 0x2d, 0x52, 0x8a, 0x1d, 0x21, 0x4a, 0xa0, 0x9f,
-	0xd0, 0x54, 0xce, 0x97, 0xc9, 0xfa, 0x7a, 0x26, 0xf2, 0x9d, 0xda, 0xe3, 0x09, 0x34, 0x15, 0xcb,
-	0xb8, 0x4f, 0x3b, 0x76, 0x59, 0x74, 0x09, 0xf7, 0x37, 0x4d, 0xdd, 0x5c, 0xcf, 0xa0, 0x65, 0x19,
-	0x2a, 0xf4, 0x46, 0xfe, 0xb8, 0x33, 0xe9, 0x57, 0x83, 0x55, 0xbc, 0x32, 0xd7, 0x71, 0xc5, 0x89,
-	0x7e, 0x7a, 0x70, 0x58, 0xaf, 0x98, 0x19, 0x16, 0x59, 0x4e, 0x6e, 0x2c, 0x8e, 0x71, 0x08, 0x90,
-	0x67, 0x05, 0xbd, 0x2f, 0xaf, 0x67, 0x24, 0x79, 0xba, 0x46, 0x5c, 0x43, 0x8c, 0xc6, 0x64, 0x6e,
-	0x42, 0x8e, 0x2b, 0xcd, 0x05, 0x2d, 0x84, 0xa4, 0x70, 0x9f, 0x2b, 0x35, 0x04, 0x1f, 0x43, 0xdb,
-	0x64, 0xaf, 0x16, 0x9a, 0x64, 0xd8, 0xe0, 0xf2, 0x2d, 0x60, 0x1c, 0xbf, 0x64, 0x45, 0x1a, 0x36,
-	0xad, 0xa3, 0x89, 0x4f, 0x3f, 0x40, 0xfb, 0x52, 0x4a, 0x21, 0xcd, 0xb7, 0xc5, 0x0e, 0xb4, 0x92,
-	0x72, 0x3e, 0x27, 0xa5, 0x82, 0x7b, 0x88, 0x70, 0x74, 0x55, 0x68, 0x92, 0xc5, 0x34, 0x67, 0x46,
-	0xf0, 0xdb, 0xc7, 0x1e, 0x74, 0x78, 0x6d, 0x24, 0x2f, 0x4a, 0xb5, 0x0e, 0xbe, 0xdf, 0x0c, 0xb1,
-	0x0b, 0x07, 0x0c, 0x65, 0xc5, 0xe7, 0xe0, 0xc7, 0xcd, 0xf0, 0xf4, 0x1d, 0xc0, 0xed, 0xb6, 0xb0,
-	0x0f, 0xc7, 0x36, 0xfb, 0x54, 0x58, 0x61, 0xca, 0xce, 0x5d, 0x0b, 0xfe, 0x15, 0xee, 0x61, 0x0f,
-	0x8e, 0x6a, 0x18, 0xa5, 0x81, 0x3f, 0xf9, 0xe5, 0x41, 0x83, 0x33, 0xfc, 0x08, 0xc7, 0xff, 0x9c,
-	0x27, 0x3e, 0x71, 0x0b, 0xd8, 0x7e, 0xf6, 0x83, 0xe1, 0x5d, 0x65, 0xb7, 0xd0, 0x37, 0xee, 0x21,
-	0xb6, 0x27, 0x3e, 0xac, 0xd3, 0x37, 0xee, 0x79, 0x30, 0xd8, 0x56, 0x72, 0x2e, 0x6f, 0xe1, 0xb0,
-	0x7e, 0x2e, 0x58, 0x71, 0xb7, 0x1c, 0xe6, 0xe0, 0xd1, 0xd6, 0x9a, 0x35, 0x9a, 0x35, 0xf9, 0x6f,
-	0x3e, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x46, 0xdf, 0xbe, 0x83, 0xdc, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("index.proto", fileDescriptor_index_34d46b4002de9eeb) }
+
+var fileDescriptor_index_34d46b4002de9eeb = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5b, 0x4e, 0xdb, 0x4c,
+	0x14, 0xfe, 0x83, 0x71, 0x2e, 0x27, 0x10, 0xcc, 0x84, 0x8b, 0xff, 0x00, 0x51, 0xe4, 0x87, 0x8a,
+	0x22, 0x95, 0x07, 0x78, 0xab, 0xfa, 0x00, 0xb4, 0xa8, 0xa5, 0xa2, 0x17, 0x4d, 0xe8, 0x02, 0x9c,
+	0xf8, 0x24, 0x58, 0x18, 0x0f, 0x9d, 0x19, 0x57, 0xcd, 0x73, 0x77, 0xd0, 0x1d, 0xb4, 0x4b, 0x61,
+	0x4d, 0x5d, 0x40, 0x35, 0x17, 0x3b, 0x4e, 0x08, 0x52, 0x79, 0x3b, 0xdf, 0x77, 0x6e, 0x9f, 0xcf,
+	0x9c, 0x19, 0x43, 0x33, 0x4e, 0x23, 0xfc, 0x7e, 0x78, 0xc7, 0x99, 0x64, 0xc4, 0xd5, 0x20, 0xb8,
+	0x82, 0xad, 0x0b, 0x65, 0x50, 0xbc, 0x63, 0x22, 0x96, 0x8c, 0x4f, 0x28, 0x7e, 0xcd, 0x50, 0x48,
+	0xe2, 0x81, 0x93, 0xf1, 0xc4, 0xaf, 0xf4, 0x2a, 0xfb, 0x0d, 0xaa, 0x4c, 0x42, 0x60, 0xf9, 0x3a,
+	0x14, 0xd7, 0xfe, 0x92, 0xa6, 0xb4, 0x4d, 0x36, 0xc0, 0x1d, 0x31, 0x3e, 0x44, 0xdf, 0xe9, 0x55,
+	0xf6, 0xeb, 0xd4, 0x80, 0xe0, 0x18, 0xb6, 0x1f, 0x54, 0x15, 0x77, 0x2c, 0x15, 0x48, 0x7c, 0xa8,
+	0xe9, 0xce, 0x18, 0xe9, 0xd2, 0x75, 0x9a, 0xc3, 0xe0, 0x25, 0x10, 0x9d, 0xd4, 0x97, 0xa1, 0xcc,
+	0xc4, 0x93, 0x64, 0x04, 0x27, 0xd0, 0x9e, 0xc9, 0xb5, 0xcd, 0x9e, 0x43, 0x55, 0x68, 0x46, 0xcb,
+	0x6b, 0x1d, 0xad, 0x1f, 0x9a, 0x11, 0x98, 0xb0, 0xd7, 0x2c, 0x42, 0x6a, 0x03, 0x82, 0x1b, 0x68,
+	0xf7, 0x31, 0xe4, 0xc3, 0xeb, 0xfe, 0xe4, 0x76, 0xc0, 0x92, 0xa7, 0x4d, 0x61, 0x0b, 0xaa, 0x42,
+	0xa7, 0xe9, 0x3e, 0x0d, 0x6a, 0x91, 0xe2, 0xe3, 0x71, 0xca, 0x38, 0xfa, 0xcb, 0x3d, 0x47, 0xf1,
+	0x06, 0x05, 0xe7, 0xb0, 0x31, 0xdb, 0xcc, 0xea, 0x7d, 0x01, 0x35, 0x93, 0x29, 0xfc, 0x4a, 0xcf,
+	0xd9, 0x6f, 0x1e, 0xb5, 0x73, 0xc1, 0x79, 0x5c, 0x96, 0x48, 0x9a, 0xc7, 0x04, 0x3f, 0x97, 0x60,
+	0xa5, 0xec, 0x51, 0xda, 0x46, 0x71, 0x82, 0x56, 0xae, 0xb6, 0x49, 0x17, 0x20, 0x89, 0x53, 0xfc,
+	0x98, 0xdd, 0x0e, 0x90, 0x6b, 0xd5, 0x2e, 0x2d, 0x31, 0x2a, 0x47, 0x21, 0xab, 0x5c, 0xdb, 0x79,
+	0xce, 0x19, 0x8e, 0x8c, 0x76, 0xe5, 0x29, 0x31, 0x64, 0x17, 0x1a, 0x0a, 0x9d, 0x8e, 0x24, 0x72,
+	0xdf, 0xd5, 0xee, 0x29, 0xa1, 0x2a, 0xde, 0xc4, 0x69, 0xe4, 0x57, 0x4d, 0x45, 0x65, 0xab, 0x0c,
+	0x11, 0x8f, 0xd3, 0x50, 0x66, 0x1c, 0xfd, 0x9a, 0xc9, 0x28, 0x08, 0x35, 0xe5, 0x88, 0x0d, 0xfd,
+	0xba, 0x99, 0x72, 0xc4, 0x86, 0x2a, 0x7e, 0x8c, 0x29, 0xf2, 0x50, 0x62, 0xe4, 0x37, 0xf4, 0xa2,
+	0x4c, 0x09, 0xd2, 0x81, 0xfa, 0x37, 0x4c, 0x23, 0xc6, 0x31, 0xf2, 0x41, 0x3b, 0x0b, 0x1c, 0xbc,
+	0x82, 0x8d, 0xcb, 0x30, 0x1d, 0x67, 0xe1, 0x18, 0xd5, 0x31, 0x3f, 0x71, 0x91, 0xce, 0x60, 0x73,
+	0x2e, 0xbb, 0x58, 0x25, 0x57, 0x6d, 0xca, 0xfc, 0xc1, 0x94, 0x83, 0xa9, 0x89, 0x08, 0x12, 0x58,
+	0x29, 0xd3, 0x4a, 0x6d, 0x62, 0xb1, 0x6d, 0x5f, 0x60, 0x7d, 0x7f, 0xe2, 0x04, 0x85, 0x16, 0xe1,
+	0x50, 0x03, 0x14, 0x3b, 0x98, 0x48, 0x34, 0x6b, 0xeb, 0x50, 0x03, 0x14, 0xab, 0x86, 0x2c, 0xf4,
+	0x81, 0x38, 0xd4, 0x80, 0x60, 0x1b, 0x36, 0x2f, 0x63, 0x21, 0xf5, 0xfa, 0x5f, 0x85, 0xe2, 0x26,
+	0xff, 0xe0, 0x00, 0xa1, 0x51, 0x90, 0xff, 0xb8, 0xc7, 0xea, 0x94, 0x64, 0xc8, 0x25, 0x46, 0xa7,
+	0xd2, 0xf6, 0x9e, 0x12, 0xd3, 0xbb, 0xbe, 0x5c, 0xbe, 0xeb, 0x27, 0xb0, 0x35, 0xdf, 0xdf, 0x8e,
+	0xec, 0x19, 0xb8, 0x52, 0x11, 0x76, 0x64, 0x9e, 0x1d, 0x59, 0x11, 0x49, 0x8d, 0xfb, 0xe0, 0x13,
+	0x34, 0xce, 0x39, 0x67, 0x5c, 0xdd, 0x47, 0xd2, 0x84, 0x5a, 0x3f, 0x1b, 0x0e, 0x51, 0x08, 0xef,
+	0x3f, 0x42, 0x60, 0xf5, 0x22, 0x95, 0xc8, 0xd3, 0x30, 0xd1, 0x11, 0xde, 0x1f, 0x87, 0xac, 0x43,
+	0x53, 0x57, 0x40, 0x7e, 0x96, 0x89, 0x89, 0xf7, 0xeb, 0xbe, 0x4b, 0x5a, 0x50, 0xd7, 0x54, 0x9c,
+	0x8e, 0xbd, 0xdf, 0xf7, 0xdd, 0x83, 0x77, 0x00, 0xd3, 0x1b, 0x4e, 0xda, 0xb0, 0x66, 0xd0, 0x97,
+	0xd4, 0x24, 0x46, 0xba, 0x72, 0xcb, 0x90, 0x45, 0xe2, 0x12, 0x59, 0x87, 0xd5, 0x12, 0x87, 0x91,
+	0xe7, 0x1c, 0xfd, 0x70, 0xc0, 0xd5, 0x88, 0x7c, 0x86, 0xb5, 0xb9, 0x27, 0x8d, 0xec, 0x95, 0x3f,
+	0xe8, 0xc1, 0x03, 0xda, 0xe9, 0x3e, 0xe6, 0xb6, 0xe3, 0x79, 0x63, 0x3f, 0xc4, 0xf4, 0x24, 0xff,
+	0x97, 0xc3, 0x67, 0xde, 0xc0, 0x4e, 0x67, 0x91, 0xcb, 0x56, 0x79, 0x0b, 0x2b, 0xe5, 0xa7, 0x84,
+	0xe4, 0xb1, 0x0b, 0x1e, 0xb3, 0xce, 0xce, 0x42, 0x9f, 0x2d, 0xf4, 0x1e, 0x56, 0x67, 0x36, 0x9f,
+	0xec, 0x2c, 0x58, 0xf1, 0x42, 0xd2, 0xee, 0x62, 0xa7, 0xad, 0xf5, 0x01, 0x5a, 0xb3, 0x3b, 0x41,
+	0x8a, 0xf8, 0x45, 0xab, 0xda, 0xd9, 0x7b, 0xc4, 0x6b, 0xca, 0x0d, 0xaa, 0xfa, 0x97, 0x75, 0xfc,
+	0x77, 0x00, 0x6f, 0xc9, 0x60, 0x09, 0xc1, 0x06, 0x00, 0x00,
 }
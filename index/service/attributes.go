@@ -0,0 +1,106 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/common/glob"
+	"path"
+	"sort"
+	"strings"
+)
+
+const gitAttributesFile = ".gitattributes"
+
+// linguist attributes of a .gitattributes line, nil if not specified
+type attributeRule struct {
+	base      string
+	pattern   string
+	generated *bool
+	vendored  *bool
+}
+
+// parse linguist-generated and linguist-vendored attributes of a .gitattributes
+// file, base is the directory the file lies in
+func parseGitAttributes(base, content string) []attributeRule {
+	rules := make([]attributeRule, 0)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		// comments, macro definitions and quoted patterns are not supported
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "[attr]") || strings.HasPrefix(fields[0], "\"") {
+			continue
+		}
+		rule := attributeRule{base: base, pattern: fields[0]}
+		for _, attr := range fields[1:] {
+			name, value := parseAttribute(attr)
+			switch name {
+			case "linguist-generated":
+				rule.generated = &value
+			case "linguist-vendored":
+				rule.vendored = &value
+			}
+		}
+		if rule.generated != nil || rule.vendored != nil {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func parseAttribute(attr string) (string, bool) {
+	switch {
+	case strings.HasPrefix(attr, "-"), strings.HasPrefix(attr, "!"):
+		return attr[1:], false
+	case strings.Contains(attr, "="):
+		parts := strings.SplitN(attr, "=", 2)
+		return parts[0], parts[1] == "true"
+	default:
+		return attr, true
+	}
+}
+
+func (rule attributeRule) match(file string) bool {
+	if rule.base != "" {
+		if !strings.HasPrefix(file, rule.base+"/") {
+			return false
+		}
+		file = strings.TrimPrefix(file, rule.base+"/")
+	}
+	return glob.Match(rule.pattern, file)
+}
+
+// sort rules so that rules of deeper .gitattributes files come later and
+// override the ones of their parents
+func sortAttributeRules(rules []attributeRule) {
+	depth := func(base string) int {
+		if base == "" {
+			return 0
+		}
+		return strings.Count(base, "/") + 1
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return depth(rules[i].base) < depth(rules[j].base)
+	})
+}
+
+// linguist attributes of file, later rules override earlier ones
+func fileAttributes(rules []attributeRule, file string) (generated, vendored bool) {
+	for _, rule := range rules {
+		if !rule.match(file) {
+			continue
+		}
+		if rule.generated != nil {
+			generated = *rule.generated
+		}
+		if rule.vendored != nil {
+			vendored = *rule.vendored
+		}
+	}
+	return
+}
+
+// directory of a .gitattributes file, empty for root
+func attributesBase(file string) string {
+	dir := path.Dir(file)
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
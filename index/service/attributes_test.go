@@ -0,0 +1,38 @@
+package service
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFileAttributes(t *testing.T) {
+	rules := parseGitAttributes("", `# comment
+*.pb.go linguist-generated=true
+third_party/** linguist-vendored
+docs/* -linguist-generated
+`)
+	rules = append(rules, parseGitAttributes("web", `dist/* linguist-generated
+keep.pb.go linguist-generated=false
+`)...)
+	sortAttributeRules(rules)
+
+	cases := []struct {
+		file      string
+		generated bool
+		vendored  bool
+	}{
+		{"index/proto/index.pb.go", true, false},
+		{"third_party/a/b.c", false, true},
+		{"web/dist/app.js", true, false},
+		{"dist/app.js", false, false},
+		{"web/keep.pb.go", false, false},
+		{"main.go", false, false},
+	}
+	for _, c := range cases {
+		generated, vendored := fileAttributes(rules, c.file)
+		require.Equal(t, c.generated, generated, c.file)
+		require.Equal(t, c.vendored, vendored, c.file)
+	}
+	require.Equal(t, "", attributesBase(".gitattributes"))
+	require.Equal(t, "web", attributesBase("web/.gitattributes"))
+}
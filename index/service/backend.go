@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"github.com/lt90s/rfschub-server/index/config"
+	log "github.com/sirupsen/logrus"
+	"sync"
+)
+
+// Indexer generates symbols of a single file.
+// An Indexer is used by one goroutine at a time.
+type Indexer interface {
+	IndexFile(fileName string, content []byte) ([]ResponseEntry, error)
+	Stop()
+}
+
+// IndexerFactory creates an Indexer from its configuration
+type IndexerFactory func(conf config.IndexerConfig) (Indexer, error)
+
+var (
+	factoryMutex sync.RWMutex
+	factories    = make(map[string]IndexerFactory)
+)
+
+// RegisterIndexer makes an indexer backend available by type
+func RegisterIndexer(typ string, factory IndexerFactory) {
+	factoryMutex.Lock()
+	defer factoryMutex.Unlock()
+	if _, ok := factories[typ]; ok {
+		panic("indexer registered twice: " + typ)
+	}
+	factories[typ] = factory
+}
+
+func newIndexerBackend(conf config.IndexerConfig) (Indexer, error) {
+	factoryMutex.RLock()
+	factory, ok := factories[conf.Type]
+	factoryMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown indexer type: %s", conf.Type)
+	}
+	return factory(conf)
+}
+
+// indexerSet picks an indexer backend for a file by its language
+type indexerSet struct {
+	indexers  map[string]Indexer
+	languages map[string]string
+}
+
+func newIndexerSet(conf config.IndexConfig) *indexerSet {
+	set := &indexerSet{
+		indexers:  make(map[string]Indexer, len(conf.Indexers)),
+		languages: conf.Languages,
+	}
+	for name, iConf := range conf.Indexers {
+		if iConf.Type == "ctags" && iConf.Path == "" {
+			iConf.Path = conf.Path
+		}
+		indexer, err := newIndexerBackend(iConf)
+		if err != nil {
+			log.Panicf("create indexer error: name=%s type=%s error=%v", name, iConf.Type, err)
+		}
+		set.indexers[name] = indexer
+	}
+	for language, name := range conf.Languages {
+		if _, ok := set.indexers[name]; !ok {
+			log.Panicf("indexer of language not configured: language=%s indexer=%s", language, name)
+		}
+	}
+	return set
+}
+
+// name of the indexer configured in languages for file, empty if none
+func indexerName(languages map[string]string, fileName string) string {
+	name, ok := languages[detectLanguage(fileName)]
+	if !ok {
+		name = languages["*"]
+	}
+	return name
+}
+
+// get the indexer of file, nil if no indexer configured
+func (set *indexerSet) indexerOf(fileName string) Indexer {
+	return set.indexers[indexerName(set.languages, fileName)]
+}
+
+func (set *indexerSet) indexFile(fileName string, content []byte) ([]ResponseEntry, error) {
+	indexer := set.indexerOf(fileName)
+	if indexer == nil {
+		return nil, nil
+	}
+	return indexer.IndexFile(fileName, content)
+}
+
+func (set *indexerSet) stop() {
+	for _, indexer := range set.indexers {
+		indexer.Stop()
+	}
+}
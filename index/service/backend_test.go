@@ -0,0 +1,21 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/index/config"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestIndexerName(t *testing.T) {
+	// ctags indexes all languages by default, other indexers are opt-in
+	languages := config.DefaultConfig.Languages
+	require.Equal(t, map[string]string{"*": "ctags"}, languages)
+	require.Equal(t, "ctags", indexerName(languages, "main.go"))
+	require.Equal(t, "ctags", indexerName(languages, "src/main.c"))
+	require.Equal(t, "ctags", indexerName(languages, "README"))
+
+	languages = map[string]string{"Go": "go", "*": "ctags"}
+	require.Equal(t, "go", indexerName(languages, "main.go"))
+	require.Equal(t, "ctags", indexerName(languages, "src/main.c"))
+	require.Equal(t, "", indexerName(map[string]string{"Go": "go"}, "src/main.c"))
+}
@@ -5,11 +5,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"github.com/lt90s/rfschub-server/index/config"
 	log "github.com/sirupsen/logrus"
 	"io"
 	"os/exec"
 )
 
+func init() {
+	RegisterIndexer("ctags", func(conf config.IndexerConfig) (Indexer, error) {
+		return newCommander(conf.Path), nil
+	})
+}
+
+// commander indexes files with a long running universal-ctags in interactive mode
 type commander struct {
 	c   *exec.Cmd
 	in  io.WriteCloser
@@ -89,9 +97,11 @@ type ResponseEntry struct {
 	Kind      string `json:"kind"`
 	Scope     string `json:"scope"`
 	ScopeKind string `json:"scopeKind"`
+	Signature string `json:"signature"`
+	Doc       string `json:"doc"`
 }
 
-func (c *commander) indexFile(fileName string, content []byte) (entries []ResponseEntry, err error) {
+func (c *commander) IndexFile(fileName string, content []byte) (entries []ResponseEntry, err error) {
 	r := request{
 		Command:  "generate-tags",
 		FileName: fileName,
@@ -139,6 +149,6 @@ func (c *commander) indexFile(fileName string, content []byte) (entries []Respon
 	return
 }
 
-func (c *commander) stop() {
+func (c *commander) Stop() {
 	c.c.Process.Kill()
 }
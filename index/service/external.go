@@ -0,0 +1,59 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/lt90s/rfschub-server/index/config"
+	log "github.com/sirupsen/logrus"
+	"os/exec"
+)
+
+func init() {
+	RegisterIndexer("external", func(conf config.IndexerConfig) (Indexer, error) {
+		if conf.Path == "" {
+			return nil, errors.New("external indexer path not set")
+		}
+		return &externalIndexer{path: conf.Path, args: conf.Args}, nil
+	})
+}
+
+// externalIndexer runs an external tool once per file. The tool reads file
+// content from stdin and writes one JSON encoded ResponseEntry per line.
+type externalIndexer struct {
+	path string
+	args []string
+}
+
+func (e *externalIndexer) IndexFile(fileName string, content []byte) ([]ResponseEntry, error) {
+	args := append(append([]string{}, e.args...), fileName)
+	cmd := exec.Command(e.path, args...)
+	cmd.Stdin = bytes.NewReader(content)
+	out, err := cmd.Output()
+	if err != nil {
+		log.Debugf("[externalIndexer] run error: path=%s file=%s error=%v", e.path, fileName, err)
+		return nil, err
+	}
+
+	entries := make([]ResponseEntry, 0, 256)
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry ResponseEntry
+		if err = json.Unmarshal(line, &entry); err != nil {
+			return nil, err
+		}
+		if entry.Name == "" || entry.Line <= 0 {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+func (e *externalIndexer) Stop() {}
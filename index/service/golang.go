@@ -0,0 +1,168 @@
+package service
+
+import (
+	"bytes"
+	"github.com/lt90s/rfschub-server/index/config"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strings"
+)
+
+func init() {
+	RegisterIndexer("go", func(conf config.IndexerConfig) (Indexer, error) {
+		return goIndexer{}, nil
+	})
+}
+
+// goIndexer indexes go source files with go/parser, it provides signatures
+// and doc comments which ctags does not
+type goIndexer struct{}
+
+func (goIndexer) IndexFile(fileName string, content []byte) ([]ResponseEntry, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, fileName, content, parser.ParseComments)
+	if file == nil {
+		return nil, err
+	}
+
+	g := &goSymbols{fset: fset, fileName: fileName, content: content}
+	g.add(file.Name, "package", "", "", "", nil)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			g.addFunc(decl)
+		case *ast.GenDecl:
+			g.addGenDecl(decl)
+		}
+	}
+	// return what has been parsed even if the file has syntax errors
+	return g.entries, nil
+}
+
+func (goIndexer) Stop() {}
+
+type goSymbols struct {
+	fset     *token.FileSet
+	fileName string
+	content  []byte
+	entries  []ResponseEntry
+}
+
+func (g *goSymbols) add(ident *ast.Ident, kind, scope, scopeKind, signature string, doc *ast.CommentGroup) {
+	if ident == nil || ident.Name == "_" {
+		return
+	}
+	position := g.fset.Position(ident.Pos())
+	g.entries = append(g.entries, ResponseEntry{
+		Type:      "tag",
+		Name:      ident.Name,
+		Path:      g.fileName,
+		Pattern:   g.pattern(position.Offset),
+		Language:  "Go",
+		Line:      position.Line,
+		Kind:      kind,
+		Scope:     scope,
+		ScopeKind: scopeKind,
+		Signature: signature,
+		Doc:       strings.TrimSpace(doc.Text()),
+	})
+}
+
+// ctags style search pattern of the line at offset
+func (g *goSymbols) pattern(offset int) string {
+	start := bytes.LastIndexByte(g.content[:offset], '\n') + 1
+	end := bytes.IndexByte(g.content[offset:], '\n')
+	if end == -1 {
+		end = len(g.content)
+	} else {
+		end += offset
+	}
+	return "/^" + string(bytes.TrimRight(g.content[start:end], "\r")) + "$/"
+}
+
+func (g *goSymbols) print(node ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.fset, node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+func (g *goSymbols) addFunc(decl *ast.FuncDecl) {
+	signature := strings.TrimPrefix(g.print(decl.Type), "func")
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		g.add(decl.Name, "func", "", "", signature, decl.Doc)
+		return
+	}
+	g.add(decl.Name, "method", receiverType(decl.Recv.List[0].Type), "struct", signature, decl.Doc)
+}
+
+// name of the receiver base type, without pointer and type parameters
+func receiverType(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
+
+func (g *goSymbols) addGenDecl(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		switch spec := spec.(type) {
+		case *ast.TypeSpec:
+			doc := spec.Doc
+			if doc == nil && len(decl.Specs) == 1 {
+				doc = decl.Doc
+			}
+			g.addType(spec, doc)
+		case *ast.ValueSpec:
+			kind := "var"
+			if decl.Tok == token.CONST {
+				kind = "const"
+			}
+			doc := spec.Doc
+			if doc == nil {
+				doc = decl.Doc
+			}
+			var signature string
+			if spec.Type != nil {
+				signature = g.print(spec.Type)
+			}
+			for _, name := range spec.Names {
+				g.add(name, kind, "", "", signature, doc)
+			}
+		}
+	}
+}
+
+func (g *goSymbols) addType(spec *ast.TypeSpec, doc *ast.CommentGroup) {
+	switch t := spec.Type.(type) {
+	case *ast.StructType:
+		g.add(spec.Name, "struct", "", "", "", doc)
+		for _, field := range t.Fields.List {
+			for _, name := range field.Names {
+				g.add(name, "member", spec.Name.Name, "struct", g.print(field.Type), field.Doc)
+			}
+		}
+	case *ast.InterfaceType:
+		g.add(spec.Name, "interface", "", "", "", doc)
+		for _, method := range t.Methods.List {
+			for _, name := range method.Names {
+				g.add(name, "methodSpec", spec.Name.Name, "interface", strings.TrimPrefix(g.print(method.Type), "func"), method.Doc)
+			}
+		}
+	default:
+		g.add(spec.Name, "type", "", "", g.print(spec.Type), doc)
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGoIndexer_IndexFile(t *testing.T) {
+	content := `package foo
+
+// Bar does bar
+type Bar struct {
+	Name string
+}
+
+// Baz returns name
+func (b *Bar) Baz(n int) (string, error) {
+	return b.Name, nil
+}
+
+const Max = 10
+`
+	entries, err := goIndexer{}.IndexFile("foo.go", []byte(content))
+	require.NoError(t, err)
+
+	symbols := make(map[string]ResponseEntry)
+	for _, entry := range entries {
+		symbols[entry.Name] = entry
+	}
+	require.Len(t, symbols, 5)
+
+	require.Equal(t, "package", symbols["foo"].Kind)
+
+	require.Equal(t, "struct", symbols["Bar"].Kind)
+	require.Equal(t, 4, symbols["Bar"].Line)
+	require.Equal(t, "Bar does bar", symbols["Bar"].Doc)
+	require.Equal(t, "/^type Bar struct {$/", symbols["Bar"].Pattern)
+
+	require.Equal(t, "member", symbols["Name"].Kind)
+	require.Equal(t, "Bar", symbols["Name"].Scope)
+	require.Equal(t, "string", symbols["Name"].Signature)
+
+	require.Equal(t, "method", symbols["Baz"].Kind)
+	require.Equal(t, "Bar", symbols["Baz"].Scope)
+	require.Equal(t, "(n int) (string, error)", symbols["Baz"].Signature)
+	require.Equal(t, "Baz returns name", symbols["Baz"].Doc)
+
+	require.Equal(t, "const", symbols["Max"].Kind)
+	require.Equal(t, 13, symbols["Max"].Line)
+}
+
+func TestDetectLanguage(t *testing.T) {
+	require.Equal(t, "Go", detectLanguage("a/b/c.go"))
+	require.Equal(t, "Make", detectLanguage("a/Makefile"))
+	require.Equal(t, "C++", detectLanguage("x.HPP"))
+	require.Equal(t, "", detectLanguage("LICENSE"))
+}
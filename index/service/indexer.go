@@ -4,18 +4,22 @@ import (
 	"archive/tar"
 	"bytes"
 	"context"
+	"github.com/lt90s/rfschub-server/common/glob"
 	"github.com/lt90s/rfschub-server/gits/client"
 	"github.com/lt90s/rfschub-server/gits/proto"
 	"github.com/lt90s/rfschub-server/index/config"
 	"github.com/lt90s/rfschub-server/index/store"
 	log "github.com/sirupsen/logrus"
 	"io"
+	"path"
 	"time"
 )
 
 type indexRequest struct {
 	url  string
 	hash string
+	// index blobs indexed already again
+	force bool
 }
 
 type indexResult struct {
@@ -33,14 +37,18 @@ type indexer struct {
 	gitClient gits.GitsService
 	maxSize   int64
 	buffer    []byte
-	cmds      []*commander
+	backends  []*indexerSet
+	languages map[string]string
+	ignore    []string
+	generated []string
+	vendored  []string
 }
 
 func newIndexer(config config.IndexConfig, reqChan <-chan indexRequest, resChan chan<- indexResult, store store.Store) *indexer {
 	gitClient := client.New(client.ServerConfig{ServiceName: config.Gits.Name})
-	cmds := make([]*commander, config.Concurrency)
+	backends := make([]*indexerSet, config.Concurrency)
 	for i := 0; i < config.Concurrency; i++ {
-		cmds[i] = newCommander(config.Path)
+		backends[i] = newIndexerSet(config)
 	}
 	indexer := &indexer{
 		reqChan:   reqChan,
@@ -51,7 +59,11 @@ func newIndexer(config config.IndexConfig, reqChan <-chan indexRequest, resChan
 		stop:      make(chan struct{}, config.Concurrency),
 		maxSize:   config.Size,
 		buffer:    make([]byte, config.Size),
-		cmds:      cmds,
+		backends:  backends,
+		languages: config.Languages,
+		ignore:    config.Ignore,
+		generated: config.Generated,
+		vendored:  config.Vendored,
 	}
 
 	for i := 0; i < config.Concurrency; i++ {
@@ -65,7 +77,7 @@ func (indexer *indexer) start(i int) {
 	for {
 		select {
 		case <-indexer.stop:
-			indexer.cmds[i].stop()
+			indexer.backends[i].stop()
 			return
 		case task, ok := <-indexer.reqChan:
 			if !ok {
@@ -115,9 +127,142 @@ func (gas *gitsArchiveReader) Read(p []byte) (int, error) {
 	return n, err
 }
 
+// archive at most this number of pending paths explicitly, otherwise
+// archive the whole commit and skip files which need not to be indexed
+const maxArchivePaths = 1000
+
+// indexPlan describes which files of a commit need to be indexed
+type indexPlan struct {
+	// file path to blob hash of all files
+	blobs map[string]string
+	// files whose blob has not been indexed, one file for each blob
+	pending map[string]struct{}
+	// manifest of the commit
+	manifest []store.ManifestEntry
+	// information of indexed blobs
+	infos map[string]store.BlobInfo
+}
+
+func (plan *indexPlan) needIndex(file string) bool {
+	_, ok := plan.pending[file]
+	return ok
+}
+
+// prepare an index plan for task. The manifest of the commit is saved and
+// only blobs which have never been indexed, by this commit or any other
+// commit of any repository, need to be indexed, unless task is forced.
+// Blobs indexed by an indexer other than the one configured for them now
+// are indexed again.
+// Files unchanged since an earlier commit keep their blob, so no diff with
+// the last indexed commit is needed, and commits of other branches and forks
+// benefit as well.
+func (indexer *indexer) prepareIndexPlan(ctx context.Context, task indexRequest) (*indexPlan, error) {
+	fRsp, err := indexer.gitClient.GetRepositoryFiles(ctx, &gits.GetRepositoryFilesRequest{Url: task.url, Commit: task.hash})
+	if err != nil {
+		return nil, err
+	}
+	plan := &indexPlan{
+		blobs:   make(map[string]string, len(fRsp.Entries)),
+		pending: make(map[string]struct{}),
+	}
+	rules := indexer.attributeRules(ctx, task, fRsp.Entries)
+	manifest := make([]store.ManifestEntry, 0, len(fRsp.Entries))
+	fileOfBlob := make(map[string]string, len(fRsp.Entries))
+	for _, entry := range fRsp.Entries {
+		if entry.Dir || glob.MatchAny(indexer.ignore, entry.File) {
+			continue
+		}
+		generated, vendored := fileAttributes(rules, entry.File)
+		plan.blobs[entry.File] = entry.Hash
+		manifest = append(manifest, store.ManifestEntry{
+			File:      entry.File,
+			Blob:      entry.Hash,
+			Generated: generated || glob.MatchAny(indexer.generated, entry.File),
+			Vendored:  vendored || glob.MatchAny(indexer.vendored, entry.File),
+		})
+		fileOfBlob[entry.Hash] = entry.File
+	}
+
+	plan.manifest = manifest
+	if err = indexer.store.SetManifest(ctx, task.url, task.hash, manifest); err != nil {
+		return nil, err
+	}
+
+	blobs := make([]string, 0, len(fileOfBlob))
+	for blob := range fileOfBlob {
+		blobs = append(blobs, blob)
+	}
+	if task.force {
+		plan.infos = make(map[string]store.BlobInfo, len(blobs))
+	} else if plan.infos, err = indexer.store.IndexedBlobs(ctx, blobs); err != nil {
+		return nil, err
+	}
+	for blob, file := range fileOfBlob {
+		info, ok := plan.infos[blob]
+		if !ok || info.Indexer != indexerName(indexer.languages, file) {
+			delete(plan.infos, blob)
+			plan.pending[file] = struct{}{}
+		}
+	}
+	log.Debugf("[prepareIndexPlan] url=%s hash=%s files=%d blobs=%d pending=%d", task.url, task.hash, len(manifest), len(blobs), len(plan.pending))
+	return plan, nil
+}
+
+// linguist attribute rules of all .gitattributes files of the commit.
+// A .gitattributes file which can not be fetched is ignored.
+func (indexer *indexer) attributeRules(ctx context.Context, task indexRequest, entries []*gits.FileEntry) []attributeRule {
+	rules := make([]attributeRule, 0)
+	for _, entry := range entries {
+		if entry.Dir || path.Base(entry.File) != gitAttributesFile {
+			continue
+		}
+		rsp, err := indexer.gitClient.GetRepositoryBlob(ctx, &gits.GetRepositoryBlobRequest{Url: task.url, Commit: task.hash, File: entry.File})
+		if err != nil {
+			log.Warnf("[attributeRules] get .gitattributes error: url=%s hash=%s file=%s error=%v", task.url, task.hash, entry.File, err)
+			continue
+		}
+		rules = append(rules, parseGitAttributes(attributesBase(entry.File), rsp.Content)...)
+	}
+	sortAttributeRules(rules)
+	return rules
+}
+
 func (indexer *indexer) indexRepository(ctx context.Context, task indexRequest, index int) error {
 	now := time.Now()
+	plan, err := indexer.prepareIndexPlan(ctx, task)
+	if err != nil {
+		log.Warnf("[indexRepository] prepare index plan error: url=%s hash=%s error=%v", task.url, task.hash, err)
+		return err
+	}
+
+	if len(plan.pending) > 0 {
+		if err = indexer.indexPendingBlobs(ctx, task, plan, index); err != nil {
+			return err
+		}
+	} else {
+		log.Debugf("[indexRepository] all blobs indexed: url=%s commit=%s", task.url, task.hash)
+	}
+
+	err = indexer.store.SetLanguageStats(ctx, task.url, task.hash, languageStats(plan.manifest, plan.infos))
+	if err != nil {
+		log.Warnf("[indexRepository] set language stats error: url=%s hash=%s error=%v", task.url, task.hash, err)
+		return err
+	}
+	log.Debugf("[indexRepository] finish indexing: url=%s commit=%s time=%v", task.url, task.hash, time.Since(now))
+	return nil
+}
+
+// index blobs which are pending in plan, information of indexed blobs is
+// added to plan
+func (indexer *indexer) indexPendingBlobs(ctx context.Context, task indexRequest, plan *indexPlan, index int) error {
 	req := &gits.ArchiveRequest{Url: task.url, Commit: task.hash}
+	if len(plan.pending) <= maxArchivePaths {
+		req.Paths = make([]string, 0, len(plan.pending))
+		for file := range plan.pending {
+			req.Paths = append(req.Paths, file)
+		}
+	}
+
 	as, err := indexer.gitClient.Archive(ctx, req)
 	if err != nil {
 		log.Warnf("[indexRepository] git client archive returns error: %s", err.Error())
@@ -131,29 +276,28 @@ func (indexer *indexer) indexRepository(ctx context.Context, task indexRequest,
 		var hdr *tar.Header
 		hdr, err = tarReader.Next()
 		if err == io.EOF {
-			err = nil
-			break
+			return nil
 		}
 		if err != nil {
 			log.Warnf("[indexRepository] tarReader.Next() returns error: %s", err.Error())
 			return err
 		}
 
-		// check if not regular
-		if hdr.Typeflag != tar.TypeReg {
+		if !plan.needIndex(hdr.Name) {
 			continue
 		}
+		info := store.BlobInfo{Blob: plan.blobs[hdr.Name], Size: hdr.Size, Indexer: indexerName(indexer.languages, hdr.Name)}
 
-		if hdr.Size > indexer.maxSize {
+		// not regular, mark the blob indexed without symbols
+		if hdr.Typeflag != tar.TypeReg {
+			if err = indexer.addBlob(ctx, plan, info, nil); err != nil {
+				return err
+			}
 			continue
 		}
 
-		// check if already indexed
-		// as we index a file at a time, some files may be indexed already
-		// TODO: ask store if this file is indexed
-
-		n, err := tarReader.Read(buffer[:1024])
-		if err != nil && err != io.EOF {
+		n, err := io.ReadFull(tarReader, buffer[:min64(1024, hdr.Size)])
+		if err != nil {
 			log.Warnf("[indexRepository] tarReader.Read returns error: %s", err.Error())
 			return err
 		}
@@ -161,51 +305,83 @@ func (indexer *indexer) indexRepository(ctx context.Context, task indexRequest,
 		// check if binary
 		if bytes.IndexByte(buffer[:n], 0) != -1 {
 			log.Debugf("[indexRepository] ignore binary file, name=%s", hdr.Name)
+			if err = indexer.addBlob(ctx, plan, info, nil); err != nil {
+				return err
+			}
 			continue
 		}
 
-		ok, err := indexer.store.RepositoryFileIndexed(ctx, task.url, task.hash, hdr.Name)
-		if err != nil {
-			log.Warnf("[indexRepository] check RepositoryFileIndexed failed: url=%s hash=%s name=%s error=%v", task.url, task.hash, hdr.Name, err)
-		}
+		info.Text = true
+		info.Language = detectLanguage(hdr.Name)
 
-		// already indexed
-		if ok {
+		// too big, only count lines
+		if hdr.Size > indexer.maxSize {
+			lines, err := countReaderLines(buffer[:n], tarReader, buffer[n:])
+			if err != nil {
+				return err
+			}
+			info.Lines = lines
+			if err = indexer.addBlob(ctx, plan, info, nil); err != nil {
+				return err
+			}
 			continue
 		}
 
 		log.Debugf("[indexRepository] start to index file: name=%s size=%d", hdr.Name, hdr.Size)
 
 		// read it all
-		_, err = tarReader.Read(buffer[n:])
-		if err != nil && err != io.EOF {
+		_, err = io.ReadFull(tarReader, buffer[n:hdr.Size])
+		if err != nil {
 			return err
 		}
+		content := buffer[:hdr.Size]
+		info.Lines = countLines(content)
 
-		entries, err := indexer.cmds[index].indexFile(hdr.Name, buffer[:hdr.Size])
+		entries, err := indexer.backends[index].indexFile(hdr.Name, content)
 		if err != nil {
 			log.Warnf("[indexRepository] index file error: %s", err.Error())
 		}
+		if info.Language == "" && len(entries) > 0 {
+			info.Language = entries[0].Language
+		}
 
-		err = indexer.saveResponseEntries(ctx, task.url, task.hash, entries, buffer)
+		indexEntries, err := responseIndexEntries(info.Blob, entries, content)
 		if err != nil {
-			log.Warnf("[indexRepository] save repository file index entries error: %s", err.Error())
-			break
+			log.Warnf("[indexRepository] convert response entries error: name=%s error=%v", hdr.Name, err)
+		}
+		if err = indexer.addBlob(ctx, plan, info, indexEntries); err != nil {
+			return err
 		}
 	}
-	log.Debugf("[indexRepository] finish indexing: url=%s commit=%s time=%v", req.Url, req.Commit, time.Since(now))
+}
 
+func (indexer *indexer) addBlob(ctx context.Context, plan *indexPlan, info store.BlobInfo, entries []store.IndexEntry) error {
+	err := indexer.store.AddBlobIndexEntries(ctx, info, entries)
+	if err != nil {
+		log.Warnf("[indexRepository] add blob index entries error: blob=%s error=%v", info.Blob, err)
+		return err
+	}
+	plan.infos[info.Blob] = info
 	return nil
 }
 
-func (indexer *indexer) saveResponseEntries(ctx context.Context, url, hash string, entries []ResponseEntry, content []byte) error {
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// convert entries responded by indexer backends to index entries, entries
+// converted before an error are returned along with it
+func responseIndexEntries(blob string, entries []ResponseEntry, content []byte) ([]store.IndexEntry, error) {
 	liner := newLiner(content)
 	indexEntries := make([]store.IndexEntry, 0, len(entries))
 	for _, entry := range entries {
 		var lineBefore, lineAfter string
 		line, err := liner.getLine(entry.Line)
 		if err != nil {
-			return err
+			return indexEntries, err
 		}
 		lineAfter, err = liner.getLine(entry.Line + 1)
 		if err == errLineNotExist {
@@ -220,9 +396,7 @@ func (indexer *indexer) saveResponseEntries(ctx context.Context, url, hash strin
 		}
 
 		indexEntry := store.IndexEntry{
-			Url:        url,
-			Hash:       hash,
-			File:       entry.Path,
+			Blob:       blob,
 			Name:       entry.Name,
 			Pattern:    entry.Pattern,
 			Language:   entry.Language,
@@ -233,12 +407,11 @@ func (indexer *indexer) saveResponseEntries(ctx context.Context, url, hash strin
 			Kind:       entry.Kind,
 			Scope:      entry.Scope,
 			ScopeKind:  entry.ScopeKind,
+			Signature:  entry.Signature,
+			Doc:        entry.Doc,
 		}
 
 		indexEntries = append(indexEntries, indexEntry)
 	}
-	if len(indexEntries) == 0 {
-		return nil
-	}
-	return indexer.store.AddFileIndexEntries(ctx, indexEntries)
+	return indexEntries, nil
 }
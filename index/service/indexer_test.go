@@ -1,10 +1,21 @@
 package service
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"crypto/sha1"
+	"fmt"
+	"github.com/lt90s/rfschub-server/gits/proto"
 	"github.com/lt90s/rfschub-server/index/config"
+	"github.com/lt90s/rfschub-server/index/store"
 	"github.com/lt90s/rfschub-server/index/store/mock"
+	"github.com/micro/go-micro/client"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"io"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -17,9 +28,160 @@ func init() {
 	logrus.SetLevel(logrus.DebugLevel)
 }
 
+// fakeGits serves files and archives of files, other methods are not
+// implemented
+type fakeGits struct {
+	gits.GitsService
+	files map[string]string
+	// paths of the last archive request
+	archived []string
+}
+
+func (f *fakeGits) GetRepositoryFiles(ctx context.Context, in *gits.GetRepositoryFilesRequest, opts ...client.CallOption) (*gits.GetRepositoryFilesResponse, error) {
+	rsp := &gits.GetRepositoryFilesResponse{}
+	for name, content := range f.files {
+		rsp.Entries = append(rsp.Entries, &gits.FileEntry{File: name, Hash: fmt.Sprintf("%x", sha1.Sum([]byte(content)))})
+	}
+	return rsp, nil
+}
+
+func (f *fakeGits) Archive(ctx context.Context, in *gits.ArchiveRequest, opts ...client.CallOption) (gits.Gits_ArchiveService, error) {
+	f.archived = in.Paths
+	var buf bytes.Buffer
+	writer := tar.NewWriter(&buf)
+	for name, content := range f.files {
+		if len(in.Paths) > 0 && !contains(in.Paths, name) {
+			continue
+		}
+		err := writer.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = writer.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return &fakeArchiveStream{data: buf.Bytes()}, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeArchiveStream struct {
+	gits.Gits_ArchiveService
+	data []byte
+}
+
+func (s *fakeArchiveStream) Recv() (*gits.ArchiveResponse, error) {
+	if len(s.data) == 0 {
+		return nil, io.EOF
+	}
+	rsp := &gits.ArchiveResponse{Data: s.data}
+	s.data = nil
+	return rsp, nil
+}
+
+// lineIndexer generates a symbol for each line starting with "func "
+type lineIndexer struct{}
+
+func (lineIndexer) IndexFile(fileName string, content []byte) (entries []ResponseEntry, err error) {
+	for idx, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(line, "func ") {
+			name := strings.TrimSuffix(strings.Fields(line)[1], "()")
+			entries = append(entries, ResponseEntry{Name: name, Path: fileName, Line: idx + 1, Kind: "func"})
+		}
+	}
+	return
+}
+
+func (lineIndexer) Stop() {}
+
+func newTestIndexer(s store.Store, gitClient gits.GitsService, languages map[string]string) *indexer {
+	conf := config.DefaultConfig
+	return &indexer{
+		store:     s,
+		maxSize:   conf.Size,
+		buffer:    make([]byte, conf.Size),
+		gitClient: gitClient,
+		backends: []*indexerSet{{
+			indexers:  map[string]Indexer{"line": lineIndexer{}, "go": goIndexer{}},
+			languages: languages,
+		}},
+		languages: languages,
+	}
+}
+
 func TestIndexer_indexRepository(t *testing.T) {
-	indexer := newIndexer(config.DefaultConfig, nil, nil, mock.NewMockStore())
-	err := indexer.indexRepository(context.Background(), indexRequest{url, hash}, 0)
-	t.Log(err)
+	s := mock.NewMockStore()
+	gitClient := &fakeGits{files: map[string]string{
+		"main.go":  "package main\n\nfunc main() {\n}\n",
+		"util.go":  "package main\n\nfunc util() {\n}\n",
+		"data.bin": "\x00\x01",
+	}}
+	indexer := newTestIndexer(s, gitClient, map[string]string{"*": "line"})
+	ctx := context.Background()
+
+	err := indexer.indexRepository(ctx, indexRequest{url: url, hash: hash}, 0)
+	require.NoError(t, err)
+	require.Len(t, gitClient.archived, 3)
+
+	symbols, err := s.FindSymbols(ctx, url, hash, "main")
+	require.NoError(t, err)
+	require.Len(t, symbols, 1)
+	require.Equal(t, "main.go", symbols[0].File)
+	require.Equal(t, 3, symbols[0].LineNumber)
+
+	// the binary file is not counted
+	stats, err := s.LanguageStats(ctx, url, hash)
+	require.NoError(t, err)
+	require.Equal(t, []store.LanguageStat{{Language: "Go", Files: 2, Bytes: 60, Lines: 8}}, stats)
+
+	// only the blob changed is archived for the next commit
+	next := "1111111111111111111111111111111111111111"
+	gitClient.files["util.go"] = "package main\n\nfunc util2() {\n}\n"
+	err = indexer.indexRepository(ctx, indexRequest{url: url, hash: next}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"util.go"}, gitClient.archived)
+
+	symbols, err = s.FindSymbols(ctx, url, next, "main")
+	require.NoError(t, err)
+	require.Len(t, symbols, 1)
+	symbols, err = s.FindSymbols(ctx, url, next, "util2")
+	require.NoError(t, err)
+	require.Len(t, symbols, 1)
+	symbols, err = s.FindSymbols(ctx, url, next, "util")
+	require.NoError(t, err)
+	require.Len(t, symbols, 0)
+
+	// stats count blobs indexed by earlier commits as well
+	stats, err = s.LanguageStats(ctx, url, next)
+	require.NoError(t, err)
+	require.Equal(t, []store.LanguageStat{{Language: "Go", Files: 2, Bytes: 61, Lines: 8}}, stats)
+
+	// blobs of Go files are indexed again once another indexer is configured
+	// for them
+	indexer = newTestIndexer(s, gitClient, map[string]string{"Go": "go", "*": "line"})
+	err = indexer.indexRepository(ctx, indexRequest{url: url, hash: next}, 0)
+	require.NoError(t, err)
+	sort.Strings(gitClient.archived)
+	require.Equal(t, []string{"main.go", "util.go"}, gitClient.archived)
+
+	symbols, err = s.FindSymbols(ctx, url, next, "util2")
+	require.NoError(t, err)
+	require.Len(t, symbols, 1)
+	require.Equal(t, "()", symbols[0].Signature)
 
+	// a forced task indexes every blob again
+	err = indexer.indexRepository(ctx, indexRequest{url: url, hash: next, force: true}, 0)
+	require.NoError(t, err)
+	require.Len(t, gitClient.archived, 3)
 }
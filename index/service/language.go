@@ -0,0 +1,81 @@
+package service
+
+import (
+	"path"
+	"strings"
+)
+
+// language names follow universal-ctags, so they can be compared with the
+// `language` field ctags returns
+var extensionLanguages = map[string]string{
+	".c":     "C",
+	".h":     "C",
+	".cc":    "C++",
+	".cpp":   "C++",
+	".cxx":   "C++",
+	".hh":    "C++",
+	".hpp":   "C++",
+	".hxx":   "C++",
+	".cs":    "C#",
+	".go":    "Go",
+	".java":  "Java",
+	".kt":    "Kotlin",
+	".scala": "Scala",
+	".js":    "JavaScript",
+	".jsx":   "JavaScript",
+	".mjs":   "JavaScript",
+	".ts":    "TypeScript",
+	".tsx":   "TypeScript",
+	".py":    "Python",
+	".rb":    "Ruby",
+	".php":   "PHP",
+	".rs":    "Rust",
+	".swift": "Swift",
+	".m":     "ObjectiveC",
+	".lua":   "Lua",
+	".pl":    "Perl",
+	".pm":    "Perl",
+	".sh":    "Sh",
+	".bash":  "Sh",
+	".erl":   "Erlang",
+	".ex":    "Elixir",
+	".exs":   "Elixir",
+	".hs":    "Haskell",
+	".ml":    "OCaml",
+	".clj":   "Clojure",
+	".r":     "R",
+	".sql":   "SQL",
+	".proto": "Protobuf",
+	".html":  "HTML",
+	".htm":   "HTML",
+	".css":   "CSS",
+	".scss":  "SCSS",
+	".vue":   "Vue",
+	".md":    "Markdown",
+	".json":  "JSON",
+	".yaml":  "Yaml",
+	".yml":   "Yaml",
+	".toml":  "TOML",
+	".xml":   "XML",
+	".mk":    "Make",
+	".cmake": "CMake",
+	".vim":   "Vim",
+	".tex":   "Tex",
+}
+
+var fileNameLanguages = map[string]string{
+	"Makefile":       "Make",
+	"makefile":       "Make",
+	"GNUmakefile":    "Make",
+	"CMakeLists.txt": "CMake",
+	"Dockerfile":     "Dockerfile",
+}
+
+// detect language of a file by its name, empty if unknown
+func detectLanguage(file string) string {
+	base := path.Base(file)
+	if language, ok := fileNameLanguages[base]; ok {
+		return language
+	}
+	return extensionLanguages[strings.ToLower(path.Ext(base))]
+}
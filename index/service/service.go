@@ -3,17 +3,27 @@ package service
 import (
 	"context"
 	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/glob"
 	"github.com/lt90s/rfschub-server/index/config"
 	proto "github.com/lt90s/rfschub-server/index/proto"
 	"github.com/lt90s/rfschub-server/index/store"
 	log "github.com/sirupsen/logrus"
+	"sort"
 	"sync"
+	"time"
 )
 
+// indexTask is a commit being indexed, tasks are keyed by commit hash
+type indexTask struct {
+	url       string
+	startedAt int64
+	force     bool
+}
+
 type indexService struct {
 	concurrency int
 	mu          sync.RWMutex
-	tasks       map[string]string
+	tasks       map[string]indexTask
 	indexers    []*indexer
 	stop        chan struct{}
 	reqChan     chan indexRequest
@@ -37,7 +47,7 @@ func NewIndexService(store store.Store) proto.IndexHandler {
 
 	service := &indexService{
 		concurrency: concurrency,
-		tasks:       make(map[string]string, config.DefaultConfig.Concurrency),
+		tasks:       make(map[string]indexTask, config.DefaultConfig.Concurrency),
 		indexers:    indexers,
 		stop:        make(chan struct{}, 1),
 		reqChan:     reqChan,
@@ -63,7 +73,7 @@ func (service *indexService) checkResult() {
 }
 
 func (service *indexService) IndexRepository(ctx context.Context, req *proto.IndexRepositoryRequest, rsp *proto.IndexRepositoryResponse) error {
-	log.Debugf("[IndexRepository]: url=%s hash=%s", req.Url, req.Hash)
+	log.Debugf("[IndexRepository]: url=%s hash=%s force=%v", req.Url, req.Hash, req.Force)
 	// check if already indexed
 	indexed, err := service.store.RepositoryIndexed(ctx, req.Url, req.Hash)
 	if err != nil {
@@ -71,7 +81,7 @@ func (service *indexService) IndexRepository(ctx context.Context, req *proto.Ind
 		return err
 	}
 
-	if indexed {
+	if indexed && !req.Force {
 		log.Debugf("[IndexRepository] already indexed: url=%s hash=%s", req.Url, req.Hash)
 		rsp.Indexed = true
 		return nil
@@ -90,7 +100,7 @@ func (service *indexService) IndexRepository(ctx context.Context, req *proto.Ind
 		return errors.NewServiceUnavailable(-1, "indexer busy")
 	}
 
-	service.tasks[req.Hash] = req.Url
+	service.tasks[req.Hash] = indexTask{url: req.Url, startedAt: time.Now().Unix(), force: req.Force}
 	service.mu.Unlock()
 
 	err = service.store.NewIndexTask(ctx, req.Url, req.Hash)
@@ -103,8 +113,9 @@ func (service *indexService) IndexRepository(ctx context.Context, req *proto.Ind
 	}
 
 	request := indexRequest{
-		url:  req.Url,
-		hash: req.Hash,
+		url:   req.Url,
+		hash:  req.Hash,
+		force: req.Force,
 	}
 	// should not block here
 	service.reqChan <- request
@@ -113,8 +124,8 @@ func (service *indexService) IndexRepository(ctx context.Context, req *proto.Ind
 
 func (service *indexService) IndexStatus(ctx context.Context, req *proto.IndexStatusRequest, rsp *proto.IndexStatusResponse) error {
 	service.mu.RLock()
-	if url, ok := service.tasks[req.Hash]; ok {
-		if url == req.Url {
+	if task, ok := service.tasks[req.Hash]; ok {
+		if task.url == req.Url {
 			service.mu.RUnlock()
 			rsp.Status = proto.StatusCode_StatusIndexing
 			return nil
@@ -141,8 +152,16 @@ func (service *indexService) SearchSymbol(ctx context.Context, req *proto.Search
 		return err
 	}
 
+	// symbols in generated or vendored files are ranked last
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return !symbols[i].Generated && !symbols[i].Vendored && (symbols[j].Generated || symbols[j].Vendored)
+	})
+
 	rsp.Symbols = make([]*proto.SymbolResult, 0, len(symbols))
 	for _, symbol := range symbols {
+		if glob.MatchAny(req.Ignore, symbol.File) {
+			continue
+		}
 		rsp.Symbols = append(rsp.Symbols, &proto.SymbolResult{
 			File:       symbol.File,
 			LineNumber: int32(symbol.LineNumber),
@@ -150,7 +169,53 @@ func (service *indexService) SearchSymbol(ctx context.Context, req *proto.Search
 			LineBefore: symbol.LineBefore,
 			LineAfter:  symbol.LineAfter,
 			Kind:       symbol.Kind,
+			Signature:  symbol.Signature,
+			Doc:        symbol.Doc,
+			Generated:  symbol.Generated,
+			Vendored:   symbol.Vendored,
 		})
 	}
 	return nil
 }
+
+func (service *indexService) LanguageStats(ctx context.Context, req *proto.LanguageStatsRequest, rsp *proto.LanguageStatsResponse) error {
+	log.Debugf("[LanguageStats] url=%s hash=%s", req.Url, req.Hash)
+	stats, err := service.store.LanguageStats(ctx, req.Url, req.Hash)
+	if err != nil {
+		log.Warnf("[LanguageStats] get language stats error: url=%s hash=%s error=%v", req.Url, req.Hash, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Stats = make([]*proto.LanguageStat, 0, len(stats))
+	for _, stat := range stats {
+		rsp.Stats = append(rsp.Stats, &proto.LanguageStat{
+			Language: stat.Language,
+			Files:    stat.Files,
+			Bytes:    stat.Bytes,
+			Lines:    stat.Lines,
+		})
+	}
+	return nil
+}
+
+func (service *indexService) ListIndexTasks(ctx context.Context, req *proto.ListIndexTasksRequest, rsp *proto.ListIndexTasksResponse) error {
+	service.mu.RLock()
+	rsp.Tasks = make([]*proto.IndexTask, 0, len(service.tasks))
+	for hash, task := range service.tasks {
+		rsp.Tasks = append(rsp.Tasks, &proto.IndexTask{
+			Url:       task.url,
+			Hash:      hash,
+			StartedAt: task.startedAt,
+			Force:     task.force,
+		})
+	}
+	service.mu.RUnlock()
+
+	sort.Slice(rsp.Tasks, func(i, j int) bool {
+		if rsp.Tasks[i].StartedAt != rsp.Tasks[j].StartedAt {
+			return rsp.Tasks[i].StartedAt < rsp.Tasks[j].StartedAt
+		}
+		return rsp.Tasks[i].Hash < rsp.Tasks[j].Hash
+	})
+	return nil
+}
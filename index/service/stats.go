@@ -0,0 +1,91 @@
+package service
+
+import (
+	"bytes"
+	"github.com/lt90s/rfschub-server/index/store"
+	"io"
+	"sort"
+)
+
+// language of files which can not be detected
+const otherLanguage = "Other"
+
+// number of lines of content, the last line may not end with newline
+func countLines(content []byte) int64 {
+	if len(content) == 0 {
+		return 0
+	}
+	lines := int64(bytes.Count(content, []byte{'\n'}))
+	if content[len(content)-1] != '\n' {
+		lines++
+	}
+	return lines
+}
+
+// count lines of head followed by the rest of reader, buffer is used to read
+func countReaderLines(head []byte, reader io.Reader, buffer []byte) (int64, error) {
+	lines := int64(bytes.Count(head, []byte{'\n'}))
+	last := byte('\n')
+	if len(head) > 0 {
+		last = head[len(head)-1]
+	}
+	for {
+		n, err := reader.Read(buffer)
+		if n > 0 {
+			lines += int64(bytes.Count(buffer[:n], []byte{'\n'}))
+			last = buffer[n-1]
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if last != '\n' {
+		lines++
+	}
+	return lines, nil
+}
+
+// languageStats aggregates bytes, files and lines of text files by language,
+// generated and vendored files are not counted. Result is sorted by bytes.
+func languageStats(manifest []store.ManifestEntry, infos map[string]store.BlobInfo) []store.LanguageStat {
+	byLanguage := make(map[string]*store.LanguageStat)
+	for _, entry := range manifest {
+		if entry.Generated || entry.Vendored {
+			continue
+		}
+		info, ok := infos[entry.Blob]
+		if !ok || !info.Text {
+			continue
+		}
+		language := detectLanguage(entry.File)
+		if language == "" {
+			language = info.Language
+		}
+		if language == "" {
+			language = otherLanguage
+		}
+		stat, ok := byLanguage[language]
+		if !ok {
+			stat = &store.LanguageStat{Language: language}
+			byLanguage[language] = stat
+		}
+		stat.Files++
+		stat.Bytes += info.Size
+		stat.Lines += info.Lines
+	}
+
+	stats := make([]store.LanguageStat, 0, len(byLanguage))
+	for _, stat := range byLanguage {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Bytes != stats[j].Bytes {
+			return stats[i].Bytes > stats[j].Bytes
+		}
+		return stats[i].Language < stats[j].Language
+	})
+	return stats
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"bytes"
+	"github.com/lt90s/rfschub-server/index/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCountLines(t *testing.T) {
+	require.Equal(t, int64(0), countLines(nil))
+	require.Equal(t, int64(1), countLines([]byte("a")))
+	require.Equal(t, int64(2), countLines([]byte("a\nb\n")))
+	require.Equal(t, int64(3), countLines([]byte("a\n\nb")))
+
+	lines, err := countReaderLines([]byte("a\nb"), bytes.NewReader([]byte("c\nd\ne")), make([]byte, 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), lines)
+}
+
+func TestLanguageStats(t *testing.T) {
+	manifest := []store.ManifestEntry{
+		{File: "main.go", Blob: "1"},
+		{File: "util.go", Blob: "2"},
+		{File: "proto/a.pb.go", Blob: "3", Generated: true},
+		{File: "web/app.js", Blob: "4"},
+		{File: "logo.png", Blob: "5"},
+		{File: "LICENSE", Blob: "6"},
+		{File: "script", Blob: "7"},
+	}
+	infos := map[string]store.BlobInfo{
+		"1": {Blob: "1", Text: true, Size: 100, Lines: 10},
+		"2": {Blob: "2", Text: true, Size: 50, Lines: 5},
+		"3": {Blob: "3", Text: true, Size: 1000, Lines: 100},
+		"4": {Blob: "4", Text: true, Size: 120, Lines: 3},
+		"5": {Blob: "5", Size: 4096},
+		"6": {Blob: "6", Text: true, Size: 20, Lines: 2},
+		"7": {Blob: "7", Text: true, Size: 10, Lines: 1, Language: "Sh"},
+	}
+	stats := languageStats(manifest, infos)
+	require.Equal(t, []store.LanguageStat{
+		{Language: "Go", Files: 2, Bytes: 150, Lines: 15},
+		{Language: "JavaScript", Files: 1, Bytes: 120, Lines: 3},
+		{Language: "Other", Files: 1, Bytes: 20, Lines: 2},
+		{Language: "Sh", Files: 1, Bytes: 10, Lines: 1},
+	}, stats)
+}
@@ -2,7 +2,6 @@ package mock
 
 import (
 	"context"
-	"fmt"
 	"github.com/lt90s/rfschub-server/index/config"
 	"github.com/lt90s/rfschub-server/index/store"
 	"sync"
@@ -10,10 +9,13 @@ import (
 )
 
 type mockStore struct {
-	mutex   sync.RWMutex
-	tasks   []indexTask
-	iMutex  sync.RWMutex
-	indexes []store.IndexEntry
+	mutex     sync.RWMutex
+	tasks     []indexTask
+	iMutex    sync.RWMutex
+	blobs     map[string]store.BlobInfo
+	indexes   map[string][]store.IndexEntry
+	manifests map[string][]store.ManifestEntry
+	stats     map[string][]store.LanguageStat
 }
 
 type indexTask struct {
@@ -25,8 +27,11 @@ type indexTask struct {
 
 func NewMockStore() store.Store {
 	return &mockStore{
-		tasks:   make([]indexTask, 0),
-		indexes: make([]store.IndexEntry, 0),
+		tasks:     make([]indexTask, 0),
+		blobs:     make(map[string]store.BlobInfo),
+		indexes:   make(map[string][]store.IndexEntry),
+		manifests: make(map[string][]store.ManifestEntry),
+		stats:     make(map[string][]store.LanguageStat),
 	}
 }
 
@@ -78,39 +83,67 @@ func (m *mockStore) RepositoryIndexed(ctx context.Context, url, hash string) (bo
 	return false, nil
 }
 
-func (m *mockStore) RepositoryFileIndexed(ctx context.Context, url, hash, file string) (bool, error) {
+func (m *mockStore) SetManifest(ctx context.Context, url, hash string, entries []store.ManifestEntry) error {
+	m.iMutex.Lock()
+	defer m.iMutex.Unlock()
+	m.manifests[url+"@"+hash] = entries
+	return nil
+}
+
+func (m *mockStore) IndexedBlobs(ctx context.Context, blobs []string) (map[string]store.BlobInfo, error) {
 	m.iMutex.RLock()
 	defer m.iMutex.RUnlock()
-	for _, index := range m.indexes {
-		if index.Url == url && index.Hash == hash && index.File == file {
-			return true, nil
+	indexed := make(map[string]store.BlobInfo)
+	for _, blob := range blobs {
+		if info, ok := m.blobs[blob]; ok {
+			indexed[blob] = info
 		}
 	}
-	return false, nil
+	return indexed, nil
 }
 
-func (m *mockStore) AddFileIndexEntries(ctx context.Context, entries []store.IndexEntry) error {
+func (m *mockStore) AddBlobIndexEntries(ctx context.Context, info store.BlobInfo, entries []store.IndexEntry) error {
 	m.iMutex.Lock()
 	defer m.iMutex.Unlock()
-	m.indexes = append(m.indexes, entries...)
-	fmt.Println(entries)
+	m.blobs[info.Blob] = info
+	m.indexes[info.Blob] = append([]store.IndexEntry{}, entries...)
 	return nil
 }
 
 func (m *mockStore) FindSymbols(ctx context.Context, url, hash, name string) (symbols []store.Symbol, err error) {
 	m.iMutex.RLock()
 	defer m.iMutex.RUnlock()
-	for _, index := range m.indexes {
-		if index.Url == url && index.Hash == hash && index.Name == name {
+	for _, manifest := range m.manifests[url+"@"+hash] {
+		for _, index := range m.indexes[manifest.Blob] {
+			if index.Name != name {
+				continue
+			}
 			symbols = append(symbols, store.Symbol{
-				File:       index.File,
+				File:       manifest.File,
 				LineNumber: index.LineNumber,
 				Line:       index.Line,
 				LineBefore: index.LineBefore,
 				LineAfter:  index.LineAfter,
 				Kind:       index.Kind,
+				Signature:  index.Signature,
+				Doc:        index.Doc,
+				Generated:  manifest.Generated,
+				Vendored:   manifest.Vendored,
 			})
 		}
 	}
 	return
 }
+
+func (m *mockStore) SetLanguageStats(ctx context.Context, url, hash string, stats []store.LanguageStat) error {
+	m.iMutex.Lock()
+	defer m.iMutex.Unlock()
+	m.stats[url+"@"+hash] = stats
+	return nil
+}
+
+func (m *mockStore) LanguageStats(ctx context.Context, url, hash string) (stats []store.LanguageStat, err error) {
+	m.iMutex.RLock()
+	defer m.iMutex.RUnlock()
+	return m.stats[url+"@"+hash], nil
+}
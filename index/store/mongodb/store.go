@@ -8,9 +8,20 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"strings"
 	"time"
 )
 
+// bump it when the layout of index data changes, commits indexed with an
+// older version are treated as not indexed
+const indexVersion = 3
+
+// bump it when the information saved of a blob changes, blobs indexed with
+// an older version are indexed again
+const blobVersion = 1
+
+const batchSize = 1000
+
 type mongodbStore struct {
 	client  *mongo.Client
 	name    string
@@ -26,9 +37,49 @@ func NewMongodbStore() store.Store {
 		name:    conf.Mongodb.Database,
 		timeout: int64(conf.Timeout),
 	}
+	ms.setup()
 	return ms
 }
 
+func (ms *mongodbStore) setup() {
+	unique := true
+	indexes := map[*mongo.Collection][]mongo.IndexModel{
+		ms.blobCollection(): {
+			{
+				Keys:    bson.M{"blob": 1},
+				Options: &options.IndexOptions{Unique: &unique},
+			},
+		},
+		ms.blobIndexCollection(): {
+			{
+				Keys: bson.D{
+					{Key: "blob", Value: 1},
+					{Key: "name", Value: 1},
+					{Key: "lineNumber", Value: 1},
+					{Key: "kind", Value: 1},
+				},
+				Options: &options.IndexOptions{Unique: &unique},
+			},
+			{Keys: bson.D{{Key: "name", Value: 1}, {Key: "blob", Value: 1}}},
+		},
+		ms.manifestCollection(): {
+			{Keys: bson.D{{Key: "url", Value: 1}, {Key: "hash", Value: 1}, {Key: "blob", Value: 1}}},
+		},
+		ms.languageStatsCollection(): {
+			{
+				Keys:    bson.D{{Key: "url", Value: 1}, {Key: "hash", Value: 1}},
+				Options: &options.IndexOptions{Unique: &unique},
+			},
+		},
+	}
+	for collection, models := range indexes {
+		_, err := collection.Indexes().CreateMany(context.Background(), models)
+		if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+			panic(err)
+		}
+	}
+}
+
 func (ms *mongodbStore) database() *mongo.Database {
 	return ms.client.Database(ms.name)
 }
@@ -37,8 +88,24 @@ func (ms *mongodbStore) taskCollection() *mongo.Collection {
 	return ms.database().Collection("tasks")
 }
 
-func (ms *mongodbStore) fileIndexCollection() *mongo.Collection {
-	return ms.database().Collection("file_indexes")
+// blobs which have been indexed
+func (ms *mongodbStore) blobCollection() *mongo.Collection {
+	return ms.database().Collection("blobs")
+}
+
+// index symbols of blobs
+func (ms *mongodbStore) blobIndexCollection() *mongo.Collection {
+	return ms.database().Collection("blob_indexes")
+}
+
+// file to blob mapping of indexed commits
+func (ms *mongodbStore) manifestCollection() *mongo.Collection {
+	return ms.database().Collection("manifests")
+}
+
+// language statistics of indexed commits
+func (ms *mongodbStore) languageStatsCollection() *mongo.Collection {
+	return ms.database().Collection("language_stats")
 }
 
 func (ms *mongodbStore) NewIndexTask(ctx context.Context, url, hash string) error {
@@ -76,7 +143,9 @@ func (ms *mongodbStore) SetTaskState(ctx context.Context, url, hash string, succ
 	update := bson.M{}
 	if success {
 		update["$set"] = bson.M{
-			"success": true,
+			"success":   true,
+			"indexedAt": time.Now().Unix(),
+			"version":   indexVersion,
 		}
 	} else {
 		return nil
@@ -93,6 +162,7 @@ func (ms *mongodbStore) RepositoryIndexed(ctx context.Context, url, hash string)
 	option := &options.FindOneOptions{
 		Projection: bson.M{
 			"success": 1,
+			"version": 1,
 		},
 	}
 	sr := ms.taskCollection().FindOne(ctx, filter, option)
@@ -101,6 +171,7 @@ func (ms *mongodbStore) RepositoryIndexed(ctx context.Context, url, hash string)
 	}
 	var tmp struct {
 		Success bool `bson:"success"`
+		Version int  `bson:"version"`
 	}
 	if err := sr.Decode(&tmp); err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -108,58 +179,259 @@ func (ms *mongodbStore) RepositoryIndexed(ctx context.Context, url, hash string)
 		}
 		return false, err
 	}
-	return tmp.Success, nil
+	return tmp.Success && tmp.Version == indexVersion, nil
 }
-func (ms *mongodbStore) RepositoryFileIndexed(ctx context.Context, url, hash, file string) (bool, error) {
+
+func (ms *mongodbStore) SetManifest(ctx context.Context, url, hash string, entries []store.ManifestEntry) error {
 	filter := bson.M{
 		"url":  url,
 		"hash": hash,
-		"file": file,
 	}
-	count, err := ms.fileIndexCollection().CountDocuments(ctx, filter)
-	return count > 0, err
+	_, err := ms.manifestCollection().DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	documents := make([]interface{}, 0, batchSize)
+	for _, entry := range entries {
+		documents = append(documents, bson.M{
+			"url":       url,
+			"hash":      hash,
+			"file":      entry.File,
+			"blob":      entry.Blob,
+			"generated": entry.Generated,
+			"vendored":  entry.Vendored,
+		})
+		if len(documents) == batchSize {
+			if _, err = ms.manifestCollection().InsertMany(ctx, documents); err != nil {
+				return err
+			}
+			documents = documents[:0]
+		}
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+	_, err = ms.manifestCollection().InsertMany(ctx, documents)
+	return err
 }
 
-// add all index symbols of a file
-func (ms *mongodbStore) AddFileIndexEntries(ctx context.Context, entries []store.IndexEntry) error {
-	documents := make([]interface{}, len(entries))
-	for idx := range entries {
-		documents[idx] = entries[idx]
+func (ms *mongodbStore) IndexedBlobs(ctx context.Context, blobs []string) (map[string]store.BlobInfo, error) {
+	indexed := make(map[string]store.BlobInfo, len(blobs))
+	for start := 0; start < len(blobs); start += batchSize {
+		end := start + batchSize
+		if end > len(blobs) {
+			end = len(blobs)
+		}
+		filter := bson.M{
+			"blob":    bson.M{"$in": blobs[start:end]},
+			"version": blobVersion,
+		}
+		cursor, err := ms.blobCollection().Find(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		for cursor.Next(ctx) {
+			var info store.BlobInfo
+			if err = cursor.Decode(&info); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			indexed[info.Blob] = info
+		}
+		cursor.Close(ctx)
 	}
-	_, err := ms.fileIndexCollection().InsertMany(ctx, documents)
+	return indexed, nil
+}
+
+func (ms *mongodbStore) AddBlobIndexEntries(ctx context.Context, info store.BlobInfo, entries []store.IndexEntry) error {
+	blob := info.Blob
+	// entries of an older blob version are dropped
+	_, err := ms.blobIndexCollection().DeleteMany(ctx, bson.M{"blob": blob})
+	if err != nil {
+		return err
+	}
+
+	// a blob may be indexed by two tasks at the same time, entries are unique
+	// by blob, name, line and kind so that both tasks write the same ones
+	ordered := false
+	models := make([]mongo.WriteModel, 0, batchSize)
+	for start := 0; start < len(entries); start += batchSize {
+		end := start + batchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		models = models[:0]
+		for idx := start; idx < end; idx++ {
+			entry := entries[idx]
+			entry.Blob = blob
+			filter := bson.M{
+				"blob":       blob,
+				"name":       entry.Name,
+				"lineNumber": entry.LineNumber,
+				"kind":       entry.Kind,
+			}
+			models = append(models, mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(entry).SetUpsert(true))
+		}
+		_, err = ms.blobIndexCollection().BulkWrite(ctx, models, &options.BulkWriteOptions{Ordered: &ordered})
+		// the other task upserted the same entries first
+		if err != nil && !onlyDuplicateKeys(err) {
+			return err
+		}
+	}
+
+	upsert := true
+	update := bson.M{
+		"$set": bson.M{
+			"language":  info.Language,
+			"text":      info.Text,
+			"size":      info.Size,
+			"lines":     info.Lines,
+			"indexer":   info.Indexer,
+			"version":   blobVersion,
+			"indexedAt": time.Now().Unix(),
+		},
+	}
+	_, err = ms.blobCollection().UpdateOne(ctx, bson.M{"blob": blob}, update, &options.UpdateOptions{Upsert: &upsert})
 	return err
 }
 
+// the manifest of the commit is read first, symbols are then looked up
+// among its blobs only
 func (ms *mongodbStore) FindSymbols(ctx context.Context, url, hash, name string) (symbols []store.Symbol, err error) {
 	filter := bson.M{
 		"url":  url,
 		"hash": hash,
-		"name": name,
 	}
 	option := &options.FindOptions{
 		Projection: bson.M{
-			"url":     0,
-			"hash":    0,
-			"name":    0,
-			"pattern": 0,
+			"file":      1,
+			"blob":      1,
+			"generated": 1,
+			"vendored":  1,
 		},
 	}
+	cursor, err := ms.manifestCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
 
-	cursor, err := ms.fileIndexCollection().Find(ctx, filter, option)
+	// a blob may be referred by several files
+	files := make(map[string][]store.ManifestEntry)
+	blobs := make([]string, 0, batchSize)
+	for cursor.Next(ctx) {
+		var manifest store.ManifestEntry
+		if err = cursor.Decode(&manifest); err != nil {
+			cursor.Close(ctx)
+			return
+		}
+		if _, ok := files[manifest.Blob]; !ok {
+			blobs = append(blobs, manifest.Blob)
+		}
+		files[manifest.Blob] = append(files[manifest.Blob], manifest)
+	}
+	err = cursor.Err()
+	cursor.Close(ctx)
 	if err != nil {
 		return
 	}
 
 	symbols = make([]store.Symbol, 0, 8)
-	var symbol store.Symbol
-	for cursor.Next(ctx) {
-		if err = cursor.Err(); err != nil {
+	option = &options.FindOptions{
+		Projection: bson.M{
+			"name":    0,
+			"pattern": 0,
+		},
+	}
+	for start := 0; start < len(blobs); start += batchSize {
+		end := start + batchSize
+		if end > len(blobs) {
+			end = len(blobs)
+		}
+		filter := bson.M{
+			"name": name,
+			"blob": bson.M{"$in": blobs[start:end]},
+		}
+		cursor, err = ms.blobIndexCollection().Find(ctx, filter, option)
+		if err != nil {
 			return
 		}
-		if err = cursor.Decode(&symbol); err != nil {
+		for cursor.Next(ctx) {
+			var entry store.IndexEntry
+			if err = cursor.Decode(&entry); err != nil {
+				cursor.Close(ctx)
+				return
+			}
+			for _, manifest := range files[entry.Blob] {
+				symbols = append(symbols, store.Symbol{
+					File:       manifest.File,
+					LineNumber: entry.LineNumber,
+					Line:       entry.Line,
+					LineBefore: entry.LineBefore,
+					LineAfter:  entry.LineAfter,
+					Kind:       entry.Kind,
+					Signature:  entry.Signature,
+					Doc:        entry.Doc,
+					Generated:  manifest.Generated,
+					Vendored:   manifest.Vendored,
+				})
+			}
+		}
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
 			return
 		}
-		symbols = append(symbols, symbol)
 	}
 	return
 }
+
+func (ms *mongodbStore) SetLanguageStats(ctx context.Context, url, hash string, stats []store.LanguageStat) error {
+	filter := bson.M{
+		"url":  url,
+		"hash": hash,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"stats": stats,
+		},
+	}
+	upsert := true
+	_, err := ms.languageStatsCollection().UpdateOne(ctx, filter, update, &options.UpdateOptions{Upsert: &upsert})
+	return err
+}
+
+func (ms *mongodbStore) LanguageStats(ctx context.Context, url, hash string) (stats []store.LanguageStat, err error) {
+	filter := bson.M{
+		"url":  url,
+		"hash": hash,
+	}
+	sr := ms.languageStatsCollection().FindOne(ctx, filter)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+		}
+		return
+	}
+	var tmp struct {
+		Stats []store.LanguageStat `bson:"stats"`
+	}
+	if err = sr.Decode(&tmp); err != nil {
+		return
+	}
+	stats = tmp.Stats
+	return
+}
+
+func onlyDuplicateKeys(err error) bool {
+	bwe, ok := err.(mongo.BulkWriteException)
+	if !ok || bwe.WriteConcernError != nil {
+		return false
+	}
+	for _, we := range bwe.WriteErrors {
+		if we.Code != 11000 {
+			return false
+		}
+	}
+	return true
+}
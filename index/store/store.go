@@ -5,26 +5,59 @@ import (
 	"errors"
 )
 
+// Symbols are stored by git blob hash, so that the same file content is only
+// indexed once no matter how many commits or forks refer to it. A manifest
+// records which blob each file of an indexed commit points to.
 type Store interface {
 	// create new index task. if task not expire, ErrIndexTaskExist should be returned
 	NewIndexTask(ctx context.Context, url, hash string) error
 	// set task state success or failure
 	SetTaskState(ctx context.Context, url, hash string, success bool) error
 	RepositoryIndexed(ctx context.Context, url, hash string) (bool, error)
-	RepositoryFileIndexed(ctx context.Context, url, hash, file string) (bool, error)
-	// add all index symbols of a file
-	AddFileIndexEntries(ctx context.Context, entries []IndexEntry) error
+	// set file to blob manifest of a commit, replacing the old one
+	SetManifest(ctx context.Context, url, hash string, entries []ManifestEntry) error
+	// return information of blobs which have been indexed among the given blobs
+	IndexedBlobs(ctx context.Context, blobs []string) (map[string]BlobInfo, error)
+	// add all index symbols of a blob and mark it indexed, entries may be empty
+	AddBlobIndexEntries(ctx context.Context, info BlobInfo, entries []IndexEntry) error
 	FindSymbols(ctx context.Context, url, hash, name string) (symbols []Symbol, err error)
+	// set language statistics of a commit, replacing the old one
+	SetLanguageStats(ctx context.Context, url, hash string, stats []LanguageStat) error
+	LanguageStats(ctx context.Context, url, hash string) (stats []LanguageStat, err error)
 }
 
 var (
 	ErrIndexTaskExist = errors.New("index task exists")
 )
 
+type ManifestEntry struct {
+	File      string `json:"file" bson:"file"`
+	Blob      string `json:"blob" bson:"blob"`
+	Generated bool   `json:"generated" bson:"generated"`
+	Vendored  bool   `json:"vendored" bson:"vendored"`
+}
+
+// BlobInfo is what we know about a blob after indexing it
+type BlobInfo struct {
+	Blob     string `json:"blob" bson:"blob"`
+	Language string `json:"language" bson:"language"`
+	Text     bool   `json:"text" bson:"text"` // regular and not binary
+	Size     int64  `json:"size" bson:"size"`
+	Lines    int64  `json:"lines" bson:"lines"`
+	// name of the indexer configured for the blob when it was indexed, it is
+	// indexed again if another one is configured
+	Indexer string `json:"indexer" bson:"indexer"`
+}
+
+type LanguageStat struct {
+	Language string `json:"language" bson:"language"`
+	Files    int64  `json:"files" bson:"files"`
+	Bytes    int64  `json:"bytes" bson:"bytes"`
+	Lines    int64  `json:"lines" bson:"lines"`
+}
+
 type IndexEntry struct {
-	Url        string `json:"url" bson:"url"`
-	Hash       string `json:"hash" bson:"hash"`
-	File       string `json:"file" bson:"file"`
+	Blob       string `json:"blob" bson:"blob"`
 	Name       string `json:"name" bson:"name"`
 	Pattern    string `json:"pattern" bson:"pattern"`
 	Language   string `json:"language" bson:"language"`
@@ -35,6 +68,8 @@ type IndexEntry struct {
 	Kind       string `json:"kind" bson:"kind"`
 	Scope      string `json:"scope" bson:"scope"`
 	ScopeKind  string `json:"scopeKind" bson:"scopeKind"`
+	Signature  string `json:"signature" bson:"signature"`
+	Doc        string `json:"doc" bson:"doc"`
 }
 
 type Symbol struct {
@@ -44,4 +79,8 @@ type Symbol struct {
 	LineBefore string `json:"lineBefore" bson:"lineBefore"`
 	LineAfter  string `json:"lineAfter" bson:"lineAfter"`
 	Kind       string `json:"kind" bson:"kind"`
+	Signature  string `json:"signature" bson:"signature"`
+	Doc        string `json:"doc" bson:"doc"`
+	Generated  bool   `json:"generated" bson:"generated"`
+	Vendored   bool   `json:"vendored" bson:"vendored"`
 }
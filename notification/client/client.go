@@ -0,0 +1,18 @@
+package client
+
+import (
+	"github.com/lt90s/rfschub-server/notification/proto"
+	"github.com/micro/go-micro"
+)
+
+type ServerConfig struct {
+	ServiceName string
+}
+
+func New(config ServerConfig) notification.NotificationService {
+	s := micro.NewService()
+	s.Init()
+
+	client := notification.NewNotificationService(config.ServiceName, s.Client())
+	return client
+}
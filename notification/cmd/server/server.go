@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/notification/config"
+	"github.com/lt90s/rfschub-server/notification/proto"
+	"github.com/lt90s/rfschub-server/notification/service"
+	"github.com/lt90s/rfschub-server/notification/store"
+	"github.com/lt90s/rfschub-server/notification/store/mongodb"
+	"github.com/micro/go-micro"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+func main() {
+	log.SetLevel(log.DebugLevel)
+
+	var store store.Store
+
+	switch config.DefaultConfig.Store {
+	case "mongodb":
+		store = mongodb.NewMongodbStore()
+	default:
+		store = mongodb.NewMongodbStore()
+	}
+
+	if config.DefaultConfig.DigestInterval != "" {
+		interval, err := time.ParseDuration(config.DefaultConfig.DigestInterval)
+		if err != nil || interval <= 0 {
+			log.Panicf("invalid digest interval: %s", config.DefaultConfig.DigestInterval)
+		}
+		go service.NewDigester(store).Run(context.Background(), interval)
+	}
+
+	s := micro.NewService(micro.Name(config.DefaultConfig.Name))
+	s.Init()
+
+	err := notification.RegisterNotificationHandler(s.Server(), service.New(store))
+	if err != nil {
+		log.Panicf("register notification service handler failed: err = %s\n", err.Error())
+	}
+
+	log.Info("starts to run notification service...")
+	err = s.Run()
+	if err != nil {
+		log.Warnf("notification service exit error: %s", err.Error())
+	}
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"github.com/lt90s/rfschub-server/common/mail"
+	"github.com/micro/go-config"
+	"github.com/micro/go-config/source/env"
+	"github.com/micro/go-config/source/file"
+	"os"
+)
+
+type NotificationConfig struct {
+	Name    string        `json:"name"`
+	Store   string        `json:"store"`
+	Mongodb MongodbConfig `json:"mongodb"`
+	Account string        `json:"account"`
+	Mail    mail.Config   `json:"mail"`
+	// interval of mailing digests of unread notifications, like "24h".
+	// Digests are not mailed if it is empty.
+	DigestInterval string `json:"digestInterval"`
+	// link to the inbox in digests
+	InboxLink string `json:"inboxLink"`
+}
+
+type MongodbConfig struct {
+	Uri      string `json:"uri"`
+	Database string `json:"database"`
+}
+
+var DefaultConfig = NotificationConfig{
+	Name:  "NotificationService",
+	Store: "mongodb",
+	Mongodb: MongodbConfig{
+		Uri:      "mongodb://127.0.0.1:27017",
+		Database: "rfschub",
+	},
+	Account: "AccountService",
+	Mail: mail.Config{
+		Transport: "stdout",
+		From:      "rfschub <noreply@rfschub.local>",
+	},
+	DigestInterval: "24h",
+	InboxLink:      "http://127.0.0.1:8080/notifications",
+}
+
+func init() {
+	configPath := os.Getenv("NOTIFICATION_CONFIG_PATH")
+	if configPath == "" {
+		configPath = "config.yaml"
+	}
+	conf := config.NewConfig()
+	_ = conf.Load(
+		file.NewSource(file.WithPath(configPath)),
+		env.NewSource(env.WithStrippedPrefix("NOTIFICATION")),
+	)
+	err := conf.Scan(&DefaultConfig)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	accountClient "github.com/lt90s/rfschub-server/account/client"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/common/mail"
+	"github.com/lt90s/rfschub-server/notification/config"
+	"github.com/lt90s/rfschub-server/notification/store"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"time"
+)
+
+// maximum number of notifications in a digest, the rest are in later ones
+const maxDigestSize = 50
+
+var digestTemplate = mail.MustTemplate("digest",
+	"You have {{len .Items}} unread notification{{if gt (len .Items) 1}}s{{end}} on rfschub",
+	`Hi {{.Name}},
+
+Here is what happened since the last digest:
+{{range .Items}}
+- {{.}}{{end}}
+
+See all notifications at {{.Link}}
+`,
+	`<p>Hi {{.Name}},</p>
+<p>Here is what happened since the last digest:</p>
+<ul>{{range .Items}}
+<li>{{.}}</li>{{end}}
+</ul>
+<p><a href="{{.Link}}">See all notifications</a></p>
+`)
+
+// Digester mails digests of unread notifications to users who want them
+type Digester struct {
+	store         store.Store
+	accountClient account.AccountService
+	mailer        *mail.Queue
+}
+
+func NewDigester(store store.Store) *Digester {
+	conf := config.DefaultConfig
+	transport, err := mail.New(conf.Mail)
+	if err != nil {
+		log.Panicf("create mail transport failed: err=%v", err)
+	}
+	return &Digester{
+		store:         store,
+		accountClient: accountClient.New(accountClient.ServerConfig{ServiceName: conf.Account}),
+		mailer:        mail.NewQueue(transport, mail.QueueOptions{}),
+	}
+}
+
+// Run mails digests every interval until ctx is done
+func (d *Digester) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer d.mailer.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.MailDigests(ctx)
+		}
+	}
+}
+
+// MailDigests mails a digest to each user with new unread notifications,
+// notifications mailed are not included in later digests
+func (d *Digester) MailDigests(ctx context.Context) {
+	uids, err := d.store.GetDigestRecipients(ctx)
+	if err != nil {
+		log.Warnf("[MailDigests] get digest recipients error: %v", err)
+		return
+	}
+	if len(uids) == 0 {
+		return
+	}
+	emailRsp, err := d.accountClient.AccountsEmail(ctx, &account.AccountsEmailRequest{Uids: uids})
+	if err != nil {
+		log.Warnf("[MailDigests] get emails error: %v", err)
+		return
+	}
+	infoRsp, err := d.accountClient.AccountsBasicInfo(ctx, &account.AccountsBasicInfoRequest{Uids: uids})
+	if err != nil {
+		log.Warnf("[MailDigests] get account names error: %v", err)
+		return
+	}
+	names := make(map[string]string, len(infoRsp.Infos))
+	for _, info := range infoRsp.Infos {
+		names[info.Id] = info.Name
+	}
+
+	for _, uid := range uids {
+		email, ok := emailRsp.Emails[uid]
+		if !ok {
+			continue
+		}
+		if err := d.mailDigest(ctx, uid, names[uid], email); err != nil {
+			log.Warnf("[MailDigests] mail digest error: uid=%s error=%v", uid, err)
+		}
+	}
+}
+
+func (d *Digester) mailDigest(ctx context.Context, uid, name, email string) error {
+	notifications, err := d.store.GetUndigested(ctx, uid, maxDigestSize)
+	if err != nil || len(notifications) == 0 {
+		return err
+	}
+	items := make([]string, 0, len(notifications))
+	ids := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		items = append(items, describeEvent(notification.Event))
+		ids = append(ids, notification.Id)
+	}
+	message, err := digestTemplate.Render(email, struct {
+		Name  string
+		Items []string
+		Link  string
+	}{name, items, config.DefaultConfig.InboxLink})
+	if err != nil {
+		return err
+	}
+	if err = d.mailer.Send(ctx, message); err != nil {
+		return err
+	}
+	return d.store.SetDigested(ctx, uid, ids)
+}
+
+// one line description of event in digests
+func describeEvent(event store.Event) string {
+	actor := event.ActorName
+	if actor == "" {
+		actor = "someone"
+	}
+	var what string
+	switch event.Type {
+	case store.EventAnnotation:
+		what = " annotated "
+	case store.EventReply:
+		what = " replied on "
+	case store.EventMention:
+		what = " mentioned you on "
+	}
+	description := actor + what + event.File
+	if event.LineNumber > 0 {
+		description += "#L" + strconv.Itoa(event.LineNumber)
+	}
+	if event.Project != "" {
+		description += " in " + event.Project
+	}
+	if event.Brief != "" {
+		description += ": " + event.Brief
+	}
+	return description
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/errors"
+	proto "github.com/lt90s/rfschub-server/notification/proto"
+	"github.com/lt90s/rfschub-server/notification/store"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultInboxPageSize = 20
+	maxInboxPageSize     = 100
+)
+
+type notificationService struct {
+	store store.Store
+}
+
+func New(store store.Store) proto.NotificationHandler {
+	return &notificationService{
+		store: store,
+	}
+}
+
+func (service *notificationService) Notify(ctx context.Context, req *proto.NotifyRequest, rsp *proto.NotifyResponse) error {
+	if req.Event == nil {
+		return errors.NewBadRequestError(-1, "event missing")
+	}
+	if _, ok := proto.EventType_name[int32(req.Event.Type)]; !ok {
+		return errors.NewBadRequestError(-1, "invalid event type")
+	}
+	event := storeEvent(req.Event)
+	recipients := uniqueRecipients(req.Recipients, event.Actor)
+	preferences, err := service.store.GetPreferences(ctx, recipients)
+	if err != nil {
+		log.Warnf("[Notify] get preferences error: recipients=%v error=%v", recipients, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	recipients = filterRecipients(recipients, preferences, event.Type)
+
+	err = service.store.AddNotifications(ctx, recipients, event)
+	if err != nil {
+		log.Warnf("[Notify] add notifications error: recipients=%v error=%v", recipients, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Delivered = int32(len(recipients))
+	return nil
+}
+
+func (service *notificationService) Inbox(ctx context.Context, req *proto.InboxRequest, rsp *proto.InboxResponse) error {
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultInboxPageSize
+	} else if pageSize > maxInboxPageSize {
+		pageSize = maxInboxPageSize
+	}
+	notifications, total, unread, err := service.store.GetNotifications(ctx, req.Uid, req.UnreadOnly, (page-1)*pageSize, pageSize)
+	if err != nil {
+		log.Warnf("[Inbox] get notifications error: uid=%s error=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Total = total
+	rsp.Unread = unread
+	rsp.Notifications = make([]*proto.NotificationRecord, 0, len(notifications))
+	for _, notification := range notifications {
+		rsp.Notifications = append(rsp.Notifications, &proto.NotificationRecord{
+			Id:        notification.Id,
+			Event:     protoEvent(notification.Event),
+			Read:      notification.Read,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (service *notificationService) MarkRead(ctx context.Context, req *proto.MarkReadRequest, rsp *proto.MarkReadResponse) error {
+	err := service.store.SetRead(ctx, req.Uid, req.Ids, !req.Unread)
+	if err == store.ErrNotificationNotExist {
+		return errors.NewNotFoundError(int(proto.ErrorCode_NotificationNotExist), "notification not exist")
+	} else if err != nil {
+		log.Warnf("[MarkRead] set read error: uid=%s ids=%v error=%v", req.Uid, req.Ids, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *notificationService) GetPreference(ctx context.Context, req *proto.GetPreferenceRequest, rsp *proto.Preference) error {
+	preferences, err := service.store.GetPreferences(ctx, []string{req.Uid})
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	preference, ok := preferences[req.Uid]
+	if !ok {
+		preference = store.DefaultPreference
+	}
+	rsp.Annotation = preference.Annotation
+	rsp.Reply = preference.Reply
+	rsp.Mention = preference.Mention
+	rsp.Digest = preference.Digest
+	return nil
+}
+
+func (service *notificationService) SetPreference(ctx context.Context, req *proto.SetPreferenceRequest, rsp *proto.SetPreferenceResponse) error {
+	if req.Preference == nil {
+		return errors.NewBadRequestError(-1, "preference missing")
+	}
+	err := service.store.SetPreference(ctx, req.Uid, store.Preference{
+		Annotation: req.Preference.Annotation,
+		Reply:      req.Preference.Reply,
+		Mention:    req.Preference.Mention,
+		Digest:     req.Preference.Digest,
+	})
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *notificationService) DeleteUserNotifications(ctx context.Context, req *proto.DeleteUserNotificationsRequest, rsp *proto.DeleteUserNotificationsResponse) error {
+	if err := service.store.DeleteUserNotifications(ctx, req.Uid); err != nil {
+		log.Warnf("[DeleteUserNotifications] delete notifications error: uid=%s error=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+// remove duplicated and empty recipients, and the actor who should not be
+// notified of what they did
+func uniqueRecipients(recipients []string, actor string) []string {
+	seen := make(map[string]bool, len(recipients))
+	unique := make([]string, 0, len(recipients))
+	for _, uid := range recipients {
+		if uid == "" || uid == actor || seen[uid] {
+			continue
+		}
+		seen[uid] = true
+		unique = append(unique, uid)
+	}
+	return unique
+}
+
+// keep recipients whose preference allows event type t
+func filterRecipients(recipients []string, preferences map[string]store.Preference, t store.EventType) []string {
+	filtered := recipients[:0]
+	for _, uid := range recipients {
+		preference, ok := preferences[uid]
+		if !ok {
+			preference = store.DefaultPreference
+		}
+		if preference.Allows(t) {
+			filtered = append(filtered, uid)
+		}
+	}
+	return filtered
+}
+
+func storeEvent(event *proto.Event) store.Event {
+	return store.Event{
+		Type:       store.EventType(event.Type),
+		Actor:      event.Actor,
+		Pid:        event.Pid,
+		Url:        event.Url,
+		Project:    event.Project,
+		File:       event.File,
+		LineNumber: int(event.LineNumber),
+		Annotation: event.Annotation,
+		Thread:     event.Thread,
+		Brief:      event.Brief,
+		ActorName:  event.ActorName,
+	}
+}
+
+func protoEvent(event store.Event) *proto.Event {
+	return &proto.Event{
+		Type:       proto.EventType(event.Type),
+		Actor:      event.Actor,
+		Pid:        event.Pid,
+		Url:        event.Url,
+		Project:    event.Project,
+		File:       event.File,
+		LineNumber: int32(event.LineNumber),
+		Annotation: event.Annotation,
+		Thread:     event.Thread,
+		Brief:      event.Brief,
+		ActorName:  event.ActorName,
+	}
+}
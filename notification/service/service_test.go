@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/notification/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUniqueRecipients(t *testing.T) {
+	recipients := uniqueRecipients([]string{"a", "b", "", "a", "actor", "c", "b"}, "actor")
+	require.Equal(t, []string{"a", "b", "c"}, recipients)
+}
+
+func TestFilterRecipients(t *testing.T) {
+	preferences := map[string]store.Preference{
+		"a": {Annotation: false, Reply: true, Mention: true},
+		"b": {Annotation: true, Reply: false, Mention: false},
+	}
+	require.Equal(t, []string{"b", "c"}, filterRecipients([]string{"a", "b", "c"}, preferences, store.EventAnnotation))
+	require.Equal(t, []string{"a", "c"}, filterRecipients([]string{"a", "b", "c"}, preferences, store.EventMention))
+}
+
+func TestDescribeEvent(t *testing.T) {
+	event := store.Event{
+		Type:       store.EventReply,
+		ActorName:  "alice",
+		Project:    "go",
+		File:       "src/main.go",
+		LineNumber: 12,
+		Brief:      "why?",
+	}
+	require.Equal(t, "alice replied on src/main.go#L12 in go: why?", describeEvent(event))
+
+	event.Type, event.ActorName, event.Brief = store.EventMention, "", ""
+	require.Equal(t, "someone mentioned you on src/main.go#L12 in go", describeEvent(event))
+}
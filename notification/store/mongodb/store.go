@@ -0,0 +1,240 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/store/mongodb"
+	"github.com/lt90s/rfschub-server/notification/config"
+	"github.com/lt90s/rfschub-server/notification/store"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type mongodbStore struct {
+	client       *mongo.Client
+	databaseName string
+}
+
+const (
+	notificationCollection = "notifications"
+	preferenceCollection   = "notification_preferences"
+)
+
+func NewMongodbStore() store.Store {
+	client := mongodb.NewClient(config.DefaultConfig.Mongodb.Uri)
+	ms := &mongodbStore{
+		client:       client,
+		databaseName: config.DefaultConfig.Mongodb.Database,
+	}
+	return ms
+}
+
+func (ms *mongodbStore) database() *mongo.Database {
+	return ms.client.Database(ms.databaseName)
+}
+
+func (ms *mongodbStore) notificationCollection() *mongo.Collection {
+	return ms.database().Collection(notificationCollection)
+}
+
+func (ms *mongodbStore) preferenceCollection() *mongo.Collection {
+	return ms.database().Collection(preferenceCollection)
+}
+
+func (ms *mongodbStore) AddNotifications(ctx context.Context, recipients []string, event store.Event) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	now := time.Now().Unix()
+	documents := make([]interface{}, 0, len(recipients))
+	for _, uid := range recipients {
+		documents = append(documents, store.Notification{
+			Uid:       uid,
+			Event:     event,
+			CreatedAt: now,
+		})
+	}
+	_, err := ms.notificationCollection().InsertMany(ctx, documents)
+	return err
+}
+
+func (ms *mongodbStore) GetNotifications(ctx context.Context, uid string, unreadOnly bool, skip, limit int) (notifications []store.Notification, total, unread int64, err error) {
+	filter := bson.M{"uid": uid}
+	unreadFilter := bson.M{"uid": uid, "read": false}
+	unread, err = ms.notificationCollection().CountDocuments(ctx, unreadFilter)
+	if err != nil {
+		return
+	}
+	if unreadOnly {
+		filter, total = unreadFilter, unread
+	} else if total, err = ms.notificationCollection().CountDocuments(ctx, filter); err != nil {
+		return
+	}
+
+	skip64, limit64 := int64(skip), int64(limit)
+	option := &options.FindOptions{
+		Skip:  &skip64,
+		Limit: &limit64,
+		Sort:  bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+	}
+	cursor, err := ms.notificationCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	notifications = make([]store.Notification, 0, limit)
+	for cursor.Next(ctx) {
+		var tmp struct {
+			Id                 primitive.ObjectID `bson:"_id"`
+			store.Notification `bson:",inline"`
+		}
+		if err = cursor.Decode(&tmp); err != nil {
+			return
+		}
+		tmp.Notification.Id = tmp.Id.Hex()
+		notifications = append(notifications, tmp.Notification)
+	}
+	err = cursor.Err()
+	return
+}
+
+func objectIds(ids []string) ([]primitive.ObjectID, error) {
+	oids := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		oid, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, store.ErrNotificationNotExist
+		}
+		oids = append(oids, oid)
+	}
+	return oids, nil
+}
+
+func (ms *mongodbStore) SetRead(ctx context.Context, uid string, ids []string, read bool) error {
+	filter := bson.M{"uid": uid}
+	if len(ids) > 0 {
+		oids, err := objectIds(ids)
+		if err != nil {
+			return err
+		}
+		filter["_id"] = bson.M{"$in": oids}
+	}
+	ur, err := ms.notificationCollection().UpdateMany(ctx, filter, bson.M{"$set": bson.M{"read": read}})
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 && ur.MatchedCount == 0 {
+		return store.ErrNotificationNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) GetPreferences(ctx context.Context, uids []string) (map[string]store.Preference, error) {
+	preferences := make(map[string]store.Preference, len(uids))
+	if len(uids) == 0 {
+		return preferences, nil
+	}
+	cursor, err := ms.preferenceCollection().Find(ctx, bson.M{"uid": bson.M{"$in": uids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var tmp struct {
+			Uid              string `bson:"uid"`
+			store.Preference `bson:",inline"`
+		}
+		if err = cursor.Decode(&tmp); err != nil {
+			return nil, err
+		}
+		preferences[tmp.Uid] = tmp.Preference
+	}
+	return preferences, cursor.Err()
+}
+
+func (ms *mongodbStore) SetPreference(ctx context.Context, uid string, preference store.Preference) error {
+	upsert := true
+	_, err := ms.preferenceCollection().UpdateOne(ctx, bson.M{"uid": uid}, bson.M{"$set": preference}, &options.UpdateOptions{Upsert: &upsert})
+	return err
+}
+
+func (ms *mongodbStore) GetDigestRecipients(ctx context.Context) (uids []string, err error) {
+	subscribers, err := ms.preferenceCollection().Distinct(ctx, "uid", bson.M{"digest": true})
+	if err != nil || len(subscribers) == 0 {
+		return
+	}
+	filter := bson.M{
+		"uid":      bson.M{"$in": subscribers},
+		"read":     false,
+		"digested": bson.M{"$ne": true},
+	}
+	recipients, err := ms.notificationCollection().Distinct(ctx, "uid", filter)
+	if err != nil {
+		return
+	}
+	uids = make([]string, 0, len(recipients))
+	for _, uid := range recipients {
+		if s, ok := uid.(string); ok {
+			uids = append(uids, s)
+		}
+	}
+	return
+}
+
+func (ms *mongodbStore) GetUndigested(ctx context.Context, uid string, limit int) (notifications []store.Notification, err error) {
+	filter := bson.M{
+		"uid":      uid,
+		"read":     false,
+		"digested": bson.M{"$ne": true},
+	}
+	limit64 := int64(limit)
+	option := &options.FindOptions{
+		Limit: &limit64,
+		Sort:  bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}},
+	}
+	cursor, err := ms.notificationCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	notifications = make([]store.Notification, 0, limit)
+	for cursor.Next(ctx) {
+		var tmp struct {
+			Id                 primitive.ObjectID `bson:"_id"`
+			store.Notification `bson:",inline"`
+		}
+		if err = cursor.Decode(&tmp); err != nil {
+			return
+		}
+		tmp.Notification.Id = tmp.Id.Hex()
+		notifications = append(notifications, tmp.Notification)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) SetDigested(ctx context.Context, uid string, ids []string) error {
+	oids, err := objectIds(ids)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{
+		"uid": uid,
+		"_id": bson.M{"$in": oids},
+	}
+	_, err = ms.notificationCollection().UpdateMany(ctx, filter, bson.M{"$set": bson.M{"digested": true}})
+	return err
+}
+
+func (ms *mongodbStore) DeleteUserNotifications(ctx context.Context, uid string) error {
+	if _, err := ms.notificationCollection().DeleteMany(ctx, bson.M{"uid": uid}); err != nil {
+		return err
+	}
+	_, err := ms.preferenceCollection().DeleteOne(ctx, bson.M{"uid": uid})
+	return err
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+type Store interface {
+	// add a notification of event to each of recipients
+	AddNotifications(ctx context.Context, recipients []string, event Event) error
+	// get notifications of uid newest first, total is the number of all
+	// notifications matching and unread the number of unread ones
+	GetNotifications(ctx context.Context, uid string, unreadOnly bool, skip, limit int) (notifications []Notification, total, unread int64, err error)
+	// mark notifications of uid with ids as read, or all of them if ids is empty
+	SetRead(ctx context.Context, uid string, ids []string, read bool) error
+	// get preferences of users, users without preference are not in the result
+	GetPreferences(ctx context.Context, uids []string) (map[string]Preference, error)
+	SetPreference(ctx context.Context, uid string, preference Preference) error
+	// users who want digests and have unread notifications not in a digest yet
+	GetDigestRecipients(ctx context.Context) (uids []string, err error)
+	// unread notifications of uid not in a digest yet, oldest first
+	GetUndigested(ctx context.Context, uid string, limit int) (notifications []Notification, err error)
+	// mark notifications of uid with ids as included in a digest
+	SetDigested(ctx context.Context, uid string, ids []string) error
+	// delete notifications and the preference of uid
+	DeleteUserNotifications(ctx context.Context, uid string) error
+}
+
+var (
+	ErrNotificationNotExist = errors.New("notification not exist")
+)
+
+// EventType values are the same as proto.EventType
+type EventType int
+
+const (
+	EventAnnotation EventType = iota
+	EventReply
+	EventMention
+)
+
+type Event struct {
+	Type       EventType `bson:"type"`
+	Actor      string    `bson:"actor"`
+	Pid        string    `bson:"pid"`
+	Url        string    `bson:"url"`
+	Project    string    `bson:"project"`
+	File       string    `bson:"file"`
+	LineNumber int       `bson:"lineNumber"`
+	Annotation string    `bson:"annotation"`
+	Thread     string    `bson:"thread"`
+	Brief      string    `bson:"brief"`
+	ActorName  string    `bson:"actorName"`
+}
+
+type Notification struct {
+	Id    string `bson:"-"`
+	Uid   string `bson:"uid"`
+	Event `bson:",inline"`
+	Read  bool `bson:"read"`
+	// included in a mailed digest
+	Digested  bool  `bson:"digested"`
+	CreatedAt int64 `bson:"createdAt"`
+}
+
+// event types a user is notified of
+type Preference struct {
+	Annotation bool `bson:"annotation"`
+	Reply      bool `bson:"reply"`
+	Mention    bool `bson:"mention"`
+	Digest     bool `bson:"digest"`
+}
+
+// DefaultPreference is used for users who never set one
+var DefaultPreference = Preference{
+	Annotation: true,
+	Reply:      true,
+	Mention:    true,
+}
+
+// Allows reports whether events of type t are delivered
+func (p Preference) Allows(t EventType) bool {
+	switch t {
+	case EventAnnotation:
+		return p.Annotation
+	case EventReply:
+		return p.Reply
+	case EventMention:
+		return p.Mention
+	}
+	return false
+}
@@ -13,6 +13,10 @@ type ProjectConfig struct {
 	Mongodb MongodbConfig `json:"mongodb"`
 	Index   string        `json:"index"`
 	Account string        `json:"account"`
+	Gits    string        `json:"gits"`
+	// notification service, annotations are not notified if it is empty
+	Notification string `json:"notification"`
+	Viewer       string `json:"viewer"` // link of code references in annotations, with {pid}, {file} and {lines} replaced
 }
 
 type MongodbConfig struct {
@@ -27,8 +31,11 @@ var DefaultConfig = ProjectConfig{
 		Uri:      "mongodb://127.0.0.1:27017",
 		Database: "rfschub",
 	},
-	Index:   "IndexService",
-	Account: "AccountService",
+	Index:        "IndexService",
+	Account:      "AccountService",
+	Gits:         "GitService",
+	Notification: "NotificationService",
+	Viewer:       "/project/{pid}/blob/{file}#{lines}",
 }
 
 func init() {
@@ -41,6 +41,55 @@ type ProjectService interface {
 	GetAnnotationLines(ctx context.Context, in *GetAnnotationLinesRequest, opts ...client.CallOption) (*GetAnnotationLinesResponse, error)
 	GetAnnotations(ctx context.Context, in *GetAnnotationsRequest, opts ...client.CallOption) (*GetAnnotationsResponse, error)
 	GetLatestAnnotations(ctx context.Context, in *GetLatestAnnotationsRequest, opts ...client.CallOption) (*GetLatestAnnotationsResponse, error)
+	// update an annotation by its author or a maintainer
+	UpdateAnnotation(ctx context.Context, in *UpdateAnnotationRequest, opts ...client.CallOption) (*UpdateAnnotationResponse, error)
+	// delete an annotation by its author or a maintainer
+	DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, opts ...client.CallOption) (*DeleteAnnotationResponse, error)
+	GetAnnotationRevisions(ctx context.Context, in *GetAnnotationRevisionsRequest, opts ...client.CallOption) (*GetAnnotationRevisionsResponse, error)
+	// mark a thread resolved or not by its author or a maintainer
+	ResolveAnnotation(ctx context.Context, in *ResolveAnnotationRequest, opts ...client.CallOption) (*ResolveAnnotationResponse, error)
+	// add or remove an emoji reaction of an annotation
+	ReactAnnotation(ctx context.Context, in *ReactAnnotationRequest, opts ...client.CallOption) (*ReactAnnotationResponse, error)
+	// set globs of files whose symbols are excluded from symbol search
+	SetProjectIgnore(ctx context.Context, in *SetProjectIgnoreRequest, opts ...client.CallOption) (*SetProjectIgnoreResponse, error)
+	// invite a user to join project
+	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...client.CallOption) (*InviteMemberResponse, error)
+	// create a link anyone holding it can join project with
+	CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...client.CallOption) (*CreateInviteLinkResponse, error)
+	JoinByLink(ctx context.Context, in *JoinByLinkRequest, opts ...client.CallOption) (*JoinByLinkResponse, error)
+	// list invite links of project not yet expired
+	ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...client.CallOption) (*ListInviteLinksResponse, error)
+	RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...client.CallOption) (*RevokeInviteLinkResponse, error)
+	// list pending invitations of a user
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...client.CallOption) (*ListInvitationsResponse, error)
+	ReplyInvitation(ctx context.Context, in *ReplyInvitationRequest, opts ...client.CallOption) (*ReplyInvitationResponse, error)
+	// request to join project, need approval of a maintainer
+	RequestJoin(ctx context.Context, in *RequestJoinRequest, opts ...client.CallOption) (*RequestJoinResponse, error)
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...client.CallOption) (*ListJoinRequestsResponse, error)
+	ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...client.CallOption) (*ReviewJoinRequestResponse, error)
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...client.CallOption) (*ListMembersResponse, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...client.CallOption) (*RemoveMemberResponse, error)
+	SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...client.CallOption) (*SetMemberRoleResponse, error)
+	SetProjectVisibility(ctx context.Context, in *SetProjectVisibilityRequest, opts ...client.CallOption) (*SetProjectVisibilityResponse, error)
+	// check if a user can read project, url and hash of the project are returned
+	ProjectAccess(ctx context.Context, in *ProjectAccessRequest, opts ...client.CallOption) (*ProjectAccessResponse, error)
+	// move a branch project to the new head of its branch, annotations are
+	// moved along or marked outdated if their code changed
+	RebaseProject(ctx context.Context, in *RebaseProjectRequest, opts ...client.CallOption) (*RebaseProjectResponse, error)
+	// projects created and annotations written by a user in public
+	// projects, newest first
+	UserActivity(ctx context.Context, in *UserActivityRequest, opts ...client.CallOption) (*UserActivityResponse, error)
+	// delete a project or an annotation regardless of permissions, for
+	// admins moderating content
+	AdminDeleteProject(ctx context.Context, in *AdminDeleteProjectRequest, opts ...client.CallOption) (*AdminDeleteProjectResponse, error)
+	AdminDeleteAnnotation(ctx context.Context, in *AdminDeleteAnnotationRequest, opts ...client.CallOption) (*AdminDeleteAnnotationResponse, error)
+	// projects owned by a user and annotations written by them, for exports
+	// of accounts
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...client.CallOption) (*ExportUserDataResponse, error)
+	// remove data of an account being deleted: projects it owns are handed to
+	// the member with the highest role or deleted, its memberships are
+	// removed, and its annotations are deleted or anonymized
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...client.CallOption) (*DeleteUserDataResponse, error)
 }
 
 type projectService struct {
@@ -131,6 +180,276 @@ func (c *projectService) GetLatestAnnotations(ctx context.Context, in *GetLatest
 	return out, nil
 }
 
+func (c *projectService) UpdateAnnotation(ctx context.Context, in *UpdateAnnotationRequest, opts ...client.CallOption) (*UpdateAnnotationResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.UpdateAnnotation", in)
+	out := new(UpdateAnnotationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, opts ...client.CallOption) (*DeleteAnnotationResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.DeleteAnnotation", in)
+	out := new(DeleteAnnotationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) GetAnnotationRevisions(ctx context.Context, in *GetAnnotationRevisionsRequest, opts ...client.CallOption) (*GetAnnotationRevisionsResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.GetAnnotationRevisions", in)
+	out := new(GetAnnotationRevisionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ResolveAnnotation(ctx context.Context, in *ResolveAnnotationRequest, opts ...client.CallOption) (*ResolveAnnotationResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ResolveAnnotation", in)
+	out := new(ResolveAnnotationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ReactAnnotation(ctx context.Context, in *ReactAnnotationRequest, opts ...client.CallOption) (*ReactAnnotationResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ReactAnnotation", in)
+	out := new(ReactAnnotationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) SetProjectIgnore(ctx context.Context, in *SetProjectIgnoreRequest, opts ...client.CallOption) (*SetProjectIgnoreResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.SetProjectIgnore", in)
+	out := new(SetProjectIgnoreResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...client.CallOption) (*InviteMemberResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.InviteMember", in)
+	out := new(InviteMemberResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...client.CallOption) (*CreateInviteLinkResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.CreateInviteLink", in)
+	out := new(CreateInviteLinkResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) JoinByLink(ctx context.Context, in *JoinByLinkRequest, opts ...client.CallOption) (*JoinByLinkResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.JoinByLink", in)
+	out := new(JoinByLinkResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...client.CallOption) (*ListInviteLinksResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ListInviteLinks", in)
+	out := new(ListInviteLinksResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...client.CallOption) (*RevokeInviteLinkResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.RevokeInviteLink", in)
+	out := new(RevokeInviteLinkResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...client.CallOption) (*ListInvitationsResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ListInvitations", in)
+	out := new(ListInvitationsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ReplyInvitation(ctx context.Context, in *ReplyInvitationRequest, opts ...client.CallOption) (*ReplyInvitationResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ReplyInvitation", in)
+	out := new(ReplyInvitationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) RequestJoin(ctx context.Context, in *RequestJoinRequest, opts ...client.CallOption) (*RequestJoinResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.RequestJoin", in)
+	out := new(RequestJoinResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...client.CallOption) (*ListJoinRequestsResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ListJoinRequests", in)
+	out := new(ListJoinRequestsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...client.CallOption) (*ReviewJoinRequestResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ReviewJoinRequest", in)
+	out := new(ReviewJoinRequestResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...client.CallOption) (*ListMembersResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ListMembers", in)
+	out := new(ListMembersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...client.CallOption) (*RemoveMemberResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.RemoveMember", in)
+	out := new(RemoveMemberResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...client.CallOption) (*SetMemberRoleResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.SetMemberRole", in)
+	out := new(SetMemberRoleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) SetProjectVisibility(ctx context.Context, in *SetProjectVisibilityRequest, opts ...client.CallOption) (*SetProjectVisibilityResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.SetProjectVisibility", in)
+	out := new(SetProjectVisibilityResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ProjectAccess(ctx context.Context, in *ProjectAccessRequest, opts ...client.CallOption) (*ProjectAccessResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ProjectAccess", in)
+	out := new(ProjectAccessResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) RebaseProject(ctx context.Context, in *RebaseProjectRequest, opts ...client.CallOption) (*RebaseProjectResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.RebaseProject", in)
+	out := new(RebaseProjectResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) UserActivity(ctx context.Context, in *UserActivityRequest, opts ...client.CallOption) (*UserActivityResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.UserActivity", in)
+	out := new(UserActivityResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) AdminDeleteProject(ctx context.Context, in *AdminDeleteProjectRequest, opts ...client.CallOption) (*AdminDeleteProjectResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.AdminDeleteProject", in)
+	out := new(AdminDeleteProjectResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) AdminDeleteAnnotation(ctx context.Context, in *AdminDeleteAnnotationRequest, opts ...client.CallOption) (*AdminDeleteAnnotationResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.AdminDeleteAnnotation", in)
+	out := new(AdminDeleteAnnotationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...client.CallOption) (*ExportUserDataResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.ExportUserData", in)
+	out := new(ExportUserDataResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectService) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...client.CallOption) (*DeleteUserDataResponse, error) {
+	req := c.c.NewRequest(c.name, "Project.DeleteUserData", in)
+	out := new(DeleteUserDataResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Project service
 
 type ProjectHandler interface {
@@ -141,6 +460,55 @@ type ProjectHandler interface {
 	GetAnnotationLines(context.Context, *GetAnnotationLinesRequest, *GetAnnotationLinesResponse) error
 	GetAnnotations(context.Context, *GetAnnotationsRequest, *GetAnnotationsResponse) error
 	GetLatestAnnotations(context.Context, *GetLatestAnnotationsRequest, *GetLatestAnnotationsResponse) error
+	// update an annotation by its author or a maintainer
+	UpdateAnnotation(context.Context, *UpdateAnnotationRequest, *UpdateAnnotationResponse) error
+	// delete an annotation by its author or a maintainer
+	DeleteAnnotation(context.Context, *DeleteAnnotationRequest, *DeleteAnnotationResponse) error
+	GetAnnotationRevisions(context.Context, *GetAnnotationRevisionsRequest, *GetAnnotationRevisionsResponse) error
+	// mark a thread resolved or not by its author or a maintainer
+	ResolveAnnotation(context.Context, *ResolveAnnotationRequest, *ResolveAnnotationResponse) error
+	// add or remove an emoji reaction of an annotation
+	ReactAnnotation(context.Context, *ReactAnnotationRequest, *ReactAnnotationResponse) error
+	// set globs of files whose symbols are excluded from symbol search
+	SetProjectIgnore(context.Context, *SetProjectIgnoreRequest, *SetProjectIgnoreResponse) error
+	// invite a user to join project
+	InviteMember(context.Context, *InviteMemberRequest, *InviteMemberResponse) error
+	// create a link anyone holding it can join project with
+	CreateInviteLink(context.Context, *CreateInviteLinkRequest, *CreateInviteLinkResponse) error
+	JoinByLink(context.Context, *JoinByLinkRequest, *JoinByLinkResponse) error
+	// list invite links of project not yet expired
+	ListInviteLinks(context.Context, *ListInviteLinksRequest, *ListInviteLinksResponse) error
+	RevokeInviteLink(context.Context, *RevokeInviteLinkRequest, *RevokeInviteLinkResponse) error
+	// list pending invitations of a user
+	ListInvitations(context.Context, *ListInvitationsRequest, *ListInvitationsResponse) error
+	ReplyInvitation(context.Context, *ReplyInvitationRequest, *ReplyInvitationResponse) error
+	// request to join project, need approval of a maintainer
+	RequestJoin(context.Context, *RequestJoinRequest, *RequestJoinResponse) error
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest, *ListJoinRequestsResponse) error
+	ReviewJoinRequest(context.Context, *ReviewJoinRequestRequest, *ReviewJoinRequestResponse) error
+	ListMembers(context.Context, *ListMembersRequest, *ListMembersResponse) error
+	RemoveMember(context.Context, *RemoveMemberRequest, *RemoveMemberResponse) error
+	SetMemberRole(context.Context, *SetMemberRoleRequest, *SetMemberRoleResponse) error
+	SetProjectVisibility(context.Context, *SetProjectVisibilityRequest, *SetProjectVisibilityResponse) error
+	// check if a user can read project, url and hash of the project are returned
+	ProjectAccess(context.Context, *ProjectAccessRequest, *ProjectAccessResponse) error
+	// move a branch project to the new head of its branch, annotations are
+	// moved along or marked outdated if their code changed
+	RebaseProject(context.Context, *RebaseProjectRequest, *RebaseProjectResponse) error
+	// projects created and annotations written by a user in public
+	// projects, newest first
+	UserActivity(context.Context, *UserActivityRequest, *UserActivityResponse) error
+	// delete a project or an annotation regardless of permissions, for
+	// admins moderating content
+	AdminDeleteProject(context.Context, *AdminDeleteProjectRequest, *AdminDeleteProjectResponse) error
+	AdminDeleteAnnotation(context.Context, *AdminDeleteAnnotationRequest, *AdminDeleteAnnotationResponse) error
+	// projects owned by a user and annotations written by them, for exports
+	// of accounts
+	ExportUserData(context.Context, *ExportUserDataRequest, *ExportUserDataResponse) error
+	// remove data of an account being deleted: projects it owns are handed to
+	// the member with the highest role or deleted, its memberships are
+	// removed, and its annotations are deleted or anonymized
+	DeleteUserData(context.Context, *DeleteUserDataRequest, *DeleteUserDataResponse) error
 }
 
 func RegisterProjectHandler(s server.Server, hdlr ProjectHandler, opts ...server.HandlerOption) error {
@@ -152,6 +520,33 @@ func RegisterProjectHandler(s server.Server, hdlr ProjectHandler, opts ...server
 		GetAnnotationLines(ctx context.Context, in *GetAnnotationLinesRequest, out *GetAnnotationLinesResponse) error
 		GetAnnotations(ctx context.Context, in *GetAnnotationsRequest, out *GetAnnotationsResponse) error
 		GetLatestAnnotations(ctx context.Context, in *GetLatestAnnotationsRequest, out *GetLatestAnnotationsResponse) error
+		UpdateAnnotation(ctx context.Context, in *UpdateAnnotationRequest, out *UpdateAnnotationResponse) error
+		DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, out *DeleteAnnotationResponse) error
+		GetAnnotationRevisions(ctx context.Context, in *GetAnnotationRevisionsRequest, out *GetAnnotationRevisionsResponse) error
+		ResolveAnnotation(ctx context.Context, in *ResolveAnnotationRequest, out *ResolveAnnotationResponse) error
+		ReactAnnotation(ctx context.Context, in *ReactAnnotationRequest, out *ReactAnnotationResponse) error
+		SetProjectIgnore(ctx context.Context, in *SetProjectIgnoreRequest, out *SetProjectIgnoreResponse) error
+		InviteMember(ctx context.Context, in *InviteMemberRequest, out *InviteMemberResponse) error
+		CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, out *CreateInviteLinkResponse) error
+		JoinByLink(ctx context.Context, in *JoinByLinkRequest, out *JoinByLinkResponse) error
+		ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, out *ListInviteLinksResponse) error
+		RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, out *RevokeInviteLinkResponse) error
+		ListInvitations(ctx context.Context, in *ListInvitationsRequest, out *ListInvitationsResponse) error
+		ReplyInvitation(ctx context.Context, in *ReplyInvitationRequest, out *ReplyInvitationResponse) error
+		RequestJoin(ctx context.Context, in *RequestJoinRequest, out *RequestJoinResponse) error
+		ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, out *ListJoinRequestsResponse) error
+		ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, out *ReviewJoinRequestResponse) error
+		ListMembers(ctx context.Context, in *ListMembersRequest, out *ListMembersResponse) error
+		RemoveMember(ctx context.Context, in *RemoveMemberRequest, out *RemoveMemberResponse) error
+		SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, out *SetMemberRoleResponse) error
+		SetProjectVisibility(ctx context.Context, in *SetProjectVisibilityRequest, out *SetProjectVisibilityResponse) error
+		ProjectAccess(ctx context.Context, in *ProjectAccessRequest, out *ProjectAccessResponse) error
+		RebaseProject(ctx context.Context, in *RebaseProjectRequest, out *RebaseProjectResponse) error
+		UserActivity(ctx context.Context, in *UserActivityRequest, out *UserActivityResponse) error
+		AdminDeleteProject(ctx context.Context, in *AdminDeleteProjectRequest, out *AdminDeleteProjectResponse) error
+		AdminDeleteAnnotation(ctx context.Context, in *AdminDeleteAnnotationRequest, out *AdminDeleteAnnotationResponse) error
+		ExportUserData(ctx context.Context, in *ExportUserDataRequest, out *ExportUserDataResponse) error
+		DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, out *DeleteUserDataResponse) error
 	}
 	type Project struct {
 		project
@@ -191,3 +586,111 @@ func (h *projectHandler) GetAnnotations(ctx context.Context, in *GetAnnotationsR
 func (h *projectHandler) GetLatestAnnotations(ctx context.Context, in *GetLatestAnnotationsRequest, out *GetLatestAnnotationsResponse) error {
 	return h.ProjectHandler.GetLatestAnnotations(ctx, in, out)
 }
+
+func (h *projectHandler) UpdateAnnotation(ctx context.Context, in *UpdateAnnotationRequest, out *UpdateAnnotationResponse) error {
+	return h.ProjectHandler.UpdateAnnotation(ctx, in, out)
+}
+
+func (h *projectHandler) DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, out *DeleteAnnotationResponse) error {
+	return h.ProjectHandler.DeleteAnnotation(ctx, in, out)
+}
+
+func (h *projectHandler) GetAnnotationRevisions(ctx context.Context, in *GetAnnotationRevisionsRequest, out *GetAnnotationRevisionsResponse) error {
+	return h.ProjectHandler.GetAnnotationRevisions(ctx, in, out)
+}
+
+func (h *projectHandler) ResolveAnnotation(ctx context.Context, in *ResolveAnnotationRequest, out *ResolveAnnotationResponse) error {
+	return h.ProjectHandler.ResolveAnnotation(ctx, in, out)
+}
+
+func (h *projectHandler) ReactAnnotation(ctx context.Context, in *ReactAnnotationRequest, out *ReactAnnotationResponse) error {
+	return h.ProjectHandler.ReactAnnotation(ctx, in, out)
+}
+
+func (h *projectHandler) SetProjectIgnore(ctx context.Context, in *SetProjectIgnoreRequest, out *SetProjectIgnoreResponse) error {
+	return h.ProjectHandler.SetProjectIgnore(ctx, in, out)
+}
+
+func (h *projectHandler) InviteMember(ctx context.Context, in *InviteMemberRequest, out *InviteMemberResponse) error {
+	return h.ProjectHandler.InviteMember(ctx, in, out)
+}
+
+func (h *projectHandler) CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, out *CreateInviteLinkResponse) error {
+	return h.ProjectHandler.CreateInviteLink(ctx, in, out)
+}
+
+func (h *projectHandler) JoinByLink(ctx context.Context, in *JoinByLinkRequest, out *JoinByLinkResponse) error {
+	return h.ProjectHandler.JoinByLink(ctx, in, out)
+}
+
+func (h *projectHandler) ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, out *ListInviteLinksResponse) error {
+	return h.ProjectHandler.ListInviteLinks(ctx, in, out)
+}
+
+func (h *projectHandler) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, out *RevokeInviteLinkResponse) error {
+	return h.ProjectHandler.RevokeInviteLink(ctx, in, out)
+}
+
+func (h *projectHandler) ListInvitations(ctx context.Context, in *ListInvitationsRequest, out *ListInvitationsResponse) error {
+	return h.ProjectHandler.ListInvitations(ctx, in, out)
+}
+
+func (h *projectHandler) ReplyInvitation(ctx context.Context, in *ReplyInvitationRequest, out *ReplyInvitationResponse) error {
+	return h.ProjectHandler.ReplyInvitation(ctx, in, out)
+}
+
+func (h *projectHandler) RequestJoin(ctx context.Context, in *RequestJoinRequest, out *RequestJoinResponse) error {
+	return h.ProjectHandler.RequestJoin(ctx, in, out)
+}
+
+func (h *projectHandler) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, out *ListJoinRequestsResponse) error {
+	return h.ProjectHandler.ListJoinRequests(ctx, in, out)
+}
+
+func (h *projectHandler) ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, out *ReviewJoinRequestResponse) error {
+	return h.ProjectHandler.ReviewJoinRequest(ctx, in, out)
+}
+
+func (h *projectHandler) ListMembers(ctx context.Context, in *ListMembersRequest, out *ListMembersResponse) error {
+	return h.ProjectHandler.ListMembers(ctx, in, out)
+}
+
+func (h *projectHandler) RemoveMember(ctx context.Context, in *RemoveMemberRequest, out *RemoveMemberResponse) error {
+	return h.ProjectHandler.RemoveMember(ctx, in, out)
+}
+
+func (h *projectHandler) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, out *SetMemberRoleResponse) error {
+	return h.ProjectHandler.SetMemberRole(ctx, in, out)
+}
+
+func (h *projectHandler) SetProjectVisibility(ctx context.Context, in *SetProjectVisibilityRequest, out *SetProjectVisibilityResponse) error {
+	return h.ProjectHandler.SetProjectVisibility(ctx, in, out)
+}
+
+func (h *projectHandler) ProjectAccess(ctx context.Context, in *ProjectAccessRequest, out *ProjectAccessResponse) error {
+	return h.ProjectHandler.ProjectAccess(ctx, in, out)
+}
+
+func (h *projectHandler) RebaseProject(ctx context.Context, in *RebaseProjectRequest, out *RebaseProjectResponse) error {
+	return h.ProjectHandler.RebaseProject(ctx, in, out)
+}
+
+func (h *projectHandler) UserActivity(ctx context.Context, in *UserActivityRequest, out *UserActivityResponse) error {
+	return h.ProjectHandler.UserActivity(ctx, in, out)
+}
+
+func (h *projectHandler) AdminDeleteProject(ctx context.Context, in *AdminDeleteProjectRequest, out *AdminDeleteProjectResponse) error {
+	return h.ProjectHandler.AdminDeleteProject(ctx, in, out)
+}
+
+func (h *projectHandler) AdminDeleteAnnotation(ctx context.Context, in *AdminDeleteAnnotationRequest, out *AdminDeleteAnnotationResponse) error {
+	return h.ProjectHandler.AdminDeleteAnnotation(ctx, in, out)
+}
+
+func (h *projectHandler) ExportUserData(ctx context.Context, in *ExportUserDataRequest, out *ExportUserDataResponse) error {
+	return h.ProjectHandler.ExportUserData(ctx, in, out)
+}
+
+func (h *projectHandler) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, out *DeleteUserDataResponse) error {
+	return h.ProjectHandler.DeleteUserData(ctx, in, out)
+}
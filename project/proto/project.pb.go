@@ -25,6 +25,12 @@ const (
 	ErrorCode_RepositoryNotExist  ErrorCode = 400001
 	ErrorCode_ProjectExist        ErrorCode = 400002
 	ErrorCode_IndexProjectFailure ErrorCode = 400003
+	ErrorCode_NoPermission        ErrorCode = 400004
+	ErrorCode_InvitationNotExist  ErrorCode = 400005
+	ErrorCode_MemberExist         ErrorCode = 400006
+	ErrorCode_MemberNotExist      ErrorCode = 400007
+	ErrorCode_InvitationExpired   ErrorCode = 400008
+	ErrorCode_AnnotationNotExist  ErrorCode = 400009
 )
 
 var ErrorCode_name = map[int32]string{
@@ -32,27 +38,104 @@ var ErrorCode_name = map[int32]string{
 	400001: "RepositoryNotExist",
 	400002: "ProjectExist",
 	400003: "IndexProjectFailure",
+	400004: "NoPermission",
+	400005: "InvitationNotExist",
+	400006: "MemberExist",
+	400007: "MemberNotExist",
+	400008: "InvitationExpired",
+	400009: "AnnotationNotExist",
 }
 var ErrorCode_value = map[string]int32{
 	"Success":             0,
 	"RepositoryNotExist":  400001,
 	"ProjectExist":        400002,
 	"IndexProjectFailure": 400003,
+	"NoPermission":        400004,
+	"InvitationNotExist":  400005,
+	"MemberExist":         400006,
+	"MemberNotExist":      400007,
+	"InvitationExpired":   400008,
+	"AnnotationNotExist":  400009,
 }
 
 func (x ErrorCode) String() string {
 	return proto.EnumName(ErrorCode_name, int32(x))
 }
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{0}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{0}
+}
+
+type Visibility int32
+
+const (
+	Visibility_Public Visibility = 0
+	// readable by anyone knowing it, but not listed
+	Visibility_Unlisted Visibility = 1
+	// only readable by members
+	Visibility_Private Visibility = 2
+)
+
+var Visibility_name = map[int32]string{
+	0: "Public",
+	1: "Unlisted",
+	2: "Private",
+}
+var Visibility_value = map[string]int32{
+	"Public":   0,
+	"Unlisted": 1,
+	"Private":  2,
+}
+
+func (x Visibility) String() string {
+	return proto.EnumName(Visibility_name, int32(x))
+}
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{1}
+}
+
+// a role includes permissions of roles lower than it
+type Role int32
+
+const (
+	Role_RoleNone   Role = 0
+	Role_Viewer     Role = 1
+	Role_Annotator  Role = 2
+	Role_Maintainer Role = 3
+	Role_Owner      Role = 4
+)
+
+var Role_name = map[int32]string{
+	0: "RoleNone",
+	1: "Viewer",
+	2: "Annotator",
+	3: "Maintainer",
+	4: "Owner",
+}
+var Role_value = map[string]int32{
+	"RoleNone":   0,
+	"Viewer":     1,
+	"Annotator":  2,
+	"Maintainer": 3,
+	"Owner":      4,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{2}
 }
 
 type NewProjectRequest struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
-	Url                  string   `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
-	Hash                 string   `protobuf:"bytes,3,opt,name=hash" json:"hash,omitempty"`
-	Name                 string   `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
-	Branch               bool     `protobuf:"varint,5,opt,name=branch" json:"branch,omitempty"`
+	Uid        string     `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Url        string     `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	Hash       string     `protobuf:"bytes,3,opt,name=hash" json:"hash,omitempty"`
+	Name       string     `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Branch     bool       `protobuf:"varint,5,opt,name=branch" json:"branch,omitempty"`
+	Visibility Visibility `protobuf:"varint,6,opt,name=visibility,enum=project.Visibility" json:"visibility,omitempty"`
+	// organization owning the project, maintainers of it can create
+	// projects. The project is owned by uid if empty
+	Owner                string   `protobuf:"bytes,7,opt,name=owner" json:"owner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -62,7 +145,7 @@ func (m *NewProjectRequest) Reset()         { *m = NewProjectRequest{} }
 func (m *NewProjectRequest) String() string { return proto.CompactTextString(m) }
 func (*NewProjectRequest) ProtoMessage()    {}
 func (*NewProjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{0}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{0}
 }
 func (m *NewProjectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewProjectRequest.Unmarshal(m, b)
@@ -117,6 +200,20 @@ func (m *NewProjectRequest) GetBranch() bool {
 	return false
 }
 
+func (m *NewProjectRequest) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Visibility_Public
+}
+
+func (m *NewProjectRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 type NewProjectResponse struct {
 	Id                   string   `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -128,7 +225,7 @@ func (m *NewProjectResponse) Reset()         { *m = NewProjectResponse{} }
 func (m *NewProjectResponse) String() string { return proto.CompactTextString(m) }
 func (*NewProjectResponse) ProtoMessage()    {}
 func (*NewProjectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{1}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{1}
 }
 func (m *NewProjectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewProjectResponse.Unmarshal(m, b)
@@ -169,7 +266,7 @@ func (m *ProjectInfoRequest) Reset()         { *m = ProjectInfoRequest{} }
 func (m *ProjectInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoRequest) ProtoMessage()    {}
 func (*ProjectInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{2}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{2}
 }
 func (m *ProjectInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoRequest.Unmarshal(m, b)
@@ -218,11 +315,16 @@ func (m *ProjectInfoRequest) GetName() string {
 }
 
 type ProjectInfoResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Hash                 string   `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
-	Branch               bool     `protobuf:"varint,3,opt,name=branch" json:"branch,omitempty"`
-	CanAnnotate          bool     `protobuf:"varint,4,opt,name=canAnnotate" json:"canAnnotate,omitempty"`
-	Indexed              bool     `protobuf:"varint,5,opt,name=indexed" json:"indexed,omitempty"`
+	Id          string     `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Hash        string     `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	Branch      bool       `protobuf:"varint,3,opt,name=branch" json:"branch,omitempty"`
+	CanAnnotate bool       `protobuf:"varint,4,opt,name=canAnnotate" json:"canAnnotate,omitempty"`
+	Indexed     bool       `protobuf:"varint,5,opt,name=indexed" json:"indexed,omitempty"`
+	Ignore      []string   `protobuf:"bytes,6,rep,name=ignore" json:"ignore,omitempty"`
+	Role        Role       `protobuf:"varint,7,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	Visibility  Visibility `protobuf:"varint,8,opt,name=visibility,enum=project.Visibility" json:"visibility,omitempty"`
+	// owned by an organization
+	Org                  bool     `protobuf:"varint,9,opt,name=org" json:"org,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -232,7 +334,7 @@ func (m *ProjectInfoResponse) Reset()         { *m = ProjectInfoResponse{} }
 func (m *ProjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoResponse) ProtoMessage()    {}
 func (*ProjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{3}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{3}
 }
 func (m *ProjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoResponse.Unmarshal(m, b)
@@ -287,23 +389,55 @@ func (m *ProjectInfoResponse) GetIndexed() bool {
 	return false
 }
 
+func (m *ProjectInfoResponse) GetIgnore() []string {
+	if m != nil {
+		return m.Ignore
+	}
+	return nil
+}
+
+func (m *ProjectInfoResponse) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+func (m *ProjectInfoResponse) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Visibility_Public
+}
+
+func (m *ProjectInfoResponse) GetOrg() bool {
+	if m != nil {
+		return m.Org
+	}
+	return false
+}
+
 type AddAnnotationRequest struct {
-	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
-	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
-	Url                  string   `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
-	File                 string   `protobuf:"bytes,4,opt,name=file" json:"file,omitempty"`
-	LineNumber           int32    `protobuf:"varint,5,opt,name=lineNumber" json:"lineNumber,omitempty"`
-	Annotation           string   `protobuf:"bytes,6,opt,name=annotation" json:"annotation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Pid        string `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid        string `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Url        string `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
+	File       string `protobuf:"bytes,4,opt,name=file" json:"file,omitempty"`
+	LineNumber int32  `protobuf:"varint,5,opt,name=lineNumber" json:"lineNumber,omitempty"`
+	Annotation string `protobuf:"bytes,6,opt,name=annotation" json:"annotation,omitempty"`
+	// reply to the thread of this annotation, file, lineNumber and range are ignored
+	Parent string `protobuf:"bytes,7,opt,name=parent" json:"parent,omitempty"`
+	// lines the annotation covers, a single line of lineNumber if not set
+	Range                *AnnotationRange `protobuf:"bytes,8,opt,name=range" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *AddAnnotationRequest) Reset()         { *m = AddAnnotationRequest{} }
 func (m *AddAnnotationRequest) String() string { return proto.CompactTextString(m) }
 func (*AddAnnotationRequest) ProtoMessage()    {}
 func (*AddAnnotationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{4}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{4}
 }
 func (m *AddAnnotationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddAnnotationRequest.Unmarshal(m, b)
@@ -365,7 +499,85 @@ func (m *AddAnnotationRequest) GetAnnotation() string {
 	return ""
 }
 
+func (m *AddAnnotationRequest) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *AddAnnotationRequest) GetRange() *AnnotationRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+// lines and columns start from 1, a zero column covers the whole line
+type AnnotationRange struct {
+	StartLine            int32    `protobuf:"varint,1,opt,name=startLine" json:"startLine,omitempty"`
+	StartColumn          int32    `protobuf:"varint,2,opt,name=startColumn" json:"startColumn,omitempty"`
+	EndLine              int32    `protobuf:"varint,3,opt,name=endLine" json:"endLine,omitempty"`
+	EndColumn            int32    `protobuf:"varint,4,opt,name=endColumn" json:"endColumn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnnotationRange) Reset()         { *m = AnnotationRange{} }
+func (m *AnnotationRange) String() string { return proto.CompactTextString(m) }
+func (*AnnotationRange) ProtoMessage()    {}
+func (*AnnotationRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{5}
+}
+func (m *AnnotationRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationRange.Unmarshal(m, b)
+}
+func (m *AnnotationRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationRange.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationRange.Merge(dst, src)
+}
+func (m *AnnotationRange) XXX_Size() int {
+	return xxx_messageInfo_AnnotationRange.Size(m)
+}
+func (m *AnnotationRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationRange proto.InternalMessageInfo
+
+func (m *AnnotationRange) GetStartLine() int32 {
+	if m != nil {
+		return m.StartLine
+	}
+	return 0
+}
+
+func (m *AnnotationRange) GetStartColumn() int32 {
+	if m != nil {
+		return m.StartColumn
+	}
+	return 0
+}
+
+func (m *AnnotationRange) GetEndLine() int32 {
+	if m != nil {
+		return m.EndLine
+	}
+	return 0
+}
+
+func (m *AnnotationRange) GetEndColumn() int32 {
+	if m != nil {
+		return m.EndColumn
+	}
+	return 0
+}
+
 type AddAnnotationResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -375,7 +587,7 @@ func (m *AddAnnotationResponse) Reset()         { *m = AddAnnotationResponse{} }
 func (m *AddAnnotationResponse) String() string { return proto.CompactTextString(m) }
 func (*AddAnnotationResponse) ProtoMessage()    {}
 func (*AddAnnotationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{5}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{6}
 }
 func (m *AddAnnotationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddAnnotationResponse.Unmarshal(m, b)
@@ -395,9 +607,17 @@ func (m *AddAnnotationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddAnnotationResponse proto.InternalMessageInfo
 
+func (m *AddAnnotationResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type GetAnnotationLinesRequest struct {
 	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
 	File                 string   `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
+	Uid                  string   `protobuf:"bytes,3,opt,name=uid" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -407,7 +627,7 @@ func (m *GetAnnotationLinesRequest) Reset()         { *m = GetAnnotationLinesReq
 func (m *GetAnnotationLinesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAnnotationLinesRequest) ProtoMessage()    {}
 func (*GetAnnotationLinesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{6}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{7}
 }
 func (m *GetAnnotationLinesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAnnotationLinesRequest.Unmarshal(m, b)
@@ -441,18 +661,27 @@ func (m *GetAnnotationLinesRequest) GetFile() string {
 	return ""
 }
 
+func (m *GetAnnotationLinesRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
 type GetAnnotationLinesResponse struct {
-	Lines                []int32  `protobuf:"varint,1,rep,packed,name=lines" json:"lines,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// start lines of ranges
+	Lines                []int32            `protobuf:"varint,1,rep,packed,name=lines" json:"lines,omitempty"`
+	Ranges               []*AnnotationRange `protobuf:"bytes,2,rep,name=ranges" json:"ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *GetAnnotationLinesResponse) Reset()         { *m = GetAnnotationLinesResponse{} }
 func (m *GetAnnotationLinesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAnnotationLinesResponse) ProtoMessage()    {}
 func (*GetAnnotationLinesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{7}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{8}
 }
 func (m *GetAnnotationLinesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAnnotationLinesResponse.Unmarshal(m, b)
@@ -479,10 +708,23 @@ func (m *GetAnnotationLinesResponse) GetLines() []int32 {
 	return nil
 }
 
+func (m *GetAnnotationLinesResponse) GetRanges() []*AnnotationRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
 type GetAnnotationsRequest struct {
-	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
-	File                 string   `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
-	LineNumber           int32    `protobuf:"varint,3,opt,name=lineNumber" json:"lineNumber,omitempty"`
+	Pid        string `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	File       string `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
+	LineNumber int32  `protobuf:"varint,3,opt,name=lineNumber" json:"lineNumber,omitempty"`
+	Uid        string `protobuf:"bytes,4,opt,name=uid" json:"uid,omitempty"`
+	// page starts from 1
+	Page     int32 `protobuf:"varint,5,opt,name=page" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,6,opt,name=pageSize" json:"pageSize,omitempty"`
+	// get outdated threads of file instead of threads of lineNumber
+	Outdated             bool     `protobuf:"varint,7,opt,name=outdated" json:"outdated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -492,7 +734,7 @@ func (m *GetAnnotationsRequest) Reset()         { *m = GetAnnotationsRequest{} }
 func (m *GetAnnotationsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAnnotationsRequest) ProtoMessage()    {}
 func (*GetAnnotationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{8}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{9}
 }
 func (m *GetAnnotationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAnnotationsRequest.Unmarshal(m, b)
@@ -533,8 +775,38 @@ func (m *GetAnnotationsRequest) GetLineNumber() int32 {
 	return 0
 }
 
+func (m *GetAnnotationsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GetAnnotationsRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAnnotationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetAnnotationsRequest) GetOutdated() bool {
+	if m != nil {
+		return m.Outdated
+	}
+	return false
+}
+
 type GetAnnotationsResponse struct {
+	// threads, with replies in each of them
 	Records              []*AnnotationRecord `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+	Total                int64               `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -544,7 +816,7 @@ func (m *GetAnnotationsResponse) Reset()         { *m = GetAnnotationsResponse{}
 func (m *GetAnnotationsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAnnotationsResponse) ProtoMessage()    {}
 func (*GetAnnotationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{9}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{10}
 }
 func (m *GetAnnotationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAnnotationsResponse.Unmarshal(m, b)
@@ -571,11 +843,30 @@ func (m *GetAnnotationsResponse) GetRecords() []*AnnotationRecord {
 	return nil
 }
 
+func (m *GetAnnotationsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 type AnnotationRecord struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Annotation           string   `protobuf:"bytes,3,opt,name=annotation" json:"annotation,omitempty"`
-	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	Uid        string              `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Name       string              `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Annotation string              `protobuf:"bytes,3,opt,name=annotation" json:"annotation,omitempty"`
+	CreatedAt  int64               `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	Id         string              `protobuf:"bytes,5,opt,name=id" json:"id,omitempty"`
+	UpdatedAt  int64               `protobuf:"varint,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	Resolved   bool                `protobuf:"varint,7,opt,name=resolved" json:"resolved,omitempty"`
+	Reactions  []*Reaction         `protobuf:"bytes,8,rep,name=reactions" json:"reactions,omitempty"`
+	Replies    []*AnnotationRecord `protobuf:"bytes,9,rep,name=replies" json:"replies,omitempty"`
+	Range      *AnnotationRange    `protobuf:"bytes,10,opt,name=range" json:"range,omitempty"`
+	// commit range refers to, an outdated thread stays at the commit it was
+	// created on or last moved to
+	Hash     string `protobuf:"bytes,11,opt,name=hash" json:"hash,omitempty"`
+	Outdated bool   `protobuf:"varint,12,opt,name=outdated" json:"outdated,omitempty"`
+	// sanitized HTML rendered from annotation written in markdown
+	Html                 string   `protobuf:"bytes,13,opt,name=html" json:"html,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -585,7 +876,7 @@ func (m *AnnotationRecord) Reset()         { *m = AnnotationRecord{} }
 func (m *AnnotationRecord) String() string { return proto.CompactTextString(m) }
 func (*AnnotationRecord) ProtoMessage()    {}
 func (*AnnotationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{10}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{11}
 }
 func (m *AnnotationRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnnotationRecord.Unmarshal(m, b)
@@ -633,9 +924,119 @@ func (m *AnnotationRecord) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *AnnotationRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AnnotationRecord) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *AnnotationRecord) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
+}
+
+func (m *AnnotationRecord) GetReactions() []*Reaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *AnnotationRecord) GetReplies() []*AnnotationRecord {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
+func (m *AnnotationRecord) GetRange() *AnnotationRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *AnnotationRecord) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *AnnotationRecord) GetOutdated() bool {
+	if m != nil {
+		return m.Outdated
+	}
+	return false
+}
+
+func (m *AnnotationRecord) GetHtml() string {
+	if m != nil {
+		return m.Html
+	}
+	return ""
+}
+
+type Reaction struct {
+	Emoji                string   `protobuf:"bytes,1,opt,name=emoji" json:"emoji,omitempty"`
+	Uids                 []string `protobuf:"bytes,2,rep,name=uids" json:"uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reaction) Reset()         { *m = Reaction{} }
+func (m *Reaction) String() string { return proto.CompactTextString(m) }
+func (*Reaction) ProtoMessage()    {}
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{12}
+}
+func (m *Reaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reaction.Unmarshal(m, b)
+}
+func (m *Reaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reaction.Marshal(b, m, deterministic)
+}
+func (dst *Reaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reaction.Merge(dst, src)
+}
+func (m *Reaction) XXX_Size() int {
+	return xxx_messageInfo_Reaction.Size(m)
+}
+func (m *Reaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reaction proto.InternalMessageInfo
+
+func (m *Reaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *Reaction) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
 type GetLatestAnnotationsRequest struct {
 	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
 	Parent               string   `protobuf:"bytes,2,opt,name=parent" json:"parent,omitempty"`
+	Uid                  string   `protobuf:"bytes,3,opt,name=uid" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -645,7 +1046,7 @@ func (m *GetLatestAnnotationsRequest) Reset()         { *m = GetLatestAnnotation
 func (m *GetLatestAnnotationsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLatestAnnotationsRequest) ProtoMessage()    {}
 func (*GetLatestAnnotationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{11}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{13}
 }
 func (m *GetLatestAnnotationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLatestAnnotationsRequest.Unmarshal(m, b)
@@ -679,6 +1080,13 @@ func (m *GetLatestAnnotationsRequest) GetParent() string {
 	return ""
 }
 
+func (m *GetLatestAnnotationsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
 type GetLatestAnnotationsResponse struct {
 	Annotations          []*LatestAnnotation `protobuf:"bytes,1,rep,name=annotations" json:"annotations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -690,7 +1098,7 @@ func (m *GetLatestAnnotationsResponse) Reset()         { *m = GetLatestAnnotatio
 func (m *GetLatestAnnotationsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLatestAnnotationsResponse) ProtoMessage()    {}
 func (*GetLatestAnnotationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{12}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{14}
 }
 func (m *GetLatestAnnotationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLatestAnnotationsResponse.Unmarshal(m, b)
@@ -718,21 +1126,22 @@ func (m *GetLatestAnnotationsResponse) GetAnnotations() []*LatestAnnotation {
 }
 
 type LatestAnnotation struct {
-	File                 string   `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
-	LineNumber           int32    `protobuf:"varint,2,opt,name=lineNumber" json:"lineNumber,omitempty"`
-	Brief                string   `protobuf:"bytes,3,opt,name=brief" json:"brief,omitempty"`
-	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
-	Sub                  string   `protobuf:"bytes,5,opt,name=sub" json:"sub,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	File                 string           `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	LineNumber           int32            `protobuf:"varint,2,opt,name=lineNumber" json:"lineNumber,omitempty"`
+	Brief                string           `protobuf:"bytes,3,opt,name=brief" json:"brief,omitempty"`
+	Timestamp            int64            `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Sub                  string           `protobuf:"bytes,5,opt,name=sub" json:"sub,omitempty"`
+	Range                *AnnotationRange `protobuf:"bytes,6,opt,name=range" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *LatestAnnotation) Reset()         { *m = LatestAnnotation{} }
 func (m *LatestAnnotation) String() string { return proto.CompactTextString(m) }
 func (*LatestAnnotation) ProtoMessage()    {}
 func (*LatestAnnotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{13}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{15}
 }
 func (m *LatestAnnotation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LatestAnnotation.Unmarshal(m, b)
@@ -787,8 +1196,18 @@ func (m *LatestAnnotation) GetSub() string {
 	return ""
 }
 
+func (m *LatestAnnotation) GetRange() *AnnotationRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
 type ListProjectsRequest struct {
-	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Uid string `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	// who is listing, only public projects are listed to others except
+	// members of the organization uid
+	Viewer               string   `protobuf:"bytes,2,opt,name=viewer" json:"viewer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -798,7 +1217,7 @@ func (m *ListProjectsRequest) Reset()         { *m = ListProjectsRequest{} }
 func (m *ListProjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsRequest) ProtoMessage()    {}
 func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{14}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{16}
 }
 func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsRequest.Unmarshal(m, b)
@@ -825,6 +1244,13 @@ func (m *ListProjectsRequest) GetUid() string {
 	return ""
 }
 
+func (m *ListProjectsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
 type ListProjectsResponse struct {
 	Projects             []*ProjectInfo `protobuf:"bytes,1,rep,name=projects" json:"projects,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -836,7 +1262,7 @@ func (m *ListProjectsResponse) Reset()         { *m = ListProjectsResponse{} }
 func (m *ListProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsResponse) ProtoMessage()    {}
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{15}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{17}
 }
 func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsResponse.Unmarshal(m, b)
@@ -864,21 +1290,24 @@ func (m *ListProjectsResponse) GetProjects() []*ProjectInfo {
 }
 
 type ProjectInfo struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Hash                 string   `protobuf:"bytes,3,opt,name=hash" json:"hash,omitempty"`
-	Branch               bool     `protobuf:"varint,4,opt,name=branch" json:"branch,omitempty"`
-	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt" json:"createdAt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Url                  string     `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Hash                 string     `protobuf:"bytes,3,opt,name=hash" json:"hash,omitempty"`
+	Branch               bool       `protobuf:"varint,4,opt,name=branch" json:"branch,omitempty"`
+	CreatedAt            int64      `protobuf:"varint,5,opt,name=createdAt" json:"createdAt,omitempty"`
+	Visibility           Visibility `protobuf:"varint,6,opt,name=visibility,enum=project.Visibility" json:"visibility,omitempty"`
+	Id                   string     `protobuf:"bytes,7,opt,name=id" json:"id,omitempty"`
+	Org                  bool       `protobuf:"varint,8,opt,name=org" json:"org,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ProjectInfo) Reset()         { *m = ProjectInfo{} }
 func (m *ProjectInfo) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfo) ProtoMessage()    {}
 func (*ProjectInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_19ccf6b0d5f9ff9e, []int{16}
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{18}
 }
 func (m *ProjectInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfo.Unmarshal(m, b)
@@ -933,78 +1362,3109 @@ func (m *ProjectInfo) GetCreatedAt() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*NewProjectRequest)(nil), "project.NewProjectRequest")
-	proto.RegisterType((*NewProjectResponse)(nil), "project.NewProjectResponse")
-	proto.RegisterType((*ProjectInfoRequest)(nil), "project.ProjectInfoRequest")
-	proto.RegisterType((*ProjectInfoResponse)(nil), "project.ProjectInfoResponse")
-	proto.RegisterType((*AddAnnotationRequest)(nil), "project.AddAnnotationRequest")
-	proto.RegisterType((*AddAnnotationResponse)(nil), "project.AddAnnotationResponse")
-	proto.RegisterType((*GetAnnotationLinesRequest)(nil), "project.GetAnnotationLinesRequest")
+func (m *ProjectInfo) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Visibility_Public
+}
+
+func (m *ProjectInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProjectInfo) GetOrg() bool {
+	if m != nil {
+		return m.Org
+	}
+	return false
+}
+
+type SetProjectIgnoreRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Ignore               []string `protobuf:"bytes,3,rep,name=ignore" json:"ignore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetProjectIgnoreRequest) Reset()         { *m = SetProjectIgnoreRequest{} }
+func (m *SetProjectIgnoreRequest) String() string { return proto.CompactTextString(m) }
+func (*SetProjectIgnoreRequest) ProtoMessage()    {}
+func (*SetProjectIgnoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{19}
+}
+func (m *SetProjectIgnoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetProjectIgnoreRequest.Unmarshal(m, b)
+}
+func (m *SetProjectIgnoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetProjectIgnoreRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetProjectIgnoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProjectIgnoreRequest.Merge(dst, src)
+}
+func (m *SetProjectIgnoreRequest) XXX_Size() int {
+	return xxx_messageInfo_SetProjectIgnoreRequest.Size(m)
+}
+func (m *SetProjectIgnoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProjectIgnoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProjectIgnoreRequest proto.InternalMessageInfo
+
+func (m *SetProjectIgnoreRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *SetProjectIgnoreRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetProjectIgnoreRequest) GetIgnore() []string {
+	if m != nil {
+		return m.Ignore
+	}
+	return nil
+}
+
+type SetProjectIgnoreResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetProjectIgnoreResponse) Reset()         { *m = SetProjectIgnoreResponse{} }
+func (m *SetProjectIgnoreResponse) String() string { return proto.CompactTextString(m) }
+func (*SetProjectIgnoreResponse) ProtoMessage()    {}
+func (*SetProjectIgnoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{20}
+}
+func (m *SetProjectIgnoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetProjectIgnoreResponse.Unmarshal(m, b)
+}
+func (m *SetProjectIgnoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetProjectIgnoreResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetProjectIgnoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProjectIgnoreResponse.Merge(dst, src)
+}
+func (m *SetProjectIgnoreResponse) XXX_Size() int {
+	return xxx_messageInfo_SetProjectIgnoreResponse.Size(m)
+}
+func (m *SetProjectIgnoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProjectIgnoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProjectIgnoreResponse proto.InternalMessageInfo
+
+type InviteMemberRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Invitee              string   `protobuf:"bytes,3,opt,name=invitee" json:"invitee,omitempty"`
+	Role                 Role     `protobuf:"varint,4,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteMemberRequest) Reset()         { *m = InviteMemberRequest{} }
+func (m *InviteMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteMemberRequest) ProtoMessage()    {}
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{21}
+}
+func (m *InviteMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteMemberRequest.Unmarshal(m, b)
+}
+func (m *InviteMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteMemberRequest.Marshal(b, m, deterministic)
+}
+func (dst *InviteMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteMemberRequest.Merge(dst, src)
+}
+func (m *InviteMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteMemberRequest.Size(m)
+}
+func (m *InviteMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteMemberRequest proto.InternalMessageInfo
+
+func (m *InviteMemberRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *InviteMemberRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *InviteMemberRequest) GetInvitee() string {
+	if m != nil {
+		return m.Invitee
+	}
+	return ""
+}
+
+func (m *InviteMemberRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+type InviteMemberResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteMemberResponse) Reset()         { *m = InviteMemberResponse{} }
+func (m *InviteMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*InviteMemberResponse) ProtoMessage()    {}
+func (*InviteMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{22}
+}
+func (m *InviteMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteMemberResponse.Unmarshal(m, b)
+}
+func (m *InviteMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteMemberResponse.Marshal(b, m, deterministic)
+}
+func (dst *InviteMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteMemberResponse.Merge(dst, src)
+}
+func (m *InviteMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_InviteMemberResponse.Size(m)
+}
+func (m *InviteMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteMemberResponse proto.InternalMessageInfo
+
+func (m *InviteMemberResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CreateInviteLinkRequest struct {
+	Pid  string `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid  string `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Role Role   `protobuf:"varint,3,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	// seconds the link expires in, 0 for never
+	Expire               int64    `protobuf:"varint,4,opt,name=expire" json:"expire,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateInviteLinkRequest) Reset()         { *m = CreateInviteLinkRequest{} }
+func (m *CreateInviteLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInviteLinkRequest) ProtoMessage()    {}
+func (*CreateInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{23}
+}
+func (m *CreateInviteLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInviteLinkRequest.Unmarshal(m, b)
+}
+func (m *CreateInviteLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInviteLinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateInviteLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInviteLinkRequest.Merge(dst, src)
+}
+func (m *CreateInviteLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateInviteLinkRequest.Size(m)
+}
+func (m *CreateInviteLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInviteLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInviteLinkRequest proto.InternalMessageInfo
+
+func (m *CreateInviteLinkRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *CreateInviteLinkRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CreateInviteLinkRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+func (m *CreateInviteLinkRequest) GetExpire() int64 {
+	if m != nil {
+		return m.Expire
+	}
+	return 0
+}
+
+type CreateInviteLinkResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateInviteLinkResponse) Reset()         { *m = CreateInviteLinkResponse{} }
+func (m *CreateInviteLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateInviteLinkResponse) ProtoMessage()    {}
+func (*CreateInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{24}
+}
+func (m *CreateInviteLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInviteLinkResponse.Unmarshal(m, b)
+}
+func (m *CreateInviteLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInviteLinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateInviteLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInviteLinkResponse.Merge(dst, src)
+}
+func (m *CreateInviteLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateInviteLinkResponse.Size(m)
+}
+func (m *CreateInviteLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInviteLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInviteLinkResponse proto.InternalMessageInfo
+
+func (m *CreateInviteLinkResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CreateInviteLinkResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type InviteLink struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	Role  Role   `protobuf:"varint,3,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	// uid and name are of the creator
+	Uid                  string   `protobuf:"bytes,4,opt,name=uid" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt" json:"createdAt,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,7,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteLink) Reset()         { *m = InviteLink{} }
+func (m *InviteLink) String() string { return proto.CompactTextString(m) }
+func (*InviteLink) ProtoMessage()    {}
+func (*InviteLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{25}
+}
+func (m *InviteLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteLink.Unmarshal(m, b)
+}
+func (m *InviteLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteLink.Marshal(b, m, deterministic)
+}
+func (dst *InviteLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteLink.Merge(dst, src)
+}
+func (m *InviteLink) XXX_Size() int {
+	return xxx_messageInfo_InviteLink.Size(m)
+}
+func (m *InviteLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteLink proto.InternalMessageInfo
+
+func (m *InviteLink) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InviteLink) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *InviteLink) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+func (m *InviteLink) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *InviteLink) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InviteLink) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *InviteLink) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type ListInviteLinksRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInviteLinksRequest) Reset()         { *m = ListInviteLinksRequest{} }
+func (m *ListInviteLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInviteLinksRequest) ProtoMessage()    {}
+func (*ListInviteLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{26}
+}
+func (m *ListInviteLinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInviteLinksRequest.Unmarshal(m, b)
+}
+func (m *ListInviteLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInviteLinksRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListInviteLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInviteLinksRequest.Merge(dst, src)
+}
+func (m *ListInviteLinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInviteLinksRequest.Size(m)
+}
+func (m *ListInviteLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInviteLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInviteLinksRequest proto.InternalMessageInfo
+
+func (m *ListInviteLinksRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ListInviteLinksRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ListInviteLinksResponse struct {
+	Links                []*InviteLink `protobuf:"bytes,1,rep,name=links" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListInviteLinksResponse) Reset()         { *m = ListInviteLinksResponse{} }
+func (m *ListInviteLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInviteLinksResponse) ProtoMessage()    {}
+func (*ListInviteLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{27}
+}
+func (m *ListInviteLinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInviteLinksResponse.Unmarshal(m, b)
+}
+func (m *ListInviteLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInviteLinksResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListInviteLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInviteLinksResponse.Merge(dst, src)
+}
+func (m *ListInviteLinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInviteLinksResponse.Size(m)
+}
+func (m *ListInviteLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInviteLinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInviteLinksResponse proto.InternalMessageInfo
+
+func (m *ListInviteLinksResponse) GetLinks() []*InviteLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type RevokeInviteLinkRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInviteLinkRequest) Reset()         { *m = RevokeInviteLinkRequest{} }
+func (m *RevokeInviteLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteLinkRequest) ProtoMessage()    {}
+func (*RevokeInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{28}
+}
+func (m *RevokeInviteLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInviteLinkRequest.Unmarshal(m, b)
+}
+func (m *RevokeInviteLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInviteLinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeInviteLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteLinkRequest.Merge(dst, src)
+}
+func (m *RevokeInviteLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeInviteLinkRequest.Size(m)
+}
+func (m *RevokeInviteLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInviteLinkRequest proto.InternalMessageInfo
+
+func (m *RevokeInviteLinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevokeInviteLinkRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RevokeInviteLinkResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeInviteLinkResponse) Reset()         { *m = RevokeInviteLinkResponse{} }
+func (m *RevokeInviteLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteLinkResponse) ProtoMessage()    {}
+func (*RevokeInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{29}
+}
+func (m *RevokeInviteLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeInviteLinkResponse.Unmarshal(m, b)
+}
+func (m *RevokeInviteLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeInviteLinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeInviteLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteLinkResponse.Merge(dst, src)
+}
+func (m *RevokeInviteLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeInviteLinkResponse.Size(m)
+}
+func (m *RevokeInviteLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeInviteLinkResponse proto.InternalMessageInfo
+
+type JoinByLinkRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinByLinkRequest) Reset()         { *m = JoinByLinkRequest{} }
+func (m *JoinByLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinByLinkRequest) ProtoMessage()    {}
+func (*JoinByLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{30}
+}
+func (m *JoinByLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinByLinkRequest.Unmarshal(m, b)
+}
+func (m *JoinByLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinByLinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *JoinByLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinByLinkRequest.Merge(dst, src)
+}
+func (m *JoinByLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_JoinByLinkRequest.Size(m)
+}
+func (m *JoinByLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinByLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinByLinkRequest proto.InternalMessageInfo
+
+func (m *JoinByLinkRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *JoinByLinkRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type JoinByLinkResponse struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Role                 Role     `protobuf:"varint,2,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinByLinkResponse) Reset()         { *m = JoinByLinkResponse{} }
+func (m *JoinByLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*JoinByLinkResponse) ProtoMessage()    {}
+func (*JoinByLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{31}
+}
+func (m *JoinByLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinByLinkResponse.Unmarshal(m, b)
+}
+func (m *JoinByLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinByLinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *JoinByLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinByLinkResponse.Merge(dst, src)
+}
+func (m *JoinByLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_JoinByLinkResponse.Size(m)
+}
+func (m *JoinByLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinByLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinByLinkResponse proto.InternalMessageInfo
+
+func (m *JoinByLinkResponse) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *JoinByLinkResponse) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+type Invitation struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Pid                  string   `protobuf:"bytes,2,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,3,opt,name=uid" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Role                 Role     `protobuf:"varint,5,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Invitation) Reset()         { *m = Invitation{} }
+func (m *Invitation) String() string { return proto.CompactTextString(m) }
+func (*Invitation) ProtoMessage()    {}
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{32}
+}
+func (m *Invitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invitation.Unmarshal(m, b)
+}
+func (m *Invitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invitation.Marshal(b, m, deterministic)
+}
+func (dst *Invitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invitation.Merge(dst, src)
+}
+func (m *Invitation) XXX_Size() int {
+	return xxx_messageInfo_Invitation.Size(m)
+}
+func (m *Invitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Invitation proto.InternalMessageInfo
+
+func (m *Invitation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Invitation) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *Invitation) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Invitation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Invitation) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+func (m *Invitation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ListInvitationsRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInvitationsRequest) Reset()         { *m = ListInvitationsRequest{} }
+func (m *ListInvitationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInvitationsRequest) ProtoMessage()    {}
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{33}
+}
+func (m *ListInvitationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvitationsRequest.Unmarshal(m, b)
+}
+func (m *ListInvitationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvitationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListInvitationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitationsRequest.Merge(dst, src)
+}
+func (m *ListInvitationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInvitationsRequest.Size(m)
+}
+func (m *ListInvitationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitationsRequest proto.InternalMessageInfo
+
+func (m *ListInvitationsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	// uid and name are of the inviter
+	Invitations          []*Invitation `protobuf:"bytes,1,rep,name=invitations" json:"invitations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListInvitationsResponse) Reset()         { *m = ListInvitationsResponse{} }
+func (m *ListInvitationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInvitationsResponse) ProtoMessage()    {}
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{34}
+}
+func (m *ListInvitationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvitationsResponse.Unmarshal(m, b)
+}
+func (m *ListInvitationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvitationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListInvitationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitationsResponse.Merge(dst, src)
+}
+func (m *ListInvitationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInvitationsResponse.Size(m)
+}
+func (m *ListInvitationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitationsResponse proto.InternalMessageInfo
+
+func (m *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if m != nil {
+		return m.Invitations
+	}
+	return nil
+}
+
+type ReplyInvitationRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Accept               bool     `protobuf:"varint,3,opt,name=accept" json:"accept,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyInvitationRequest) Reset()         { *m = ReplyInvitationRequest{} }
+func (m *ReplyInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplyInvitationRequest) ProtoMessage()    {}
+func (*ReplyInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{35}
+}
+func (m *ReplyInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyInvitationRequest.Unmarshal(m, b)
+}
+func (m *ReplyInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyInvitationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReplyInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyInvitationRequest.Merge(dst, src)
+}
+func (m *ReplyInvitationRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplyInvitationRequest.Size(m)
+}
+func (m *ReplyInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyInvitationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyInvitationRequest proto.InternalMessageInfo
+
+func (m *ReplyInvitationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReplyInvitationRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReplyInvitationRequest) GetAccept() bool {
+	if m != nil {
+		return m.Accept
+	}
+	return false
+}
+
+type ReplyInvitationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyInvitationResponse) Reset()         { *m = ReplyInvitationResponse{} }
+func (m *ReplyInvitationResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplyInvitationResponse) ProtoMessage()    {}
+func (*ReplyInvitationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{36}
+}
+func (m *ReplyInvitationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyInvitationResponse.Unmarshal(m, b)
+}
+func (m *ReplyInvitationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyInvitationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReplyInvitationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyInvitationResponse.Merge(dst, src)
+}
+func (m *ReplyInvitationResponse) XXX_Size() int {
+	return xxx_messageInfo_ReplyInvitationResponse.Size(m)
+}
+func (m *ReplyInvitationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyInvitationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyInvitationResponse proto.InternalMessageInfo
+
+type RequestJoinRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestJoinRequest) Reset()         { *m = RequestJoinRequest{} }
+func (m *RequestJoinRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestJoinRequest) ProtoMessage()    {}
+func (*RequestJoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{37}
+}
+func (m *RequestJoinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestJoinRequest.Unmarshal(m, b)
+}
+func (m *RequestJoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestJoinRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestJoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestJoinRequest.Merge(dst, src)
+}
+func (m *RequestJoinRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestJoinRequest.Size(m)
+}
+func (m *RequestJoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestJoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestJoinRequest proto.InternalMessageInfo
+
+func (m *RequestJoinRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *RequestJoinRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RequestJoinResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestJoinResponse) Reset()         { *m = RequestJoinResponse{} }
+func (m *RequestJoinResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestJoinResponse) ProtoMessage()    {}
+func (*RequestJoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{38}
+}
+func (m *RequestJoinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestJoinResponse.Unmarshal(m, b)
+}
+func (m *RequestJoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestJoinResponse.Marshal(b, m, deterministic)
+}
+func (dst *RequestJoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestJoinResponse.Merge(dst, src)
+}
+func (m *RequestJoinResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestJoinResponse.Size(m)
+}
+func (m *RequestJoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestJoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestJoinResponse proto.InternalMessageInfo
+
+func (m *RequestJoinResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListJoinRequestsRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListJoinRequestsRequest) Reset()         { *m = ListJoinRequestsRequest{} }
+func (m *ListJoinRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJoinRequestsRequest) ProtoMessage()    {}
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{39}
+}
+func (m *ListJoinRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJoinRequestsRequest.Unmarshal(m, b)
+}
+func (m *ListJoinRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJoinRequestsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListJoinRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJoinRequestsRequest.Merge(dst, src)
+}
+func (m *ListJoinRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJoinRequestsRequest.Size(m)
+}
+func (m *ListJoinRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJoinRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJoinRequestsRequest proto.InternalMessageInfo
+
+func (m *ListJoinRequestsRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ListJoinRequestsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ListJoinRequestsResponse struct {
+	// uid and name are of the requester
+	Requests             []*Invitation `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListJoinRequestsResponse) Reset()         { *m = ListJoinRequestsResponse{} }
+func (m *ListJoinRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJoinRequestsResponse) ProtoMessage()    {}
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{40}
+}
+func (m *ListJoinRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJoinRequestsResponse.Unmarshal(m, b)
+}
+func (m *ListJoinRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJoinRequestsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListJoinRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJoinRequestsResponse.Merge(dst, src)
+}
+func (m *ListJoinRequestsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListJoinRequestsResponse.Size(m)
+}
+func (m *ListJoinRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJoinRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJoinRequestsResponse proto.InternalMessageInfo
+
+func (m *ListJoinRequestsResponse) GetRequests() []*Invitation {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type ReviewJoinRequestRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Approve              bool     `protobuf:"varint,3,opt,name=approve" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewJoinRequestRequest) Reset()         { *m = ReviewJoinRequestRequest{} }
+func (m *ReviewJoinRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*ReviewJoinRequestRequest) ProtoMessage()    {}
+func (*ReviewJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{41}
+}
+func (m *ReviewJoinRequestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewJoinRequestRequest.Unmarshal(m, b)
+}
+func (m *ReviewJoinRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewJoinRequestRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReviewJoinRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewJoinRequestRequest.Merge(dst, src)
+}
+func (m *ReviewJoinRequestRequest) XXX_Size() int {
+	return xxx_messageInfo_ReviewJoinRequestRequest.Size(m)
+}
+func (m *ReviewJoinRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewJoinRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewJoinRequestRequest proto.InternalMessageInfo
+
+func (m *ReviewJoinRequestRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReviewJoinRequestRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReviewJoinRequestRequest) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type ReviewJoinRequestResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewJoinRequestResponse) Reset()         { *m = ReviewJoinRequestResponse{} }
+func (m *ReviewJoinRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*ReviewJoinRequestResponse) ProtoMessage()    {}
+func (*ReviewJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{42}
+}
+func (m *ReviewJoinRequestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewJoinRequestResponse.Unmarshal(m, b)
+}
+func (m *ReviewJoinRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewJoinRequestResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReviewJoinRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewJoinRequestResponse.Merge(dst, src)
+}
+func (m *ReviewJoinRequestResponse) XXX_Size() int {
+	return xxx_messageInfo_ReviewJoinRequestResponse.Size(m)
+}
+func (m *ReviewJoinRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewJoinRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewJoinRequestResponse proto.InternalMessageInfo
+
+type Member struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Role                 Role     `protobuf:"varint,3,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{43}
+}
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Member.Unmarshal(m, b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+}
+func (dst *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(dst, src)
+}
+func (m *Member) XXX_Size() int {
+	return xxx_messageInfo_Member.Size(m)
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Member) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Member) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+func (m *Member) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ListMembersRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMembersRequest) Reset()         { *m = ListMembersRequest{} }
+func (m *ListMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMembersRequest) ProtoMessage()    {}
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{44}
+}
+func (m *ListMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMembersRequest.Unmarshal(m, b)
+}
+func (m *ListMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMembersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMembersRequest.Merge(dst, src)
+}
+func (m *ListMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMembersRequest.Size(m)
+}
+func (m *ListMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMembersRequest proto.InternalMessageInfo
+
+func (m *ListMembersRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ListMembersRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ListMembersResponse struct {
+	Members              []*Member `protobuf:"bytes,1,rep,name=members" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListMembersResponse) Reset()         { *m = ListMembersResponse{} }
+func (m *ListMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMembersResponse) ProtoMessage()    {}
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{45}
+}
+func (m *ListMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMembersResponse.Unmarshal(m, b)
+}
+func (m *ListMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMembersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMembersResponse.Merge(dst, src)
+}
+func (m *ListMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMembersResponse.Size(m)
+}
+func (m *ListMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMembersResponse proto.InternalMessageInfo
+
+func (m *ListMembersResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type RemoveMemberRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Member               string   `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveMemberRequest) Reset()         { *m = RemoveMemberRequest{} }
+func (m *RemoveMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveMemberRequest) ProtoMessage()    {}
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{46}
+}
+func (m *RemoveMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMemberRequest.Unmarshal(m, b)
+}
+func (m *RemoveMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMemberRequest.Marshal(b, m, deterministic)
+}
+func (dst *RemoveMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMemberRequest.Merge(dst, src)
+}
+func (m *RemoveMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveMemberRequest.Size(m)
+}
+func (m *RemoveMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMemberRequest proto.InternalMessageInfo
+
+func (m *RemoveMemberRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *RemoveMemberRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RemoveMemberRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+type RemoveMemberResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveMemberResponse) Reset()         { *m = RemoveMemberResponse{} }
+func (m *RemoveMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveMemberResponse) ProtoMessage()    {}
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{47}
+}
+func (m *RemoveMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMemberResponse.Unmarshal(m, b)
+}
+func (m *RemoveMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMemberResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemoveMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMemberResponse.Merge(dst, src)
+}
+func (m *RemoveMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveMemberResponse.Size(m)
+}
+func (m *RemoveMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMemberResponse proto.InternalMessageInfo
+
+type SetMemberRoleRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Member               string   `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	Role                 Role     `protobuf:"varint,4,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetMemberRoleRequest) Reset()         { *m = SetMemberRoleRequest{} }
+func (m *SetMemberRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMemberRoleRequest) ProtoMessage()    {}
+func (*SetMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{48}
+}
+func (m *SetMemberRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMemberRoleRequest.Unmarshal(m, b)
+}
+func (m *SetMemberRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMemberRoleRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetMemberRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMemberRoleRequest.Merge(dst, src)
+}
+func (m *SetMemberRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMemberRoleRequest.Size(m)
+}
+func (m *SetMemberRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMemberRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMemberRoleRequest proto.InternalMessageInfo
+
+func (m *SetMemberRoleRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *SetMemberRoleRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetMemberRoleRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *SetMemberRoleRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+type SetMemberRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetMemberRoleResponse) Reset()         { *m = SetMemberRoleResponse{} }
+func (m *SetMemberRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetMemberRoleResponse) ProtoMessage()    {}
+func (*SetMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{49}
+}
+func (m *SetMemberRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMemberRoleResponse.Unmarshal(m, b)
+}
+func (m *SetMemberRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMemberRoleResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetMemberRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMemberRoleResponse.Merge(dst, src)
+}
+func (m *SetMemberRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetMemberRoleResponse.Size(m)
+}
+func (m *SetMemberRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMemberRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMemberRoleResponse proto.InternalMessageInfo
+
+type SetProjectVisibilityRequest struct {
+	Pid                  string     `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string     `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Visibility           Visibility `protobuf:"varint,3,opt,name=visibility,enum=project.Visibility" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetProjectVisibilityRequest) Reset()         { *m = SetProjectVisibilityRequest{} }
+func (m *SetProjectVisibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetProjectVisibilityRequest) ProtoMessage()    {}
+func (*SetProjectVisibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{50}
+}
+func (m *SetProjectVisibilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetProjectVisibilityRequest.Unmarshal(m, b)
+}
+func (m *SetProjectVisibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetProjectVisibilityRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetProjectVisibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProjectVisibilityRequest.Merge(dst, src)
+}
+func (m *SetProjectVisibilityRequest) XXX_Size() int {
+	return xxx_messageInfo_SetProjectVisibilityRequest.Size(m)
+}
+func (m *SetProjectVisibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProjectVisibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProjectVisibilityRequest proto.InternalMessageInfo
+
+func (m *SetProjectVisibilityRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *SetProjectVisibilityRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetProjectVisibilityRequest) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return Visibility_Public
+}
+
+type SetProjectVisibilityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetProjectVisibilityResponse) Reset()         { *m = SetProjectVisibilityResponse{} }
+func (m *SetProjectVisibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*SetProjectVisibilityResponse) ProtoMessage()    {}
+func (*SetProjectVisibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{51}
+}
+func (m *SetProjectVisibilityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetProjectVisibilityResponse.Unmarshal(m, b)
+}
+func (m *SetProjectVisibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetProjectVisibilityResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetProjectVisibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProjectVisibilityResponse.Merge(dst, src)
+}
+func (m *SetProjectVisibilityResponse) XXX_Size() int {
+	return xxx_messageInfo_SetProjectVisibilityResponse.Size(m)
+}
+func (m *SetProjectVisibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProjectVisibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProjectVisibilityResponse proto.InternalMessageInfo
+
+type ProjectAccessRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectAccessRequest) Reset()         { *m = ProjectAccessRequest{} }
+func (m *ProjectAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectAccessRequest) ProtoMessage()    {}
+func (*ProjectAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{52}
+}
+func (m *ProjectAccessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectAccessRequest.Unmarshal(m, b)
+}
+func (m *ProjectAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectAccessRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProjectAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectAccessRequest.Merge(dst, src)
+}
+func (m *ProjectAccessRequest) XXX_Size() int {
+	return xxx_messageInfo_ProjectAccessRequest.Size(m)
+}
+func (m *ProjectAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectAccessRequest proto.InternalMessageInfo
+
+func (m *ProjectAccessRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ProjectAccessRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ProjectAccessResponse struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	Role                 Role     `protobuf:"varint,3,opt,name=role,enum=project.Role" json:"role,omitempty"`
+	Ignore               []string `protobuf:"bytes,4,rep,name=ignore" json:"ignore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectAccessResponse) Reset()         { *m = ProjectAccessResponse{} }
+func (m *ProjectAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectAccessResponse) ProtoMessage()    {}
+func (*ProjectAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{53}
+}
+func (m *ProjectAccessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectAccessResponse.Unmarshal(m, b)
+}
+func (m *ProjectAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectAccessResponse.Marshal(b, m, deterministic)
+}
+func (dst *ProjectAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectAccessResponse.Merge(dst, src)
+}
+func (m *ProjectAccessResponse) XXX_Size() int {
+	return xxx_messageInfo_ProjectAccessResponse.Size(m)
+}
+func (m *ProjectAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectAccessResponse proto.InternalMessageInfo
+
+func (m *ProjectAccessResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ProjectAccessResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ProjectAccessResponse) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_RoleNone
+}
+
+func (m *ProjectAccessResponse) GetIgnore() []string {
+	if m != nil {
+		return m.Ignore
+	}
+	return nil
+}
+
+type UpdateAnnotationRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Annotation           string   `protobuf:"bytes,3,opt,name=annotation" json:"annotation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAnnotationRequest) Reset()         { *m = UpdateAnnotationRequest{} }
+func (m *UpdateAnnotationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAnnotationRequest) ProtoMessage()    {}
+func (*UpdateAnnotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{54}
+}
+func (m *UpdateAnnotationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAnnotationRequest.Unmarshal(m, b)
+}
+func (m *UpdateAnnotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAnnotationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAnnotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAnnotationRequest.Merge(dst, src)
+}
+func (m *UpdateAnnotationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAnnotationRequest.Size(m)
+}
+func (m *UpdateAnnotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAnnotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAnnotationRequest proto.InternalMessageInfo
+
+func (m *UpdateAnnotationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateAnnotationRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UpdateAnnotationRequest) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+type UpdateAnnotationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAnnotationResponse) Reset()         { *m = UpdateAnnotationResponse{} }
+func (m *UpdateAnnotationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAnnotationResponse) ProtoMessage()    {}
+func (*UpdateAnnotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{55}
+}
+func (m *UpdateAnnotationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAnnotationResponse.Unmarshal(m, b)
+}
+func (m *UpdateAnnotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAnnotationResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAnnotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAnnotationResponse.Merge(dst, src)
+}
+func (m *UpdateAnnotationResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateAnnotationResponse.Size(m)
+}
+func (m *UpdateAnnotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAnnotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAnnotationResponse proto.InternalMessageInfo
+
+type DeleteAnnotationRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAnnotationRequest) Reset()         { *m = DeleteAnnotationRequest{} }
+func (m *DeleteAnnotationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAnnotationRequest) ProtoMessage()    {}
+func (*DeleteAnnotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{56}
+}
+func (m *DeleteAnnotationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAnnotationRequest.Unmarshal(m, b)
+}
+func (m *DeleteAnnotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAnnotationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAnnotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAnnotationRequest.Merge(dst, src)
+}
+func (m *DeleteAnnotationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAnnotationRequest.Size(m)
+}
+func (m *DeleteAnnotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAnnotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAnnotationRequest proto.InternalMessageInfo
+
+func (m *DeleteAnnotationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteAnnotationRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DeleteAnnotationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAnnotationResponse) Reset()         { *m = DeleteAnnotationResponse{} }
+func (m *DeleteAnnotationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAnnotationResponse) ProtoMessage()    {}
+func (*DeleteAnnotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{57}
+}
+func (m *DeleteAnnotationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAnnotationResponse.Unmarshal(m, b)
+}
+func (m *DeleteAnnotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAnnotationResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAnnotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAnnotationResponse.Merge(dst, src)
+}
+func (m *DeleteAnnotationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAnnotationResponse.Size(m)
+}
+func (m *DeleteAnnotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAnnotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAnnotationResponse proto.InternalMessageInfo
+
+type GetAnnotationRevisionsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAnnotationRevisionsRequest) Reset()         { *m = GetAnnotationRevisionsRequest{} }
+func (m *GetAnnotationRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAnnotationRevisionsRequest) ProtoMessage()    {}
+func (*GetAnnotationRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{58}
+}
+func (m *GetAnnotationRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAnnotationRevisionsRequest.Unmarshal(m, b)
+}
+func (m *GetAnnotationRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAnnotationRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAnnotationRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAnnotationRevisionsRequest.Merge(dst, src)
+}
+func (m *GetAnnotationRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAnnotationRevisionsRequest.Size(m)
+}
+func (m *GetAnnotationRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAnnotationRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAnnotationRevisionsRequest proto.InternalMessageInfo
+
+func (m *GetAnnotationRevisionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetAnnotationRevisionsRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type GetAnnotationRevisionsResponse struct {
+	// oldest first, the current one not included
+	Revisions            []*AnnotationRevision `protobuf:"bytes,1,rep,name=revisions" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetAnnotationRevisionsResponse) Reset()         { *m = GetAnnotationRevisionsResponse{} }
+func (m *GetAnnotationRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAnnotationRevisionsResponse) ProtoMessage()    {}
+func (*GetAnnotationRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{59}
+}
+func (m *GetAnnotationRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAnnotationRevisionsResponse.Unmarshal(m, b)
+}
+func (m *GetAnnotationRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAnnotationRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAnnotationRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAnnotationRevisionsResponse.Merge(dst, src)
+}
+func (m *GetAnnotationRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAnnotationRevisionsResponse.Size(m)
+}
+func (m *GetAnnotationRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAnnotationRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAnnotationRevisionsResponse proto.InternalMessageInfo
+
+func (m *GetAnnotationRevisionsResponse) GetRevisions() []*AnnotationRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type AnnotationRevision struct {
+	Annotation           string   `protobuf:"bytes,1,opt,name=annotation" json:"annotation,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,2,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnnotationRevision) Reset()         { *m = AnnotationRevision{} }
+func (m *AnnotationRevision) String() string { return proto.CompactTextString(m) }
+func (*AnnotationRevision) ProtoMessage()    {}
+func (*AnnotationRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{60}
+}
+func (m *AnnotationRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationRevision.Unmarshal(m, b)
+}
+func (m *AnnotationRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationRevision.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationRevision.Merge(dst, src)
+}
+func (m *AnnotationRevision) XXX_Size() int {
+	return xxx_messageInfo_AnnotationRevision.Size(m)
+}
+func (m *AnnotationRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationRevision proto.InternalMessageInfo
+
+func (m *AnnotationRevision) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *AnnotationRevision) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type ResolveAnnotationRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Resolved             bool     `protobuf:"varint,3,opt,name=resolved" json:"resolved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveAnnotationRequest) Reset()         { *m = ResolveAnnotationRequest{} }
+func (m *ResolveAnnotationRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveAnnotationRequest) ProtoMessage()    {}
+func (*ResolveAnnotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{61}
+}
+func (m *ResolveAnnotationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveAnnotationRequest.Unmarshal(m, b)
+}
+func (m *ResolveAnnotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveAnnotationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResolveAnnotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveAnnotationRequest.Merge(dst, src)
+}
+func (m *ResolveAnnotationRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveAnnotationRequest.Size(m)
+}
+func (m *ResolveAnnotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveAnnotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveAnnotationRequest proto.InternalMessageInfo
+
+func (m *ResolveAnnotationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResolveAnnotationRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ResolveAnnotationRequest) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
+}
+
+type ResolveAnnotationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveAnnotationResponse) Reset()         { *m = ResolveAnnotationResponse{} }
+func (m *ResolveAnnotationResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveAnnotationResponse) ProtoMessage()    {}
+func (*ResolveAnnotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{62}
+}
+func (m *ResolveAnnotationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveAnnotationResponse.Unmarshal(m, b)
+}
+func (m *ResolveAnnotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveAnnotationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ResolveAnnotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveAnnotationResponse.Merge(dst, src)
+}
+func (m *ResolveAnnotationResponse) XXX_Size() int {
+	return xxx_messageInfo_ResolveAnnotationResponse.Size(m)
+}
+func (m *ResolveAnnotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveAnnotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveAnnotationResponse proto.InternalMessageInfo
+
+type ReactAnnotationRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Uid   string `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	Emoji string `protobuf:"bytes,3,opt,name=emoji" json:"emoji,omitempty"`
+	// remove the reaction if true
+	Remove               bool     `protobuf:"varint,4,opt,name=remove" json:"remove,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReactAnnotationRequest) Reset()         { *m = ReactAnnotationRequest{} }
+func (m *ReactAnnotationRequest) String() string { return proto.CompactTextString(m) }
+func (*ReactAnnotationRequest) ProtoMessage()    {}
+func (*ReactAnnotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{63}
+}
+func (m *ReactAnnotationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactAnnotationRequest.Unmarshal(m, b)
+}
+func (m *ReactAnnotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactAnnotationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReactAnnotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactAnnotationRequest.Merge(dst, src)
+}
+func (m *ReactAnnotationRequest) XXX_Size() int {
+	return xxx_messageInfo_ReactAnnotationRequest.Size(m)
+}
+func (m *ReactAnnotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactAnnotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactAnnotationRequest proto.InternalMessageInfo
+
+func (m *ReactAnnotationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReactAnnotationRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ReactAnnotationRequest) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ReactAnnotationRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+type ReactAnnotationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReactAnnotationResponse) Reset()         { *m = ReactAnnotationResponse{} }
+func (m *ReactAnnotationResponse) String() string { return proto.CompactTextString(m) }
+func (*ReactAnnotationResponse) ProtoMessage()    {}
+func (*ReactAnnotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{64}
+}
+func (m *ReactAnnotationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactAnnotationResponse.Unmarshal(m, b)
+}
+func (m *ReactAnnotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactAnnotationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReactAnnotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactAnnotationResponse.Merge(dst, src)
+}
+func (m *ReactAnnotationResponse) XXX_Size() int {
+	return xxx_messageInfo_ReactAnnotationResponse.Size(m)
+}
+func (m *ReactAnnotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactAnnotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactAnnotationResponse proto.InternalMessageInfo
+
+type RebaseProjectRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebaseProjectRequest) Reset()         { *m = RebaseProjectRequest{} }
+func (m *RebaseProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*RebaseProjectRequest) ProtoMessage()    {}
+func (*RebaseProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{65}
+}
+func (m *RebaseProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebaseProjectRequest.Unmarshal(m, b)
+}
+func (m *RebaseProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebaseProjectRequest.Marshal(b, m, deterministic)
+}
+func (dst *RebaseProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebaseProjectRequest.Merge(dst, src)
+}
+func (m *RebaseProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_RebaseProjectRequest.Size(m)
+}
+func (m *RebaseProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebaseProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebaseProjectRequest proto.InternalMessageInfo
+
+func (m *RebaseProjectRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *RebaseProjectRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type RebaseProjectResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
+	// number of threads moved to the new commit
+	Moved int32 `protobuf:"varint,2,opt,name=moved" json:"moved,omitempty"`
+	// number of threads marked outdated
+	Outdated             int32    `protobuf:"varint,3,opt,name=outdated" json:"outdated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebaseProjectResponse) Reset()         { *m = RebaseProjectResponse{} }
+func (m *RebaseProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*RebaseProjectResponse) ProtoMessage()    {}
+func (*RebaseProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{66}
+}
+func (m *RebaseProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebaseProjectResponse.Unmarshal(m, b)
+}
+func (m *RebaseProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebaseProjectResponse.Marshal(b, m, deterministic)
+}
+func (dst *RebaseProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebaseProjectResponse.Merge(dst, src)
+}
+func (m *RebaseProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_RebaseProjectResponse.Size(m)
+}
+func (m *RebaseProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebaseProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebaseProjectResponse proto.InternalMessageInfo
+
+func (m *RebaseProjectResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *RebaseProjectResponse) GetMoved() int32 {
+	if m != nil {
+		return m.Moved
+	}
+	return 0
+}
+
+func (m *RebaseProjectResponse) GetOutdated() int32 {
+	if m != nil {
+		return m.Outdated
+	}
+	return 0
+}
+
+type UserActivityRequest struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	// activities before this timestamp, 0 for the newest
+	Before               int64    `protobuf:"varint,2,opt,name=before" json:"before,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserActivityRequest) Reset()         { *m = UserActivityRequest{} }
+func (m *UserActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*UserActivityRequest) ProtoMessage()    {}
+func (*UserActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{67}
+}
+func (m *UserActivityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserActivityRequest.Unmarshal(m, b)
+}
+func (m *UserActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserActivityRequest.Marshal(b, m, deterministic)
+}
+func (dst *UserActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserActivityRequest.Merge(dst, src)
+}
+func (m *UserActivityRequest) XXX_Size() int {
+	return xxx_messageInfo_UserActivityRequest.Size(m)
+}
+func (m *UserActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserActivityRequest proto.InternalMessageInfo
+
+func (m *UserActivityRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UserActivityRequest) GetBefore() int64 {
+	if m != nil {
+		return m.Before
+	}
+	return 0
+}
+
+func (m *UserActivityRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Activity struct {
+	// "project" or "annotation"
+	Kind    string       `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"`
+	Project *ProjectInfo `protobuf:"bytes,2,opt,name=project" json:"project,omitempty"`
+	// the annotation written, empty for projects
+	AnnotationId string `protobuf:"bytes,3,opt,name=annotationId" json:"annotationId,omitempty"`
+	// thread the annotation replies to, empty for threads
+	Parent               string           `protobuf:"bytes,4,opt,name=parent" json:"parent,omitempty"`
+	File                 string           `protobuf:"bytes,5,opt,name=file" json:"file,omitempty"`
+	Range                *AnnotationRange `protobuf:"bytes,6,opt,name=range" json:"range,omitempty"`
+	Brief                string           `protobuf:"bytes,7,opt,name=brief" json:"brief,omitempty"`
+	CreatedAt            int64            `protobuf:"varint,8,opt,name=createdAt" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Activity) Reset()         { *m = Activity{} }
+func (m *Activity) String() string { return proto.CompactTextString(m) }
+func (*Activity) ProtoMessage()    {}
+func (*Activity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{68}
+}
+func (m *Activity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Activity.Unmarshal(m, b)
+}
+func (m *Activity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Activity.Marshal(b, m, deterministic)
+}
+func (dst *Activity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Activity.Merge(dst, src)
+}
+func (m *Activity) XXX_Size() int {
+	return xxx_messageInfo_Activity.Size(m)
+}
+func (m *Activity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Activity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Activity proto.InternalMessageInfo
+
+func (m *Activity) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Activity) GetProject() *ProjectInfo {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+func (m *Activity) GetAnnotationId() string {
+	if m != nil {
+		return m.AnnotationId
+	}
+	return ""
+}
+
+func (m *Activity) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *Activity) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *Activity) GetRange() *AnnotationRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *Activity) GetBrief() string {
+	if m != nil {
+		return m.Brief
+	}
+	return ""
+}
+
+func (m *Activity) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type UserActivityResponse struct {
+	Activities []*Activity `protobuf:"bytes,1,rep,name=activities" json:"activities,omitempty"`
+	// before of the next page, 0 if there is no more activity
+	Next                 int64    `protobuf:"varint,2,opt,name=next" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserActivityResponse) Reset()         { *m = UserActivityResponse{} }
+func (m *UserActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*UserActivityResponse) ProtoMessage()    {}
+func (*UserActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{69}
+}
+func (m *UserActivityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserActivityResponse.Unmarshal(m, b)
+}
+func (m *UserActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserActivityResponse.Marshal(b, m, deterministic)
+}
+func (dst *UserActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserActivityResponse.Merge(dst, src)
+}
+func (m *UserActivityResponse) XXX_Size() int {
+	return xxx_messageInfo_UserActivityResponse.Size(m)
+}
+func (m *UserActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserActivityResponse proto.InternalMessageInfo
+
+func (m *UserActivityResponse) GetActivities() []*Activity {
+	if m != nil {
+		return m.Activities
+	}
+	return nil
+}
+
+func (m *UserActivityResponse) GetNext() int64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+type AdminDeleteProjectRequest struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminDeleteProjectRequest) Reset()         { *m = AdminDeleteProjectRequest{} }
+func (m *AdminDeleteProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminDeleteProjectRequest) ProtoMessage()    {}
+func (*AdminDeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{70}
+}
+func (m *AdminDeleteProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminDeleteProjectRequest.Unmarshal(m, b)
+}
+func (m *AdminDeleteProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminDeleteProjectRequest.Marshal(b, m, deterministic)
+}
+func (dst *AdminDeleteProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDeleteProjectRequest.Merge(dst, src)
+}
+func (m *AdminDeleteProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_AdminDeleteProjectRequest.Size(m)
+}
+func (m *AdminDeleteProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDeleteProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDeleteProjectRequest proto.InternalMessageInfo
+
+func (m *AdminDeleteProjectRequest) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+type AdminDeleteProjectResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminDeleteProjectResponse) Reset()         { *m = AdminDeleteProjectResponse{} }
+func (m *AdminDeleteProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminDeleteProjectResponse) ProtoMessage()    {}
+func (*AdminDeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{71}
+}
+func (m *AdminDeleteProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminDeleteProjectResponse.Unmarshal(m, b)
+}
+func (m *AdminDeleteProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminDeleteProjectResponse.Marshal(b, m, deterministic)
+}
+func (dst *AdminDeleteProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDeleteProjectResponse.Merge(dst, src)
+}
+func (m *AdminDeleteProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_AdminDeleteProjectResponse.Size(m)
+}
+func (m *AdminDeleteProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDeleteProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDeleteProjectResponse proto.InternalMessageInfo
+
+type AdminDeleteAnnotationRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminDeleteAnnotationRequest) Reset()         { *m = AdminDeleteAnnotationRequest{} }
+func (m *AdminDeleteAnnotationRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminDeleteAnnotationRequest) ProtoMessage()    {}
+func (*AdminDeleteAnnotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{72}
+}
+func (m *AdminDeleteAnnotationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminDeleteAnnotationRequest.Unmarshal(m, b)
+}
+func (m *AdminDeleteAnnotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminDeleteAnnotationRequest.Marshal(b, m, deterministic)
+}
+func (dst *AdminDeleteAnnotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDeleteAnnotationRequest.Merge(dst, src)
+}
+func (m *AdminDeleteAnnotationRequest) XXX_Size() int {
+	return xxx_messageInfo_AdminDeleteAnnotationRequest.Size(m)
+}
+func (m *AdminDeleteAnnotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDeleteAnnotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDeleteAnnotationRequest proto.InternalMessageInfo
+
+func (m *AdminDeleteAnnotationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type AdminDeleteAnnotationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminDeleteAnnotationResponse) Reset()         { *m = AdminDeleteAnnotationResponse{} }
+func (m *AdminDeleteAnnotationResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminDeleteAnnotationResponse) ProtoMessage()    {}
+func (*AdminDeleteAnnotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{73}
+}
+func (m *AdminDeleteAnnotationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminDeleteAnnotationResponse.Unmarshal(m, b)
+}
+func (m *AdminDeleteAnnotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminDeleteAnnotationResponse.Marshal(b, m, deterministic)
+}
+func (dst *AdminDeleteAnnotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminDeleteAnnotationResponse.Merge(dst, src)
+}
+func (m *AdminDeleteAnnotationResponse) XXX_Size() int {
+	return xxx_messageInfo_AdminDeleteAnnotationResponse.Size(m)
+}
+func (m *AdminDeleteAnnotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminDeleteAnnotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminDeleteAnnotationResponse proto.InternalMessageInfo
+
+type ExportUserDataRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUserDataRequest) Reset()         { *m = ExportUserDataRequest{} }
+func (m *ExportUserDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataRequest) ProtoMessage()    {}
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{74}
+}
+func (m *ExportUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUserDataRequest.Unmarshal(m, b)
+}
+func (m *ExportUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUserDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataRequest.Merge(dst, src)
+}
+func (m *ExportUserDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportUserDataRequest.Size(m)
+}
+func (m *ExportUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUserDataRequest proto.InternalMessageInfo
+
+func (m *ExportUserDataRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type ExportedAnnotation struct {
+	Id  string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Pid string `protobuf:"bytes,2,opt,name=pid" json:"pid,omitempty"`
+	// thread the annotation replies to, empty for threads
+	Parent               string           `protobuf:"bytes,3,opt,name=parent" json:"parent,omitempty"`
+	File                 string           `protobuf:"bytes,4,opt,name=file" json:"file,omitempty"`
+	Hash                 string           `protobuf:"bytes,5,opt,name=hash" json:"hash,omitempty"`
+	Range                *AnnotationRange `protobuf:"bytes,6,opt,name=range" json:"range,omitempty"`
+	Annotation           string           `protobuf:"bytes,7,opt,name=annotation" json:"annotation,omitempty"`
+	Resolved             bool             `protobuf:"varint,8,opt,name=resolved" json:"resolved,omitempty"`
+	Outdated             bool             `protobuf:"varint,9,opt,name=outdated" json:"outdated,omitempty"`
+	CreatedAt            int64            `protobuf:"varint,10,opt,name=createdAt" json:"createdAt,omitempty"`
+	UpdatedAt            int64            `protobuf:"varint,11,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportedAnnotation) Reset()         { *m = ExportedAnnotation{} }
+func (m *ExportedAnnotation) String() string { return proto.CompactTextString(m) }
+func (*ExportedAnnotation) ProtoMessage()    {}
+func (*ExportedAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{75}
+}
+func (m *ExportedAnnotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportedAnnotation.Unmarshal(m, b)
+}
+func (m *ExportedAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportedAnnotation.Marshal(b, m, deterministic)
+}
+func (dst *ExportedAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedAnnotation.Merge(dst, src)
+}
+func (m *ExportedAnnotation) XXX_Size() int {
+	return xxx_messageInfo_ExportedAnnotation.Size(m)
+}
+func (m *ExportedAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedAnnotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportedAnnotation proto.InternalMessageInfo
+
+func (m *ExportedAnnotation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportedAnnotation) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ExportedAnnotation) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *ExportedAnnotation) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *ExportedAnnotation) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ExportedAnnotation) GetRange() *AnnotationRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *ExportedAnnotation) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *ExportedAnnotation) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
+}
+
+func (m *ExportedAnnotation) GetOutdated() bool {
+	if m != nil {
+		return m.Outdated
+	}
+	return false
+}
+
+func (m *ExportedAnnotation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ExportedAnnotation) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type ExportUserDataResponse struct {
+	Projects []*ProjectInfo `protobuf:"bytes,1,rep,name=projects" json:"projects,omitempty"`
+	// newest first
+	Annotations          []*ExportedAnnotation `protobuf:"bytes,2,rep,name=annotations" json:"annotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ExportUserDataResponse) Reset()         { *m = ExportUserDataResponse{} }
+func (m *ExportUserDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataResponse) ProtoMessage()    {}
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{76}
+}
+func (m *ExportUserDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUserDataResponse.Unmarshal(m, b)
+}
+func (m *ExportUserDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUserDataResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportUserDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataResponse.Merge(dst, src)
+}
+func (m *ExportUserDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportUserDataResponse.Size(m)
+}
+func (m *ExportUserDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUserDataResponse proto.InternalMessageInfo
+
+func (m *ExportUserDataResponse) GetProjects() []*ProjectInfo {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ExportUserDataResponse) GetAnnotations() []*ExportedAnnotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type DeleteUserDataRequest struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid" json:"uid,omitempty"`
+	// delete annotations instead of anonymizing them, threads replied by
+	// others are anonymized anyway
+	DeleteAnnotations    bool     `protobuf:"varint,2,opt,name=deleteAnnotations" json:"deleteAnnotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserDataRequest) Reset()         { *m = DeleteUserDataRequest{} }
+func (m *DeleteUserDataRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserDataRequest) ProtoMessage()    {}
+func (*DeleteUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{77}
+}
+func (m *DeleteUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserDataRequest.Unmarshal(m, b)
+}
+func (m *DeleteUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserDataRequest.Merge(dst, src)
+}
+func (m *DeleteUserDataRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserDataRequest.Size(m)
+}
+func (m *DeleteUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserDataRequest proto.InternalMessageInfo
+
+func (m *DeleteUserDataRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DeleteUserDataRequest) GetDeleteAnnotations() bool {
+	if m != nil {
+		return m.DeleteAnnotations
+	}
+	return false
+}
+
+type DeleteUserDataResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserDataResponse) Reset()         { *m = DeleteUserDataResponse{} }
+func (m *DeleteUserDataResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserDataResponse) ProtoMessage()    {}
+func (*DeleteUserDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_bae29d84ecfc58a7, []int{78}
+}
+func (m *DeleteUserDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserDataResponse.Unmarshal(m, b)
+}
+func (m *DeleteUserDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserDataResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteUserDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserDataResponse.Merge(dst, src)
+}
+func (m *DeleteUserDataResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserDataResponse.Size(m)
+}
+func (m *DeleteUserDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserDataResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*NewProjectRequest)(nil), "project.NewProjectRequest")
+	proto.RegisterType((*NewProjectResponse)(nil), "project.NewProjectResponse")
+	proto.RegisterType((*ProjectInfoRequest)(nil), "project.ProjectInfoRequest")
+	proto.RegisterType((*ProjectInfoResponse)(nil), "project.ProjectInfoResponse")
+	proto.RegisterType((*AddAnnotationRequest)(nil), "project.AddAnnotationRequest")
+	proto.RegisterType((*AnnotationRange)(nil), "project.AnnotationRange")
+	proto.RegisterType((*AddAnnotationResponse)(nil), "project.AddAnnotationResponse")
+	proto.RegisterType((*GetAnnotationLinesRequest)(nil), "project.GetAnnotationLinesRequest")
 	proto.RegisterType((*GetAnnotationLinesResponse)(nil), "project.GetAnnotationLinesResponse")
 	proto.RegisterType((*GetAnnotationsRequest)(nil), "project.GetAnnotationsRequest")
 	proto.RegisterType((*GetAnnotationsResponse)(nil), "project.GetAnnotationsResponse")
 	proto.RegisterType((*AnnotationRecord)(nil), "project.AnnotationRecord")
+	proto.RegisterType((*Reaction)(nil), "project.Reaction")
 	proto.RegisterType((*GetLatestAnnotationsRequest)(nil), "project.GetLatestAnnotationsRequest")
 	proto.RegisterType((*GetLatestAnnotationsResponse)(nil), "project.GetLatestAnnotationsResponse")
 	proto.RegisterType((*LatestAnnotation)(nil), "project.LatestAnnotation")
 	proto.RegisterType((*ListProjectsRequest)(nil), "project.ListProjectsRequest")
 	proto.RegisterType((*ListProjectsResponse)(nil), "project.ListProjectsResponse")
 	proto.RegisterType((*ProjectInfo)(nil), "project.ProjectInfo")
+	proto.RegisterType((*SetProjectIgnoreRequest)(nil), "project.SetProjectIgnoreRequest")
+	proto.RegisterType((*SetProjectIgnoreResponse)(nil), "project.SetProjectIgnoreResponse")
+	proto.RegisterType((*InviteMemberRequest)(nil), "project.InviteMemberRequest")
+	proto.RegisterType((*InviteMemberResponse)(nil), "project.InviteMemberResponse")
+	proto.RegisterType((*CreateInviteLinkRequest)(nil), "project.CreateInviteLinkRequest")
+	proto.RegisterType((*CreateInviteLinkResponse)(nil), "project.CreateInviteLinkResponse")
+	proto.RegisterType((*InviteLink)(nil), "project.InviteLink")
+	proto.RegisterType((*ListInviteLinksRequest)(nil), "project.ListInviteLinksRequest")
+	proto.RegisterType((*ListInviteLinksResponse)(nil), "project.ListInviteLinksResponse")
+	proto.RegisterType((*RevokeInviteLinkRequest)(nil), "project.RevokeInviteLinkRequest")
+	proto.RegisterType((*RevokeInviteLinkResponse)(nil), "project.RevokeInviteLinkResponse")
+	proto.RegisterType((*JoinByLinkRequest)(nil), "project.JoinByLinkRequest")
+	proto.RegisterType((*JoinByLinkResponse)(nil), "project.JoinByLinkResponse")
+	proto.RegisterType((*Invitation)(nil), "project.Invitation")
+	proto.RegisterType((*ListInvitationsRequest)(nil), "project.ListInvitationsRequest")
+	proto.RegisterType((*ListInvitationsResponse)(nil), "project.ListInvitationsResponse")
+	proto.RegisterType((*ReplyInvitationRequest)(nil), "project.ReplyInvitationRequest")
+	proto.RegisterType((*ReplyInvitationResponse)(nil), "project.ReplyInvitationResponse")
+	proto.RegisterType((*RequestJoinRequest)(nil), "project.RequestJoinRequest")
+	proto.RegisterType((*RequestJoinResponse)(nil), "project.RequestJoinResponse")
+	proto.RegisterType((*ListJoinRequestsRequest)(nil), "project.ListJoinRequestsRequest")
+	proto.RegisterType((*ListJoinRequestsResponse)(nil), "project.ListJoinRequestsResponse")
+	proto.RegisterType((*ReviewJoinRequestRequest)(nil), "project.ReviewJoinRequestRequest")
+	proto.RegisterType((*ReviewJoinRequestResponse)(nil), "project.ReviewJoinRequestResponse")
+	proto.RegisterType((*Member)(nil), "project.Member")
+	proto.RegisterType((*ListMembersRequest)(nil), "project.ListMembersRequest")
+	proto.RegisterType((*ListMembersResponse)(nil), "project.ListMembersResponse")
+	proto.RegisterType((*RemoveMemberRequest)(nil), "project.RemoveMemberRequest")
+	proto.RegisterType((*RemoveMemberResponse)(nil), "project.RemoveMemberResponse")
+	proto.RegisterType((*SetMemberRoleRequest)(nil), "project.SetMemberRoleRequest")
+	proto.RegisterType((*SetMemberRoleResponse)(nil), "project.SetMemberRoleResponse")
+	proto.RegisterType((*SetProjectVisibilityRequest)(nil), "project.SetProjectVisibilityRequest")
+	proto.RegisterType((*SetProjectVisibilityResponse)(nil), "project.SetProjectVisibilityResponse")
+	proto.RegisterType((*ProjectAccessRequest)(nil), "project.ProjectAccessRequest")
+	proto.RegisterType((*ProjectAccessResponse)(nil), "project.ProjectAccessResponse")
+	proto.RegisterType((*UpdateAnnotationRequest)(nil), "project.UpdateAnnotationRequest")
+	proto.RegisterType((*UpdateAnnotationResponse)(nil), "project.UpdateAnnotationResponse")
+	proto.RegisterType((*DeleteAnnotationRequest)(nil), "project.DeleteAnnotationRequest")
+	proto.RegisterType((*DeleteAnnotationResponse)(nil), "project.DeleteAnnotationResponse")
+	proto.RegisterType((*GetAnnotationRevisionsRequest)(nil), "project.GetAnnotationRevisionsRequest")
+	proto.RegisterType((*GetAnnotationRevisionsResponse)(nil), "project.GetAnnotationRevisionsResponse")
+	proto.RegisterType((*AnnotationRevision)(nil), "project.AnnotationRevision")
+	proto.RegisterType((*ResolveAnnotationRequest)(nil), "project.ResolveAnnotationRequest")
+	proto.RegisterType((*ResolveAnnotationResponse)(nil), "project.ResolveAnnotationResponse")
+	proto.RegisterType((*ReactAnnotationRequest)(nil), "project.ReactAnnotationRequest")
+	proto.RegisterType((*ReactAnnotationResponse)(nil), "project.ReactAnnotationResponse")
+	proto.RegisterType((*RebaseProjectRequest)(nil), "project.RebaseProjectRequest")
+	proto.RegisterType((*RebaseProjectResponse)(nil), "project.RebaseProjectResponse")
+	proto.RegisterType((*UserActivityRequest)(nil), "project.UserActivityRequest")
+	proto.RegisterType((*Activity)(nil), "project.Activity")
+	proto.RegisterType((*UserActivityResponse)(nil), "project.UserActivityResponse")
+	proto.RegisterType((*AdminDeleteProjectRequest)(nil), "project.AdminDeleteProjectRequest")
+	proto.RegisterType((*AdminDeleteProjectResponse)(nil), "project.AdminDeleteProjectResponse")
+	proto.RegisterType((*AdminDeleteAnnotationRequest)(nil), "project.AdminDeleteAnnotationRequest")
+	proto.RegisterType((*AdminDeleteAnnotationResponse)(nil), "project.AdminDeleteAnnotationResponse")
+	proto.RegisterType((*ExportUserDataRequest)(nil), "project.ExportUserDataRequest")
+	proto.RegisterType((*ExportedAnnotation)(nil), "project.ExportedAnnotation")
+	proto.RegisterType((*ExportUserDataResponse)(nil), "project.ExportUserDataResponse")
+	proto.RegisterType((*DeleteUserDataRequest)(nil), "project.DeleteUserDataRequest")
+	proto.RegisterType((*DeleteUserDataResponse)(nil), "project.DeleteUserDataResponse")
 	proto.RegisterEnum("project.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterEnum("project.Visibility", Visibility_name, Visibility_value)
+	proto.RegisterEnum("project.Role", Role_name, Role_value)
 }
 
-func init() { proto.RegisterFile("project.proto", fileDescriptor_project_19ccf6b0d5f9ff9e) }
-
-var fileDescriptor_project_19ccf6b0d5f9ff9e = []byte{
-	// 776 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xbe, 0x8e, 0xf3, 0x7b, 0xd2, 0x56, 0xb9, 0xd3, 0xb4, 0xd7, 0x75, 0x7b, 0x7b, 0xa3, 0xb9,
-	0x45, 0x54, 0x2c, 0x2a, 0xd4, 0x2e, 0x59, 0x45, 0xa8, 0xb4, 0x15, 0x25, 0x02, 0x23, 0x56, 0xa8,
-	0x0b, 0xc7, 0x9e, 0x2a, 0x83, 0x12, 0x8f, 0x99, 0x99, 0xd0, 0xb2, 0x60, 0x01, 0x6c, 0xe1, 0x1d,
-	0x78, 0x89, 0xbe, 0x06, 0xcf, 0x84, 0x6c, 0x8f, 0xed, 0xb1, 0x63, 0xf3, 0xb3, 0x9b, 0xf3, 0x33,
-	0x67, 0xbe, 0xf3, 0x9d, 0xe3, 0x2f, 0x81, 0xf5, 0x90, 0xb3, 0x37, 0xc4, 0x93, 0x47, 0x21, 0x67,
-	0x92, 0xa1, 0x8e, 0x32, 0xf1, 0x0d, 0xfc, 0x3d, 0x21, 0x37, 0xcf, 0x13, 0xcb, 0x21, 0x6f, 0x97,
-	0x44, 0x48, 0x34, 0x00, 0x73, 0x49, 0x7d, 0xcb, 0x18, 0x19, 0x87, 0x3d, 0x27, 0x3a, 0xc6, 0x1e,
-	0x3e, 0xb7, 0x1a, 0xca, 0xc3, 0xe7, 0x08, 0x41, 0x73, 0xe6, 0x8a, 0x99, 0x65, 0xc6, 0xae, 0xf8,
-	0x1c, 0xf9, 0x02, 0x77, 0x41, 0xac, 0x66, 0xe2, 0x8b, 0xce, 0x68, 0x1b, 0xda, 0x53, 0xee, 0x06,
-	0xde, 0xcc, 0x6a, 0x8d, 0x8c, 0xc3, 0xae, 0xa3, 0x2c, 0x7c, 0x00, 0x48, 0x7f, 0x58, 0x84, 0x2c,
-	0x10, 0x04, 0x6d, 0x40, 0x83, 0xfa, 0xaa, 0x66, 0x83, 0xfa, 0x78, 0x06, 0x48, 0xa5, 0x5c, 0x04,
-	0xd7, 0xac, 0x1e, 0x9f, 0x0d, 0x5d, 0x76, 0x13, 0x10, 0xfe, 0x8a, 0xfa, 0x0a, 0x64, 0x66, 0xa7,
-	0xd8, 0xcd, 0x02, 0xf6, 0x32, 0x4e, 0xfc, 0xd5, 0x80, 0xcd, 0xc2, 0x53, 0x05, 0x44, 0x46, 0x8a,
-	0x28, 0xeb, 0xbb, 0xa1, 0xf5, 0x9d, 0xf7, 0x68, 0xea, 0x3d, 0xa2, 0x11, 0xf4, 0x3d, 0x37, 0x18,
-	0x07, 0x01, 0x93, 0xae, 0x4c, 0x9e, 0xeb, 0x3a, 0xba, 0x0b, 0x59, 0xd0, 0xa1, 0x81, 0x4f, 0x6e,
-	0x89, 0xaf, 0xe8, 0x49, 0x4d, 0xfc, 0xcd, 0x80, 0xe1, 0xd8, 0xf7, 0x55, 0x26, 0x65, 0x81, 0xd6,
-	0x7c, 0x98, 0x37, 0x1f, 0xaa, 0x06, 0xb3, 0xbe, 0xf5, 0x71, 0x15, 0x5b, 0xbe, 0xa6, 0xf3, 0xac,
-	0xe5, 0xe8, 0x8c, 0xf6, 0x01, 0xe6, 0x34, 0x20, 0x93, 0xe5, 0x62, 0x4a, 0x78, 0xfc, 0x7e, 0xcb,
-	0xd1, 0x3c, 0x51, 0xdc, 0xcd, 0x9e, 0xb7, 0xda, 0xf1, 0x4d, 0xcd, 0x83, 0xff, 0x81, 0xad, 0x12,
-	0xc2, 0x84, 0x33, 0x3c, 0x86, 0x9d, 0x33, 0x22, 0xf3, 0xc0, 0x25, 0x0d, 0x88, 0xa8, 0xc7, 0x9f,
-	0x62, 0x6b, 0xe4, 0xd8, 0xf0, 0x31, 0xd8, 0x55, 0x25, 0xd4, 0x50, 0x86, 0xd0, 0x8a, 0x70, 0x0a,
-	0xcb, 0x18, 0x99, 0x87, 0x2d, 0x27, 0x31, 0xf0, 0x15, 0x6c, 0x15, 0xee, 0xfc, 0xd9, 0x93, 0x25,
-	0x3a, 0xcc, 0x32, 0x1d, 0xf8, 0x19, 0x6c, 0x97, 0xcb, 0x2b, 0x38, 0x27, 0xd0, 0xe1, 0xc4, 0x63,
-	0xdc, 0x4f, 0x00, 0xf5, 0x8f, 0x77, 0x8e, 0xd2, 0xcf, 0x4d, 0x67, 0x27, 0xca, 0x70, 0xd2, 0x4c,
-	0xfc, 0x0e, 0x06, 0xe5, 0x60, 0xc5, 0x62, 0xa7, 0xab, 0xda, 0xd0, 0x3e, 0xa9, 0xe2, 0x5c, 0xcc,
-	0xf2, 0x5c, 0xd0, 0x1e, 0xf4, 0x3c, 0x4e, 0x5c, 0x49, 0xfc, 0xb1, 0x8c, 0x07, 0x6e, 0x3a, 0xb9,
-	0x03, 0x9f, 0xc1, 0xee, 0x19, 0x91, 0x97, 0xae, 0x24, 0xe2, 0xf7, 0xb8, 0xda, 0x86, 0x76, 0xe8,
-	0x72, 0x12, 0x48, 0x05, 0x42, 0x59, 0xf8, 0x35, 0xec, 0x55, 0x17, 0x52, 0xac, 0x3c, 0x82, 0x7e,
-	0x0e, 0x6a, 0x95, 0x99, 0xf2, 0x45, 0x47, 0xcf, 0xc6, 0x5f, 0x0c, 0x18, 0x94, 0x33, 0xb2, 0xa9,
-	0x19, 0xb5, 0x53, 0x6b, 0xac, 0x2c, 0xf1, 0x10, 0x5a, 0x53, 0x4e, 0xc9, 0xb5, 0xe2, 0x29, 0x31,
-	0x22, 0x8a, 0x24, 0x5d, 0x10, 0x21, 0xdd, 0x45, 0x98, 0x52, 0x94, 0x39, 0x22, 0x0e, 0xc4, 0x72,
-	0x1a, 0x7f, 0x11, 0x3d, 0x27, 0x3a, 0xe2, 0xfb, 0xb0, 0x79, 0x49, 0x85, 0x54, 0x02, 0x21, 0x6a,
-	0x85, 0x08, 0x9f, 0xc3, 0xb0, 0x98, 0xa8, 0xc8, 0x78, 0x08, 0x5d, 0xd5, 0x78, 0xca, 0xc4, 0x30,
-	0x63, 0x42, 0x97, 0x9d, 0x2c, 0x0b, 0x7f, 0x80, 0xbe, 0x16, 0x48, 0x3f, 0x69, 0x63, 0x55, 0xc5,
-	0xf4, 0xd5, 0xa8, 0x52, 0xe5, 0x5c, 0x9d, 0x9a, 0x05, 0x75, 0x2a, 0xac, 0x49, 0xab, 0xb4, 0x26,
-	0x0f, 0x5c, 0xe8, 0x9d, 0x72, 0xce, 0xf8, 0x63, 0xe6, 0x13, 0xd4, 0x87, 0xce, 0xcb, 0xa5, 0xe7,
-	0x11, 0x21, 0x06, 0x7f, 0x21, 0x0b, 0x90, 0x43, 0x42, 0x26, 0xa8, 0x64, 0xfc, 0xfd, 0x84, 0xc9,
-	0xd3, 0x5b, 0x2a, 0xe4, 0xe0, 0xe3, 0x9d, 0x85, 0x10, 0xac, 0x29, 0xc8, 0x89, 0xef, 0xd3, 0x9d,
-	0x85, 0x76, 0x60, 0xf3, 0x22, 0x92, 0x34, 0x15, 0x78, 0xe2, 0xd2, 0xf9, 0x92, 0x93, 0xc1, 0xe7,
-	0x3b, 0xeb, 0xf8, 0x7b, 0x13, 0x3a, 0xca, 0x8d, 0x4e, 0x01, 0xf2, 0x9f, 0x03, 0x64, 0x67, 0xdc,
-	0xac, 0xfc, 0x38, 0xd9, 0xbb, 0x95, 0x31, 0x45, 0xf3, 0x79, 0x91, 0xb4, 0xdd, 0x4a, 0x8e, 0x55,
-	0xa1, 0xbd, 0xea, 0xa0, 0xaa, 0xf4, 0x14, 0xd6, 0xf4, 0x41, 0xa2, 0x3c, 0xbb, 0x62, 0x11, 0xec,
-	0x7f, 0x6b, 0xa2, 0xaa, 0xd8, 0x04, 0xd6, 0x0b, 0x4a, 0x89, 0xf2, 0xfc, 0x2a, 0x8d, 0xb7, 0xf7,
-	0xeb, 0xc2, 0xaa, 0xde, 0x15, 0xa0, 0x55, 0x75, 0x44, 0x38, 0xbb, 0x55, 0xab, 0xbe, 0xf6, 0xff,
-	0x3f, 0xcd, 0x51, 0xe5, 0x5f, 0xc0, 0x46, 0x51, 0xe9, 0xd0, 0x7e, 0xf5, 0xb5, 0xac, 0xec, 0x7f,
-	0xb5, 0x71, 0x55, 0xd2, 0x83, 0x61, 0x95, 0x58, 0xa0, 0x03, 0xfd, 0x62, 0x9d, 0x28, 0xd9, 0xf7,
-	0x7e, 0x91, 0x95, 0x3c, 0x32, 0x6d, 0xc7, 0x7f, 0x6e, 0x4e, 0x7e, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x26, 0x9c, 0xa0, 0x88, 0xed, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("project.proto", fileDescriptor_project_bae29d84ecfc58a7) }
+
+var fileDescriptor_project_bae29d84ecfc58a7 = []byte{
+	// 2712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xdd, 0x72, 0xdb, 0xc6,
+	0x15, 0x0e, 0x09, 0x92, 0x22, 0x8f, 0x6c, 0x85, 0x5a, 0x51, 0x14, 0x04, 0xfd, 0x98, 0xda, 0xc4,
+	0x89, 0xe3, 0x69, 0x9d, 0xd4, 0x6e, 0x67, 0xda, 0xa6, 0x99, 0x46, 0xb5, 0xd5, 0xc4, 0x89, 0xa3,
+	0x3a, 0x70, 0x65, 0xb7, 0xf5, 0xf8, 0x02, 0x22, 0xd6, 0x36, 0x62, 0x12, 0x60, 0x01, 0x50, 0x96,
+	0x7b, 0xd7, 0xf4, 0xd7, 0x57, 0xbd, 0xea, 0x4d, 0xdf, 0x43, 0xd3, 0x99, 0xb6, 0x57, 0x7d, 0x86,
+	0xbe, 0x41, 0x6f, 0x3a, 0xd3, 0x97, 0xe8, 0xec, 0x0f, 0xb0, 0xbb, 0xc0, 0x82, 0x22, 0xd5, 0x2b,
+	0x71, 0xf7, 0x9c, 0x3d, 0x7b, 0xf6, 0x3b, 0x3f, 0x7b, 0xf6, 0x40, 0x70, 0x79, 0x12, 0x47, 0x5f,
+	0x91, 0x61, 0x7a, 0x63, 0x12, 0x47, 0x69, 0x84, 0x96, 0xc4, 0x10, 0xff, 0xb3, 0x06, 0xab, 0x87,
+	0xe4, 0xe5, 0x7d, 0x3e, 0x74, 0xc9, 0x2f, 0xa7, 0x24, 0x49, 0x51, 0x17, 0xac, 0x69, 0xe0, 0xdb,
+	0xb5, 0x41, 0xed, 0x5a, 0xc7, 0xa5, 0x3f, 0xd9, 0x4c, 0x3c, 0xb2, 0xeb, 0x62, 0x26, 0x1e, 0x21,
+	0x04, 0x8d, 0xe7, 0x5e, 0xf2, 0xdc, 0xb6, 0xd8, 0x14, 0xfb, 0x4d, 0xe7, 0x42, 0x6f, 0x4c, 0xec,
+	0x06, 0x9f, 0xa3, 0xbf, 0x51, 0x1f, 0x5a, 0xc7, 0xb1, 0x17, 0x0e, 0x9f, 0xdb, 0xcd, 0x41, 0xed,
+	0x5a, 0xdb, 0x15, 0x23, 0x74, 0x0b, 0xe0, 0x24, 0x48, 0x82, 0xe3, 0x60, 0x14, 0xa4, 0xaf, 0xec,
+	0xd6, 0xa0, 0x76, 0x6d, 0xe5, 0xe6, 0xda, 0x8d, 0x4c, 0xcd, 0x87, 0x39, 0xc9, 0x55, 0xd8, 0x50,
+	0x0f, 0x9a, 0xd1, 0xcb, 0x90, 0xc4, 0xf6, 0x12, 0xdb, 0x81, 0x0f, 0xf0, 0xdb, 0x80, 0xd4, 0x33,
+	0x24, 0x93, 0x28, 0x4c, 0x08, 0x5a, 0x81, 0x7a, 0xe0, 0x0b, 0xf5, 0xea, 0x81, 0x8f, 0x9f, 0x03,
+	0x12, 0x2c, 0x77, 0xc3, 0xa7, 0x51, 0xf5, 0x51, 0x1d, 0x68, 0x33, 0xb1, 0x47, 0x81, 0x2f, 0xce,
+	0x9b, 0x8f, 0x33, 0x18, 0x2c, 0x0d, 0x86, 0xe2, 0x91, 0xf1, 0x9f, 0xea, 0xb0, 0xa6, 0x6d, 0xa5,
+	0x69, 0x54, 0xcb, 0x34, 0xca, 0x21, 0xac, 0x2b, 0x10, 0x4a, 0xb8, 0x2c, 0x0d, 0xae, 0x01, 0x2c,
+	0x0f, 0xbd, 0x70, 0x3f, 0x0c, 0xa3, 0xd4, 0x4b, 0xf9, 0x76, 0x6d, 0x57, 0x9d, 0x42, 0x36, 0x2c,
+	0x05, 0xa1, 0x4f, 0x4e, 0x89, 0x2f, 0x90, 0xce, 0x86, 0x54, 0x66, 0xf0, 0x2c, 0x8c, 0x62, 0x62,
+	0xb7, 0x06, 0xd6, 0xb5, 0x8e, 0x2b, 0x46, 0x68, 0x0f, 0x1a, 0x71, 0x34, 0x22, 0x0c, 0xcc, 0x95,
+	0x9b, 0x97, 0x73, 0xf0, 0xdd, 0x68, 0x44, 0x5c, 0x46, 0x2a, 0x58, 0xa9, 0x3d, 0x9f, 0x95, 0xba,
+	0x60, 0x45, 0xf1, 0x33, 0xbb, 0xc3, 0xb4, 0xa0, 0x3f, 0xf1, 0x7f, 0x6a, 0xd0, 0xdb, 0xf7, 0x7d,
+	0xa1, 0x6b, 0x10, 0x85, 0x0a, 0xfc, 0x13, 0x09, 0xff, 0x44, 0x40, 0x9c, 0x23, 0xaf, 0xfa, 0x9e,
+	0x0e, 0xfa, 0xd3, 0x60, 0x94, 0x83, 0x4e, 0x7f, 0xa3, 0x5d, 0x80, 0x51, 0x10, 0x92, 0xc3, 0xe9,
+	0xf8, 0x98, 0xc4, 0x0c, 0x81, 0xa6, 0xab, 0xcc, 0x50, 0xba, 0x97, 0x6f, 0xcf, 0xfc, 0xad, 0xe3,
+	0x2a, 0x33, 0x14, 0xa4, 0x89, 0x17, 0x93, 0x30, 0x15, 0xbe, 0x25, 0x46, 0xe8, 0x06, 0x34, 0x63,
+	0x2f, 0x7c, 0x46, 0xd8, 0xe1, 0x97, 0x6f, 0xda, 0xf9, 0xe1, 0x95, 0xc3, 0x50, 0xba, 0xcb, 0xd9,
+	0xf0, 0xeb, 0x1a, 0xbc, 0x59, 0x20, 0xa1, 0x6d, 0xe8, 0x24, 0xa9, 0x17, 0xa7, 0xf7, 0x82, 0x90,
+	0xb0, 0xb3, 0x36, 0x5d, 0x39, 0x41, 0x4d, 0xcb, 0x06, 0xb7, 0xa3, 0xd1, 0x74, 0x1c, 0xb2, 0x93,
+	0x37, 0x5d, 0x75, 0x8a, 0x9a, 0x96, 0x84, 0x3e, 0x5b, 0x6d, 0x31, 0x6a, 0x36, 0xa4, 0x92, 0x49,
+	0xe8, 0x8b, 0x95, 0x0d, 0x2e, 0x39, 0x9f, 0xc0, 0xef, 0xc2, 0x7a, 0x01, 0x75, 0xb3, 0x27, 0xe2,
+	0x07, 0xb0, 0xf9, 0x09, 0x49, 0x25, 0x23, 0x95, 0x9d, 0x54, 0xdb, 0x28, 0xc3, 0xbf, 0xae, 0xe0,
+	0x2f, 0xec, 0x66, 0xe5, 0x76, 0xc3, 0x3e, 0x38, 0x26, 0xa1, 0x42, 0x85, 0x1e, 0x34, 0xa9, 0x75,
+	0x12, 0xbb, 0x36, 0xb0, 0xae, 0x35, 0x5d, 0x3e, 0x40, 0x1f, 0x40, 0x8b, 0xc1, 0x98, 0xd8, 0xf5,
+	0x81, 0x35, 0x13, 0x6e, 0xc1, 0x87, 0xff, 0x5e, 0x83, 0x75, 0x6d, 0x9b, 0x05, 0xf5, 0xd6, 0xfd,
+	0xc6, 0x2a, 0xf9, 0x8d, 0x38, 0x57, 0x43, 0xfa, 0x23, 0x82, 0xc6, 0xc4, 0x7b, 0x46, 0x84, 0x8f,
+	0xb1, 0xdf, 0x34, 0x69, 0xd0, 0xbf, 0x0f, 0x82, 0x5f, 0x11, 0xe6, 0x5b, 0x4d, 0x37, 0x1f, 0x53,
+	0x5a, 0x34, 0x4d, 0x7d, 0x2f, 0x25, 0x3e, 0xf3, 0xad, 0xb6, 0x9b, 0x8f, 0xf1, 0x10, 0xfa, 0x45,
+	0xe5, 0x05, 0x3e, 0xb7, 0x60, 0x29, 0x26, 0xc3, 0x28, 0xf6, 0x39, 0x42, 0xcb, 0x37, 0x37, 0x4d,
+	0x50, 0x30, 0x0e, 0x37, 0xe3, 0xa4, 0xa0, 0xa6, 0x51, 0xea, 0xf1, 0x44, 0x6d, 0xb9, 0x7c, 0x80,
+	0xff, 0x62, 0x41, 0xb7, 0xb8, 0xc6, 0x90, 0xf8, 0xb2, 0x54, 0x56, 0x57, 0xb2, 0xb7, 0x1e, 0x35,
+	0x56, 0x29, 0x6a, 0xb6, 0xa1, 0x33, 0x8c, 0x09, 0x3d, 0xca, 0x7e, 0xca, 0x30, 0xb2, 0x5c, 0x39,
+	0x21, 0xdc, 0xac, 0x99, 0x27, 0xbc, 0x6d, 0xe8, 0x4c, 0x27, 0xbe, 0xe0, 0x6e, 0x71, 0xee, 0x7c,
+	0x82, 0xe2, 0x14, 0x93, 0x24, 0x1a, 0x9d, 0x48, 0x9c, 0xb2, 0x31, 0x7a, 0x1f, 0x3a, 0x31, 0xf1,
+	0x86, 0x0c, 0x22, 0xbb, 0xcd, 0xf0, 0x58, 0x95, 0xf9, 0x4a, 0x50, 0x5c, 0xc9, 0xc3, 0xe1, 0x9b,
+	0x8c, 0x02, 0x92, 0xd8, 0x9d, 0x39, 0xe0, 0x63, 0x9c, 0x32, 0xd6, 0x61, 0xae, 0x58, 0xcf, 0x13,
+	0xf8, 0xb2, 0x92, 0xc0, 0x55, 0x6b, 0x5f, 0xd2, 0xad, 0xcd, 0xf8, 0xd3, 0xf1, 0xc8, 0xbe, 0x2c,
+	0xf8, 0xd3, 0xf1, 0x08, 0x7f, 0x1b, 0xda, 0x99, 0xfe, 0xd4, 0x7c, 0x64, 0x1c, 0x7d, 0x15, 0x08,
+	0xab, 0xf0, 0x01, 0x5d, 0x35, 0x0d, 0x7c, 0x1e, 0x11, 0x1d, 0x97, 0xfd, 0xc6, 0x3f, 0x87, 0xad,
+	0x4f, 0x48, 0x7a, 0xcf, 0x4b, 0x49, 0x32, 0x9f, 0xeb, 0xcb, 0xf4, 0x56, 0xd7, 0xd2, 0x5b, 0x39,
+	0x6c, 0x1f, 0xc3, 0xb6, 0x59, 0xb4, 0x70, 0xcc, 0x0f, 0x61, 0x59, 0x3a, 0x40, 0xd9, 0x39, 0x8b,
+	0x0b, 0x5d, 0x95, 0x1b, 0xff, 0xa3, 0x06, 0xdd, 0x22, 0x47, 0x1e, 0x96, 0xb5, 0xca, 0xb0, 0xac,
+	0x97, 0xc2, 0xb2, 0x07, 0xcd, 0xe3, 0x38, 0x20, 0x4f, 0x85, 0xe6, 0x7c, 0x40, 0x1d, 0x2c, 0x0d,
+	0xc6, 0x24, 0x49, 0xbd, 0xf1, 0x24, 0x73, 0xc7, 0x7c, 0x82, 0x9e, 0x35, 0x99, 0x1e, 0x0b, 0x7f,
+	0xa4, 0x3f, 0xa5, 0xc1, 0x5b, 0xf3, 0x25, 0xf7, 0x1f, 0xc2, 0xda, 0xbd, 0x20, 0x49, 0xc5, 0xe5,
+	0x9e, 0x54, 0x17, 0x11, 0x7d, 0x68, 0x9d, 0x04, 0xe4, 0xa5, 0x50, 0xbd, 0xe3, 0x8a, 0x11, 0xfe,
+	0x14, 0x7a, 0xba, 0x00, 0x01, 0xea, 0x07, 0xd0, 0x16, 0x5b, 0x67, 0x88, 0xf6, 0x72, 0x5d, 0xd4,
+	0x52, 0x22, 0xe7, 0xc2, 0xff, 0xaa, 0xc1, 0xb2, 0x42, 0xc9, 0x6e, 0xc9, 0x5a, 0xb9, 0x34, 0x51,
+	0xe3, 0xd9, 0x54, 0xb5, 0xc9, 0x92, 0xa3, 0xa1, 0x95, 0x1c, 0x5a, 0x6c, 0x37, 0x8b, 0xb1, 0x7d,
+	0xa1, 0xfa, 0x8d, 0x27, 0x84, 0xa5, 0x3c, 0x21, 0x88, 0x4a, 0xa1, 0x2d, 0x2b, 0x85, 0x23, 0xd8,
+	0x78, 0x40, 0x32, 0x7c, 0xee, 0xb2, 0x3a, 0x65, 0x91, 0x5a, 0x41, 0x96, 0x3a, 0x96, 0x5a, 0xea,
+	0x60, 0x07, 0xec, 0xb2, 0x58, 0x8e, 0x3d, 0x3e, 0x81, 0xb5, 0xbb, 0xe1, 0x49, 0x90, 0x92, 0x2f,
+	0x08, 0x75, 0xad, 0x45, 0xb6, 0x63, 0x35, 0x17, 0x5d, 0x4a, 0x04, 0xa2, 0xd9, 0x30, 0xaf, 0xad,
+	0x1a, 0x95, 0xb5, 0x15, 0x7e, 0x07, 0x7a, 0xfa, 0xbe, 0x15, 0x97, 0xf3, 0x29, 0x6c, 0xdc, 0x66,
+	0xb0, 0x73, 0xee, 0x7b, 0x41, 0xf8, 0x62, 0x11, 0x1d, 0x33, 0x4d, 0xac, 0xea, 0x2a, 0xaf, 0x0f,
+	0x2d, 0x72, 0x3a, 0x09, 0x62, 0x22, 0x62, 0x46, 0x8c, 0xf0, 0xc7, 0x60, 0x97, 0x77, 0x96, 0xf7,
+	0x77, 0x1a, 0xbd, 0x20, 0x61, 0x96, 0xab, 0xd8, 0x40, 0xe8, 0x5e, 0xcf, 0x75, 0xff, 0x6b, 0x0d,
+	0x40, 0x2e, 0x2e, 0x55, 0xc0, 0xb9, 0x90, 0xba, 0x2a, 0x64, 0x0e, 0x8d, 0x8d, 0xb7, 0x32, 0xf3,
+	0xf6, 0xa6, 0xe2, 0xed, 0x9a, 0x07, 0xb7, 0x8a, 0x1e, 0x4c, 0x6b, 0x27, 0x76, 0xce, 0x64, 0x9f,
+	0x17, 0x7d, 0x96, 0x2b, 0x27, 0xf0, 0x0f, 0xa0, 0x4f, 0x23, 0x55, 0x2a, 0x9f, 0x2c, 0x00, 0x3a,
+	0xbe, 0x03, 0x1b, 0xa5, 0xd5, 0x02, 0xb8, 0xf7, 0x58, 0xe1, 0xf3, 0x22, 0x8b, 0x73, 0x19, 0x33,
+	0x0a, 0xc8, 0x9c, 0x03, 0x7f, 0x08, 0x1b, 0x2e, 0x39, 0x89, 0x5e, 0x18, 0x2c, 0x5f, 0x44, 0xb2,
+	0xac, 0x82, 0x03, 0x76, 0x79, 0xb1, 0x70, 0xf9, 0x0f, 0x61, 0xf5, 0xb3, 0x28, 0x08, 0x7f, 0xf4,
+	0xaa, 0xe0, 0x4c, 0x85, 0x2c, 0x66, 0x34, 0x0f, 0xbe, 0x0b, 0x48, 0x5d, 0x2c, 0x8e, 0x55, 0x46,
+	0x25, 0x33, 0x63, 0xbd, 0x3a, 0x04, 0xfe, 0x9c, 0xb9, 0x07, 0xbf, 0x08, 0x0c, 0x87, 0x9a, 0xc8,
+	0x43, 0x29, 0x48, 0x5b, 0x65, 0xbb, 0xab, 0x6f, 0xce, 0x6c, 0xe7, 0x66, 0xb5, 0x03, 0xcd, 0x74,
+	0x0d, 0x7c, 0x5d, 0x31, 0x7e, 0xe9, 0x66, 0xd5, 0x41, 0xc2, 0xf7, 0x61, 0xa3, 0xc4, 0x2b, 0x30,
+	0xf9, 0x0e, 0x2c, 0x07, 0x72, 0xda, 0x6c, 0x70, 0x71, 0x49, 0x2a, 0x7c, 0xd8, 0x85, 0xbe, 0x4b,
+	0x26, 0xa3, 0x57, 0x0a, 0x7d, 0x5e, 0xab, 0xd3, 0x50, 0xf6, 0x86, 0x43, 0x32, 0x49, 0xb3, 0xf7,
+	0x23, 0x1f, 0xe1, 0x4d, 0xd8, 0x28, 0xc9, 0x14, 0xce, 0xf0, 0x5d, 0x40, 0x42, 0x3e, 0x35, 0xeb,
+	0x22, 0x5e, 0x7e, 0x15, 0xd6, 0xb4, 0x95, 0x15, 0x09, 0xec, 0x23, 0x8e, 0x90, 0x22, 0x7d, 0xa1,
+	0x58, 0xfa, 0x1c, 0xec, 0xf2, 0x72, 0xb1, 0xd5, 0xfb, 0xb4, 0x66, 0xe4, 0x73, 0xb3, 0xe0, 0xcd,
+	0x99, 0xf0, 0x43, 0x16, 0x15, 0x01, 0x79, 0xa9, 0x88, 0x9b, 0x1f, 0x5d, 0x1b, 0x96, 0xbc, 0xc9,
+	0x24, 0x8e, 0x4e, 0x88, 0x80, 0x37, 0x1b, 0xe2, 0x2d, 0xd8, 0x34, 0xc8, 0x15, 0x08, 0x47, 0xd0,
+	0xe2, 0x39, 0x7e, 0xce, 0xaa, 0x7b, 0x8e, 0x04, 0x38, 0xb3, 0xf0, 0xa6, 0x26, 0xa5, 0x90, 0xf1,
+	0x4d, 0x17, 0x02, 0xfb, 0x63, 0x58, 0xd3, 0x56, 0xe6, 0x49, 0x6b, 0x69, 0xcc, 0xa7, 0x04, 0xcc,
+	0x6f, 0xe6, 0x4a, 0x71, 0x56, 0x37, 0xa3, 0xe3, 0x2f, 0xa9, 0x53, 0x8c, 0xa3, 0x93, 0x0b, 0x5c,
+	0xa7, 0x7d, 0x68, 0x71, 0x29, 0x22, 0xc0, 0xc5, 0x08, 0xf7, 0xa1, 0xa7, 0x8b, 0x14, 0xb8, 0x4e,
+	0xa1, 0xf7, 0x80, 0x08, 0x5d, 0x19, 0x36, 0xff, 0xff, 0x5e, 0xf3, 0x5c, 0xdc, 0x1b, 0xb0, 0x5e,
+	0xd8, 0x36, 0xaf, 0x24, 0xb6, 0x64, 0x95, 0xa1, 0x94, 0x40, 0x0b, 0xa8, 0xa5, 0x97, 0x55, 0xd6,
+	0x5c, 0x65, 0x15, 0xde, 0x85, 0x6d, 0xf3, 0xbe, 0x42, 0xaf, 0xef, 0x43, 0x4f, 0x10, 0xf7, 0x87,
+	0x43, 0x92, 0x2c, 0xe4, 0x10, 0xa7, 0xb0, 0x5e, 0x58, 0x2b, 0x13, 0x7e, 0xb9, 0xe0, 0x2c, 0xf5,
+	0xb3, 0xe6, 0xab, 0x3e, 0x44, 0xcd, 0xd6, 0xd0, 0x6a, 0xb6, 0xc7, 0xb0, 0x71, 0xc4, 0x1e, 0x87,
+	0xe5, 0xb6, 0xd1, 0xf9, 0x91, 0x7a, 0xce, 0xc3, 0x95, 0xde, 0x8e, 0x65, 0xe1, 0xf9, 0xed, 0xb8,
+	0x71, 0x87, 0x8c, 0xc8, 0x85, 0x36, 0xa6, 0x82, 0xcb, 0x8b, 0x85, 0xe0, 0x7d, 0xd8, 0xd1, 0x5e,
+	0xfb, 0x34, 0x63, 0x24, 0xea, 0xed, 0x72, 0xbe, 0xf8, 0xc7, 0xb0, 0x5b, 0x25, 0x42, 0xd8, 0xe5,
+	0x7b, 0xf4, 0xa9, 0x2c, 0x26, 0x45, 0xb0, 0x6e, 0x19, 0xdf, 0xbe, 0x9c, 0xc7, 0x95, 0xdc, 0xd8,
+	0x05, 0x54, 0x66, 0x28, 0x40, 0x59, 0x33, 0xf5, 0x00, 0xe4, 0xab, 0xbe, 0x5e, 0x78, 0xd5, 0xe3,
+	0x9f, 0xd1, 0x84, 0xcb, 0x5e, 0xf1, 0x17, 0x31, 0xa3, 0xda, 0x13, 0xb0, 0xf4, 0x9e, 0x00, 0x4f,
+	0xb9, 0x25, 0xc9, 0x02, 0xea, 0xe7, 0xf4, 0x0e, 0xf5, 0x86, 0xe9, 0x45, 0x36, 0xcd, 0x9f, 0xe1,
+	0x96, 0xfa, 0x0c, 0xef, 0x43, 0x2b, 0x66, 0x49, 0x28, 0x7b, 0x26, 0xf1, 0x11, 0xbf, 0x59, 0x0b,
+	0x3b, 0xc9, 0xb8, 0x73, 0xc9, 0xb1, 0x97, 0x90, 0x72, 0x7f, 0xfd, 0xdc, 0xb8, 0x7b, 0x02, 0xeb,
+	0x85, 0xb5, 0xc2, 0xbe, 0x59, 0x94, 0xd5, 0x94, 0x28, 0xeb, 0x41, 0x93, 0xea, 0xe2, 0x8b, 0x87,
+	0x32, 0x1f, 0x68, 0xad, 0x08, 0xde, 0xd8, 0xca, 0xc7, 0xf8, 0x08, 0xd6, 0x8e, 0x12, 0x12, 0xef,
+	0x0f, 0xd3, 0xe0, 0x44, 0x4f, 0x51, 0xe5, 0x97, 0xec, 0x31, 0x79, 0x4a, 0xa3, 0x93, 0x9b, 0x56,
+	0x8c, 0x78, 0xff, 0x6e, 0x1c, 0xa4, 0x42, 0x32, 0x1f, 0xe0, 0xaf, 0xeb, 0xd0, 0xce, 0x64, 0x52,
+	0x4d, 0x5f, 0x04, 0x61, 0x26, 0x8d, 0xfd, 0x46, 0x37, 0x20, 0xfb, 0xf6, 0xc0, 0xe4, 0x55, 0xbd,
+	0x73, 0x33, 0x26, 0x84, 0xe1, 0x92, 0x74, 0xb5, 0xbb, 0x59, 0xe5, 0xa7, 0xcd, 0x29, 0xbd, 0x8d,
+	0x86, 0xd6, 0xdb, 0xc8, 0xfa, 0x0a, 0x4d, 0xa5, 0xaf, 0xb0, 0xe0, 0x8b, 0x5f, 0xf6, 0x19, 0x96,
+	0x0a, 0x7d, 0x06, 0x79, 0xfb, 0xb6, 0x8b, 0xb7, 0xef, 0x13, 0xe8, 0xe9, 0xd8, 0x0a, 0xcb, 0x7d,
+	0x0b, 0xc0, 0xe3, 0x73, 0x01, 0xc9, 0x42, 0x53, 0x76, 0xb1, 0x72, 0x76, 0x85, 0x89, 0x1e, 0x21,
+	0x24, 0xa7, 0x59, 0x58, 0xb1, 0xdf, 0xf8, 0x9b, 0xb0, 0xb9, 0xef, 0x8f, 0x83, 0x90, 0xa7, 0x99,
+	0xf3, 0x5c, 0x0b, 0x6f, 0x83, 0x63, 0x62, 0x17, 0x2e, 0x7a, 0x03, 0xb6, 0x15, 0xea, 0xb9, 0xd1,
+	0x82, 0xaf, 0xc0, 0x4e, 0x05, 0xbf, 0x10, 0xf8, 0x1e, 0xac, 0x1f, 0x9c, 0x4e, 0xa2, 0x38, 0xa5,
+	0x10, 0xdc, 0xf1, 0x52, 0xaf, 0xba, 0x72, 0xfe, 0x5b, 0x1d, 0x10, 0xe7, 0x25, 0x4a, 0x93, 0x7a,
+	0x8e, 0x57, 0x80, 0x34, 0xb8, 0x65, 0x34, 0xb8, 0xfa, 0x5d, 0x20, 0x0b, 0x97, 0xa6, 0x12, 0x2e,
+	0x8b, 0x3a, 0x81, 0x9e, 0x01, 0x97, 0x4a, 0x19, 0x50, 0xcd, 0x52, 0xed, 0x42, 0xe7, 0x52, 0x0d,
+	0xc2, 0x4e, 0xa1, 0x1f, 0xa8, 0xb9, 0x11, 0x18, 0xde, 0xa7, 0x32, 0xaf, 0x2e, 0x17, 0xf3, 0xea,
+	0xeb, 0x1a, 0xf4, 0x8b, 0x40, 0x5f, 0xb4, 0x99, 0x84, 0x3e, 0xd2, 0x7b, 0x7a, 0xf5, 0xc2, 0xad,
+	0x51, 0x36, 0x92, 0xde, 0xd5, 0x7b, 0x04, 0xeb, 0xdc, 0x1f, 0xce, 0xb5, 0x39, 0xfa, 0x06, 0xac,
+	0xfa, 0x05, 0xd7, 0x49, 0x98, 0x69, 0xdb, 0x6e, 0x99, 0x80, 0x6d, 0xe8, 0x17, 0x05, 0xf3, 0x33,
+	0x5e, 0xff, 0x6f, 0x0d, 0x3a, 0x07, 0x71, 0x1c, 0xc5, 0xb7, 0x23, 0x9f, 0xa0, 0x65, 0x58, 0x7a,
+	0x30, 0x65, 0xe5, 0x49, 0xf7, 0x0d, 0x64, 0xd3, 0xf7, 0xcc, 0x24, 0x4a, 0x82, 0x34, 0x8a, 0x5f,
+	0x1d, 0x46, 0xe9, 0xc1, 0x69, 0x90, 0xa4, 0xdd, 0x5f, 0x9f, 0xd9, 0x08, 0xc1, 0x25, 0x71, 0x7e,
+	0x3e, 0xf7, 0xf5, 0x99, 0x8d, 0x36, 0x69, 0xf7, 0xc7, 0x27, 0xa7, 0x82, 0xf0, 0x63, 0x2f, 0x18,
+	0x4d, 0x63, 0xd2, 0xfd, 0x0d, 0x67, 0x3f, 0x8c, 0xee, 0x93, 0x78, 0x1c, 0x24, 0xf4, 0x22, 0xec,
+	0xfe, 0xf6, 0xcc, 0xa6, 0xc2, 0xe5, 0xbb, 0x22, 0x17, 0xfe, 0xbb, 0x33, 0x1b, 0xad, 0xc2, 0x32,
+	0x2f, 0x09, 0xf9, 0xd4, 0xef, 0xcf, 0x6c, 0xd4, 0x83, 0x15, 0x3e, 0x95, 0x33, 0xfe, 0xe1, 0xcc,
+	0x46, 0x1b, 0xb0, 0x2a, 0x45, 0x1c, 0xb0, 0x86, 0x83, 0xdf, 0xfd, 0x23, 0x97, 0x2d, 0x0f, 0x9f,
+	0x2f, 0x79, 0x7d, 0x66, 0x5f, 0xbf, 0x05, 0x20, 0xcb, 0x3a, 0x04, 0xd0, 0xba, 0x3f, 0x3d, 0x1e,
+	0x05, 0xc3, 0xee, 0x1b, 0xe8, 0x12, 0xb4, 0x8f, 0xc2, 0x51, 0x90, 0xa4, 0xc4, 0xef, 0xd6, 0x28,
+	0x0e, 0xf7, 0xe3, 0xe0, 0xc4, 0x4b, 0x49, 0xb7, 0x7e, 0xfd, 0x33, 0x68, 0xd0, 0x2a, 0x8b, 0xb2,
+	0xd0, 0xbf, 0x87, 0x51, 0x48, 0xba, 0x6f, 0xd0, 0xc5, 0x0f, 0x59, 0x2f, 0xb2, 0x5b, 0x43, 0x97,
+	0xa1, 0x23, 0x36, 0x8c, 0xe2, 0x6e, 0x1d, 0xad, 0x00, 0x7c, 0xe1, 0x05, 0x61, 0xea, 0x05, 0x21,
+	0x89, 0xbb, 0x16, 0xea, 0x40, 0xf3, 0x27, 0xf4, 0xcb, 0x67, 0xb7, 0x71, 0xf3, 0xdf, 0x7d, 0x58,
+	0x12, 0x08, 0xa1, 0x03, 0x00, 0xf9, 0xb9, 0x15, 0x39, 0xb9, 0x97, 0x94, 0xbe, 0x23, 0x3b, 0x5b,
+	0x46, 0x9a, 0xf0, 0xd2, 0x4f, 0xf5, 0xfe, 0xe5, 0x96, 0xd1, 0x45, 0x85, 0xa0, 0x6d, 0x33, 0x51,
+	0x48, 0xfa, 0x1c, 0x2e, 0xa9, 0x4d, 0x55, 0x24, 0xb9, 0x0d, 0xcd, 0x5a, 0x67, 0xa7, 0x82, 0x2a,
+	0x84, 0x1d, 0xc2, 0x65, 0xed, 0x9b, 0x19, 0x92, 0xfc, 0xa6, 0x2f, 0x98, 0xce, 0x6e, 0x15, 0x59,
+	0xc8, 0x7b, 0x02, 0xa8, 0xfc, 0x15, 0x0c, 0xe1, 0x7c, 0x55, 0xe5, 0x77, 0x37, 0xe7, 0xad, 0x99,
+	0x3c, 0x42, 0xfc, 0x97, 0xb0, 0xa2, 0x51, 0x13, 0xb4, 0x6b, 0x5e, 0x96, 0x8b, 0xbd, 0x52, 0x49,
+	0x17, 0x22, 0x87, 0xd0, 0x33, 0x7d, 0x00, 0x40, 0x6f, 0xab, 0x0b, 0xab, 0x3e, 0x3d, 0x38, 0x57,
+	0xcf, 0xe1, 0x12, 0x9b, 0x3c, 0x82, 0x6e, 0xb1, 0xfe, 0x46, 0x83, 0x7c, 0x69, 0x45, 0xdd, 0xef,
+	0xec, 0xcd, 0xe0, 0x90, 0x82, 0x8b, 0x77, 0x93, 0x22, 0xb8, 0xe2, 0x9a, 0x73, 0xf6, 0x66, 0x70,
+	0x08, 0xc1, 0x41, 0xe1, 0x53, 0x5d, 0x5e, 0x79, 0xa3, 0x77, 0xcc, 0x88, 0x16, 0xab, 0x7b, 0xe7,
+	0xdd, 0x73, 0xf9, 0xc4, 0x56, 0xbf, 0x80, 0xd5, 0x52, 0x65, 0x8b, 0xa4, 0x8a, 0x55, 0xf5, 0xb4,
+	0x83, 0x67, 0xb1, 0x08, 0xd9, 0x3f, 0x85, 0x37, 0x0b, 0xe5, 0x2a, 0xba, 0xa2, 0x7f, 0x49, 0x2b,
+	0xcb, 0x1d, 0x54, 0x33, 0x48, 0xd4, 0x8b, 0xfd, 0x75, 0x05, 0xf5, 0x8a, 0x8e, 0xbe, 0xb3, 0x37,
+	0x83, 0x43, 0xc6, 0xb6, 0xda, 0x24, 0x57, 0x62, 0xdb, 0xd0, 0xb3, 0x77, 0x76, 0x2a, 0xa8, 0x52,
+	0xcb, 0x62, 0x3f, 0x5b, 0xd1, 0xb2, 0xa2, 0xc9, 0xee, 0xec, 0xcd, 0xe0, 0x10, 0x82, 0x0f, 0x00,
+	0x64, 0x4b, 0x54, 0x49, 0x89, 0xa5, 0x26, 0xab, 0xb3, 0x65, 0xa4, 0x49, 0xdb, 0x14, 0xba, 0xc6,
+	0x8a, 0x6d, 0xcc, 0xdd, 0x68, 0x67, 0x50, 0xcd, 0x20, 0x4f, 0x5d, 0x6c, 0x04, 0x23, 0xd5, 0xa2,
+	0xc6, 0x06, 0xb3, 0xb3, 0x37, 0x83, 0xc3, 0xa0, 0xae, 0xc8, 0x11, 0x06, 0x75, 0xf5, 0xf4, 0x30,
+	0xa8, 0x66, 0x50, 0x1d, 0x54, 0xeb, 0x54, 0x6a, 0x0e, 0x6a, 0xea, 0x8b, 0x3a, 0x83, 0x6a, 0x06,
+	0x79, 0xdb, 0x28, 0xad, 0x4a, 0xe5, 0xb6, 0x29, 0xb7, 0x3e, 0x95, 0xdb, 0xc6, 0xd4, 0xdd, 0x7c,
+	0x04, 0xdd, 0x62, 0x3b, 0x12, 0xe9, 0xa7, 0x32, 0x34, 0x3a, 0x9d, 0xbd, 0x19, 0x1c, 0x6a, 0xd4,
+	0x17, 0x5a, 0x88, 0x5a, 0xd4, 0x9b, 0xdb, 0x96, 0x0e, 0x9e, 0xc5, 0x22, 0x8f, 0xaf, 0xb4, 0xf5,
+	0x94, 0xe3, 0x97, 0xdb, 0x84, 0xce, 0xb6, 0x99, 0x28, 0x03, 0x52, 0xed, 0xc5, 0x21, 0x15, 0xac,
+	0x52, 0xd7, 0xcf, 0xd9, 0xa9, 0xa0, 0xca, 0xcb, 0x56, 0xeb, 0xa4, 0x29, 0x97, 0xad, 0xa9, 0xb1,
+	0xe7, 0xec, 0x56, 0x91, 0xe5, 0xd5, 0x65, 0x6a, 0x84, 0x29, 0x57, 0xd7, 0x8c, 0xfe, 0x9c, 0x73,
+	0xf5, 0x1c, 0x2e, 0xa9, 0xb4, 0xd6, 0x11, 0x53, 0x94, 0x36, 0x75, 0xd9, 0x9c, 0xdd, 0x2a, 0xb2,
+	0x94, 0xa7, 0xbd, 0xf4, 0x91, 0x0a, 0x5a, 0xb9, 0x7b, 0xe0, 0xec, 0x56, 0x91, 0xa5, 0x85, 0xd4,
+	0xe7, 0xa7, 0x62, 0x21, 0xc3, 0x8b, 0xdf, 0xd9, 0xa9, 0xa0, 0xca, 0xf2, 0xa5, 0xfc, 0x7a, 0x54,
+	0xca, 0x97, 0xca, 0x97, 0xa8, 0xf3, 0xd6, 0x4c, 0x1e, 0x21, 0xfe, 0x29, 0xac, 0x2b, 0x54, 0xe5,
+	0x4e, 0xba, 0x6a, 0x5a, 0x5d, 0xbe, 0x99, 0xde, 0x39, 0x8f, 0x4d, 0x96, 0x49, 0xfa, 0x63, 0x49,
+	0x29, 0x93, 0x8c, 0xcf, 0x55, 0xe7, 0x4a, 0x25, 0x5d, 0x8a, 0xd4, 0xdf, 0x26, 0x8a, 0x48, 0xe3,
+	0x6b, 0xc8, 0xb9, 0x52, 0x49, 0xe7, 0x22, 0x8f, 0x5b, 0xec, 0xbf, 0x33, 0x6f, 0xfd, 0x6f, 0x00,
+	0xca, 0x5a, 0x80, 0xc7, 0xae, 0x29, 0x00, 0x00,
 }
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/errors"
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+	"sort"
+)
+
+const (
+	activityKindProject    = "project"
+	activityKindAnnotation = "annotation"
+
+	defaultActivityLimit = 20
+	maxActivityLimit     = 50
+	// annotations in projects not public are skipped, at most this many
+	// batches of them are read for a page
+	maxActivityBatches = 5
+)
+
+func protoProjectInfo(info store.ProjectInfo) *proto.ProjectInfo {
+	return &proto.ProjectInfo{
+		Id:         info.Id,
+		Url:        info.Url,
+		Hash:       info.Hash,
+		Name:       info.Name,
+		Branch:     info.Branch,
+		CreatedAt:  info.CreatedAt,
+		Visibility: proto.Visibility(info.Visibility),
+		Org:        info.Org,
+	}
+}
+
+// activities of projects public and created before before
+func projectActivities(projects []store.ProjectInfo, before int64) []*proto.Activity {
+	activities := make([]*proto.Activity, 0, len(projects))
+	for _, info := range projects {
+		if info.Visibility != store.VisibilityPublic || (before > 0 && info.CreatedAt >= before) {
+			continue
+		}
+		activities = append(activities, &proto.Activity{
+			Kind:      activityKindProject,
+			Project:   protoProjectInfo(info),
+			CreatedAt: info.CreatedAt,
+		})
+	}
+	return activities
+}
+
+// mergeActivities returns the newest limit activities of a and b which are
+// not older than floor, and the before of the next page. Activities older
+// than floor are not all known yet, the next page starts from floor then.
+func mergeActivities(a, b []*proto.Activity, floor int64, limit int) ([]*proto.Activity, int64) {
+	activities := make([]*proto.Activity, 0, len(a)+len(b))
+	for _, list := range [][]*proto.Activity{a, b} {
+		for _, activity := range list {
+			if activity.CreatedAt >= floor {
+				activities = append(activities, activity)
+			}
+		}
+	}
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].CreatedAt > activities[j].CreatedAt
+	})
+
+	var next int64
+	if len(activities) >= limit {
+		activities = activities[:limit]
+		next = activities[limit-1].CreatedAt
+	} else if floor > 0 {
+		next = floor
+	}
+	return activities, next
+}
+
+// UserActivity lists projects created and annotations written in public
+// projects. Pages are split by timestamp, activities of the same second as
+// the last one of a page may be missed.
+func (service *projectService) UserActivity(ctx context.Context, req *proto.UserActivityRequest, rsp *proto.UserActivityResponse) error {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	} else if limit > maxActivityLimit {
+		limit = maxActivityLimit
+	}
+
+	projects, err := service.store.GetUserProjects(ctx, req.Uid)
+	if err != nil {
+		log.Errorf("[UserActivity] GetUserProjects error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	annotations, floor, err := service.publicAnnotations(ctx, req.Uid, req.Before, limit)
+	if err != nil {
+		log.Errorf("[UserActivity] GetUserAnnotations error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Activities, rsp.Next = mergeActivities(projectActivities(projects, req.Before), annotations, floor, limit)
+	return nil
+}
+
+// up to limit annotations of uid in public projects before before, floor
+// is the time annotations are read back to if there may be more of them
+func (service *projectService) publicAnnotations(ctx context.Context, uid string, before int64, limit int) (activities []*proto.Activity, floor int64, err error) {
+	// nil for projects not public
+	projects := make(map[string]*proto.ProjectInfo)
+	batchSize := limit * 2
+	for i := 0; i < maxActivityBatches; i++ {
+		annotations, err := service.store.GetUserAnnotations(ctx, uid, before, batchSize)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, annotation := range annotations {
+			info, ok := projects[annotation.Pid]
+			if !ok {
+				project, err := service.store.GetProject(ctx, annotation.Pid)
+				if err != nil && err != store.ErrProjectNotExist {
+					return nil, 0, err
+				}
+				if err == nil && project.Visibility == store.VisibilityPublic {
+					info = protoProjectInfo(project)
+				}
+				projects[annotation.Pid] = info
+			}
+			if info == nil {
+				continue
+			}
+			activities = append(activities, &proto.Activity{
+				Kind:         activityKindAnnotation,
+				Project:      info,
+				AnnotationId: annotation.Id,
+				Parent:       annotation.Parent,
+				File:         annotation.File,
+				Range:        annotationRange(annotation.LineRange),
+				Brief:        annotationBrief(annotation.Annotation),
+				CreatedAt:    annotation.CreatedAt,
+			})
+		}
+		if len(annotations) < batchSize {
+			return activities, 0, nil
+		}
+		before = annotations[len(annotations)-1].CreatedAt
+		if len(activities) >= limit {
+			break
+		}
+	}
+	return activities, before, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func activityTimes(activities []*proto.Activity) []int64 {
+	times := make([]int64, 0, len(activities))
+	for _, activity := range activities {
+		times = append(times, activity.CreatedAt)
+	}
+	return times
+}
+
+func TestProjectActivities(t *testing.T) {
+	projects := []store.ProjectInfo{
+		{Id: "a", CreatedAt: 10},
+		{Id: "b", CreatedAt: 20, Visibility: store.VisibilityPrivate},
+		{Id: "c", CreatedAt: 30, Visibility: store.VisibilityUnlisted},
+		{Id: "d", CreatedAt: 40},
+	}
+	require.Equal(t, []int64{10, 40}, activityTimes(projectActivities(projects, 0)))
+	require.Equal(t, []int64{10}, activityTimes(projectActivities(projects, 40)))
+}
+
+func TestMergeActivities(t *testing.T) {
+	projects := []*proto.Activity{{CreatedAt: 50}, {CreatedAt: 10}, {CreatedAt: 30}}
+	annotations := []*proto.Activity{{CreatedAt: 40}, {CreatedAt: 20}, {CreatedAt: 5}}
+
+	activities, next := mergeActivities(projects, annotations, 0, 4)
+	require.Equal(t, []int64{50, 40, 30, 20}, activityTimes(activities))
+	require.Equal(t, int64(20), next)
+
+	activities, next = mergeActivities(projects, annotations, 0, 10)
+	require.Len(t, activities, 6)
+	require.Equal(t, int64(0), next)
+
+	// annotations older than 20 are not read yet
+	activities, next = mergeActivities(projects, annotations[:2], 20, 10)
+	require.Equal(t, []int64{50, 40, 30, 20}, activityTimes(activities))
+	require.Equal(t, int64(20), next)
+}
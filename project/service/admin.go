@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/errors"
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+)
+
+// Admin requests are not checked against project roles, the api only
+// forwards them from accounts with the admin role.
+
+func (service *projectService) AdminDeleteProject(ctx context.Context, req *proto.AdminDeleteProjectRequest, rsp *proto.AdminDeleteProjectResponse) error {
+	err := service.store.DeleteProject(ctx, req.Pid)
+	if err != nil {
+		if err == store.ErrProjectNotExist {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		log.Errorf("[AdminDeleteProject] DeleteProject error: pid=%s err=%v", req.Pid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[AdminDeleteProject] project deleted: pid=%s", req.Pid)
+	return nil
+}
+
+func (service *projectService) AdminDeleteAnnotation(ctx context.Context, req *proto.AdminDeleteAnnotationRequest, rsp *proto.AdminDeleteAnnotationResponse) error {
+	annotation, err := service.getAnnotation(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	if err = service.deleteAnnotation(ctx, annotation); err != nil {
+		return err
+	}
+	log.Infof("[AdminDeleteAnnotation] annotation deleted: id=%s pid=%s", req.Id, annotation.Pid)
+	return nil
+}
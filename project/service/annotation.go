@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/errors"
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+	"path"
+	"strings"
+)
+
+var (
+	errAnnotationNotExist = errors.NewNotFoundError(int(proto.ErrorCode_AnnotationNotExist), "annotation not exist")
+	errInvalidRange       = errors.NewBadRequestError(-1, "invalid annotation range")
+)
+
+// get the lines an annotation to add covers, a single line of lineNumber if
+// range is not set
+func requestLineRange(lineNumber int32, r *proto.AnnotationRange) (store.LineRange, error) {
+	if r == nil {
+		r = &proto.AnnotationRange{StartLine: lineNumber}
+	}
+	lines := store.LineRange{
+		StartLine:   int(r.StartLine),
+		StartColumn: int(r.StartColumn),
+		EndLine:     int(r.EndLine),
+		EndColumn:   int(r.EndColumn),
+	}
+	if lines.EndLine == 0 {
+		lines.EndLine = lines.StartLine
+	}
+	if lines.StartLine < 1 || lines.EndLine < lines.StartLine || lines.StartColumn < 0 || lines.EndColumn < 0 {
+		return lines, errInvalidRange
+	}
+	if lines.StartLine == lines.EndLine && lines.StartColumn > 0 && lines.EndColumn > 0 && lines.EndColumn < lines.StartColumn {
+		return lines, errInvalidRange
+	}
+	return lines, nil
+}
+
+func annotationRange(lines store.LineRange) *proto.AnnotationRange {
+	lines = lines.Normalize()
+	return &proto.AnnotationRange{
+		StartLine:   int32(lines.StartLine),
+		StartColumn: int32(lines.StartColumn),
+		EndLine:     int32(lines.EndLine),
+		EndColumn:   int32(lines.EndColumn),
+	}
+}
+
+func (service *projectService) getAnnotation(ctx context.Context, id string) (store.Annotation, error) {
+	annotation, err := service.store.GetAnnotation(ctx, id)
+	if err != nil {
+		if err == store.ErrAnnotationNotExist {
+			return annotation, errAnnotationNotExist
+		}
+		return annotation, errors.NewInternalError(-1, err.Error())
+	}
+	return annotation, nil
+}
+
+// get the annotation starting the thread which annotation id belongs to
+func (service *projectService) threadOf(ctx context.Context, id string) (store.Annotation, error) {
+	annotation, err := service.getAnnotation(ctx, id)
+	if err != nil || annotation.Parent == "" {
+		return annotation, err
+	}
+	return service.getAnnotation(ctx, annotation.Parent)
+}
+
+// get annotation id which uid can modify, that is uid is the author or a
+// maintainer of the project
+func (service *projectService) modifiableAnnotation(ctx context.Context, id, uid string) (store.Annotation, error) {
+	annotation, err := service.getAnnotation(ctx, id)
+	if err != nil {
+		return annotation, err
+	}
+	role, err := service.memberRole(ctx, annotation.Pid, uid)
+	if err != nil {
+		return annotation, err
+	}
+	if annotation.Uid == uid && role >= store.RoleAnnotator {
+		return annotation, nil
+	}
+	if role >= store.RoleMaintainer {
+		return annotation, nil
+	}
+	return annotation, errNoPermission
+}
+
+// recompute directory summaries of all ancestors of file, each of them
+// refers to the newest annotation in its subtree or is removed if none left
+func (service *projectService) refreshLatestAnnotations(ctx context.Context, pid, file string) error {
+	currentFile := file
+	for currentFile != "." && currentFile != "/" {
+		parent := path.Dir(currentFile)
+		sub := path.Base(currentFile)
+		newest, err := service.store.GetNewestAnnotation(ctx, pid, currentFile)
+		if err == store.ErrAnnotationNotExist {
+			err = service.store.DeleteLatestAnnotation(ctx, pid, parent, sub)
+		} else if err == nil {
+			err = service.store.UpdateLatestAnnotation(ctx, pid, parent, sub, newest.File, annotationBrief(newest.Annotation), newest.LineRange, newest.CreatedAt)
+		}
+		if err != nil {
+			log.Warnf("[refreshLatestAnnotations] refresh error: pid=%s file=%s error=%v", pid, currentFile, err)
+			return err
+		}
+		currentFile = parent
+	}
+	return nil
+}
+
+func (service *projectService) UpdateAnnotation(ctx context.Context, req *proto.UpdateAnnotationRequest, rsp *proto.UpdateAnnotationResponse) error {
+	log.Debugf("[UpdateAnnotation]: id=%s uid=%s", req.Id, req.Uid)
+	annotation, err := service.modifiableAnnotation(ctx, req.Id, req.Uid)
+	if err != nil {
+		return err
+	}
+	if req.Annotation == annotation.Annotation {
+		return nil
+	}
+	err = service.store.UpdateAnnotation(ctx, req.Id, req.Annotation)
+	if err != nil {
+		if err == store.ErrAnnotationNotExist {
+			return errAnnotationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if annotation.Parent == "" {
+		_ = service.refreshLatestAnnotations(ctx, annotation.Pid, annotation.File)
+	}
+	return nil
+}
+
+func (service *projectService) DeleteAnnotation(ctx context.Context, req *proto.DeleteAnnotationRequest, rsp *proto.DeleteAnnotationResponse) error {
+	log.Debugf("[DeleteAnnotation]: id=%s uid=%s", req.Id, req.Uid)
+	annotation, err := service.modifiableAnnotation(ctx, req.Id, req.Uid)
+	if err != nil {
+		return err
+	}
+	return service.deleteAnnotation(ctx, annotation)
+}
+
+// delete annotation with its replies and refresh directory summaries
+func (service *projectService) deleteAnnotation(ctx context.Context, annotation store.Annotation) error {
+	err := service.store.DeleteAnnotation(ctx, annotation.Id)
+	if err != nil {
+		if err == store.ErrAnnotationNotExist {
+			return errAnnotationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if annotation.Parent == "" {
+		_ = service.refreshLatestAnnotations(ctx, annotation.Pid, annotation.File)
+	}
+	return nil
+}
+
+func (service *projectService) GetAnnotationRevisions(ctx context.Context, req *proto.GetAnnotationRevisionsRequest, rsp *proto.GetAnnotationRevisionsResponse) error {
+	annotation, err := service.getAnnotation(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	if _, _, err = service.readableProject(ctx, annotation.Pid, req.Uid); err != nil {
+		return err
+	}
+
+	rsp.Revisions = make([]*proto.AnnotationRevision, 0, len(annotation.Revisions))
+	for _, revision := range annotation.Revisions {
+		rsp.Revisions = append(rsp.Revisions, &proto.AnnotationRevision{
+			Annotation: revision.Annotation,
+			UpdatedAt:  revision.UpdatedAt,
+		})
+	}
+	return nil
+}
+
+func (service *projectService) ResolveAnnotation(ctx context.Context, req *proto.ResolveAnnotationRequest, rsp *proto.ResolveAnnotationResponse) error {
+	log.Debugf("[ResolveAnnotation]: id=%s uid=%s resolved=%v", req.Id, req.Uid, req.Resolved)
+	annotation, err := service.modifiableAnnotation(ctx, req.Id, req.Uid)
+	if err != nil {
+		return err
+	}
+	if annotation.Parent != "" {
+		return errors.NewBadRequestError(-1, "only threads can be resolved")
+	}
+	err = service.store.SetAnnotationResolved(ctx, req.Id, req.Resolved)
+	if err != nil {
+		if err == store.ErrAnnotationNotExist {
+			return errAnnotationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+const maxEmojiLength = 32
+
+func validEmoji(emoji string) bool {
+	return emoji != "" && len(emoji) <= maxEmojiLength && !strings.ContainsAny(emoji, " \t\r\n")
+}
+
+func (service *projectService) ReactAnnotation(ctx context.Context, req *proto.ReactAnnotationRequest, rsp *proto.ReactAnnotationResponse) error {
+	log.Debugf("[ReactAnnotation]: id=%s uid=%s emoji=%s remove=%v", req.Id, req.Uid, req.Emoji, req.Remove)
+	if req.Uid == "" {
+		return errNoPermission
+	}
+	if !validEmoji(req.Emoji) {
+		return errors.NewBadRequestError(-1, "invalid emoji")
+	}
+	annotation, err := service.getAnnotation(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	if _, _, err = service.readableProject(ctx, annotation.Pid, req.Uid); err != nil {
+		return err
+	}
+
+	if req.Remove {
+		err = service.store.RemoveReaction(ctx, req.Id, req.Emoji, req.Uid)
+	} else {
+		err = service.store.AddReaction(ctx, req.Id, req.Emoji, req.Uid)
+	}
+	if err != nil {
+		if err == store.ErrAnnotationNotExist {
+			return errAnnotationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
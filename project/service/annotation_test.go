@@ -0,0 +1,25 @@
+package service
+
+import (
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRequestLineRange(t *testing.T) {
+	lines, err := requestLineRange(7, nil)
+	require.NoError(t, err)
+	require.Equal(t, store.LineRange{StartLine: 7, EndLine: 7}, lines)
+
+	lines, err = requestLineRange(7, &proto.AnnotationRange{StartLine: 3, StartColumn: 2, EndLine: 9, EndColumn: 1})
+	require.NoError(t, err)
+	require.Equal(t, store.LineRange{StartLine: 3, StartColumn: 2, EndLine: 9, EndColumn: 1}, lines)
+
+	_, err = requestLineRange(0, nil)
+	require.Error(t, err)
+	_, err = requestLineRange(0, &proto.AnnotationRange{StartLine: 5, EndLine: 4})
+	require.Error(t, err)
+	_, err = requestLineRange(0, &proto.AnnotationRange{StartLine: 5, StartColumn: 8, EndLine: 5, EndColumn: 4})
+	require.Error(t, err)
+}
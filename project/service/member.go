@@ -0,0 +1,522 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/common/errors"
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+var (
+	errNoPermission       = errors.NewForbiddenError(int(proto.ErrorCode_NoPermission), "no permission")
+	errInvitationNotExist = errors.NewNotFoundError(int(proto.ErrorCode_InvitationNotExist), "invitation not exist")
+	errMemberNotExist     = errors.NewNotFoundError(int(proto.ErrorCode_MemberNotExist), "member not exist")
+	errMemberExist        = errors.NewBadRequestError(int(proto.ErrorCode_MemberExist), "already a member")
+	errInvalidRole        = errors.NewBadRequestError(-1, "invalid role")
+)
+
+// role of uid in project pid, project owner is RoleOwner. Members of the
+// organization owning the project have at least their organization roles
+func (service *projectService) memberRole(ctx context.Context, pid, uid string) (store.Role, error) {
+	info, err := service.store.GetProject(ctx, pid)
+	if err != nil {
+		if err == store.ErrProjectNotExist {
+			return store.RoleNone, errors.NewNotFoundError(-1, err.Error())
+		}
+		return store.RoleNone, errors.NewInternalError(-1, err.Error())
+	}
+	return service.projectRole(ctx, info, uid)
+}
+
+func (service *projectService) projectRole(ctx context.Context, info store.ProjectInfo, uid string) (store.Role, error) {
+	if uid == "" {
+		return store.RoleNone, nil
+	}
+	if uid == info.Uid {
+		return store.RoleOwner, nil
+	}
+	role, err := service.store.GetMemberRole(ctx, info.Id, uid)
+	if err != nil {
+		return store.RoleNone, errors.NewInternalError(-1, err.Error())
+	}
+	if info.Org {
+		org, _, err := service.orgRole(ctx, info.Uid, uid)
+		if err != nil {
+			return store.RoleNone, err
+		}
+		role = higherRole(role, org)
+	}
+	return role, nil
+}
+
+// private projects are readable by members only, they are not found to others
+func canRead(info store.ProjectInfo, role store.Role) bool {
+	return info.Visibility != store.VisibilityPrivate || role >= store.RoleViewer
+}
+
+// get project pid if uid can read it, uid may be empty for anonymous users
+func (service *projectService) readableProject(ctx context.Context, pid, uid string) (store.ProjectInfo, store.Role, error) {
+	info, err := service.store.GetProject(ctx, pid)
+	if err != nil {
+		if err == store.ErrProjectNotExist {
+			return info, store.RoleNone, errors.NewNotFoundError(-1, err.Error())
+		}
+		return info, store.RoleNone, errors.NewInternalError(-1, err.Error())
+	}
+	role, err := service.projectRole(ctx, info, uid)
+	if err != nil {
+		return info, role, err
+	}
+	if !canRead(info, role) {
+		return info, role, errors.NewNotFoundError(-1, store.ErrProjectNotExist.Error())
+	}
+	return info, role, nil
+}
+
+// check uid has at least role in project pid, returns the role of uid
+func (service *projectService) requireRole(ctx context.Context, pid, uid string, role store.Role) (store.Role, error) {
+	current, err := service.memberRole(ctx, pid, uid)
+	if err != nil {
+		return current, err
+	}
+	if current < role {
+		return current, errNoPermission
+	}
+	return current, nil
+}
+
+// a member can grant roles lower than their own, except owner who can grant
+// any role but owner
+func canGrant(own, role store.Role) bool {
+	if role <= store.RoleNone || role >= store.RoleOwner {
+		return false
+	}
+	return own == store.RoleOwner || role < own
+}
+
+func newInviteToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// names of accounts, accounts not found are missing
+func (service *projectService) accountNames(ctx context.Context, uids []string) (map[string]string, error) {
+	names := make(map[string]string, len(uids))
+	if len(uids) == 0 {
+		return names, nil
+	}
+	infoRsp, err := service.accountClient.AccountsBasicInfo(ctx, &account.AccountsBasicInfoRequest{Uids: uids})
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infoRsp.Infos {
+		names[info.Id] = info.Name
+	}
+	return names, nil
+}
+
+func (service *projectService) InviteMember(ctx context.Context, req *proto.InviteMemberRequest, rsp *proto.InviteMemberResponse) error {
+	log.Debugf("[InviteMember]: pid=%s uid=%s invitee=%s role=%v", req.Pid, req.Uid, req.Invitee, req.Role)
+	own, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleMaintainer)
+	if err != nil {
+		return err
+	}
+	role := store.Role(req.Role)
+	if !canGrant(own, role) {
+		return errInvalidRole
+	}
+	if req.Invitee == "" {
+		return errors.NewBadRequestError(-1, "invitee required")
+	}
+	current, err := service.memberRole(ctx, req.Pid, req.Invitee)
+	if err != nil {
+		return err
+	}
+	if current != store.RoleNone {
+		return errMemberExist
+	}
+
+	id, err := service.store.AddInvitation(ctx, store.Invitation{
+		Pid:       req.Pid,
+		Kind:      store.InvitationKindInvite,
+		Inviter:   req.Uid,
+		Invitee:   req.Invitee,
+		Role:      role,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		log.Warnf("[InviteMember] add invitation error: pid=%s invitee=%s error=%v", req.Pid, req.Invitee, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Id = id
+	return nil
+}
+
+func (service *projectService) CreateInviteLink(ctx context.Context, req *proto.CreateInviteLinkRequest, rsp *proto.CreateInviteLinkResponse) error {
+	log.Debugf("[CreateInviteLink]: pid=%s uid=%s role=%v expire=%d", req.Pid, req.Uid, req.Role, req.Expire)
+	own, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleMaintainer)
+	if err != nil {
+		return err
+	}
+	role := store.Role(req.Role)
+	if !canGrant(own, role) {
+		return errInvalidRole
+	}
+
+	token, err := newInviteToken()
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	now := time.Now().Unix()
+	invitation := store.Invitation{
+		Pid:       req.Pid,
+		Kind:      store.InvitationKindLink,
+		Inviter:   req.Uid,
+		Token:     token,
+		Role:      role,
+		CreatedAt: now,
+	}
+	if req.Expire > 0 {
+		invitation.ExpiresAt = now + req.Expire
+	}
+	id, err := service.store.AddInvitation(ctx, invitation)
+	if err != nil {
+		log.Warnf("[CreateInviteLink] add invitation error: pid=%s error=%v", req.Pid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Token = token
+	rsp.Id = id
+	return nil
+}
+
+func (service *projectService) ListInviteLinks(ctx context.Context, req *proto.ListInviteLinksRequest, rsp *proto.ListInviteLinksResponse) error {
+	if _, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleMaintainer); err != nil {
+		return err
+	}
+	links, err := service.store.GetInvitations(ctx, store.InvitationKindLink, req.Pid, "")
+	if err != nil {
+		log.Warnf("[ListInviteLinks] get invite links error: pid=%s error=%v", req.Pid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	now := time.Now().Unix()
+	uids := make([]string, 0, len(links))
+	for _, link := range links {
+		uids = append(uids, link.Inviter)
+	}
+	names, err := service.accountNames(ctx, uids)
+	if err != nil {
+		return err
+	}
+	rsp.Links = make([]*proto.InviteLink, 0, len(links))
+	for _, link := range links {
+		if link.ExpiresAt != 0 && link.ExpiresAt < now {
+			continue
+		}
+		rsp.Links = append(rsp.Links, &proto.InviteLink{
+			Id:        link.Id,
+			Token:     link.Token,
+			Role:      proto.Role(link.Role),
+			Uid:       link.Inviter,
+			Name:      names[link.Inviter],
+			CreatedAt: link.CreatedAt,
+			ExpiresAt: link.ExpiresAt,
+		})
+	}
+	return nil
+}
+
+func (service *projectService) RevokeInviteLink(ctx context.Context, req *proto.RevokeInviteLinkRequest, rsp *proto.RevokeInviteLinkResponse) error {
+	log.Debugf("[RevokeInviteLink]: id=%s uid=%s", req.Id, req.Uid)
+	link, err := service.store.GetInvitation(ctx, req.Id)
+	if err != nil {
+		if err == store.ErrInvitationNotExist {
+			return errInvitationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if link.Kind != store.InvitationKindLink {
+		return errInvitationNotExist
+	}
+	if _, err = service.requireRole(ctx, link.Pid, req.Uid, store.RoleMaintainer); err != nil {
+		return err
+	}
+	if err = service.store.DeleteInvitation(ctx, req.Id); err != nil && err != store.ErrInvitationNotExist {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *projectService) JoinByLink(ctx context.Context, req *proto.JoinByLinkRequest, rsp *proto.JoinByLinkResponse) error {
+	log.Debugf("[JoinByLink]: uid=%s", req.Uid)
+	invitation, err := service.store.GetInvitationByToken(ctx, req.Token)
+	if err != nil {
+		if err == store.ErrInvitationNotExist {
+			return errInvitationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if invitation.ExpiresAt != 0 && invitation.ExpiresAt < time.Now().Unix() {
+		return errors.NewBadRequestError(int(proto.ErrorCode_InvitationExpired), "invitation expired")
+	}
+
+	current, err := service.memberRole(ctx, invitation.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	rsp.Pid = invitation.Pid
+	// never downgrade a member
+	if current >= invitation.Role {
+		rsp.Role = proto.Role(current)
+		return nil
+	}
+	if err = service.store.SetMember(ctx, invitation.Pid, req.Uid, invitation.Role); err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Role = proto.Role(invitation.Role)
+	return nil
+}
+
+func (service *projectService) invitationRecords(ctx context.Context, invitations []store.Invitation, uidOf func(store.Invitation) string) ([]*proto.Invitation, error) {
+	uids := make([]string, 0, len(invitations))
+	for _, invitation := range invitations {
+		uids = append(uids, uidOf(invitation))
+	}
+	names, err := service.accountNames(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*proto.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		uid := uidOf(invitation)
+		records = append(records, &proto.Invitation{
+			Id:        invitation.Id,
+			Pid:       invitation.Pid,
+			Uid:       uid,
+			Name:      names[uid],
+			Role:      proto.Role(invitation.Role),
+			CreatedAt: invitation.CreatedAt,
+		})
+	}
+	return records, nil
+}
+
+func (service *projectService) ListInvitations(ctx context.Context, req *proto.ListInvitationsRequest, rsp *proto.ListInvitationsResponse) error {
+	invitations, err := service.store.GetInvitations(ctx, store.InvitationKindInvite, "", req.Uid)
+	if err != nil {
+		log.Warnf("[ListInvitations] get invitations error: uid=%s error=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Invitations, err = service.invitationRecords(ctx, invitations, func(invitation store.Invitation) string {
+		return invitation.Inviter
+	})
+	return err
+}
+
+func (service *projectService) ReplyInvitation(ctx context.Context, req *proto.ReplyInvitationRequest, rsp *proto.ReplyInvitationResponse) error {
+	log.Debugf("[ReplyInvitation]: id=%s uid=%s accept=%v", req.Id, req.Uid, req.Accept)
+	invitation, err := service.store.GetInvitation(ctx, req.Id)
+	if err != nil {
+		if err == store.ErrInvitationNotExist {
+			return errInvitationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if invitation.Kind != store.InvitationKindInvite || invitation.Invitee != req.Uid {
+		return errInvitationNotExist
+	}
+
+	if req.Accept {
+		current, err := service.memberRole(ctx, invitation.Pid, req.Uid)
+		if err != nil {
+			return err
+		}
+		if current < invitation.Role {
+			if err = service.store.SetMember(ctx, invitation.Pid, req.Uid, invitation.Role); err != nil {
+				return errors.NewInternalError(-1, err.Error())
+			}
+		}
+	}
+	if err = service.store.DeleteInvitation(ctx, req.Id); err != nil && err != store.ErrInvitationNotExist {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *projectService) RequestJoin(ctx context.Context, req *proto.RequestJoinRequest, rsp *proto.RequestJoinResponse) error {
+	log.Debugf("[RequestJoin]: pid=%s uid=%s", req.Pid, req.Uid)
+	// private projects are hidden from users who can not read them
+	_, current, err := service.readableProject(ctx, req.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	if current != store.RoleNone {
+		return errMemberExist
+	}
+
+	id, err := service.store.AddInvitation(ctx, store.Invitation{
+		Pid:       req.Pid,
+		Kind:      store.InvitationKindRequest,
+		Invitee:   req.Uid,
+		Role:      store.RoleAnnotator,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		log.Warnf("[RequestJoin] add join request error: pid=%s uid=%s error=%v", req.Pid, req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Id = id
+	return nil
+}
+
+func (service *projectService) ListJoinRequests(ctx context.Context, req *proto.ListJoinRequestsRequest, rsp *proto.ListJoinRequestsResponse) error {
+	if _, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleMaintainer); err != nil {
+		return err
+	}
+	requests, err := service.store.GetInvitations(ctx, store.InvitationKindRequest, req.Pid, "")
+	if err != nil {
+		log.Warnf("[ListJoinRequests] get join requests error: pid=%s error=%v", req.Pid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	rsp.Requests, err = service.invitationRecords(ctx, requests, func(invitation store.Invitation) string {
+		return invitation.Invitee
+	})
+	return err
+}
+
+func (service *projectService) ReviewJoinRequest(ctx context.Context, req *proto.ReviewJoinRequestRequest, rsp *proto.ReviewJoinRequestResponse) error {
+	log.Debugf("[ReviewJoinRequest]: id=%s uid=%s approve=%v", req.Id, req.Uid, req.Approve)
+	request, err := service.store.GetInvitation(ctx, req.Id)
+	if err != nil {
+		if err == store.ErrInvitationNotExist {
+			return errInvitationNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if request.Kind != store.InvitationKindRequest {
+		return errInvitationNotExist
+	}
+	if _, err = service.requireRole(ctx, request.Pid, req.Uid, store.RoleMaintainer); err != nil {
+		return err
+	}
+
+	if req.Approve {
+		// the requester may have been given a higher role since requesting
+		current, err := service.memberRole(ctx, request.Pid, request.Invitee)
+		if err != nil {
+			return err
+		}
+		if current < request.Role {
+			if err = service.store.SetMember(ctx, request.Pid, request.Invitee, request.Role); err != nil {
+				return errors.NewInternalError(-1, err.Error())
+			}
+		}
+	}
+	if err = service.store.DeleteInvitation(ctx, req.Id); err != nil && err != store.ErrInvitationNotExist {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *projectService) ListMembers(ctx context.Context, req *proto.ListMembersRequest, rsp *proto.ListMembersResponse) error {
+	info, _, err := service.readableProject(ctx, req.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	owner := info.Uid
+	members, err := service.store.GetMembers(ctx, req.Pid)
+	if err != nil {
+		log.Warnf("[ListMembers] get members error: pid=%s error=%v", req.Pid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	uids := []string{owner}
+	for _, member := range members {
+		uids = append(uids, member.Uid)
+	}
+	names, err := service.accountNames(ctx, uids)
+	if err != nil {
+		return err
+	}
+
+	rsp.Members = make([]*proto.Member, 0, len(members)+1)
+	rsp.Members = append(rsp.Members, &proto.Member{Uid: owner, Name: names[owner], Role: proto.Role_Owner})
+	for _, member := range members {
+		rsp.Members = append(rsp.Members, &proto.Member{
+			Uid:       member.Uid,
+			Name:      names[member.Uid],
+			Role:      proto.Role(member.Role),
+			CreatedAt: member.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (service *projectService) RemoveMember(ctx context.Context, req *proto.RemoveMemberRequest, rsp *proto.RemoveMemberResponse) error {
+	log.Debugf("[RemoveMember]: pid=%s uid=%s member=%s", req.Pid, req.Uid, req.Member)
+	own, err := service.memberRole(ctx, req.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	// members can leave by themselves, except owner
+	if req.Member == req.Uid {
+		if own == store.RoleOwner {
+			return errors.NewBadRequestError(-1, "owner can not leave project")
+		}
+	} else {
+		if own < store.RoleMaintainer {
+			return errNoPermission
+		}
+		target, err := service.store.GetMemberRole(ctx, req.Pid, req.Member)
+		if err != nil {
+			return errors.NewInternalError(-1, err.Error())
+		}
+		if own != store.RoleOwner && target >= own {
+			return errNoPermission
+		}
+	}
+
+	err = service.store.RemoveMember(ctx, req.Pid, req.Member)
+	if err != nil {
+		if err == store.ErrMemberNotExist {
+			return errMemberNotExist
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *projectService) SetMemberRole(ctx context.Context, req *proto.SetMemberRoleRequest, rsp *proto.SetMemberRoleResponse) error {
+	log.Debugf("[SetMemberRole]: pid=%s uid=%s member=%s role=%v", req.Pid, req.Uid, req.Member, req.Role)
+	own, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleMaintainer)
+	if err != nil {
+		return err
+	}
+	role := store.Role(req.Role)
+	if !canGrant(own, role) {
+		return errInvalidRole
+	}
+	target, err := service.store.GetMemberRole(ctx, req.Pid, req.Member)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if target == store.RoleNone {
+		return errMemberNotExist
+	}
+	if own != store.RoleOwner && target >= own {
+		return errNoPermission
+	}
+	if err = service.store.SetMember(ctx, req.Pid, req.Member, role); err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/common/markdown"
+	"github.com/lt90s/rfschub-server/notification/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+)
+
+// event types in increasing priority, a user concerned with an annotation in
+// several ways is only notified of the one of the highest priority
+var eventPriority = []notification.EventType{
+	notification.EventType_Annotation,
+	notification.EventType_Reply,
+	notification.EventType_Mention,
+}
+
+// group candidate recipients by the event they are notified of, the actor is
+// never notified
+func groupRecipients(actor string, candidates map[notification.EventType][]string) map[notification.EventType][]string {
+	events := make(map[string]notification.EventType)
+	order := make([]string, 0, 8)
+	for _, t := range eventPriority {
+		for _, uid := range candidates[t] {
+			if uid == "" || uid == actor {
+				continue
+			}
+			if _, ok := events[uid]; !ok {
+				order = append(order, uid)
+			}
+			events[uid] = t
+		}
+	}
+
+	groups := make(map[notification.EventType][]string, len(eventPriority))
+	for _, uid := range order {
+		groups[events[uid]] = append(groups[events[uid]], uid)
+	}
+	return groups
+}
+
+// notify users concerned with a newly added annotation who can read the
+// project: the project owners of a new thread, the author and repliers of a
+// thread of a reply, and users mentioned. Failures are logged only, they do
+// not fail adding the annotation.
+func (service *projectService) notifyAnnotation(ctx context.Context, info store.ProjectInfo, annotation store.Annotation, threadAuthor string) {
+	if service.notificationClient == nil {
+		return
+	}
+	candidates := make(map[notification.EventType][]string, len(eventPriority))
+	thread := annotation.Parent
+	if thread == "" {
+		thread = annotation.Id
+		owners, err := service.projectOwners(ctx, info)
+		if err != nil {
+			log.Warnf("[notifyAnnotation] get project owners error: pid=%s error=%v", info.Id, err)
+		}
+		candidates[notification.EventType_Annotation] = owners
+	} else {
+		participants := []string{threadAuthor}
+		replies, err := service.store.GetReplies(ctx, []string{thread})
+		if err != nil {
+			log.Warnf("[notifyAnnotation] get replies error: thread=%s error=%v", thread, err)
+		}
+		for _, reply := range replies {
+			participants = append(participants, reply.Uid)
+		}
+		// participants may have lost access to the project since
+		candidates[notification.EventType_Reply] = service.readers(ctx, info, participants)
+	}
+	candidates[notification.EventType_Mention] = service.mentionedReaders(ctx, info, annotation.Annotation)
+
+	names, err := service.accountNames(ctx, []string{annotation.Uid})
+	if err != nil {
+		log.Warnf("[notifyAnnotation] get account name error: uid=%s error=%v", annotation.Uid, err)
+	}
+	brief := annotationBrief(annotation.Annotation)
+	for t, recipients := range groupRecipients(annotation.Uid, candidates) {
+		_, err := service.notificationClient.Notify(ctx, &notification.NotifyRequest{
+			Event: &notification.Event{
+				Type:       t,
+				Actor:      annotation.Uid,
+				ActorName:  names[annotation.Uid],
+				Pid:        info.Id,
+				Url:        info.Url,
+				Project:    info.Name,
+				File:       annotation.File,
+				LineNumber: int32(annotation.StartLine),
+				Annotation: annotation.Id,
+				Thread:     thread,
+				Brief:      brief,
+			},
+			Recipients: recipients,
+		})
+		if err != nil {
+			log.Warnf("[notifyAnnotation] notify error: pid=%s annotation=%s type=%v error=%v", info.Id, annotation.Id, t, err)
+		}
+	}
+}
+
+// uids of users mentioned in text who can read project info
+func (service *projectService) mentionedReaders(ctx context.Context, info store.ProjectInfo, text string) []string {
+	names := markdown.Mentions(text)
+	if len(names) == 0 {
+		return nil
+	}
+	infoRsp, err := service.accountClient.AccountsBasicInfoByNames(ctx, &account.AccountsBasicInfoByNamesRequest{Names: names})
+	if err != nil {
+		log.Warnf("[mentionedReaders] get accounts error: names=%v error=%v", names, err)
+		return nil
+	}
+	uids := make([]string, 0, len(infoRsp.Infos))
+	for _, basic := range infoRsp.Infos {
+		uids = append(uids, basic.Id)
+	}
+	return service.readers(ctx, info, uids)
+}
+
+// uids of users who can read project info
+func (service *projectService) readers(ctx context.Context, info store.ProjectInfo, uids []string) []string {
+	if info.Visibility != store.VisibilityPrivate {
+		return uids
+	}
+	readers := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		role, err := service.projectRole(ctx, info, uid)
+		if err != nil || !canRead(info, role) {
+			continue
+		}
+		readers = append(readers, uid)
+	}
+	return readers
+}
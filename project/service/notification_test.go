@@ -0,0 +1,20 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/notification/proto"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGroupRecipients(t *testing.T) {
+	groups := groupRecipients("actor", map[notification.EventType][]string{
+		notification.EventType_Annotation: {"owner", "actor"},
+		notification.EventType_Reply:      {"author", "replier", "actor", "author", ""},
+		notification.EventType_Mention:    {"replier", "other"},
+	})
+	require.Equal(t, map[notification.EventType][]string{
+		notification.EventType_Annotation: {"owner"},
+		notification.EventType_Reply:      {"author"},
+		notification.EventType_Mention:    {"replier", "other"},
+	}, groups)
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+)
+
+// role of uid in organization org, ok is false if org is not an
+// organization. Organization roles have the values of project roles
+func (service *projectService) orgRole(ctx context.Context, org, uid string) (role store.Role, ok bool, err error) {
+	rsp, err := service.accountClient.OrganizationRole(ctx, &account.OrganizationRoleRequest{Org: org, Uid: uid})
+	if err != nil {
+		log.Warnf("[orgRole] OrganizationRole error: org=%s uid=%s error=%v", org, uid, err)
+		return store.RoleNone, false, err
+	}
+	return store.Role(rsp.Role), rsp.Organization, nil
+}
+
+// uids of owners and maintainers among members of an organization
+func orgManagers(members []*account.OrganizationMember) []string {
+	uids := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.Role >= account.OrgRole_OrgMaintainer {
+			uids = append(uids, member.Uid)
+		}
+	}
+	return uids
+}
+
+// users who own project info: the owner, or owners and maintainers of the
+// organization owning it
+func (service *projectService) projectOwners(ctx context.Context, info store.ProjectInfo) ([]string, error) {
+	if !info.Org {
+		return []string{info.Uid}, nil
+	}
+	rsp, err := service.accountClient.OrganizationMembers(ctx, &account.OrganizationMembersRequest{Org: info.Uid})
+	if err != nil {
+		log.Warnf("[projectOwners] OrganizationMembers error: org=%s error=%v", info.Uid, err)
+		return nil, err
+	}
+	return orgManagers(rsp.Members), nil
+}
+
+func higherRole(a, b store.Role) store.Role {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// projects of an owner are all owned by an organization or none of them
+func ownedByOrg(infos []store.ProjectInfo) bool {
+	return len(infos) > 0 && infos[0].Org
+}
+
+// projects listed to a viewer with role in all of them
+func listable(info store.ProjectInfo, role store.Role) bool {
+	return info.Visibility == store.VisibilityPublic || role >= store.RoleViewer
+}
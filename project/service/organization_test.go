@@ -0,0 +1,43 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/account/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHigherRole(t *testing.T) {
+	require.Equal(t, store.RoleMaintainer, higherRole(store.RoleViewer, store.RoleMaintainer))
+	require.Equal(t, store.RoleOwner, higherRole(store.RoleOwner, store.RoleAnnotator))
+	require.Equal(t, store.RoleNone, higherRole(store.RoleNone, store.RoleNone))
+}
+
+func TestListable(t *testing.T) {
+	public := store.ProjectInfo{Visibility: store.VisibilityPublic}
+	unlisted := store.ProjectInfo{Visibility: store.VisibilityUnlisted}
+	private := store.ProjectInfo{Visibility: store.VisibilityPrivate}
+
+	require.True(t, listable(public, store.RoleNone))
+	require.False(t, listable(unlisted, store.RoleNone))
+	require.False(t, listable(private, store.RoleNone))
+	require.True(t, listable(unlisted, store.RoleViewer))
+	require.True(t, listable(private, store.RoleViewer))
+}
+
+func TestOwnedByOrg(t *testing.T) {
+	require.False(t, ownedByOrg(nil))
+	require.False(t, ownedByOrg([]store.ProjectInfo{{}}))
+	require.True(t, ownedByOrg([]store.ProjectInfo{{Org: true}, {Org: true}}))
+}
+
+func TestOrgManagers(t *testing.T) {
+	members := []*account.OrganizationMember{
+		{Uid: "owner", Role: account.OrgRole_OrgOwner},
+		{Uid: "viewer", Role: account.OrgRole_OrgViewer},
+		{Uid: "maintainer", Role: account.OrgRole_OrgMaintainer},
+		{Uid: "annotator", Role: account.OrgRole_OrgAnnotator},
+	}
+	require.Equal(t, []string{"owner", "maintainer"}, orgManagers(members))
+	require.Empty(t, orgManagers(nil))
+}
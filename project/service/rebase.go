@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/gits/proto"
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+)
+
+// map a range of the old commit to the new one through hunks of the diff,
+// hunks are ordered by oldStart. ok is false if any line of the range changed.
+func mapLineRange(hunks []*gits.DiffHunk, lines store.LineRange) (mapped store.LineRange, ok bool) {
+	lines = lines.Normalize()
+	shift := 0
+	for _, hunk := range hunks {
+		oldStart, oldLines := int(hunk.OldStart), int(hunk.OldLines)
+		if oldLines == 0 {
+			// lines inserted after oldStart
+			if oldStart >= lines.EndLine {
+				break
+			}
+			if oldStart >= lines.StartLine {
+				return lines, false
+			}
+		} else {
+			if oldStart > lines.EndLine {
+				break
+			}
+			if oldStart+oldLines-1 >= lines.StartLine {
+				return lines, false
+			}
+		}
+		shift += int(hunk.NewLines) - oldLines
+	}
+	lines.StartLine += shift
+	lines.EndLine += shift
+	return lines, true
+}
+
+// get head commit of branch
+func (service *projectService) branchHead(ctx context.Context, url, branch string) (string, error) {
+	rsp, err := service.gitsClient.GetNamedCommits(ctx, &gits.GetNamedCommitsRequest{Url: url})
+	if err != nil {
+		log.Warnf("[branchHead] get named commits error: url=%s error=%v", url, err)
+		return "", err
+	}
+	for _, commit := range rsp.Commits {
+		if commit.Branch && commit.Name == branch {
+			return commit.Hash, nil
+		}
+	}
+	return "", errors.NewNotFoundError(-1, "branch not exist")
+}
+
+func (service *projectService) RebaseProject(ctx context.Context, req *proto.RebaseProjectRequest, rsp *proto.RebaseProjectResponse) error {
+	log.Debugf("[RebaseProject]: pid=%s uid=%s", req.Pid, req.Uid)
+	if _, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleMaintainer); err != nil {
+		return err
+	}
+	info, err := service.store.GetProject(ctx, req.Pid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if !info.Branch {
+		return errors.NewBadRequestError(-1, "project does not track a branch")
+	}
+	head, err := service.branchHead(ctx, info.Url, info.Name)
+	if err != nil {
+		return err
+	}
+	rsp.Hash = head
+	if head == info.Hash {
+		return nil
+	}
+	// check before annotations are moved, the project can not be rebased
+	// onto a commit the owner already has a project of
+	exists, err := service.store.CommitProjectExists(ctx, info.Uid, info.Url, head)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if exists {
+		return errors.NewBadRequestError(int(proto.ErrorCode_ProjectExist), "project exists")
+	}
+
+	diffRsp, err := service.gitsClient.DiffCommits(ctx, &gits.DiffCommitsRequest{Url: info.Url, From: info.Hash, To: head})
+	if err != nil {
+		log.Warnf("[RebaseProject] diff commits error: url=%s from=%s to=%s error=%v", info.Url, info.Hash, head, err)
+		return err
+	}
+	changed := make(map[string]gits.DiffStatus, len(diffRsp.Entries))
+	for _, entry := range diffRsp.Entries {
+		changed[entry.File] = entry.Status
+	}
+
+	threads, err := service.store.GetThreads(ctx, req.Pid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	fileDiffs := make(map[string]*gits.DiffFileResponse)
+	// files whose directory summaries may change
+	touched := make(map[string]struct{})
+	for _, thread := range threads {
+		// already moved by an interrupted rebase
+		if thread.Hash == head {
+			continue
+		}
+		lines, moved := thread.LineRange, true
+		if status, ok := changed[thread.File]; ok {
+			moved = false
+			if status == gits.DiffStatus_Modified {
+				fileDiff, ok := fileDiffs[thread.File]
+				if !ok {
+					fileDiff, err = service.gitsClient.DiffFile(ctx, &gits.DiffFileRequest{Url: info.Url, From: info.Hash, To: head, File: thread.File})
+					if err != nil {
+						log.Warnf("[RebaseProject] diff file error: url=%s file=%s error=%v", info.Url, thread.File, err)
+						return err
+					}
+					fileDiffs[thread.File] = fileDiff
+				}
+				if !fileDiff.Binary {
+					lines, moved = mapLineRange(fileDiff.Hunks, lines)
+				}
+			}
+		}
+
+		if err = service.store.ReanchorAnnotation(ctx, thread.Id, head, lines, !moved); err != nil {
+			log.Warnf("[RebaseProject] reanchor annotation error: id=%s error=%v", thread.Id, err)
+			return errors.NewInternalError(-1, err.Error())
+		}
+		if moved {
+			rsp.Moved++
+		} else {
+			rsp.Outdated++
+		}
+		if !moved || lines.StartLine != thread.StartLine {
+			touched[thread.File] = struct{}{}
+		}
+	}
+
+	if err = service.store.RebaseProject(ctx, req.Pid, head); err != nil {
+		if err == store.ErrProjectExist {
+			return errors.NewBadRequestError(int(proto.ErrorCode_ProjectExist), "project exists")
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	for file := range touched {
+		_ = service.refreshLatestAnnotations(ctx, req.Pid, file)
+	}
+	service.requestForIndexing(ctx, info.Uid, info.Url, head)
+	return nil
+}
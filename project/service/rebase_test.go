@@ -0,0 +1,42 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/gits/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMapLineRange(t *testing.T) {
+	hunks := []*gits.DiffHunk{
+		// line 3 replaced by 2 lines
+		{OldStart: 3, OldLines: 1, NewStart: 3, NewLines: 2},
+		// 3 lines inserted after line 10
+		{OldStart: 10, OldLines: 0, NewStart: 12, NewLines: 3},
+		// lines 20-21 deleted
+		{OldStart: 20, OldLines: 2, NewStart: 24, NewLines: 0},
+	}
+
+	lines, ok := mapLineRange(hunks, store.LineRange{StartLine: 1, EndLine: 2})
+	require.True(t, ok)
+	require.Equal(t, store.LineRange{StartLine: 1, EndLine: 2}, lines)
+
+	lines, ok = mapLineRange(hunks, store.LineRange{StartLine: 5, StartColumn: 2, EndLine: 10, EndColumn: 4})
+	require.True(t, ok)
+	require.Equal(t, store.LineRange{StartLine: 6, StartColumn: 2, EndLine: 11, EndColumn: 4}, lines)
+
+	lines, ok = mapLineRange(hunks, store.LineRange{StartLine: 11})
+	require.True(t, ok)
+	require.Equal(t, store.LineRange{StartLine: 15, EndLine: 15}, lines)
+
+	lines, ok = mapLineRange(hunks, store.LineRange{StartLine: 30, EndLine: 31})
+	require.True(t, ok)
+	require.Equal(t, store.LineRange{StartLine: 32, EndLine: 33}, lines)
+
+	_, ok = mapLineRange(hunks, store.LineRange{StartLine: 2, EndLine: 3})
+	require.False(t, ok)
+	_, ok = mapLineRange(hunks, store.LineRange{StartLine: 9, EndLine: 11})
+	require.False(t, ok)
+	_, ok = mapLineRange(hunks, store.LineRange{StartLine: 21})
+	require.False(t, ok)
+}
@@ -5,33 +5,49 @@ import (
 	accountClient "github.com/lt90s/rfschub-server/account/client"
 	"github.com/lt90s/rfschub-server/account/proto"
 	"github.com/lt90s/rfschub-server/common/errors"
+	"github.com/lt90s/rfschub-server/common/markdown"
 	"github.com/lt90s/rfschub-server/common/url"
+	gitsClient "github.com/lt90s/rfschub-server/gits/client"
+	"github.com/lt90s/rfschub-server/gits/proto"
 	indexClient "github.com/lt90s/rfschub-server/index/client"
 	"github.com/lt90s/rfschub-server/index/proto"
+	notificationClient "github.com/lt90s/rfschub-server/notification/client"
+	"github.com/lt90s/rfschub-server/notification/proto"
 	"github.com/lt90s/rfschub-server/project/config"
 	proto "github.com/lt90s/rfschub-server/project/proto"
 	"github.com/lt90s/rfschub-server/project/store"
 	log "github.com/sirupsen/logrus"
+	neturl "net/url"
 	"path"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type projectService struct {
 	store         store.Store
 	indexClient   index.IndexService
 	accountClient account.AccountService
+	gitsClient    gits.GitsService
+	// nil if notification is disabled
+	notificationClient notification.NotificationService
 }
 
 func NewProjectService(store store.Store) proto.ProjectHandler {
 	conf := config.DefaultConfig
-	return &projectService{
+	service := &projectService{
 		store:         store,
 		indexClient:   indexClient.New(indexClient.ServerConfig{ServiceName: conf.Index}),
 		accountClient: accountClient.New(accountClient.ServerConfig{ServiceName: conf.Account}),
+		gitsClient:    gitsClient.New(gitsClient.ServerConfig{ServiceName: conf.Gits}),
 	}
+	if conf.Notification != "" {
+		service.notificationClient = notificationClient.New(notificationClient.ServerConfig{ServiceName: conf.Notification})
+	}
+	return service
 }
 
-// TODO: like project & join project
+// TODO: like project
 
 // create a new annotation project
 func (service *projectService) NewProject(ctx context.Context, req *proto.NewProjectRequest, rsp *proto.NewProjectResponse) error {
@@ -40,8 +56,25 @@ func (service *projectService) NewProject(ctx context.Context, req *proto.NewPro
 		return errors.NewBadRequestError(-1, "repository url invalid")
 	}
 	log.Debugf("[NewProject]: data=%v", req)
+	if _, ok := proto.Visibility_name[int32(req.Visibility)]; !ok {
+		return errors.NewBadRequestError(-1, "invalid visibility")
+	}
+	owner, org := req.Uid, false
+	if req.Owner != "" && req.Owner != req.Uid {
+		role, ok, err := service.orgRole(ctx, req.Owner, req.Uid)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.NewNotFoundError(-1, "organization not exist")
+		}
+		if role < store.RoleMaintainer {
+			return errNoPermission
+		}
+		owner, org = req.Owner, true
+	}
 	// TODO: check if #project exceed quota
-	err := service.store.NewProject(ctx, req.Uid, repoUrl, req.Hash, req.Name, req.Branch)
+	err := service.store.NewProject(ctx, owner, repoUrl, req.Hash, req.Name, req.Branch, store.Visibility(req.Visibility), org)
 	if err != nil {
 		if err == store.ErrProjectExist {
 			return errors.NewBadRequestError(int(proto.ErrorCode_ProjectExist), "project exists")
@@ -49,7 +82,7 @@ func (service *projectService) NewProject(ctx context.Context, req *proto.NewPro
 			return errors.NewInternalError(-1, err.Error())
 		}
 	}
-	service.requestForIndexing(ctx, req.Url, req.Url, req.Hash)
+	service.requestForIndexing(ctx, owner, repoUrl, req.Hash)
 	return nil
 }
 
@@ -83,19 +116,54 @@ func (service *projectService) ProjectInfo(ctx context.Context, req *proto.Proje
 		return err
 	}
 
+	role, err := service.projectRole(ctx, info, req.Uid)
+	if err != nil {
+		return err
+	}
+	if !canRead(info, role) {
+		return errors.NewNotFoundError(-1, store.ErrProjectNotExist.Error())
+	}
+
 	// side effect: if repository is not indexed, request for indexing
 	if !info.Indexed {
 		log.Debugf("[projectInfo] project not indexed, request for indexing: uid=%s ownerUid=%s url=%s name=%s", req.Uid, req.OwnerUid, req.Url, req.Name)
 		service.requestForIndexing(ctx, req.OwnerUid, repoUrl, info.Hash)
 	}
-	// TODO: membership
-	if req.Uid == req.OwnerUid {
-		rsp.CanAnnotate = true
-	}
+	rsp.Role = proto.Role(role)
+	rsp.Visibility = proto.Visibility(info.Visibility)
+	rsp.CanAnnotate = role >= store.RoleAnnotator
 	rsp.Id = info.Id
 	rsp.Hash = info.Hash
 	rsp.Branch = info.Branch
 	rsp.Indexed = info.Indexed
+	rsp.Ignore = info.Ignore
+	rsp.Org = info.Org
+	return nil
+}
+
+func (service *projectService) SetProjectIgnore(ctx context.Context, req *proto.SetProjectIgnoreRequest, rsp *proto.SetProjectIgnoreResponse) error {
+	log.Debugf("[SetProjectIgnore]: pid=%s uid=%s ignore=%v", req.Pid, req.Uid, req.Ignore)
+	ignore := make([]string, 0, len(req.Ignore))
+	for _, pattern := range req.Ignore {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			ignore = append(ignore, pattern)
+		}
+	}
+	info, role, err := service.readableProject(ctx, req.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	if role < store.RoleOwner {
+		return errNoPermission
+	}
+	err = service.store.SetProjectIgnore(ctx, req.Pid, info.Uid, ignore)
+	if err != nil {
+		if err == store.ErrProjectNotExist {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
 	return nil
 }
 
@@ -105,44 +173,98 @@ func (service *projectService) ListProjects(ctx context.Context, req *proto.List
 		return err
 	}
 
+	// others see public projects and projects they are a member of, members
+	// of an organization see all its projects
+	viewerRole := store.RoleNone
+	memberOf := make(map[string]bool)
+	if req.Viewer == req.Uid {
+		viewerRole = store.RoleOwner
+	} else if req.Viewer != "" {
+		if ownedByOrg(infos) {
+			viewerRole, _, err = service.orgRole(ctx, req.Uid, req.Viewer)
+			if err != nil {
+				return err
+			}
+		}
+		pids, err := service.store.GetMemberProjects(ctx, req.Viewer)
+		if err != nil {
+			return errors.NewInternalError(-1, err.Error())
+		}
+		for _, pid := range pids {
+			memberOf[pid] = true
+		}
+	}
+
 	rsp.Projects = make([]*proto.ProjectInfo, 0, len(infos))
 	for _, info := range infos {
-		rsp.Projects = append(rsp.Projects, &proto.ProjectInfo{
-			Url:       info.Url,
-			Hash:      info.Hash,
-			Name:      info.Name,
-			Branch:    info.Branch,
-			CreatedAt: info.CreatedAt,
-		})
+		if !listable(info, viewerRole) && !memberOf[info.Id] {
+			continue
+		}
+		rsp.Projects = append(rsp.Projects, protoProjectInfo(info))
 	}
 	return nil
 }
 
 func (service *projectService) AddAnnotation(ctx context.Context, req *proto.AddAnnotationRequest, rsp *proto.AddAnnotationResponse) error {
-	if !service.store.ProjectExists(ctx, req.Pid) {
-		return errors.NewNotFoundError(-1, "project not exists")
+	info, role, err := service.readableProject(ctx, req.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	if role < store.RoleAnnotator {
+		return errNoPermission
 	}
-	// TODO: check if req.Uid has right to add annotation to this project req.Pid
 	// TODO: check if req.Url and req.File are valid
 
-	err := service.store.AddAnnotation(ctx, req.Pid, req.Uid, req.File, req.Annotation, int(req.LineNumber))
+	annotation := store.Annotation{
+		Pid:        req.Pid,
+		Uid:        req.Uid,
+		File:       req.File,
+		Hash:       info.Hash,
+		Annotation: req.Annotation,
+	}
+	var thread store.Annotation
+	if req.Parent == "" {
+		lines, err := requestLineRange(req.LineNumber, req.Range)
+		if err != nil {
+			return err
+		}
+		annotation.LineRange = lines
+	} else {
+		thread, err = service.threadOf(ctx, req.Parent)
+		if err != nil {
+			return err
+		}
+		if thread.Pid != req.Pid {
+			return errAnnotationNotExist
+		}
+		annotation.Parent = thread.Id
+		annotation.File = thread.File
+		annotation.Hash = thread.Hash
+		annotation.LineRange = thread.LineRange
+		annotation.Outdated = thread.Outdated
+	}
+
+	id, err := service.store.AddAnnotation(ctx, annotation)
 	if err != nil {
 		log.Warnf("[AddAnnotation] add annotation error: %v", err)
 		return err
 	}
+	rsp.Id = id
+	annotation.Id = id
+	service.notifyAnnotation(ctx, info, annotation, thread.Uid)
+	// replies do not change directory summaries
+	if annotation.Parent != "" {
+		return nil
+	}
 
+	brief := annotationBrief(req.Annotation)
+	now := time.Now().Unix()
 	currentFile := req.File
 	for currentFile != "." && currentFile != "/" {
 		parent := path.Dir(currentFile)
 		sub := path.Base(currentFile)
-		var brief string
-		if len(req.Annotation) > 64 {
-			brief = req.Annotation[:64]
-		} else {
-			brief = req.Annotation
-		}
 		log.Debugf("[AddAnnotation] update latest annotation: pid=%s parent=%s file=%s", req.Pid, parent, currentFile)
-		err = service.store.UpdateLatestAnnotation(ctx, req.Pid, parent, sub, req.File, brief, int(req.LineNumber))
+		err = service.store.UpdateLatestAnnotation(ctx, req.Pid, parent, sub, req.File, brief, annotation.LineRange, now)
 		if err != nil {
 			log.Warnf("[AddAnnotation] update latest annotation error: %v", err)
 			break
@@ -152,68 +274,160 @@ func (service *projectService) AddAnnotation(ctx context.Context, req *proto.Add
 	return nil
 }
 
+const briefLength = 64
+
+// one line plain text summary of annotation written in markdown
+func annotationBrief(annotation string) string {
+	return markdown.Summary(annotation, briefLength)
+}
+
+// markdown options of annotations of project pid, code references are
+// linked to the project viewer
+func markdownOptions(pid string) markdown.Options {
+	return markdown.Options{
+		CodeLink: func(file string, start, end int) string {
+			lines := "L" + strconv.Itoa(start)
+			if end > start {
+				lines += "-L" + strconv.Itoa(end)
+			}
+			segments := strings.Split(file, "/")
+			for i := range segments {
+				segments[i] = neturl.PathEscape(segments[i])
+			}
+			return strings.NewReplacer(
+				"{pid}", neturl.PathEscape(pid),
+				"{file}", strings.Join(segments, "/"),
+				"{lines}", lines,
+			).Replace(config.DefaultConfig.Viewer)
+		},
+	}
+}
+
 func (service *projectService) GetAnnotationLines(ctx context.Context, req *proto.GetAnnotationLinesRequest, rsp *proto.GetAnnotationLinesResponse) error {
-	lines, err := service.store.GetAnnotationLines(ctx, req.Pid, req.File)
+	if _, _, err := service.readableProject(ctx, req.Pid, req.Uid); err != nil {
+		return err
+	}
+	ranges, err := service.store.GetAnnotationLines(ctx, req.Pid, req.File)
 	if err != nil {
 		log.Warnf("[GetFileAnnotationLines] get annotation lines error: pid=%s file=%s error=%v", req.Pid, req.File, err)
 	}
 
-	rsp.Lines = lines
+	seen := make(map[int]struct{}, len(ranges))
+	rsp.Lines = make([]int32, 0, len(ranges))
+	rsp.Ranges = make([]*proto.AnnotationRange, 0, len(ranges))
+	for _, lines := range ranges {
+		rsp.Ranges = append(rsp.Ranges, annotationRange(lines))
+		if _, ok := seen[lines.StartLine]; !ok {
+			seen[lines.StartLine] = struct{}{}
+			rsp.Lines = append(rsp.Lines, int32(lines.StartLine))
+		}
+	}
 	return nil
 }
 
-// TODO: pagination support
+const (
+	defaultAnnotationPageSize = 20
+	maxAnnotationPageSize     = 100
+)
+
 func (service *projectService) GetAnnotations(ctx context.Context, req *proto.GetAnnotationsRequest, rsp *proto.GetAnnotationsResponse) error {
 	req.File = strings.TrimPrefix(req.File, "/")
 
-	log.Debugf("[GetAnnotations]: pid=%s file=%s line=%d", req.Pid, req.File, req.LineNumber)
-	records, err := service.store.GetAnnotations(ctx, req.Pid, req.File, int(req.LineNumber))
-	if err != nil {
-		log.Warnf("[GetAnnotations] store get annotations error: %v", err)
+	log.Debugf("[GetAnnotations]: pid=%s file=%s line=%d page=%d", req.Pid, req.File, req.LineNumber, req.Page)
+	if _, _, err := service.readableProject(ctx, req.Pid, req.Uid); err != nil {
 		return err
 	}
-	log.Debugf("[GetAnnotations]: records=%v", records)
 
-	rsp.Records = make([]*proto.AnnotationRecord, len(records))
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAnnotationPageSize
+	} else if pageSize > maxAnnotationPageSize {
+		pageSize = maxAnnotationPageSize
+	}
 
-	uidsMap := make(map[string]struct{})
-	for i := range records {
-		rsp.Records[i] = &proto.AnnotationRecord{
-			Uid:        records[i].Uid,
-			Annotation: records[i].Annotation,
-			CreatedAt:  records[i].CreatedAt,
-		}
-		uidsMap[records[i].Uid] = struct{}{}
+	threads, total, err := service.store.GetAnnotations(ctx, req.Pid, req.File, int(req.LineNumber), req.Outdated, (page-1)*pageSize, pageSize)
+	if err != nil {
+		log.Warnf("[GetAnnotations] store get annotations error: %v", err)
+		return err
 	}
-	uids := make([]string, 0, len(uidsMap))
-	for uid := range uidsMap {
-		uids = append(uids, uid)
+	ids := make([]string, 0, len(threads))
+	for _, thread := range threads {
+		ids = append(ids, thread.Id)
+	}
+	replies, err := service.store.GetReplies(ctx, ids)
+	if err != nil {
+		log.Warnf("[GetAnnotations] store get replies error: %v", err)
+		return err
 	}
 
-	// TODO: it's a mess
-	infoRsp, err := service.accountClient.AccountsBasicInfo(ctx, &account.AccountsBasicInfoRequest{Uids: uids})
+	uids := make([]string, 0, len(threads)+len(replies))
+	for _, record := range append(threads, replies...) {
+		uids = append(uids, record.Uid)
+	}
+	names, err := service.accountNames(ctx, uids)
 	if err != nil {
 		log.Warnf("[GetAnnotations] get account basic info error: %v", err)
 		return err
 	}
-	uidInfoMap := make(map[string]*account.BasicInfo, len(infoRsp.Infos))
-	for _, info := range infoRsp.Infos {
-		uidInfoMap[info.Id] = info
+
+	rsp.Total = total
+	opts := markdownOptions(req.Pid)
+	rsp.Records = make([]*proto.AnnotationRecord, 0, len(threads))
+	byId := make(map[string]*proto.AnnotationRecord, len(threads))
+	for _, thread := range threads {
+		record := annotationRecord(thread, names, opts)
+		byId[thread.Id] = record
+		rsp.Records = append(rsp.Records, record)
+	}
+	for _, reply := range replies {
+		if thread, ok := byId[reply.Parent]; ok {
+			thread.Replies = append(thread.Replies, annotationRecord(reply, names, opts))
+		}
 	}
+	return nil
+}
+
+func annotationRecord(record store.AnnotationRecord, names map[string]string, opts markdown.Options) *proto.AnnotationRecord {
+	return &proto.AnnotationRecord{
+		Id:         record.Id,
+		Uid:        record.Uid,
+		Name:       names[record.Uid],
+		Annotation: record.Annotation,
+		Html:       markdown.Render(record.Annotation, opts),
+		CreatedAt:  record.CreatedAt,
+		UpdatedAt:  record.UpdatedAt,
+		Resolved:   record.Resolved,
+		Reactions:  groupReactions(record.Reactions),
+		Range:      annotationRange(record.LineRange),
+		Hash:       record.Hash,
+		Outdated:   record.Outdated,
+	}
+}
 
-	for i := range records {
-		uid := rsp.Records[i].Uid
-		info, ok := uidInfoMap[uid]
+// group reactions by emoji, in the order each emoji first appears
+func groupReactions(reactions []store.Reaction) []*proto.Reaction {
+	grouped := make([]*proto.Reaction, 0)
+	byEmoji := make(map[string]*proto.Reaction)
+	for _, reaction := range reactions {
+		r, ok := byEmoji[reaction.Emoji]
 		if !ok {
-			continue
+			r = &proto.Reaction{Emoji: reaction.Emoji}
+			byEmoji[reaction.Emoji] = r
+			grouped = append(grouped, r)
 		}
-		rsp.Records[i].Name = info.Name
+		r.Uids = append(r.Uids, reaction.Uid)
 	}
-	return nil
+	return grouped
 }
 
 func (service *projectService) GetLatestAnnotations(ctx context.Context, req *proto.GetLatestAnnotationsRequest, rsp *proto.GetLatestAnnotationsResponse) error {
 	log.Debugf("[GetLatestAnnotations]: pid=%s parent=%s", req.Pid, req.Parent)
+	if _, _, err := service.readableProject(ctx, req.Pid, req.Uid); err != nil {
+		return err
+	}
 	annotations, err := service.store.GetLatestAnnotations(ctx, req.Pid, req.Parent)
 	if err != nil {
 		return err
@@ -225,9 +439,40 @@ func (service *projectService) GetLatestAnnotations(ctx context.Context, req *pr
 			Sub:        annotation.Sub,
 			File:       annotation.File,
 			Brief:      annotation.Brief,
-			LineNumber: int32(annotation.LineNumber),
+			LineNumber: int32(annotation.StartLine),
 			Timestamp:  annotation.Timestamp,
+			Range:      annotationRange(annotation.LineRange),
 		})
 	}
 	return nil
 }
+
+func (service *projectService) SetProjectVisibility(ctx context.Context, req *proto.SetProjectVisibilityRequest, rsp *proto.SetProjectVisibilityResponse) error {
+	log.Debugf("[SetProjectVisibility]: pid=%s uid=%s visibility=%v", req.Pid, req.Uid, req.Visibility)
+	if _, ok := proto.Visibility_name[int32(req.Visibility)]; !ok {
+		return errors.NewBadRequestError(-1, "invalid visibility")
+	}
+	if _, err := service.requireRole(ctx, req.Pid, req.Uid, store.RoleOwner); err != nil {
+		return err
+	}
+	err := service.store.SetProjectVisibility(ctx, req.Pid, store.Visibility(req.Visibility))
+	if err != nil {
+		if err == store.ErrProjectNotExist {
+			return errors.NewNotFoundError(-1, err.Error())
+		}
+		return errors.NewInternalError(-1, err.Error())
+	}
+	return nil
+}
+
+func (service *projectService) ProjectAccess(ctx context.Context, req *proto.ProjectAccessRequest, rsp *proto.ProjectAccessResponse) error {
+	info, role, err := service.readableProject(ctx, req.Pid, req.Uid)
+	if err != nil {
+		return err
+	}
+	rsp.Url = info.Url
+	rsp.Hash = info.Hash
+	rsp.Role = proto.Role(role)
+	rsp.Ignore = info.Ignore
+	return nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"github.com/lt90s/rfschub-server/common/errors"
+	proto "github.com/lt90s/rfschub-server/project/proto"
+	"github.com/lt90s/rfschub-server/project/store"
+	log "github.com/sirupsen/logrus"
+)
+
+// member a project is handed to when its owner is deleted: the one with the
+// highest role, the earliest of them. members are oldest first
+func projectSuccessor(members []store.Member) (store.Member, bool) {
+	var successor store.Member
+	for _, member := range members {
+		if member.Role > successor.Role {
+			successor = member
+		}
+	}
+	return successor, successor.Role > store.RoleNone
+}
+
+// threads of uid replied by others, they are kept when annotations of uid
+// are deleted
+func threadsRepliedByOthers(replies []store.AnnotationRecord, uid string) map[string]bool {
+	threads := make(map[string]bool)
+	for _, reply := range replies {
+		if reply.Uid != uid {
+			threads[reply.Parent] = true
+		}
+	}
+	return threads
+}
+
+func exportedAnnotation(annotation store.Annotation) *proto.ExportedAnnotation {
+	return &proto.ExportedAnnotation{
+		Id:         annotation.Id,
+		Pid:        annotation.Pid,
+		Parent:     annotation.Parent,
+		File:       annotation.File,
+		Hash:       annotation.Hash,
+		Range:      annotationRange(annotation.LineRange),
+		Annotation: annotation.Annotation,
+		Resolved:   annotation.Resolved,
+		Outdated:   annotation.Outdated,
+		CreatedAt:  annotation.CreatedAt,
+		UpdatedAt:  annotation.UpdatedAt,
+	}
+}
+
+func (service *projectService) ExportUserData(ctx context.Context, req *proto.ExportUserDataRequest, rsp *proto.ExportUserDataResponse) error {
+	projects, err := service.store.GetUserProjects(ctx, req.Uid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	// no limit
+	annotations, err := service.store.GetUserAnnotations(ctx, req.Uid, 0, 0)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+
+	rsp.Projects = make([]*proto.ProjectInfo, 0, len(projects))
+	for _, info := range projects {
+		rsp.Projects = append(rsp.Projects, protoProjectInfo(info))
+	}
+	rsp.Annotations = make([]*proto.ExportedAnnotation, 0, len(annotations))
+	for _, annotation := range annotations {
+		rsp.Annotations = append(rsp.Annotations, exportedAnnotation(annotation))
+	}
+	return nil
+}
+
+func (service *projectService) DeleteUserData(ctx context.Context, req *proto.DeleteUserDataRequest, rsp *proto.DeleteUserDataResponse) error {
+	if req.Uid == "" {
+		return errors.NewBadRequestError(-1, "uid required")
+	}
+	if err := service.handOverProjects(ctx, req.Uid); err != nil {
+		return err
+	}
+	if err := service.store.RemoveUserMemberships(ctx, req.Uid); err != nil {
+		log.Errorf("[DeleteUserData] RemoveUserMemberships error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	if req.DeleteAnnotations {
+		if err := service.deleteUserAnnotations(ctx, req.Uid); err != nil {
+			return err
+		}
+	}
+	if err := service.store.AnonymizeAnnotations(ctx, req.Uid); err != nil {
+		log.Errorf("[DeleteUserData] AnonymizeAnnotations error: uid=%s err=%v", req.Uid, err)
+		return errors.NewInternalError(-1, err.Error())
+	}
+	log.Infof("[DeleteUserData] user data removed: uid=%s deleteAnnotations=%v", req.Uid, req.DeleteAnnotations)
+	return nil
+}
+
+// hand projects of uid to their successors, projects without one or whose
+// successor has a project of the same url and name are deleted
+func (service *projectService) handOverProjects(ctx context.Context, uid string) error {
+	projects, err := service.store.GetUserProjects(ctx, uid)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	for _, info := range projects {
+		members, err := service.store.GetMembers(ctx, info.Id)
+		if err != nil {
+			return errors.NewInternalError(-1, err.Error())
+		}
+		if successor, ok := projectSuccessor(members); ok {
+			err = service.store.TransferProject(ctx, info.Id, successor.Uid)
+			if err == nil {
+				// the owner is not stored as a member
+				if err = service.store.RemoveMember(ctx, info.Id, successor.Uid); err != nil && err != store.ErrMemberNotExist {
+					return errors.NewInternalError(-1, err.Error())
+				}
+				log.Infof("[handOverProjects] project handed over: pid=%s from=%s to=%s", info.Id, uid, successor.Uid)
+				continue
+			}
+			if err != store.ErrProjectExist {
+				return errors.NewInternalError(-1, err.Error())
+			}
+		}
+		if err = service.store.DeleteProject(ctx, info.Id); err != nil && err != store.ErrProjectNotExist {
+			return errors.NewInternalError(-1, err.Error())
+		}
+		log.Infof("[handOverProjects] project deleted: pid=%s uid=%s", info.Id, uid)
+	}
+	return nil
+}
+
+// delete annotations of uid except threads replied by others, which are
+// anonymized later
+func (service *projectService) deleteUserAnnotations(ctx context.Context, uid string) error {
+	annotations, err := service.store.GetUserAnnotations(ctx, uid, 0, 0)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	threads := make([]string, 0, len(annotations))
+	for _, annotation := range annotations {
+		if annotation.Parent == "" {
+			threads = append(threads, annotation.Id)
+		}
+	}
+	replies, err := service.store.GetReplies(ctx, threads)
+	if err != nil {
+		return errors.NewInternalError(-1, err.Error())
+	}
+	replied := threadsRepliedByOthers(replies, uid)
+
+	for _, annotation := range annotations {
+		if replied[annotation.Id] {
+			continue
+		}
+		// replies are gone along with their threads deleted before
+		err = service.deleteAnnotation(ctx, annotation)
+		if err != nil && err != errAnnotationNotExist {
+			return err
+		}
+	}
+	return nil
+}
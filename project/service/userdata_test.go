@@ -0,0 +1,37 @@
+package service
+
+import (
+	"github.com/lt90s/rfschub-server/project/store"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestProjectSuccessor(t *testing.T) {
+	_, ok := projectSuccessor(nil)
+	require.False(t, ok)
+
+	members := []store.Member{
+		{Uid: "a", Role: store.RoleAnnotator},
+		{Uid: "b", Role: store.RoleMaintainer},
+		{Uid: "c", Role: store.RoleMaintainer},
+		{Uid: "d", Role: store.RoleViewer},
+	}
+	successor, ok := projectSuccessor(members)
+	require.True(t, ok)
+	require.Equal(t, "b", successor.Uid)
+
+	successor, ok = projectSuccessor(members[3:])
+	require.True(t, ok)
+	require.Equal(t, "d", successor.Uid)
+}
+
+func TestThreadsRepliedByOthers(t *testing.T) {
+	replies := []store.AnnotationRecord{
+		{Parent: "t1", Uid: "u"},
+		{Parent: "t2", Uid: "u"},
+		{Parent: "t2", Uid: "v"},
+		{Parent: "t3", Uid: "w"},
+	}
+	require.Equal(t, map[string]bool{"t2": true, "t3": true}, threadsRepliedByOthers(replies, "u"))
+	require.Empty(t, threadsRepliedByOthers(nil, "u"))
+}
@@ -7,31 +7,46 @@ import (
 	"sync"
 )
 
+// mockStore keeps projects in memory.
+// Methods of store.Store not implemented here panic if called
 type mockStore struct {
+	store.Store
+
 	pMutex   sync.RWMutex
 	projects []project
 	pid      int
+
+	mMutex  sync.RWMutex
+	members map[string]map[string]store.Role // pid -> uid -> role
+
+	iMutex      sync.Mutex
+	invitations []store.Invitation
+	iid         int
 }
 
 type project struct {
-	id      int
-	uid     string
-	url     string
-	hash    string
-	name    string
-	branch  bool
-	indexed bool
-	members []int
+	id         int
+	uid        string
+	url        string
+	hash       string
+	name       string
+	branch     bool
+	indexed    bool
+	visibility store.Visibility
+	org        bool
+	members    []int
 }
 
 func NewMockStore() *mockStore {
 	return &mockStore{
 		projects: make([]project, 0),
 		pid:      10000,
+		members:  make(map[string]map[string]store.Role),
+		iid:      10000,
 	}
 }
 
-func (m *mockStore) NewProject(ctx context.Context, uid, url, hash, name string, branch bool) error {
+func (m *mockStore) NewProject(ctx context.Context, uid, url, hash, name string, branch bool, visibility store.Visibility, org bool) error {
 	m.pMutex.Lock()
 	defer m.pMutex.Unlock()
 
@@ -47,12 +62,14 @@ func (m *mockStore) NewProject(ctx context.Context, uid, url, hash, name string,
 	}
 
 	m.projects = append(m.projects, project{
-		id:     m.pid,
-		uid:    uid,
-		url:    url,
-		hash:   hash,
-		name:   name,
-		branch: branch,
+		id:         m.pid,
+		uid:        uid,
+		url:        url,
+		hash:       hash,
+		name:       name,
+		branch:     branch,
+		visibility: visibility,
+		org:        org,
 	})
 	m.pid += 1
 	return nil
@@ -68,6 +85,8 @@ func (m *mockStore) GetProjectInfo(ctx context.Context, uid, url, name string) (
 			info.Id = strconv.Itoa(project.id)
 			info.Branch = project.branch
 			info.Indexed = project.indexed
+			info.Visibility = project.visibility
+			info.Org = project.org
 			return
 		}
 	}
@@ -87,3 +106,92 @@ func (m *mockStore) SetProjectIndexed(ctx context.Context, uid, url, hash string
 	}
 	return nil
 }
+
+func (m *mockStore) GetMemberRole(ctx context.Context, pid, uid string) (store.Role, error) {
+	m.mMutex.RLock()
+	defer m.mMutex.RUnlock()
+
+	return m.members[pid][uid], nil
+}
+
+func (m *mockStore) SetMember(ctx context.Context, pid, uid string, role store.Role) error {
+	m.mMutex.Lock()
+	defer m.mMutex.Unlock()
+
+	if m.members[pid] == nil {
+		m.members[pid] = make(map[string]store.Role)
+	}
+	m.members[pid][uid] = role
+	return nil
+}
+
+func (m *mockStore) GetMemberProjects(ctx context.Context, uid string) (pids []string, err error) {
+	m.mMutex.RLock()
+	defer m.mMutex.RUnlock()
+
+	pids = make([]string, 0, 8)
+	for pid, members := range m.members {
+		if _, ok := members[uid]; ok {
+			pids = append(pids, pid)
+		}
+	}
+	return
+}
+
+func (m *mockStore) AddInvitation(ctx context.Context, invitation store.Invitation) (string, error) {
+	m.iMutex.Lock()
+	defer m.iMutex.Unlock()
+
+	if invitation.Kind != store.InvitationKindLink {
+		for idx, i := range m.invitations {
+			if i.Pid == invitation.Pid && i.Invitee == invitation.Invitee {
+				invitation.Id = i.Id
+				m.invitations[idx] = invitation
+				return invitation.Id, nil
+			}
+		}
+	}
+	invitation.Id = strconv.Itoa(m.iid)
+	m.iid += 1
+	m.invitations = append(m.invitations, invitation)
+	return invitation.Id, nil
+}
+
+func (m *mockStore) GetInvitation(ctx context.Context, id string) (store.Invitation, error) {
+	m.iMutex.Lock()
+	defer m.iMutex.Unlock()
+
+	for _, invitation := range m.invitations {
+		if invitation.Id == id {
+			return invitation, nil
+		}
+	}
+	return store.Invitation{}, store.ErrInvitationNotExist
+}
+
+func (m *mockStore) GetInvitations(ctx context.Context, kind, pid, invitee string) (invitations []store.Invitation, err error) {
+	m.iMutex.Lock()
+	defer m.iMutex.Unlock()
+
+	invitations = make([]store.Invitation, 0, 4)
+	for _, invitation := range m.invitations {
+		if invitation.Kind != kind || (pid != "" && invitation.Pid != pid) || (invitee != "" && invitation.Invitee != invitee) {
+			continue
+		}
+		invitations = append(invitations, invitation)
+	}
+	return
+}
+
+func (m *mockStore) DeleteInvitation(ctx context.Context, id string) error {
+	m.iMutex.Lock()
+	defer m.iMutex.Unlock()
+
+	for idx, invitation := range m.invitations {
+		if invitation.Id == id {
+			m.invitations = append(m.invitations[:idx], m.invitations[idx+1:]...)
+			return nil
+		}
+	}
+	return store.ErrInvitationNotExist
+}
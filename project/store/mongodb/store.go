@@ -9,6 +9,8 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -22,6 +24,8 @@ const (
 	projectCollection          = "projects"
 	annotationCollection       = "annotations"
 	latestAnnotationCollection = "latest_annotations"
+	memberCollection           = "members"
+	invitationCollection       = "invitations"
 )
 
 func NewMongodbStore() store.Store {
@@ -30,9 +34,32 @@ func NewMongodbStore() store.Store {
 		client:       client,
 		databaseName: config.DefaultConfig.Mongodb.Database,
 	}
+	ms.setup()
 	return ms
 }
 
+func (ms *mongodbStore) setup() {
+	iv := ms.invitationCollection().Indexes()
+	unique := true
+	models := []mongo.IndexModel{
+		{
+			// one pending invitation or join request per invitee of a
+			// project, links have no invitee
+			Keys: bson.D{{Key: "pid", Value: 1}, {Key: "invitee", Value: 1}},
+			Options: &options.IndexOptions{
+				Unique:                  &unique,
+				PartialFilterExpression: bson.M{"invitee": bson.M{"$gt": ""}},
+			},
+		}, {
+			Keys: bson.M{"token": 1},
+		},
+	}
+	_, err := iv.CreateMany(context.Background(), models)
+	if err != nil && !strings.Contains(err.Error(), "IndexKeySpecsConflict") {
+		panic(err)
+	}
+}
+
 func (ms *mongodbStore) database() *mongo.Database {
 	return ms.client.Database(ms.databaseName)
 }
@@ -49,22 +76,36 @@ func (ms *mongodbStore) latestAnnotationCollection() *mongo.Collection {
 	return ms.database().Collection(latestAnnotationCollection)
 }
 
-func (ms *mongodbStore) NewProject(ctx context.Context, uid, url, hash, name string, branch bool) error {
+func (ms *mongodbStore) memberCollection() *mongo.Collection {
+	return ms.database().Collection(memberCollection)
+}
+
+func (ms *mongodbStore) invitationCollection() *mongo.Collection {
+	return ms.database().Collection(invitationCollection)
+}
+
+// update of the NewProject upsert, every field is set on insert only so
+// that creating an existing project leaves it untouched
+func newProjectUpdate(name string, branch bool, visibility store.Visibility, org bool) bson.M {
+	return bson.M{
+		"$setOnInsert": bson.M{
+			"name":       name,
+			"branch":     branch,
+			"visibility": visibility,
+			"createdAt":  time.Now().Unix(),
+			"indexed":    false,
+			"org":        org,
+		},
+	}
+}
+
+func (ms *mongodbStore) NewProject(ctx context.Context, uid, url, hash, name string, branch bool, visibility store.Visibility, org bool) error {
 	filter := bson.M{
 		"uid":  uid,
 		"url":  url,
 		"hash": hash,
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"name":   name,
-			"branch": branch,
-		},
-		"$setOnInsert": bson.M{
-			"createdAt": time.Now().Unix(),
-			"indexed":   false,
-		},
-	}
+	update := newProjectUpdate(name, branch, visibility, org)
 	upsert := true
 	option := &options.UpdateOptions{
 		Upsert: &upsert,
@@ -88,10 +129,13 @@ func (ms *mongodbStore) GetProjectInfo(ctx context.Context, uid, url, name strin
 	}
 	option := &options.FindOneOptions{
 		Projection: bson.M{
-			"_id":     1,
-			"hash":    1,
-			"branch":  1,
-			"indexed": 1,
+			"_id":        1,
+			"hash":       1,
+			"branch":     1,
+			"indexed":    1,
+			"ignore":     1,
+			"visibility": 1,
+			"org":        1,
 		},
 	}
 	sr := ms.projectCollection().FindOne(ctx, filter, option)
@@ -103,10 +147,13 @@ func (ms *mongodbStore) GetProjectInfo(ctx context.Context, uid, url, name strin
 	}
 
 	var tmp struct {
-		Id      primitive.ObjectID `bson:"_id"`
-		Hash    string             `bson:"hash"`
-		Branch  bool               `bson:"branch"`
-		Indexed bool               `bson:"indexed"`
+		Id         primitive.ObjectID `bson:"_id"`
+		Hash       string             `bson:"hash"`
+		Branch     bool               `bson:"branch"`
+		Indexed    bool               `bson:"indexed"`
+		Ignore     []string           `bson:"ignore"`
+		Visibility store.Visibility   `bson:"visibility"`
+		Org        bool               `bson:"org"`
 	}
 	if err = sr.Decode(&tmp); err != nil {
 		return
@@ -116,6 +163,12 @@ func (ms *mongodbStore) GetProjectInfo(ctx context.Context, uid, url, name strin
 	info.Hash = tmp.Hash
 	info.Branch = tmp.Branch
 	info.Indexed = tmp.Indexed
+	info.Ignore = tmp.Ignore
+	info.Visibility = tmp.Visibility
+	info.Org = tmp.Org
+	info.Uid = uid
+	info.Url = url
+	info.Name = name
 	return
 }
 
@@ -134,6 +187,30 @@ func (ms *mongodbStore) SetProjectIndexed(ctx context.Context, uid, url, hash st
 	return err
 }
 
+func (ms *mongodbStore) SetProjectIgnore(ctx context.Context, pid, uid string, ignore []string) error {
+	id, err := primitive.ObjectIDFromHex(pid)
+	if err != nil {
+		return store.ErrProjectNotExist
+	}
+	filter := bson.M{
+		"_id": id,
+		"uid": uid,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"ignore": ignore,
+		},
+	}
+	ur, err := ms.projectCollection().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrProjectNotExist
+	}
+	return nil
+}
+
 func (ms *mongodbStore) ProjectExists(ctx context.Context, pid string) bool {
 	id, err := primitive.ObjectIDFromHex(pid)
 	if err != nil {
@@ -144,6 +221,91 @@ func (ms *mongodbStore) ProjectExists(ctx context.Context, pid string) bool {
 	return count > 0
 }
 
+func (ms *mongodbStore) CommitProjectExists(ctx context.Context, uid, url, hash string) (bool, error) {
+	count, err := ms.projectCollection().CountDocuments(ctx, bson.M{"uid": uid, "url": url, "hash": hash})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (ms *mongodbStore) DeleteProject(ctx context.Context, pid string) error {
+	id, err := primitive.ObjectIDFromHex(pid)
+	if err != nil {
+		return store.ErrProjectNotExist
+	}
+	dr, err := ms.projectCollection().DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrProjectNotExist
+	}
+
+	filter := bson.M{"pid": pid}
+	collections := []*mongo.Collection{
+		ms.annotationCollection(),
+		ms.latestAnnotationCollection(),
+		ms.memberCollection(),
+		ms.invitationCollection(),
+	}
+	for _, collection := range collections {
+		if _, err = collection.DeleteMany(ctx, filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ms *mongodbStore) TransferProject(ctx context.Context, pid, uid string) error {
+	info, err := ms.GetProject(ctx, pid)
+	if err != nil {
+		return err
+	}
+	// projects are unique by owner, url and name, and by owner, url and hash
+	filter := bson.M{
+		"uid": uid,
+		"url": info.Url,
+		"$or": bson.A{bson.M{"name": info.Name}, bson.M{"hash": info.Hash}},
+	}
+	count, err := ms.projectCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return store.ErrProjectExist
+	}
+
+	id, _ := primitive.ObjectIDFromHex(pid)
+	ur, err := ms.projectCollection().UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"uid": uid}})
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrProjectNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) RemoveUserMemberships(ctx context.Context, uid string) error {
+	if _, err := ms.memberCollection().DeleteMany(ctx, bson.M{"uid": uid}); err != nil {
+		return err
+	}
+	_, err := ms.invitationCollection().DeleteMany(ctx, bson.M{"invitee": uid})
+	return err
+}
+
+func (ms *mongodbStore) AnonymizeAnnotations(ctx context.Context, uid string) error {
+	_, err := ms.annotationCollection().UpdateMany(ctx, bson.M{"uid": uid}, bson.M{"$set": bson.M{"uid": ""}})
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"reactions.uid": uid}
+	update := bson.M{"$pull": bson.M{"reactions": bson.M{"uid": uid}}}
+	_, err = ms.annotationCollection().UpdateMany(ctx, filter, update)
+	return err
+}
+
 func (ms *mongodbStore) GetUserProjects(ctx context.Context, uid string) (projects []store.ProjectInfo, err error) {
 	filter := bson.M{
 		"uid": uid,
@@ -152,86 +314,351 @@ func (ms *mongodbStore) GetUserProjects(ctx context.Context, uid string) (projec
 	if err != nil {
 		return
 	}
-	var tmp store.ProjectInfo
 	for cursor.Next(ctx) {
+		var tmp struct {
+			Id                primitive.ObjectID `bson:"_id"`
+			store.ProjectInfo `bson:",inline"`
+		}
 		if err = cursor.Decode(&tmp); err != nil {
 			return
 		}
-		projects = append(projects, tmp)
+		tmp.ProjectInfo.Id = tmp.Id.Hex()
+		projects = append(projects, tmp.ProjectInfo)
 	}
 	return
 }
 
-func (ms *mongodbStore) AddAnnotation(ctx context.Context, pid, uid, file, annotation string, lineNumber int) error {
-	_, err := ms.annotationCollection().InsertOne(ctx, bson.M{
-		"pid":        pid,
-		"uid":        uid,
-		"file":       file,
-		"annotation": annotation,
-		"lineNumber": lineNumber,
-		"createdAt":  time.Now().Unix(),
+func (ms *mongodbStore) AddAnnotation(ctx context.Context, annotation store.Annotation) (string, error) {
+	now := time.Now().Unix()
+	ir, err := ms.annotationCollection().InsertOne(ctx, bson.M{
+		"pid":         annotation.Pid,
+		"parent":      annotation.Parent,
+		"uid":         annotation.Uid,
+		"file":        annotation.File,
+		"hash":        annotation.Hash,
+		"outdated":    annotation.Outdated,
+		"annotation":  annotation.Annotation,
+		"lineNumber":  annotation.StartLine,
+		"startColumn": annotation.StartColumn,
+		"endLine":     annotation.EndLine,
+		"endColumn":   annotation.EndColumn,
+		"createdAt":   now,
+		"updatedAt":   now,
 	})
-	return err
+	if err != nil {
+		return "", err
+	}
+	return ir.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+type annotationDocument struct {
+	Id               primitive.ObjectID `bson:"_id"`
+	store.Annotation `bson:",inline"`
 }
 
-func (ms *mongodbStore) GetAnnotations(ctx context.Context, pid, file string, lineNumber int) (records []store.AnnotationRecord, err error) {
+func (ms *mongodbStore) findAnnotation(ctx context.Context, filter bson.M, option *options.FindOneOptions) (annotation store.Annotation, err error) {
+	sr := ms.annotationCollection().FindOne(ctx, filter, option)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrAnnotationNotExist
+		}
+		return
+	}
+	var doc annotationDocument
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	annotation = doc.Annotation
+	annotation.Id = doc.Id.Hex()
+	return
+}
+
+func (ms *mongodbStore) GetAnnotation(ctx context.Context, id string) (store.Annotation, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.Annotation{}, store.ErrAnnotationNotExist
+	}
+	return ms.findAnnotation(ctx, bson.M{"_id": oid}, nil)
+}
+
+func (ms *mongodbStore) UpdateAnnotation(ctx context.Context, id, annotation string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.ErrAnnotationNotExist
+	}
+	old, err := ms.findAnnotation(ctx, bson.M{"_id": oid}, nil)
+	if err != nil {
+		return err
+	}
+	// only update if nobody else updated it meanwhile
 	filter := bson.M{
-		"pid":        pid,
-		"file":       file,
-		"lineNumber": lineNumber,
+		"_id":       oid,
+		"updatedAt": old.UpdatedAt,
 	}
-	option := &options.FindOptions{
-		Projection: bson.M{
-			"uid":        1,
-			"annotation": 1,
-			"createdAt":  1,
+	update := bson.M{
+		"$set": bson.M{
+			"annotation": annotation,
+			"updatedAt":  time.Now().Unix(),
+		},
+		"$push": bson.M{
+			"revisions": store.AnnotationRevision{
+				Annotation: old.Annotation,
+				UpdatedAt:  old.UpdatedAt,
+			},
 		},
 	}
+	ur, err := ms.annotationCollection().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrAnnotationNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) DeleteAnnotation(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.ErrAnnotationNotExist
+	}
+	dr, err := ms.annotationCollection().DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrAnnotationNotExist
+	}
+	_, err = ms.annotationCollection().DeleteMany(ctx, bson.M{"parent": id})
+	return err
+}
+
+func (ms *mongodbStore) updateAnnotation(ctx context.Context, id string, update bson.M) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.ErrAnnotationNotExist
+	}
+	ur, err := ms.annotationCollection().UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrAnnotationNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) SetAnnotationResolved(ctx context.Context, id string, resolved bool) error {
+	return ms.updateAnnotation(ctx, id, bson.M{"$set": bson.M{"resolved": resolved}})
+}
+
+func (ms *mongodbStore) AddReaction(ctx context.Context, id, emoji, uid string) error {
+	return ms.updateAnnotation(ctx, id, bson.M{"$addToSet": bson.M{"reactions": store.Reaction{Emoji: emoji, Uid: uid}}})
+}
+
+func (ms *mongodbStore) RemoveReaction(ctx context.Context, id, emoji, uid string) error {
+	return ms.updateAnnotation(ctx, id, bson.M{"$pull": bson.M{"reactions": store.Reaction{Emoji: emoji, Uid: uid}}})
+}
+
+func (ms *mongodbStore) GetNewestAnnotation(ctx context.Context, pid, dir string) (store.Annotation, error) {
+	filter := bson.M{
+		"pid":      pid,
+		"parent":   bson.M{"$in": bson.A{"", nil}},
+		"outdated": bson.M{"$ne": true},
+	}
+	if dir != "." && dir != "" {
+		filter["file"] = bson.M{"$regex": "^" + regexp.QuoteMeta(dir) + "(/|$)"}
+	}
+	option := &options.FindOneOptions{
+		Sort:       bson.M{"createdAt": -1},
+		Projection: bson.M{"revisions": 0},
+	}
+	return ms.findAnnotation(ctx, filter, option)
+}
+
+var annotationRecordProjection = bson.M{
+	"parent":      1,
+	"uid":         1,
+	"hash":        1,
+	"outdated":    1,
+	"lineNumber":  1,
+	"startColumn": 1,
+	"endLine":     1,
+	"endColumn":   1,
+	"annotation":  1,
+	"resolved":    1,
+	"reactions":   1,
+	"createdAt":   1,
+	"updatedAt":   1,
+}
+
+func (ms *mongodbStore) findAnnotationRecords(ctx context.Context, filter bson.M, option *options.FindOptions) (records []store.AnnotationRecord, err error) {
+	option.Projection = annotationRecordProjection
+	option.Sort = bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}
 	cursor, err := ms.annotationCollection().Find(ctx, filter, option)
 	if err != nil {
 		return
 	}
+	defer cursor.Close(ctx)
 
 	records = make([]store.AnnotationRecord, 0, 4)
-	var tmp store.AnnotationRecord
 	for cursor.Next(ctx) {
+		var tmp struct {
+			Id                     primitive.ObjectID `bson:"_id"`
+			store.AnnotationRecord `bson:",inline"`
+		}
 		if err = cursor.Decode(&tmp); err != nil {
 			return
 		}
-		records = append(records, tmp)
+		tmp.AnnotationRecord.Id = tmp.Id.Hex()
+		records = append(records, tmp.AnnotationRecord)
 	}
+	err = cursor.Err()
 	return
 }
 
-func (ms *mongodbStore) GetAnnotationLines(ctx context.Context, pid, file string) (lines []int32, err error) {
+func (ms *mongodbStore) GetAnnotations(ctx context.Context, pid, file string, lineNumber int, outdated bool, skip, limit int) (records []store.AnnotationRecord, total int64, err error) {
 	filter := bson.M{
-		"pid":  pid,
-		"file": file,
+		"pid":    pid,
+		"file":   file,
+		"parent": bson.M{"$in": bson.A{"", nil}},
+	}
+	if outdated {
+		filter["outdated"] = true
+	} else {
+		filter["lineNumber"] = lineNumber
+		filter["outdated"] = bson.M{"$ne": true}
+	}
+	total, err = ms.annotationCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		return
+	}
+	skip64, limit64 := int64(skip), int64(limit)
+	records, err = ms.findAnnotationRecords(ctx, filter, &options.FindOptions{Skip: &skip64, Limit: &limit64})
+	return
+}
+
+func (ms *mongodbStore) GetReplies(ctx context.Context, parents []string) (records []store.AnnotationRecord, err error) {
+	if len(parents) == 0 {
+		return
+	}
+	filter := bson.M{
+		"parent": bson.M{"$in": parents},
+	}
+	return ms.findAnnotationRecords(ctx, filter, &options.FindOptions{})
+}
+
+func (ms *mongodbStore) GetAnnotationLines(ctx context.Context, pid, file string) (ranges []store.LineRange, err error) {
+	filter := bson.M{
+		"pid":      pid,
+		"file":     file,
+		"parent":   bson.M{"$in": bson.A{"", nil}},
+		"outdated": bson.M{"$ne": true},
 	}
 	option := &options.FindOptions{
 		Projection: bson.M{
-			"lineNumber": 1,
+			"lineNumber":  1,
+			"startColumn": 1,
+			"endLine":     1,
+			"endColumn":   1,
 		},
+		Sort: bson.D{{Key: "lineNumber", Value: 1}, {Key: "_id", Value: 1}},
 	}
 	cursor, err := ms.annotationCollection().Find(ctx, filter, option)
 	if err != nil {
 		return
 	}
-	var tmp struct {
-		LineNumber int32 `bson:"lineNumber"`
+	defer cursor.Close(ctx)
+
+	ranges = make([]store.LineRange, 0, 32)
+	for cursor.Next(ctx) {
+		var lines store.LineRange
+		if err = cursor.Decode(&lines); err != nil {
+			return
+		}
+		ranges = append(ranges, lines)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) GetThreads(ctx context.Context, pid string) (annotations []store.Annotation, err error) {
+	filter := bson.M{
+		"pid":      pid,
+		"parent":   bson.M{"$in": bson.A{"", nil}},
+		"outdated": bson.M{"$ne": true},
+	}
+	option := &options.FindOptions{
+		Projection: bson.M{"revisions": 0},
+	}
+	cursor, err := ms.annotationCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
 	}
-	// TODO: use set to filter duplicates
-	lines = make([]int32, 0, 32)
+	defer cursor.Close(ctx)
+
+	annotations = make([]store.Annotation, 0, 32)
 	for cursor.Next(ctx) {
-		if err = cursor.Decode(&tmp); err != nil {
+		var doc annotationDocument
+		if err = cursor.Decode(&doc); err != nil {
 			return
 		}
-		lines = append(lines, tmp.LineNumber)
+		doc.Annotation.Id = doc.Id.Hex()
+		annotations = append(annotations, doc.Annotation)
 	}
+	err = cursor.Err()
 	return
 }
 
-func (ms *mongodbStore) UpdateLatestAnnotation(ctx context.Context, pid, parent, sub, file, brief string, lineNumber int) error {
+func (ms *mongodbStore) GetUserAnnotations(ctx context.Context, uid string, before int64, limit int) (annotations []store.Annotation, err error) {
+	filter := bson.M{"uid": uid}
+	if before > 0 {
+		filter["createdAt"] = bson.M{"$lt": before}
+	}
+	l := int64(limit)
+	option := &options.FindOptions{
+		Projection: bson.M{"revisions": 0},
+		Sort:       bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+		Limit:      &l,
+	}
+	cursor, err := ms.annotationCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	annotations = make([]store.Annotation, 0, limit)
+	for cursor.Next(ctx) {
+		var doc annotationDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		doc.Annotation.Id = doc.Id.Hex()
+		annotations = append(annotations, doc.Annotation)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) ReanchorAnnotation(ctx context.Context, id, hash string, lines store.LineRange, outdated bool) error {
+	update := bson.M{"$set": bson.M{"outdated": outdated}}
+	if !outdated {
+		update["$set"] = bson.M{
+			"hash":        hash,
+			"lineNumber":  lines.StartLine,
+			"startColumn": lines.StartColumn,
+			"endLine":     lines.EndLine,
+			"endColumn":   lines.EndColumn,
+		}
+	}
+	if err := ms.updateAnnotation(ctx, id, update); err != nil {
+		return err
+	}
+	_, err := ms.annotationCollection().UpdateMany(ctx, bson.M{"parent": id}, update)
+	return err
+}
+
+func (ms *mongodbStore) UpdateLatestAnnotation(ctx context.Context, pid, parent, sub, file, brief string, lines store.LineRange, timestamp int64) error {
 	filter := bson.M{
 		"pid":    pid,
 		"parent": parent,
@@ -239,10 +666,13 @@ func (ms *mongodbStore) UpdateLatestAnnotation(ctx context.Context, pid, parent,
 	}
 	update := bson.M{
 		"$set": bson.M{
-			"file":       file,
-			"brief":      brief,
-			"lineNumber": lineNumber,
-			"timestamp":  time.Now().Unix(),
+			"file":        file,
+			"brief":       brief,
+			"lineNumber":  lines.StartLine,
+			"startColumn": lines.StartColumn,
+			"endLine":     lines.EndLine,
+			"endColumn":   lines.EndColumn,
+			"timestamp":   timestamp,
 		},
 	}
 	upsert := true
@@ -255,6 +685,16 @@ func (ms *mongodbStore) UpdateLatestAnnotation(ctx context.Context, pid, parent,
 	return err
 }
 
+func (ms *mongodbStore) DeleteLatestAnnotation(ctx context.Context, pid, parent, sub string) error {
+	filter := bson.M{
+		"pid":    pid,
+		"parent": parent,
+		"sub":    sub,
+	}
+	_, err := ms.latestAnnotationCollection().DeleteOne(ctx, filter)
+	return err
+}
+
 func (ms *mongodbStore) GetLatestAnnotations(ctx context.Context, pid, parent string) (annotations []store.LatestAnnotation, err error) {
 	filter := bson.M{
 		"pid":    pid,
@@ -264,8 +704,10 @@ func (ms *mongodbStore) GetLatestAnnotations(ctx context.Context, pid, parent st
 	if err != nil {
 		return
 	}
-	var annotation store.LatestAnnotation
+	defer cursor.Close(ctx)
+
 	for cursor.Next(ctx) {
+		var annotation store.LatestAnnotation
 		if err = cursor.Decode(&annotation); err != nil {
 			return
 		}
@@ -273,3 +715,298 @@ func (ms *mongodbStore) GetLatestAnnotations(ctx context.Context, pid, parent st
 	}
 	return
 }
+
+func (ms *mongodbStore) GetProject(ctx context.Context, pid string) (info store.ProjectInfo, err error) {
+	id, err := primitive.ObjectIDFromHex(pid)
+	if err != nil {
+		err = store.ErrProjectNotExist
+		return
+	}
+	sr := ms.projectCollection().FindOne(ctx, bson.M{"_id": id})
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrProjectNotExist
+		}
+		return
+	}
+	if err = sr.Decode(&info); err != nil {
+		return
+	}
+	info.Id = pid
+	return
+}
+
+func (ms *mongodbStore) SetProjectVisibility(ctx context.Context, pid string, visibility store.Visibility) error {
+	id, err := primitive.ObjectIDFromHex(pid)
+	if err != nil {
+		return store.ErrProjectNotExist
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"visibility": visibility,
+		},
+	}
+	ur, err := ms.projectCollection().UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrProjectNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) RebaseProject(ctx context.Context, pid, hash string) error {
+	id, err := primitive.ObjectIDFromHex(pid)
+	if err != nil {
+		return store.ErrProjectNotExist
+	}
+	info, err := ms.GetProject(ctx, pid)
+	if err != nil {
+		return err
+	}
+	// projects are unique by owner, url and hash, see NewProject
+	if info.Hash != hash {
+		exists, err := ms.CommitProjectExists(ctx, info.Uid, info.Url, hash)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return store.ErrProjectExist
+		}
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"hash":    hash,
+			"indexed": false,
+		},
+	}
+	ur, err := ms.projectCollection().UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if ur.MatchedCount == 0 {
+		return store.ErrProjectNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) GetMemberRole(ctx context.Context, pid, uid string) (store.Role, error) {
+	filter := bson.M{
+		"pid": pid,
+		"uid": uid,
+	}
+	sr := ms.memberCollection().FindOne(ctx, filter)
+	if err := sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return store.RoleNone, nil
+		}
+		return store.RoleNone, err
+	}
+	var member store.Member
+	if err := sr.Decode(&member); err != nil {
+		return store.RoleNone, err
+	}
+	return member.Role, nil
+}
+
+func (ms *mongodbStore) SetMember(ctx context.Context, pid, uid string, role store.Role) error {
+	filter := bson.M{
+		"pid": pid,
+		"uid": uid,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"role": role,
+		},
+		"$setOnInsert": bson.M{
+			"createdAt": time.Now().Unix(),
+		},
+	}
+	upsert := true
+	_, err := ms.memberCollection().UpdateOne(ctx, filter, update, &options.UpdateOptions{Upsert: &upsert})
+	return err
+}
+
+func (ms *mongodbStore) RemoveMember(ctx context.Context, pid, uid string) error {
+	filter := bson.M{
+		"pid": pid,
+		"uid": uid,
+	}
+	dr, err := ms.memberCollection().DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrMemberNotExist
+	}
+	return nil
+}
+
+func (ms *mongodbStore) GetMembers(ctx context.Context, pid string) (members []store.Member, err error) {
+	option := &options.FindOptions{
+		Sort: bson.M{"createdAt": 1},
+	}
+	cursor, err := ms.memberCollection().Find(ctx, bson.M{"pid": pid}, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	members = make([]store.Member, 0, 8)
+	for cursor.Next(ctx) {
+		var member store.Member
+		if err = cursor.Decode(&member); err != nil {
+			return
+		}
+		members = append(members, member)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) GetMemberProjects(ctx context.Context, uid string) (pids []string, err error) {
+	option := &options.FindOptions{
+		Projection: bson.M{"pid": 1},
+	}
+	cursor, err := ms.memberCollection().Find(ctx, bson.M{"uid": uid}, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	pids = make([]string, 0, 8)
+	var tmp struct {
+		Pid string `bson:"pid"`
+	}
+	for cursor.Next(ctx) {
+		if err = cursor.Decode(&tmp); err != nil {
+			return
+		}
+		pids = append(pids, tmp.Pid)
+	}
+	err = cursor.Err()
+	return
+}
+
+type invitationDocument struct {
+	Id               primitive.ObjectID `bson:"_id"`
+	store.Invitation `bson:",inline"`
+}
+
+func (ms *mongodbStore) AddInvitation(ctx context.Context, invitation store.Invitation) (string, error) {
+	if invitation.Kind == store.InvitationKindLink {
+		ir, err := ms.invitationCollection().InsertOne(ctx, invitation)
+		if err != nil {
+			return "", err
+		}
+		return ir.InsertedID.(primitive.ObjectID).Hex(), nil
+	}
+
+	// an invitation replaces a join request of the invitee and vice versa
+	filter := bson.M{
+		"pid":     invitation.Pid,
+		"invitee": invitation.Invitee,
+	}
+	update := bson.M{
+		"$set": invitation,
+	}
+	upsert := true
+	returnDocument := options.After
+	option := &options.FindOneAndUpdateOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &returnDocument,
+		Projection:     bson.M{"_id": 1},
+	}
+	sr := ms.invitationCollection().FindOneAndUpdate(ctx, filter, update, option)
+	if err := sr.Err(); err != nil {
+		return "", err
+	}
+	var tmp struct {
+		Id primitive.ObjectID `bson:"_id"`
+	}
+	if err := sr.Decode(&tmp); err != nil {
+		return "", err
+	}
+	return tmp.Id.Hex(), nil
+}
+
+func (ms *mongodbStore) findInvitation(ctx context.Context, filter bson.M) (invitation store.Invitation, err error) {
+	sr := ms.invitationCollection().FindOne(ctx, filter)
+	if err = sr.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = store.ErrInvitationNotExist
+		}
+		return
+	}
+	var doc invitationDocument
+	if err = sr.Decode(&doc); err != nil {
+		return
+	}
+	invitation = doc.Invitation
+	invitation.Id = doc.Id.Hex()
+	return
+}
+
+func (ms *mongodbStore) GetInvitation(ctx context.Context, id string) (store.Invitation, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.Invitation{}, store.ErrInvitationNotExist
+	}
+	return ms.findInvitation(ctx, bson.M{"_id": oid})
+}
+
+func (ms *mongodbStore) GetInvitationByToken(ctx context.Context, token string) (store.Invitation, error) {
+	if token == "" {
+		return store.Invitation{}, store.ErrInvitationNotExist
+	}
+	return ms.findInvitation(ctx, bson.M{"token": token, "kind": store.InvitationKindLink})
+}
+
+func (ms *mongodbStore) GetInvitations(ctx context.Context, kind, pid, invitee string) (invitations []store.Invitation, err error) {
+	filter := bson.M{
+		"kind": kind,
+	}
+	if pid != "" {
+		filter["pid"] = pid
+	}
+	if invitee != "" {
+		filter["invitee"] = invitee
+	}
+	option := &options.FindOptions{
+		Sort: bson.M{"createdAt": -1},
+	}
+	cursor, err := ms.invitationCollection().Find(ctx, filter, option)
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	invitations = make([]store.Invitation, 0, 4)
+	for cursor.Next(ctx) {
+		var doc invitationDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return
+		}
+		doc.Invitation.Id = doc.Id.Hex()
+		invitations = append(invitations, doc.Invitation)
+	}
+	err = cursor.Err()
+	return
+}
+
+func (ms *mongodbStore) DeleteInvitation(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return store.ErrInvitationNotExist
+	}
+	dr, err := ms.invitationCollection().DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if dr.DeletedCount == 0 {
+		return store.ErrInvitationNotExist
+	}
+	return nil
+}
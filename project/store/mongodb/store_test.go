@@ -0,0 +1,38 @@
+package mongodb
+
+import (
+	"github.com/lt90s/rfschub-server/project/store"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"testing"
+)
+
+// applyUpsert applies update the way mongodb does for an upsert, $setOnInsert
+// only takes effect when no document matched
+func applyUpsert(doc bson.M, update bson.M) bson.M {
+	inserted := doc == nil
+	if inserted {
+		doc = bson.M{}
+	}
+	for op, fields := range update {
+		if op == "$setOnInsert" && !inserted {
+			continue
+		}
+		for key, value := range fields.(bson.M) {
+			doc[key] = value
+		}
+	}
+	return doc
+}
+
+func TestNewProjectUpdate(t *testing.T) {
+	doc := applyUpsert(nil, newProjectUpdate("name", false, store.VisibilityPrivate, false))
+	require.Equal(t, "name", doc["name"])
+	require.Equal(t, store.VisibilityPrivate, doc["visibility"])
+
+	// creating the project again does not change the existing one
+	doc = applyUpsert(doc, newProjectUpdate("other", true, store.VisibilityPublic, false))
+	require.Equal(t, "name", doc["name"])
+	require.Equal(t, false, doc["branch"])
+	require.Equal(t, store.VisibilityPrivate, doc["visibility"])
+}
@@ -6,43 +6,222 @@ import (
 )
 
 type Store interface {
-	NewProject(ctx context.Context, uid, url, hash, name string, branch bool) error
+	// org is true if uid is an organization
+	NewProject(ctx context.Context, uid, url, hash, name string, branch bool, visibility Visibility, org bool) error
 	GetProjectInfo(ctx context.Context, uid, url, name string) (ProjectInfo, error)
 	SetProjectIndexed(ctx context.Context, uid, url, hash string) error
 	ProjectExists(ctx context.Context, pid string) bool
+	// whether uid has a project of url at commit hash
+	CommitProjectExists(ctx context.Context, uid, url, hash string) (bool, error)
 	GetUserProjects(ctx context.Context, uid string) (projects []ProjectInfo, err error)
-	AddAnnotation(ctx context.Context, pid, uid, file, annotation string, lineNumber int) error
-	GetAnnotationLines(ctx context.Context, pid, file string) (lines []int32, err error)
-	GetAnnotations(ctx context.Context, pid, file string, lineNumber int) (records []AnnotationRecord, err error)
-	UpdateLatestAnnotation(ctx context.Context, pid, parent, sub, file, brief string, lineNumber int) error
+	AddAnnotation(ctx context.Context, annotation Annotation) (id string, err error)
+	GetAnnotation(ctx context.Context, id string) (Annotation, error)
+	// update text of an annotation, the old text is kept as a revision
+	UpdateAnnotation(ctx context.Context, id, annotation string) error
+	// delete an annotation along with its replies
+	DeleteAnnotation(ctx context.Context, id string) error
+	SetAnnotationResolved(ctx context.Context, id string, resolved bool) error
+	AddReaction(ctx context.Context, id, emoji, uid string) error
+	RemoveReaction(ctx context.Context, id, emoji, uid string) error
+	// get the newest thread of files under dir, dir itself may be a file.
+	// Outdated threads are excluded. ErrAnnotationNotExist is returned if there is none.
+	GetNewestAnnotation(ctx context.Context, pid, dir string) (Annotation, error)
+	// get line ranges of threads which are not outdated
+	GetAnnotationLines(ctx context.Context, pid, file string) (ranges []LineRange, err error)
+	// get threads of a line, or outdated threads of file if outdated is true,
+	// oldest first. total is the number of all threads.
+	GetAnnotations(ctx context.Context, pid, file string, lineNumber int, outdated bool, skip, limit int) (records []AnnotationRecord, total int64, err error)
+	// get threads of project which are not outdated, without revisions
+	GetThreads(ctx context.Context, pid string) (annotations []Annotation, err error)
+	// move a thread and its replies to lines of commit hash, or mark them as outdated
+	ReanchorAnnotation(ctx context.Context, id, hash string, lines LineRange, outdated bool) error
+	// get annotations written by uid before timestamp before, newest first,
+	// without revisions. before is ignored if it is 0
+	GetUserAnnotations(ctx context.Context, uid string, before int64, limit int) (annotations []Annotation, err error)
+	// get replies of threads, oldest first
+	GetReplies(ctx context.Context, parents []string) (records []AnnotationRecord, err error)
+	UpdateLatestAnnotation(ctx context.Context, pid, parent, sub, file, brief string, lines LineRange, timestamp int64) error
+	DeleteLatestAnnotation(ctx context.Context, pid, parent, sub string) error
 	GetLatestAnnotations(ctx context.Context, pid, parent string) (annotations []LatestAnnotation, err error)
+	// set ignore globs of project owned by uid
+	SetProjectIgnore(ctx context.Context, pid, uid string, ignore []string) error
+	GetProject(ctx context.Context, pid string) (ProjectInfo, error)
+	SetProjectVisibility(ctx context.Context, pid string, visibility Visibility) error
+	// move project to commit hash, the new commit is not indexed yet.
+	// ErrProjectExist is returned if the owner has a project of url at hash
+	RebaseProject(ctx context.Context, pid, hash string) error
+	// delete a project along with its annotations, members and invitations
+	DeleteProject(ctx context.Context, pid string) error
+	// hand project pid to uid, ErrProjectExist is returned if uid has a
+	// project of the same url and name, or of the same url and hash
+	TransferProject(ctx context.Context, pid, uid string) error
+	// remove uid from members and invitees of all projects
+	RemoveUserMemberships(ctx context.Context, uid string) error
+	// clear author of annotations written by uid, and remove reactions of uid
+	AnonymizeAnnotations(ctx context.Context, uid string) error
+
+	// role of a member, RoleNone if uid is not a member. Project owner is not
+	// stored as a member.
+	GetMemberRole(ctx context.Context, pid, uid string) (Role, error)
+	// add a member or change role of a member
+	SetMember(ctx context.Context, pid, uid string, role Role) error
+	RemoveMember(ctx context.Context, pid, uid string) error
+	GetMembers(ctx context.Context, pid string) (members []Member, err error)
+	// ids of projects uid is a member of
+	GetMemberProjects(ctx context.Context, uid string) (pids []string, err error)
+
+	// add an invitation, a pending invitation or join request of the same
+	// invitee of a project is replaced
+	AddInvitation(ctx context.Context, invitation Invitation) (id string, err error)
+	GetInvitation(ctx context.Context, id string) (Invitation, error)
+	GetInvitationByToken(ctx context.Context, token string) (Invitation, error)
+	// get invitations of kind, filtered by pid and invitee if not empty
+	GetInvitations(ctx context.Context, kind, pid, invitee string) (invitations []Invitation, err error)
+	DeleteInvitation(ctx context.Context, id string) error
 }
 
 var (
-	ErrProjectExist    = errors.New("project already created")
-	ErrProjectNotExist = errors.New("project not exist")
+	ErrProjectExist       = errors.New("project already created")
+	ErrProjectNotExist    = errors.New("project not exist")
+	ErrMemberNotExist     = errors.New("member not exist")
+	ErrInvitationNotExist = errors.New("invitation not exist")
+	ErrAnnotationNotExist = errors.New("annotation not exist")
+)
+
+// Visibility values are the same as proto.Visibility
+type Visibility int
+
+const (
+	VisibilityPublic Visibility = iota
+	// readable by anyone knowing it, but not listed
+	VisibilityUnlisted
+	// only readable by members
+	VisibilityPrivate
+)
+
+// Role values are the same as proto.Role
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleAnnotator
+	RoleMaintainer
+	RoleOwner
+)
+
+const (
+	// invite a user to join project
+	InvitationKindInvite = "invite"
+	// anyone holding the link token can join project
+	InvitationKindLink = "link"
+	// a user requests to join project
+	InvitationKindRequest = "request"
 )
 
 type ProjectInfo struct {
-	Id        string `bson:"-"`
-	Url       string `bson:"url"`
-	Name      string `bson:"name"`
-	Hash      string `bson:"hash"`
-	Branch    bool   `bson:"branch"`
-	Indexed   bool   `bson:"indexed"`
-	CreatedAt int64  `bson:"createdAt"`
+	Id         string     `bson:"-"`
+	Uid        string     `bson:"uid"`
+	Visibility Visibility `bson:"visibility"`
+	Url        string     `bson:"url"`
+	Name       string     `bson:"name"`
+	Hash       string     `bson:"hash"`
+	Branch     bool       `bson:"branch"`
+	Indexed    bool       `bson:"indexed"`
+	Ignore     []string   `bson:"ignore"`
+	CreatedAt  int64      `bson:"createdAt"`
+	// owned by an organization, members of it have their roles of the
+	// organization in the project
+	Org bool `bson:"org"`
 }
 
 type AnnotationRecord struct {
+	Id         string `bson:"-"`
+	Parent     string `bson:"parent"`
+	Uid        string `bson:"uid"`
+	Hash       string `bson:"hash"`
+	LineRange  `bson:",inline"`
+	Outdated   bool       `bson:"outdated"`
+	Annotation string     `bson:"annotation"`
+	Resolved   bool       `bson:"resolved"`
+	Reactions  []Reaction `bson:"reactions"`
+	CreatedAt  int64      `bson:"createdAt"`
+	UpdatedAt  int64      `bson:"updatedAt"`
+}
+
+// LineRange is the lines an annotation is anchored to. Lines and columns
+// start from 1, a zero column covers the whole line. Annotations saved
+// before ranges were supported have no EndLine.
+type LineRange struct {
+	StartLine   int `bson:"lineNumber"`
+	StartColumn int `bson:"startColumn,omitempty"`
+	EndLine     int `bson:"endLine,omitempty"`
+	EndColumn   int `bson:"endColumn,omitempty"`
+}
+
+// Normalize fills EndLine of single line ranges
+func (r LineRange) Normalize() LineRange {
+	if r.EndLine < r.StartLine {
+		r.EndLine = r.StartLine
+	}
+	return r
+}
+
+type Reaction struct {
+	Emoji string `bson:"emoji"`
+	Uid   string `bson:"uid"`
+}
+
+// An Annotation without parent starts a thread, replies of the thread have
+// the id of it as parent. LineRange refers to commit Hash of the project, an
+// outdated annotation is not moved along with the project since its code has
+// changed.
+type Annotation struct {
+	Id         string `bson:"-"`
+	Pid        string `bson:"pid"`
+	Parent     string `bson:"parent"`
 	Uid        string `bson:"uid"`
+	File       string `bson:"file"`
+	Hash       string `bson:"hash"`
+	LineRange  `bson:",inline"`
+	Outdated   bool                 `bson:"outdated"`
+	Annotation string               `bson:"annotation"`
+	Resolved   bool                 `bson:"resolved"`
+	Reactions  []Reaction           `bson:"reactions"`
+	CreatedAt  int64                `bson:"createdAt"`
+	UpdatedAt  int64                `bson:"updatedAt"`
+	Revisions  []AnnotationRevision `bson:"revisions"`
+}
+
+// an old text of an annotation
+type AnnotationRevision struct {
 	Annotation string `bson:"annotation"`
-	CreatedAt  int64  `bson:"createdAt"`
+	UpdatedAt  int64  `bson:"updatedAt"`
 }
 
 type LatestAnnotation struct {
-	Sub        string `bson:"sub"`
-	File       string `bson:"file"`
-	LineNumber int    `bson:"lineNumber"`
-	Brief      string `bson:"brief"`
-	Timestamp  int64  `bson:"timestamp"`
+	Sub       string `bson:"sub"`
+	File      string `bson:"file"`
+	LineRange `bson:",inline"`
+	Brief     string `bson:"brief"`
+	Timestamp int64  `bson:"timestamp"`
+}
+
+type Member struct {
+	Uid       string `bson:"uid"`
+	Role      Role   `bson:"role"`
+	CreatedAt int64  `bson:"createdAt"`
+}
+
+type Invitation struct {
+	Id      string `bson:"-"`
+	Pid     string `bson:"pid"`
+	Kind    string `bson:"kind"`
+	Inviter string `bson:"inviter"`
+	// user to join project, empty for link
+	Invitee   string `bson:"invitee"`
+	Token     string `bson:"token"`
+	Role      Role   `bson:"role"`
+	CreatedAt int64  `bson:"createdAt"`
+	ExpiresAt int64  `bson:"expiresAt"` // 0 for never
 }
@@ -31,9 +31,66 @@ func init() {
 
 func TestNewProject(t *testing.T) {
 	ctx := context.Background()
-	err := testedStore.NewProject(ctx, uid, url, hash, name, branch)
+	err := testedStore.NewProject(ctx, uid, url, hash, name, branch, store.VisibilityPrivate, false)
 	require.NoError(t, err)
 
-	err = testedStore.NewProject(ctx, uid, url, hash, name, branch)
+	err = testedStore.NewProject(ctx, uid, url, hash, name, branch, store.VisibilityPublic, false)
 	require.Equal(t, store.ErrProjectExist, err)
+
+	info, err := testedStore.GetProjectInfo(ctx, uid, url, name)
+	require.NoError(t, err)
+	require.Equal(t, store.VisibilityPrivate, info.Visibility)
+}
+
+func TestGetMemberProjects(t *testing.T) {
+	ctx := context.Background()
+	pids, err := testedStore.GetMemberProjects(ctx, "member")
+	require.NoError(t, err)
+	require.Len(t, pids, 0)
+
+	err = testedStore.SetMember(ctx, "pid1", "member", store.RoleAnnotator)
+	require.NoError(t, err)
+	err = testedStore.SetMember(ctx, "pid2", "other", store.RoleAnnotator)
+	require.NoError(t, err)
+
+	pids, err = testedStore.GetMemberProjects(ctx, "member")
+	require.NoError(t, err)
+	require.Equal(t, []string{"pid1"}, pids)
+}
+
+func TestAddInvitation(t *testing.T) {
+	ctx := context.Background()
+	pid := "pid"
+
+	id, err := testedStore.AddInvitation(ctx, store.Invitation{Pid: pid, Kind: store.InvitationKindRequest, Invitee: "invitee", Role: store.RoleAnnotator})
+	require.NoError(t, err)
+
+	// an invitation replaces the pending join request of the invitee
+	id2, err := testedStore.AddInvitation(ctx, store.Invitation{Pid: pid, Kind: store.InvitationKindInvite, Inviter: uid, Invitee: "invitee", Role: store.RoleMaintainer})
+	require.NoError(t, err)
+	require.Equal(t, id, id2)
+
+	requests, err := testedStore.GetInvitations(ctx, store.InvitationKindRequest, pid, "")
+	require.NoError(t, err)
+	require.Len(t, requests, 0)
+	invitations, err := testedStore.GetInvitations(ctx, store.InvitationKindInvite, "", "invitee")
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	require.Equal(t, store.RoleMaintainer, invitations[0].Role)
+
+	// links are never merged
+	link1, err := testedStore.AddInvitation(ctx, store.Invitation{Pid: pid, Kind: store.InvitationKindLink, Inviter: uid, Token: "token1"})
+	require.NoError(t, err)
+	link2, err := testedStore.AddInvitation(ctx, store.Invitation{Pid: pid, Kind: store.InvitationKindLink, Inviter: uid, Token: "token2"})
+	require.NoError(t, err)
+	require.NotEqual(t, link1, link2)
+
+	err = testedStore.DeleteInvitation(ctx, link1)
+	require.NoError(t, err)
+	_, err = testedStore.GetInvitation(ctx, link1)
+	require.Equal(t, store.ErrInvitationNotExist, err)
+	links, err := testedStore.GetInvitations(ctx, store.InvitationKindLink, pid, "")
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	require.Equal(t, link2, links[0].Id)
 }
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"github.com/lt90s/rfschub-server/repository/proto"
+	"os"
 	"testing"
 )
 
@@ -13,6 +14,10 @@ var (
 )
 
 func TestClient(t *testing.T) {
+	// the client connects to a running RepositoryService
+	if os.Getenv("REPOSITORY_TEST_SERVICE") == "" {
+		t.Skip("REPOSITORY_TEST_SERVICE not set, RepositoryService required")
+	}
 	client := New(conf)
 
 	rsp, err := client.IsRepositoryExist(context.Background(), &repository.RepositoryExistRequest{Url: "github.com/lt90s/goanalytics"})
@@ -9,7 +9,7 @@ import (
 
 type RepositoryConfig struct {
 	Name     string        `json:"name"`
-	Store    string        `json:"name"`
+	Store    string        `json:"store"`
 	Mongodb  MongodbConfig `json:"mongodb"`
 	MongoUri string        `json:"mongoduri"`
 	Syncer   SyncConfig    `json:"syncer"`
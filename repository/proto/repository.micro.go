@@ -38,6 +38,8 @@ type RepositoryService interface {
 	NamedCommits(ctx context.Context, in *NamedCommitsRequest, opts ...client.CallOption) (*NamedCommitsResponse, error)
 	Directory(ctx context.Context, in *DirectoryRequest, opts ...client.CallOption) (*DirectoryResponse, error)
 	Blob(ctx context.Context, in *BlobRequest, opts ...client.CallOption) (*BlobResponse, error)
+	// list synchronizations of branches, directories and blobs running
+	ListSyncTasks(ctx context.Context, in *ListSyncTasksRequest, opts ...client.CallOption) (*ListSyncTasksResponse, error)
 }
 
 type repositoryService struct {
@@ -98,6 +100,16 @@ func (c *repositoryService) Blob(ctx context.Context, in *BlobRequest, opts ...c
 	return out, nil
 }
 
+func (c *repositoryService) ListSyncTasks(ctx context.Context, in *ListSyncTasksRequest, opts ...client.CallOption) (*ListSyncTasksResponse, error) {
+	req := c.c.NewRequest(c.name, "RepositoryService.ListSyncTasks", in)
+	out := new(ListSyncTasksResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepositoryService service
 
 type RepositoryServiceHandler interface {
@@ -105,6 +117,8 @@ type RepositoryServiceHandler interface {
 	NamedCommits(context.Context, *NamedCommitsRequest, *NamedCommitsResponse) error
 	Directory(context.Context, *DirectoryRequest, *DirectoryResponse) error
 	Blob(context.Context, *BlobRequest, *BlobResponse) error
+	// list synchronizations of branches, directories and blobs running
+	ListSyncTasks(context.Context, *ListSyncTasksRequest, *ListSyncTasksResponse) error
 }
 
 func RegisterRepositoryServiceHandler(s server.Server, hdlr RepositoryServiceHandler, opts ...server.HandlerOption) error {
@@ -113,6 +127,7 @@ func RegisterRepositoryServiceHandler(s server.Server, hdlr RepositoryServiceHan
 		NamedCommits(ctx context.Context, in *NamedCommitsRequest, out *NamedCommitsResponse) error
 		Directory(ctx context.Context, in *DirectoryRequest, out *DirectoryResponse) error
 		Blob(ctx context.Context, in *BlobRequest, out *BlobResponse) error
+		ListSyncTasks(ctx context.Context, in *ListSyncTasksRequest, out *ListSyncTasksResponse) error
 	}
 	type RepositoryService struct {
 		repositoryService
@@ -140,3 +155,7 @@ func (h *repositoryServiceHandler) Directory(ctx context.Context, in *DirectoryR
 func (h *repositoryServiceHandler) Blob(ctx context.Context, in *BlobRequest, out *BlobResponse) error {
 	return h.RepositoryServiceHandler.Blob(ctx, in, out)
 }
+
+func (h *repositoryServiceHandler) ListSyncTasks(ctx context.Context, in *ListSyncTasksRequest, out *ListSyncTasksResponse) error {
+	return h.RepositoryServiceHandler.ListSyncTasks(ctx, in, out)
+}
@@ -44,7 +44,7 @@ func (x RepositoryErrorCode) String() string {
 	return proto.EnumName(RepositoryErrorCode_name, int32(x))
 }
 func (RepositoryErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{0}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{0}
 }
 
 type NamedCommitsRequest struct {
@@ -58,7 +58,7 @@ func (m *NamedCommitsRequest) Reset()         { *m = NamedCommitsRequest{} }
 func (m *NamedCommitsRequest) String() string { return proto.CompactTextString(m) }
 func (*NamedCommitsRequest) ProtoMessage()    {}
 func (*NamedCommitsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{0}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{0}
 }
 func (m *NamedCommitsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NamedCommitsRequest.Unmarshal(m, b)
@@ -96,7 +96,7 @@ func (m *NamedCommitsResponse) Reset()         { *m = NamedCommitsResponse{} }
 func (m *NamedCommitsResponse) String() string { return proto.CompactTextString(m) }
 func (*NamedCommitsResponse) ProtoMessage()    {}
 func (*NamedCommitsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{1}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{1}
 }
 func (m *NamedCommitsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NamedCommitsResponse.Unmarshal(m, b)
@@ -136,7 +136,7 @@ func (m *NamedCommit) Reset()         { *m = NamedCommit{} }
 func (m *NamedCommit) String() string { return proto.CompactTextString(m) }
 func (*NamedCommit) ProtoMessage()    {}
 func (*NamedCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{2}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{2}
 }
 func (m *NamedCommit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NamedCommit.Unmarshal(m, b)
@@ -190,7 +190,7 @@ func (m *RepositoryExistRequest) Reset()         { *m = RepositoryExistRequest{}
 func (m *RepositoryExistRequest) String() string { return proto.CompactTextString(m) }
 func (*RepositoryExistRequest) ProtoMessage()    {}
 func (*RepositoryExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{3}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{3}
 }
 func (m *RepositoryExistRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RepositoryExistRequest.Unmarshal(m, b)
@@ -242,7 +242,7 @@ func (m *RepositoryExistResponse) Reset()         { *m = RepositoryExistResponse
 func (m *RepositoryExistResponse) String() string { return proto.CompactTextString(m) }
 func (*RepositoryExistResponse) ProtoMessage()    {}
 func (*RepositoryExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{4}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{4}
 }
 func (m *RepositoryExistResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RepositoryExistResponse.Unmarshal(m, b)
@@ -282,7 +282,7 @@ func (m *DirectoryRequest) Reset()         { *m = DirectoryRequest{} }
 func (m *DirectoryRequest) String() string { return proto.CompactTextString(m) }
 func (*DirectoryRequest) ProtoMessage()    {}
 func (*DirectoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{5}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{5}
 }
 func (m *DirectoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryRequest.Unmarshal(m, b)
@@ -334,7 +334,7 @@ func (m *DirectoryResponse) Reset()         { *m = DirectoryResponse{} }
 func (m *DirectoryResponse) String() string { return proto.CompactTextString(m) }
 func (*DirectoryResponse) ProtoMessage()    {}
 func (*DirectoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{6}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{6}
 }
 func (m *DirectoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryResponse.Unmarshal(m, b)
@@ -373,7 +373,7 @@ func (m *DirectoryEntry) Reset()         { *m = DirectoryEntry{} }
 func (m *DirectoryEntry) String() string { return proto.CompactTextString(m) }
 func (*DirectoryEntry) ProtoMessage()    {}
 func (*DirectoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{7}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{7}
 }
 func (m *DirectoryEntry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryEntry.Unmarshal(m, b)
@@ -420,7 +420,7 @@ func (m *BlobRequest) Reset()         { *m = BlobRequest{} }
 func (m *BlobRequest) String() string { return proto.CompactTextString(m) }
 func (*BlobRequest) ProtoMessage()    {}
 func (*BlobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{8}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{8}
 }
 func (m *BlobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlobRequest.Unmarshal(m, b)
@@ -473,7 +473,7 @@ func (m *BlobResponse) Reset()         { *m = BlobResponse{} }
 func (m *BlobResponse) String() string { return proto.CompactTextString(m) }
 func (*BlobResponse) ProtoMessage()    {}
 func (*BlobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_0518ff3e483ebad8, []int{9}
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{9}
 }
 func (m *BlobResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlobResponse.Unmarshal(m, b)
@@ -507,6 +507,137 @@ func (m *BlobResponse) GetPlain() bool {
 	return false
 }
 
+type ListSyncTasksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSyncTasksRequest) Reset()         { *m = ListSyncTasksRequest{} }
+func (m *ListSyncTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSyncTasksRequest) ProtoMessage()    {}
+func (*ListSyncTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{10}
+}
+func (m *ListSyncTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSyncTasksRequest.Unmarshal(m, b)
+}
+func (m *ListSyncTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSyncTasksRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSyncTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSyncTasksRequest.Merge(dst, src)
+}
+func (m *ListSyncTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSyncTasksRequest.Size(m)
+}
+func (m *ListSyncTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSyncTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSyncTasksRequest proto.InternalMessageInfo
+
+// commit and file are empty when branches and tags are synchronized
+type SyncTask struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Commit               string   `protobuf:"bytes,2,opt,name=commit" json:"commit,omitempty"`
+	File                 string   `protobuf:"bytes,3,opt,name=file" json:"file,omitempty"`
+	StartedAt            int64    `protobuf:"varint,4,opt,name=startedAt" json:"startedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncTask) Reset()         { *m = SyncTask{} }
+func (m *SyncTask) String() string { return proto.CompactTextString(m) }
+func (*SyncTask) ProtoMessage()    {}
+func (*SyncTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{11}
+}
+func (m *SyncTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncTask.Unmarshal(m, b)
+}
+func (m *SyncTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncTask.Marshal(b, m, deterministic)
+}
+func (dst *SyncTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncTask.Merge(dst, src)
+}
+func (m *SyncTask) XXX_Size() int {
+	return xxx_messageInfo_SyncTask.Size(m)
+}
+func (m *SyncTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncTask proto.InternalMessageInfo
+
+func (m *SyncTask) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SyncTask) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *SyncTask) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *SyncTask) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+type ListSyncTasksResponse struct {
+	Tasks                []*SyncTask `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListSyncTasksResponse) Reset()         { *m = ListSyncTasksResponse{} }
+func (m *ListSyncTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSyncTasksResponse) ProtoMessage()    {}
+func (*ListSyncTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_88ffc0a4bdebdb27, []int{12}
+}
+func (m *ListSyncTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSyncTasksResponse.Unmarshal(m, b)
+}
+func (m *ListSyncTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSyncTasksResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSyncTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSyncTasksResponse.Merge(dst, src)
+}
+func (m *ListSyncTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSyncTasksResponse.Size(m)
+}
+func (m *ListSyncTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSyncTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSyncTasksResponse proto.InternalMessageInfo
+
+func (m *ListSyncTasksResponse) GetTasks() []*SyncTask {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NamedCommitsRequest)(nil), "repository.NamedCommitsRequest")
 	proto.RegisterType((*NamedCommitsResponse)(nil), "repository.NamedCommitsResponse")
@@ -518,41 +649,49 @@ func init() {
 	proto.RegisterType((*DirectoryEntry)(nil), "repository.DirectoryEntry")
 	proto.RegisterType((*BlobRequest)(nil), "repository.BlobRequest")
 	proto.RegisterType((*BlobResponse)(nil), "repository.BlobResponse")
+	proto.RegisterType((*ListSyncTasksRequest)(nil), "repository.ListSyncTasksRequest")
+	proto.RegisterType((*SyncTask)(nil), "repository.SyncTask")
+	proto.RegisterType((*ListSyncTasksResponse)(nil), "repository.ListSyncTasksResponse")
 	proto.RegisterEnum("repository.RepositoryErrorCode", RepositoryErrorCode_name, RepositoryErrorCode_value)
 }
 
-func init() { proto.RegisterFile("repository.proto", fileDescriptor_repository_0518ff3e483ebad8) }
-
-var fileDescriptor_repository_0518ff3e483ebad8 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xa5, 0x9b, 0xd2, 0x76, 0xa7, 0x11, 0x4a, 0x67, 0x57, 0xdb, 0xa8, 0x02, 0x51, 0x99, 0x03,
-	0x15, 0x87, 0x45, 0x2c, 0x08, 0x89, 0x0b, 0x07, 0x96, 0x05, 0x2a, 0xc1, 0x4a, 0xb8, 0x57, 0x2e,
-	0x69, 0x6a, 0x54, 0x4b, 0xad, 0x1d, 0x6c, 0x17, 0xd1, 0x5f, 0xe9, 0xdf, 0x00, 0x27, 0xfe, 0x0a,
-	0x39, 0xb1, 0x53, 0x97, 0x6d, 0x91, 0x10, 0xb7, 0xf1, 0xf8, 0xcd, 0x7b, 0x99, 0xe7, 0x99, 0x40,
-	0xa2, 0x58, 0x21, 0x35, 0x37, 0x52, 0xad, 0xcf, 0x0b, 0x25, 0x8d, 0x44, 0xd8, 0x66, 0xc8, 0x43,
-	0x38, 0xb9, 0xce, 0x96, 0x6c, 0x76, 0x29, 0x97, 0x4b, 0x6e, 0x34, 0x65, 0x5f, 0x56, 0x4c, 0x1b,
-	0x4c, 0x20, 0x5a, 0xa9, 0x45, 0xda, 0x18, 0x36, 0x46, 0xc7, 0xd4, 0x86, 0x64, 0x0c, 0xa7, 0xbb,
-	0x40, 0x5d, 0x48, 0xa1, 0x19, 0x3e, 0x81, 0x76, 0x5e, 0xa5, 0xd2, 0x68, 0x18, 0x8d, 0xba, 0x17,
-	0xfd, 0xf3, 0x40, 0x30, 0x28, 0xa1, 0x1e, 0x47, 0x3e, 0x40, 0x37, 0xc8, 0x23, 0x42, 0x53, 0x64,
-	0x4b, 0xe6, 0xc4, 0xca, 0xd8, 0xe6, 0xe6, 0x99, 0x9e, 0xa7, 0x47, 0x55, 0xce, 0xc6, 0x78, 0x06,
-	0xad, 0xa9, 0xca, 0x44, 0x3e, 0x4f, 0xa3, 0x61, 0x63, 0xd4, 0xa1, 0xee, 0x44, 0x28, 0x9c, 0xd1,
-	0x5a, 0xf1, 0xea, 0x1b, 0xd7, 0xe6, 0x60, 0x17, 0x7b, 0x79, 0xbd, 0x7e, 0xb4, 0xd5, 0x27, 0x8f,
-	0xa1, 0x7f, 0x83, 0xd3, 0x35, 0x7c, 0x0a, 0xb7, 0x99, 0x4d, 0x94, 0xb4, 0x1d, 0x5a, 0x1d, 0xc8,
-	0x7b, 0x48, 0x5e, 0x73, 0xc5, 0x72, 0x8b, 0xff, 0x67, 0xf9, 0x22, 0x33, 0x73, 0x2f, 0x6f, 0x63,
-	0x32, 0x86, 0x5e, 0xc0, 0xe6, 0x84, 0x9f, 0x41, 0x9b, 0x09, 0xa3, 0x38, 0xf3, 0x4e, 0x0f, 0x42,
-	0xa7, 0x6b, 0xfc, 0x95, 0x30, 0x6a, 0x4d, 0x3d, 0x94, 0x3c, 0x87, 0x3b, 0xbb, 0x57, 0x56, 0xf0,
-	0x33, 0x5f, 0xd4, 0x7e, 0xdb, 0xd8, 0x7e, 0xea, 0x8c, 0xab, 0xf2, 0xbb, 0x3a, 0xd4, 0x86, 0xe4,
-	0x2d, 0x74, 0x5f, 0x2d, 0xe4, 0xf4, 0xff, 0x7b, 0x79, 0x09, 0x71, 0x45, 0xe4, 0xda, 0x48, 0xed,
-	0xc0, 0x08, 0xc3, 0x84, 0x71, 0x30, 0x7f, 0xb4, 0xce, 0x16, 0x8b, 0x8c, 0x8b, 0xb4, 0x59, 0x39,
-	0x5b, 0x1e, 0x1e, 0xe5, 0x70, 0x12, 0x3c, 0x85, 0x52, 0x52, 0x5d, 0xca, 0x19, 0xc3, 0x2e, 0xb4,
-	0x27, 0xab, 0x3c, 0x67, 0x5a, 0x27, 0xb7, 0x30, 0x86, 0xd6, 0x58, 0x4c, 0xd6, 0x22, 0x4f, 0xbe,
-	0x6f, 0x62, 0x4c, 0x01, 0xb7, 0x15, 0xd7, 0xd2, 0xbc, 0x91, 0x2b, 0x31, 0x4b, 0x7e, 0x6c, 0x62,
-	0xec, 0x07, 0xbe, 0xd6, 0x17, 0x3f, 0x37, 0xf1, 0xc5, 0xaf, 0x23, 0xe8, 0x6d, 0x6b, 0x26, 0x4c,
-	0x7d, 0xe5, 0x39, 0xc3, 0x4f, 0xd0, 0x1b, 0xeb, 0x3f, 0xe6, 0x00, 0x49, 0xe8, 0xfa, 0xfe, 0xc1,
-	0x1b, 0x3c, 0xf8, 0x2b, 0xc6, 0x19, 0xf1, 0x11, 0xe2, 0x70, 0xa3, 0xf0, 0xfe, 0x81, 0xc5, 0xf1,
-	0x4b, 0x39, 0x18, 0x1e, 0x06, 0x38, 0xca, 0x77, 0x70, 0x5c, 0xf7, 0x87, 0x77, 0xf7, 0x8e, 0x87,
-	0x27, 0xbb, 0x77, 0xe0, 0xd6, 0x31, 0xbd, 0x80, 0xa6, 0x7d, 0x35, 0xdc, 0xd9, 0xe6, 0x60, 0x20,
-	0x06, 0xe9, 0xcd, 0x8b, 0xaa, 0x74, 0xda, 0x2a, 0xff, 0x32, 0x4f, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0xbb, 0xc8, 0x48, 0x40, 0x79, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("repository.proto", fileDescriptor_repository_88ffc0a4bdebdb27) }
+
+var fileDescriptor_repository_88ffc0a4bdebdb27 = []byte{
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0x13, 0x3f,
+	0x10, 0xfe, 0xe5, 0xb7, 0x69, 0xfe, 0x4c, 0x16, 0xb4, 0x71, 0x43, 0xb2, 0x8a, 0x8a, 0x08, 0xe6,
+	0x40, 0xd4, 0x43, 0x11, 0x05, 0x21, 0x71, 0x41, 0x82, 0x50, 0x20, 0x52, 0xa9, 0x84, 0xd3, 0x23,
+	0x97, 0xcd, 0xc6, 0x55, 0x2c, 0x12, 0x3b, 0xd8, 0x0e, 0x22, 0xaf, 0x92, 0x0b, 0xcf, 0x02, 0xbc,
+	0x18, 0xf2, 0xae, 0xbd, 0x71, 0xda, 0xa4, 0x12, 0xe2, 0x36, 0x33, 0xfe, 0x66, 0xbe, 0x99, 0xf1,
+	0x67, 0x43, 0x24, 0xe9, 0x42, 0x28, 0xa6, 0x85, 0x5c, 0x9d, 0x2c, 0xa4, 0xd0, 0x02, 0xc1, 0x26,
+	0x82, 0x1f, 0xc3, 0xe1, 0x45, 0x32, 0xa7, 0x93, 0x81, 0x98, 0xcf, 0x99, 0x56, 0x84, 0x7e, 0x5d,
+	0x52, 0xa5, 0x51, 0x04, 0xc1, 0x52, 0xce, 0xe2, 0x52, 0xaf, 0xd4, 0xaf, 0x13, 0x63, 0xe2, 0x21,
+	0xb4, 0xb6, 0x81, 0x6a, 0x21, 0xb8, 0xa2, 0xe8, 0x29, 0x54, 0xd3, 0x3c, 0x14, 0x07, 0xbd, 0xa0,
+	0xdf, 0x38, 0xed, 0x9c, 0x78, 0x84, 0x5e, 0x0a, 0x71, 0x38, 0xfc, 0x11, 0x1a, 0x5e, 0x1c, 0x21,
+	0x28, 0xf3, 0x64, 0x4e, 0x2d, 0x59, 0x66, 0x9b, 0xd8, 0x34, 0x51, 0xd3, 0xf8, 0xff, 0x3c, 0x66,
+	0x6c, 0xd4, 0x86, 0xca, 0x58, 0x26, 0x3c, 0x9d, 0xc6, 0x41, 0xaf, 0xd4, 0xaf, 0x11, 0xeb, 0x61,
+	0x02, 0x6d, 0x52, 0x30, 0x9e, 0x7d, 0x67, 0x4a, 0xef, 0x9d, 0x62, 0x67, 0x5d, 0xc7, 0x1f, 0x6c,
+	0xf8, 0xf1, 0x13, 0xe8, 0xdc, 0xa8, 0x69, 0x07, 0x6e, 0xc1, 0x01, 0x35, 0x81, 0xac, 0x6c, 0x8d,
+	0xe4, 0x0e, 0x3e, 0x87, 0xe8, 0x2d, 0x93, 0x34, 0x35, 0xf8, 0xbf, 0xa6, 0x5f, 0x24, 0x7a, 0xea,
+	0xe8, 0x8d, 0x8d, 0x87, 0xd0, 0xf4, 0xaa, 0x59, 0xe2, 0xe7, 0x50, 0xa5, 0x5c, 0x4b, 0x46, 0xdd,
+	0xa6, 0xbb, 0xfe, 0xa6, 0x0b, 0xfc, 0x19, 0xd7, 0x72, 0x45, 0x1c, 0x14, 0xbf, 0x80, 0xbb, 0xdb,
+	0x47, 0x86, 0xf0, 0x8a, 0xcd, 0x8a, 0x7d, 0x1b, 0xdb, 0xb4, 0x3a, 0x61, 0x32, 0xeb, 0xab, 0x46,
+	0x8c, 0x89, 0xdf, 0x43, 0xe3, 0xcd, 0x4c, 0x8c, 0xff, 0x7d, 0x96, 0x57, 0x10, 0xe6, 0x85, 0xec,
+	0x18, 0xb1, 0x11, 0x0c, 0xd7, 0x94, 0x6b, 0x0b, 0x73, 0xae, 0xd9, 0xec, 0x62, 0x96, 0x30, 0x1e,
+	0x97, 0xf3, 0xcd, 0x66, 0x0e, 0x6e, 0x43, 0xeb, 0x9c, 0x29, 0x3d, 0x5a, 0xf1, 0xf4, 0x32, 0x51,
+	0x5f, 0x9c, 0x44, 0xf1, 0x15, 0xd4, 0x5c, 0x6c, 0x47, 0x77, 0x6d, 0xa8, 0xe4, 0x72, 0xb3, 0xfd,
+	0x59, 0xaf, 0x18, 0x3e, 0xf0, 0x86, 0x3f, 0x82, 0xba, 0xd2, 0x89, 0xd4, 0x74, 0xf2, 0x5a, 0x67,
+	0xdc, 0x01, 0xd9, 0x04, 0xf0, 0x00, 0xee, 0x5d, 0xe3, 0xb7, 0x83, 0x1c, 0xc3, 0x81, 0x36, 0x81,
+	0xb8, 0x94, 0xdd, 0x46, 0xcb, 0xbf, 0x0d, 0x87, 0x26, 0x39, 0xe4, 0x38, 0x85, 0x43, 0x4f, 0x4f,
+	0x52, 0x0a, 0x39, 0x10, 0x13, 0x8a, 0x1a, 0x50, 0x1d, 0x2d, 0xd3, 0x94, 0x2a, 0x15, 0xfd, 0x87,
+	0x42, 0xa8, 0x0c, 0xb9, 0x49, 0x8c, 0x7e, 0xae, 0x43, 0x14, 0x03, 0xda, 0x64, 0x5c, 0x08, 0xfd,
+	0x4e, 0x2c, 0xf9, 0x24, 0xfa, 0xb5, 0x0e, 0x51, 0xc7, 0x13, 0x47, 0x71, 0xf0, 0x7b, 0x1d, 0x9e,
+	0xfe, 0x08, 0xa0, 0xb9, 0xc9, 0x19, 0x51, 0xf9, 0x8d, 0xa5, 0x14, 0x7d, 0x86, 0xe6, 0x50, 0x5d,
+	0x13, 0x33, 0xc2, 0x7e, 0xb3, 0xbb, 0x5f, 0x4f, 0xf7, 0xd1, 0xad, 0x18, 0xbb, 0x84, 0x4f, 0x10,
+	0xfa, 0xdf, 0x02, 0x7a, 0xb0, 0xe7, 0xf5, 0xbb, 0x6b, 0xeb, 0xf6, 0xf6, 0x03, 0x6c, 0xc9, 0x0f,
+	0x50, 0x2f, 0xe6, 0x43, 0x47, 0x3b, 0x35, 0xee, 0x8a, 0xdd, 0xdf, 0x73, 0x6a, 0x2b, 0xbd, 0x84,
+	0xb2, 0x91, 0x1e, 0xda, 0xfa, 0x92, 0x3c, 0x55, 0x77, 0xe3, 0x9b, 0x07, 0x36, 0xf5, 0x12, 0xee,
+	0x6c, 0xdd, 0x3a, 0xda, 0xea, 0x7b, 0x97, 0x20, 0xbb, 0x0f, 0x6f, 0x41, 0xe4, 0x55, 0xc7, 0x95,
+	0xec, 0x03, 0x7e, 0xf6, 0x67, 0x00, 0x75, 0x72, 0xab, 0x9f, 0x94, 0x05, 0x00, 0x00,
 }
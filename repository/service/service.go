@@ -160,3 +160,17 @@ func (r *RepositoryService) Blob(ctx context.Context, req *proto.BlobRequest, rs
 	rsp.Plain = blob.Plain
 	return nil
 }
+
+func (r *RepositoryService) ListSyncTasks(ctx context.Context, req *proto.ListSyncTasksRequest, rsp *proto.ListSyncTasksResponse) error {
+	tasks := r.syncer.listTasks()
+	rsp.Tasks = make([]*proto.SyncTask, 0, len(tasks))
+	for _, task := range tasks {
+		rsp.Tasks = append(rsp.Tasks, &proto.SyncTask{
+			Url:       task.url,
+			Commit:    task.commit,
+			File:      task.file,
+			StartedAt: task.startedAt,
+		})
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lt90s/rfschub-server/repository/store/mockdb"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"os"
 	"testing"
 	"time"
 )
@@ -18,44 +19,50 @@ func init() {
 	logrus.SetLevel(logrus.DebugLevel)
 }
 
-func TestRepositoryService_Repository_Directory_Blob(t *testing.T) {
+// skip tests which need a running GitService unless REPOSITORY_TEST_GITS is
+// set
+func requireGits(t *testing.T) {
+	if os.Getenv("REPOSITORY_TEST_GITS") == "" {
+		t.Skip("REPOSITORY_TEST_GITS not set, GitService required")
+	}
+}
+
+func TestRepositoryService_NamedCommits_Directory_Blob(t *testing.T) {
+	requireGits(t)
 	store := mockdb.NewMockStore()
 	rs := NewRepositoryService(config.DefaultConfig, store)
 
 	ctx := context.Background()
 
-	var rsp proto.RepositoryResponse
+	var rsp proto.NamedCommitsResponse
 	for i := 0; i < 10; i++ {
-		err := rs.Repository(ctx, &proto.RepositoryRequest{Url: repoUrl}, &rsp)
-		require.NoError(t, err)
-		if rsp.Code == proto.RepositoryErrorCode_Success {
+		err := rs.NamedCommits(ctx, &proto.NamedCommitsRequest{Url: repoUrl}, &rsp)
+		if err == nil {
 			break
 		}
-		require.Equal(t, proto.RepositoryErrorCode_InSync, rsp.Code)
+		require.Equal(t, errorInSync, err)
 		time.Sleep(100 * time.Millisecond)
 	}
 	t.Log(rsp.Commits)
 
 	var dRsp proto.DirectoryResponse
 	for i := 0; i < 10; i++ {
-		err := rs.Directory(ctx, &proto.DirectoryRequest{Url: repoUrl, Commit: commit, Path: "api"}, &dRsp)
-		require.NoError(t, err)
-		if dRsp.Code == proto.RepositoryErrorCode_Success {
+		err := rs.Directory(ctx, &proto.DirectoryRequest{Url: repoUrl, Hash: commit, Path: "api"}, &dRsp)
+		if err == nil {
 			break
 		}
-		require.Equal(t, proto.RepositoryErrorCode_InSync, dRsp.Code)
+		require.Equal(t, errorInSync, err)
 		time.Sleep(100 * time.Millisecond)
 	}
 	t.Log(dRsp.Entries)
 
 	var bRsp proto.BlobResponse
 	for i := 0; i < 10; i++ {
-		err := rs.Blob(ctx, &proto.BlobRequest{Url: repoUrl, Commit: commit, Path: ".gitignore"}, &bRsp)
-		require.NoError(t, err)
-		if bRsp.Code == proto.RepositoryErrorCode_Success {
+		err := rs.Blob(ctx, &proto.BlobRequest{Url: repoUrl, Hash: commit, Path: ".gitignore"}, &bRsp)
+		if err == nil {
 			break
 		}
-		require.Equal(t, proto.RepositoryErrorCode_InSync, bRsp.Code)
+		require.Equal(t, errorInSync, err)
 		time.Sleep(100 * time.Millisecond)
 	}
 	require.True(t, bRsp.Plain)
@@ -9,6 +9,7 @@ import (
 	"github.com/lt90s/rfschub-server/repository/store"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -20,12 +21,22 @@ var (
 	ErrRepositoryNotFound = errors.New("repository not found")
 )
 
+// syncTask is a synchronization running, commit and file are empty when
+// branches and tags are synchronized
+type syncTask struct {
+	cancel    context.CancelFunc
+	url       string
+	commit    string
+	file      string
+	startedAt int64
+}
+
 type syncer struct {
 	store     store.Store
 	gitClient gits.GitsService
 	sema      *semaphore.Weighted
 	mutext    sync.RWMutex
-	tasks     map[string]context.CancelFunc
+	tasks     map[string]syncTask
 	wg        *sync.WaitGroup
 	timeout   time.Duration
 }
@@ -34,11 +45,15 @@ func newSyncer(config config.RepositoryConfig, store store.Store) *syncer {
 	gitConf := client.ServerConfig{
 		ServiceName: config.Syncer.Gits,
 	}
+	return newSyncerWithClient(config, store, client.New(gitConf))
+}
+
+func newSyncerWithClient(config config.RepositoryConfig, store store.Store, gitClient gits.GitsService) *syncer {
 	return &syncer{
 		store:     store,
-		gitClient: client.New(gitConf),
+		gitClient: gitClient,
 		sema:      semaphore.NewWeighted(int64(config.Syncer.Concurrency)),
-		tasks:     make(map[string]context.CancelFunc),
+		tasks:     make(map[string]syncTask),
 		wg:        &sync.WaitGroup{},
 		timeout:   time.Duration(config.Syncer.Timeout) * time.Second,
 	}
@@ -70,7 +85,13 @@ func (s *syncer) prepareSync(url, commit, file string) (context.Context, error)
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
 
-	s.tasks[key] = cancel
+	s.tasks[key] = syncTask{
+		cancel:    cancel,
+		url:       url,
+		commit:    commit,
+		file:      file,
+		startedAt: time.Now().Unix(),
+	}
 	s.mutext.Unlock()
 
 	s.wg.Add(1)
@@ -83,9 +104,11 @@ func (s *syncer) prepareSync(url, commit, file string) (context.Context, error)
 // 4. delete task
 func (s *syncer) finishSync(ctx context.Context, url, commit, file string) {
 	key := url + "@" + commit
-	cancel := s.tasks[key]
+	s.mutext.RLock()
+	task := s.tasks[key]
+	s.mutext.RUnlock()
 	s.wg.Done()
-	cancel()
+	task.cancel()
 	s.sema.Release(1)
 	s.mutext.Lock()
 	delete(s.tasks, key)
@@ -207,11 +230,29 @@ func (s *syncer) doSyncBlob(ctx context.Context, url, commit, file string) {
 func (s *syncer) shutdown() {
 	s.mutext.Lock()
 	defer s.mutext.Unlock()
-	for _, cancel := range s.tasks {
-		cancel()
+	for _, task := range s.tasks {
+		task.cancel()
 	}
 }
 
+// synchronizations running, oldest first
+func (s *syncer) listTasks() []syncTask {
+	s.mutext.RLock()
+	tasks := make([]syncTask, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		tasks = append(tasks, task)
+	}
+	s.mutext.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].startedAt != tasks[j].startedAt {
+			return tasks[i].startedAt < tasks[j].startedAt
+		}
+		return tasks[i].url+"@"+tasks[i].commit < tasks[j].url+"@"+tasks[j].commit
+	})
+	return tasks
+}
+
 func (s *syncer) wait(timeout time.Duration) {
 	go func() {
 		<-time.NewTimer(timeout).C
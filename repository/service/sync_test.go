@@ -15,6 +15,7 @@ const (
 )
 
 func TestSyncRepository(t *testing.T) {
+	requireGits(t)
 	store := mockdb.NewMockStore()
 	syncer := newSyncer(config.DefaultConfig, store)
 	ctx := context.Background()
@@ -33,6 +34,7 @@ func TestSyncRepository(t *testing.T) {
 }
 
 func TestSyncDirectories_SyncBlob(t *testing.T) {
+	requireGits(t)
 	store := mockdb.NewMockStore()
 
 	syncer := newSyncer(config.DefaultConfig, store)
@@ -70,3 +72,22 @@ func TestSyncDirectories_SyncBlob(t *testing.T) {
 	require.True(t, blob.Plain)
 	require.Equal(t, ".idea\n*.exe", blob.Content)
 }
+
+func TestSyncer_listTasks(t *testing.T) {
+	// tasks are listed without synchronizing anything
+	syncer := newSyncerWithClient(config.DefaultConfig, mockdb.NewMockStore(), nil)
+	ctx1, err := syncer.prepareSync(repoUrl, "", "")
+	require.NoError(t, err)
+	ctx2, err := syncer.prepareSync(repoUrl, commit, "README.md")
+	require.NoError(t, err)
+
+	tasks := syncer.listTasks()
+	require.Len(t, tasks, 2)
+	require.Equal(t, "", tasks[0].commit)
+	require.Equal(t, commit, tasks[1].commit)
+	require.Equal(t, "README.md", tasks[1].file)
+
+	syncer.finishSync(ctx1, repoUrl, "", "")
+	syncer.finishSync(ctx2, repoUrl, commit, "README.md")
+	require.Empty(t, syncer.listTasks())
+}
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"github.com/lt90s/rfschub-server/syntect/config"
 	"github.com/sirupsen/logrus"
+	"os"
 	"testing"
 )
 
@@ -10,6 +12,9 @@ func init() {
 }
 
 func TestNewSyntectService(t *testing.T) {
+	if _, err := os.Stat(config.DefaultConfig.Syntect.Path); err != nil {
+		t.Skipf("syntect server not found: path=%s", config.DefaultConfig.Syntect.Path)
+	}
 	s := NewSyntectService()
 	s.Stop()
 }